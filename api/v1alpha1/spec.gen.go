@@ -18,93 +18,343 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x96XLbuJb/q6B4b1XsvqSs3bb+1fUvxVI6utfb9ZLp6VZGBZFHEhISYAOgbSWlr/MA",
-	"84jzJFMAuIuyZcdOesk3RwRB4OCc3/mdhcxny2VByChQKazeZyvEHAcgget/HWGJfTYfSQhG3jmWC/Wj",
-	"B8LlJJSEUatnXVPyWwSIeEAlmRHgaMY4kgtArrkZEQmBZVtwh4PQB6tniQD7vnOjfiRqilBNbFsUB+qq",
-	"m3+mZVscfosIB8/qSR6BbQl3AQE2a5USuJrhv37Fzqe6c/h+J/7Def+5bncbq+T33f//d8u25DLUz5ec",
-	"0Lm1WtmFDVIhMXXhyzaKSDzNE3ecLuKld34J/Ia4cLUMn7BjYW5Getr8RjdtUeSf9rJbW6nZRcioAK3D",
-	"fZ8D9pbDOyKMiruMSqBS/YnD0CcuVvvd+yDUpj9nm1HikJj4Vi8vLHRL5AIRD726CRx1WB7m3iuEzVMQ",
-	"mMcoIcR60LPqbnd/vugunH047Dr7HRccaC0OHGjMuwetxax9eKBEJSSWkbB67fqhbUkitUAvQLCIu7D+",
-	"gHjf/eOLYX/wn5Phz6PLq0trlZfl3znMrJ71t73MxvfMVbE35JxxI67iqcfyQrHAVrb1GnsX8FsEQj5R",
-	"fG8I+B56FSvBRK38FQoiIRFlEk0BQRDKZVFo+4ettjdrgdOedltOu3k4dab1WceZHnitTh3cRrcDBaHV",
-	"M6GN6A32iYe4WTXKgVoqt9Hpu/7xaDDpX/x0fTI8vXoGyb3GHkoEtbKtN4xPiecBfaLUrgVw5DEQWkoL",
-	"fAMoBB4QIQijSDKEXReEQHJBBOKxnhSFeIDbHZi1Z07H3W87nRZ2Hbcx6zruIbS7jZnX3O/OCkJsZULs",
-	"m9ln6S5S0Z0PL05Gl5ejs9PJYHg6Gg6eQXaZsFa29RaLBAifarE5YC9Z6gKLFKRfwlDL88dCe9MfHQ8H",
-	"k/OL4dHZ6WB0NTo7fQaxvcUCZaJa2daIKvTEvkIs4Oa+p0mwT1FE4S4EV4KHQM2EmOtGnIOHbhfEBxRy",
-	"pnSE0Ln2CrG5FWXahIND8uHgg3M4bxw4h/swd+adD3Vn3iIH9c6HRbdR/5CTaadox2Yz2t8AN4vIm/DV",
-	"8OK0f/wMckyfZOSG4oG2dcrkGxZR7xkcR1ENU8PWgF6U2eG0053NO3On6x10nG576jlec77vePVZZ785",
-	"h9bB/rygh+0KPVRzz/TSU4Gdnl1N3pxdnz6HwZ4yiYxkVrZ1TXEkF4yTT/BUSb3TiK2mUWzD3IBcDpp8",
-	"YF8gzI2GKdqwHfp13WbLg6bntHCn6bSbB9jB3XrHwftes133pvVO2yuIsZFDv+JCUr6SyvL6tH999XZ4",
-	"ejU66l89CwQWhLhK5ysTcU2SOAuBS2LgEYdkcgNcECPd4qzvzAXEZtpG87ho5kdECvBnaAdq85qNbhrY",
-	"Dxe4sVsb01EQRBJPfUB4JoGr49DiqI1pkfXF91h2nr7d/KpI2j8UW3v/D/N3BV+zLT0rTCQJYH35VyQA",
-	"IXEQotsF0HW6fYuFWRZ4aOfizRFqtVqHu4XVNevNrlNvOI3WVaPda9Z79fovlm3NGA+wtHqWhyU4+umK",
-	"OWLvjPrLhJeuLdYjIvTxcmJ47RpjFsCdGSdAPX+J4rFIja0MFmpjepIImHoZJFAwKj4FFGkOXhb4pYon",
-	"0ABuwGdhAFSidyeWbQX47hjoXHH5bqti8WElzU8RQ11GxAjZSKeXLNdRyxV7nwvB2aq0quLYXMyTU4ri",
-	"mO0Y/oOHIkJwHzK7nOJfquEr24qI99Qwr4auFO7MNLElArFIhpF0GPWX6ijHlGwyHXS1ADQaIBdTdb5M",
-	"Pxf7/hKpXagneuiG4DH9LQK+zKgrMnCoJ/l/iMy0ooSc3RAPPDuNyoCjOVDgWIJAGF1fjwa1MR3TN8z3",
-	"2a1A/eG502g2U8TUS2H0Ru2WUVFWtG6nDgftet0BRcDbDa/t4P1G12m3u91Op92u1+uNdcULCE3+2bAf",
-	"H9E9eN5R6H0ZYvhYSBQwz4h7C9zo9Bpfghur9Bc2/QCucvd3DobQSR1XFvkKq/erVW12E/XPCfFW1nvb",
-	"Cv2IY79sdsqfETqPfMxLlzLoTX4NMMVz4DXPDWqE7RUGb0iOPJvzSSb87oS+tRNKg5U/mDdy0iCr6JbS",
-	"DNp97il388N+Kjf4uRxWLhMySWafbOmPYmNyGTd5Lk/FX/mMXHqiY5popTl4Ijae/L3uDJHNNvgncy2P",
-	"pBKJtj0DpchO4zu3+M4tHsUtsmz6rwV/XPIUsXa//xIyUoG6MStJUOw+euLkE2MbeIqTq6BsT1iyuzYw",
-	"l2Ni0tdF9kLhTk5CPIeJZB+hgsFcqZ+1vXKQnMBNkuxSdyJ1Z21Mh0Eol8gcCCLUI642EQ24ROjhWivi",
-	"4QVNgOU/b34Jfvn0y8//Jmcfrm9n//7xxyqCwkFEvilglPL1nOOlcgqVYJIao051aob4eHSzMv6K1dPW",
-	"lC5ZnL0m0DVlqz6dyxh2i1u7NKgV52DUIeDqXdrIgxmhydkUxnCYAQftDZUrM7DqMjoj84jjHDIVNaNE",
-	"uSs0IyO05kGjwT0uNluGeAynDapUIRLAJzfYj+A+dVCjkBn1sPvfVjkUt3yn5nxQJcryKy77AbX4ixnr",
-	"l9joy9nm02yyZIoFXvpEU9Tj7hNm1UTVOq/OH7uL4lizYhDqVyE5JlQKEwLBDCvZ6bnMKsY0DkkKGxN5",
-	"oTzCnHRh9Ci/FnUGAaEjc3ejfLbF0KEalC7zK1u3+mcDolWF+qQFp+Ky9M8oKcujmSaMSkUUO9o/qO+j",
-	"c86mPgRooOsBRuJvr67OUf98JIy6aHp52DK1GXSR1PirhF/Un6TGUF7V2yjA1FH0SksA7kIfU6MRyZwq",
-	"7tUijCtfCsnj+EsXo1TMjJdKcSQmNKmAOentXrwdydAC/BB5MI2MYRAh1iPprQvla4hDcgma7aIPkkmu",
-	"WN0zHuHIxBCRSKJHjt2P6siMYUyj+ZzQeXkDW1btU54bceKkClm1r6Q4s3Z2SjfMReQyD9BOgKW7ABFX",
-	"ImNNMyMK3Ft3CqQLIFS2mtmDCZUwB135iytBa/i3YFzaaFHUHREFAebLgm5oA6yN6eWCRb6nhKnwhQgJ",
-	"VCLscibyaiWSewUOShMUJLxNb0MmvmqUOMHuglDIqb5+nJJjDV0rm+oPtXR1rTJ3NYnhaBQo17LWQ2Gv",
-	"lcXsXNHRLjer2BWtBLZ1Mbw8u744Gk6GP7/tX1+aWarK57bVf312Ya6fXV9Nzt5MLvqnPw31MkYn58dD",
-	"tSh9OS0V6xW+64+O+6+P1cDBsD84Hp2qhx0Nh4PhQHnFnLTXd7it7pbccNwqFetzol5VLrjCKaxRodgz",
-	"rR/twFwwxC+zdO28amOqC9EehEA9oaJ+nVxT116JJBO7E2cPzD5sRKNgCtxGU8Z8wNRGZqU20i5JZ2hn",
-	"CDyi3ciPM+wLsAtsakbuwDMLKg3W8WxhLKFEEuzviWg+ByFz9+WNoGlbNPJ9NYcJirfMiWJXAZiPp+CX",
-	"RIMIRdejvaPjkVkiC4iU4Ckyw8mNgkDOAr1CnZaM09RjHVDXbtwwqrksonJsof/97/9BY+udG0boyPy0",
-	"Wzbho/Nrc22LJGkiq8KhGyGXtvgfC5AL4Aiop7m/0GkgnYhY5ndqNEPnL2IMyeUPhdl+eoqQpaHMMWp/",
-	"CAkzqjydQpYi1prN+d5/Xp6dGqHGU6fnITMuc5WQLBTpbhOPaY+YePyhebToVZ1IekwBBIwva4J8gsl8",
-	"ai4EILGHJa5ppRA1SYCPrdJ5laaswlmNyXo5k6wLAHseMRm485zxGvFUCOHS2F+ehColTabWhDo9xR2P",
-	"45lEzXqz7jSaSsXOdH7QdFsoTNcnXDA15YuiMGRcigzc84/+CMtbxj3R057HRgGhJIgCGwX4Tv8xpnFe",
-	"yEbKB+gRRn31mORPkK5ODF4k6NhDCylD0dvTLSCOEVGN8fme3sZevI38VScTafE4ygp0qvFJeU9lVy7j",
-	"INBOw2l0d415qYVbvUZX8+r4H7YVRL4koQ9nszzLzrv/IiyX0FzrchV4vwXsGzUvAna18h9hyihxsW8s",
-	"IGYAuW6WTAkXZuJtUtibKJOeAaUeqDz38kEfFt/66ARivPZ8VjDdjrJnH6RaYdwKnKUF00H35wHjYaWu",
-	"5i8rVRbDu9gzFouT6q8pSPPH77dSmWbjH1mlrPdaX1alTLB1/SAM2G6GyM8VJc/CNv8FS8f4sRATbnDS",
-	"xRLmjJNPJoIzCQZfAjdhymsmFwrgTGQf58UwT3hOuTqhE4BqvqXVsyjIW8Y/Fjh1HhnWUOAJpc1Y4Rw1",
-	"l9j7XGiYX8X1uZgkuSlqVJSUkuMuK11x/lwrYlELi8NeoNxZAYI+FiJLKVUYoApHWRAwmpwboa4fedBD",
-	"N4GdBN+Koyp1m2JFXVw/EhJUfN73FO4rlyoZFyjAyzjfg9xISMXq1FbRFJaMeurRArZLwyalwu3dfIxO",
-	"WXqgmIZKYCaB3N1adu6YIhZiFch7xNVP42naoVz/zeY3OSvtixOOhKbLwuDemDro3UkPKYJjI0OSbCQk",
-	"43gONporhjhhwo4bLtXwo0TiPUQCPSrNYNpJU7CNYqtRNwzic+khoHNCwUYxDufu1BObU+tll6mKOtGO",
-	"2ilnPgp9rO5W8wIXu2pjVwttw5ErIw7oBnOiNokFeEmAk1c/bf1G0IkvWLN8IwP1V8wVrd6B9qtaJFqB",
-	"ifioPJtCiRC7RC71qE49feFlylieKArPWr1XPNENI60z3F0QCXrNVs+6O+hOum3LtgzB7DVXJg2YV6hG",
-	"Bc48stxcsKnvVeY/UJW54MQfXWFu9tqdl6owF7D9qRXmaucXt8eU6smFscUycv7Sg6yxMLjEHV+s/qS8",
-	"W1yQeXwp6sw4AP1w5CCPGQvCXADSCTpqcBAFmEbKIO8vXw1vT97Wn1i+KpV1YgiPs+FJntrYeLJfpBO0",
-	"elMaGB5RJsmz+uctd2X1zC0jtrV0RVZmTRhdocX+952ziCrQ510xc5jt76XSh0XYqg61k9Wun+FKFz9m",
-	"LHntBLvKctciBuWzBkcnacX+xIAB6p+PEh+kvE1Ciskn8NAtXqpTNrgxpgWdN0VOU2lUlCJfYjPxCKEz",
-	"jjNikkuwxaxOPXqWOTW0o34Y0gWmLuguSuX9mcC+2E3Xpace08TiHMYJUBXQeSDI3PTj/e1v6CIjVYpW",
-	"/fBDzoLEDz/00MAwYAlB6GvMUSv2yEzna2RMidls0ybGFKGddycbuPe/oilwCmramIbbGp9ydHvXLCtn",
-	"KnpZR4oKg5fCC1MLUtGZeeu2yGtLBV+1Jn0SWf5M65ZPXKBCK3rMzfohdheAmrW6ZVsR1+mIOD11e3tb",
-	"w/qyzk7F94q949HR8PRy6DRr9dpCBn6uQGRtUCuls0myIQv5V7bFQqA4JFbPatXqtbaJvxYac/Y2NEv1",
-	"PltzkFURpXYzWnVDPCdUS88nQm5sCBL5LGAaIKuooLpvRbMvS6/aCHrkWT1LOciKNh6hN5N9QeDXL/KQ",
-	"yavk2l1k75LnID3/ztUaaVmveulcYIxIWru1sUrlomTEKQqB6zVseHCA74w/UXBceHaamW9UFhezLGRd",
-	"Xc/nIcuJx/Vlv9FntOEw185NH5dOBZs9iXiTtwvgJoVeK3XmoKxwSkRlQn/t8wUluay3+mw+lfelt/Ob",
-	"9foWLwxu92bdpq6/inftLiMdzM4iP60VK9Ns1xubHpKueq/4np66qfXwTYX3mztm0/ffUfUmr34r0JSa",
-	"YyPcoBc6CcVEBWQc6QygAgwKtxv7wXIYoQiAk0V2o4FQ0Z022lebOkBfoXLspz2iB0HIJFB3WYUpZmVV",
-	"7YcPgMpZHIGWl7oJ0B6j2yV1LkWCj/w0xXvDbEDI18xbvqTeJ9/ByH9lY7Vmeo2XX0K53lF1IklSWqRG",
-	"6S+NYT0fNtzz5nexn2LKvCVK2r2Q8cxfDxna9cOH7yh+0eT58MQY4KYeWz1473Fv4Bj48UFCVaeCDwaI",
-	"7mlKLSKEuWUrhKiSRTZkb/N3fyo8VLuqcFelyGarVYr8lZSn/fAd6QcNnk9vzLFs1hv7YfJqqoAbEHu6",
-	"RESKDUz0J5BfXSHqvw/cnCXn+CfXr59Abg9KzxEsbY6RSlWph+Ki7/HQV4mHRMXR3B8DFUpCDwdAG+lh",
-	"Ofv9reOev1a886QwZ/vo5rnimGeJX/7UYcs3DFcedLffo5PfcXRS4f/LH6l5fAyyVejxRQzzyaHGHy3C",
-	"2EpjCp/7e+Gw5MnRyCOCkJdRjfo3Qb+/bowR9/G5VR/G1d0bolSP0gWzUpxiSr+6aHwCfA7oXBfBdc/G",
-	"fuuwu6vZyCmTgOQCS5TrrTCdSmtkFXO473Mca6pp1voS2rkNIwjUph0txn+8MDv4NvZhGnm+MTswi0i/",
-	"v/nnt1aj1JVcYJE24FfCfNwE7y7A/ajJ/Obq7RrEv81a8F9I894mneyrDa2jiAiUdOsXRZLfmJFEsVHq",
-	"aVmRTT0Pla+SxLcr7DK4p/MXOjMhNiVL8o0Jz5osqY3p2VT3JuRe3iq1BMU0WsN2yOGGsEik8XHcn/RN",
-	"Ei7mLSzKZNZSaWdvo0uGGvX65vV9lbzMSxKUcifeYxIaW+BL7nvmf8wcSN4qt86BbDDl506HjEz7/mig",
-	"oGpjh+8t8f20zRcxCpsTKfnmvycmUkaD6hboMT2JhIybsNDg9NJpNJqt7AXRAEu047Nb4C4WgHQLD40C",
-	"4MQ1DUmLZbgAKnZLL41WtzLTNHjYIrH4R0jgFNoyv24CZ+3R1d5S6/rvMoGTvd0Yf9X8r5bFKfwXIut8",
-	"pfwG1Fb8JY7bC0j3UNx+L7w8EBmt/x8qX8stPqj0f624vaRM8StnySmaFs89HJK9rA/z/er/AgAA//+2",
-	"l/IG+2gAAA==",
+	"H4sIAAAAAAAC/+z963bbOLI/DN8KlvZeq+0ZSpblY5w1a/8d20n730mciZ3u2XuY14JIyEKbAtUEZUeT",
+	"7a/vBTyX+FzJs6pwIECBluTYmXR3PiUWSRwLhTr8qupzK8nHk1wwUcrWwefWhBZ0zEpW4F8vaJmMXrHy",
+	"NJV/n7JiBr+lTCYFn5Q8F62D1umxJPmQlCNGCibzaZEwScqcXLEyIgWbMFqylAzzgjCajMjpcYe8Z3Ka",
+	"lZLQgsWiYOW0ECwlXGAjko4ZyYuUFc8JtD2mMzJgtqVOLFpRi32i40nGWgf/bMkxzbL2zbgVtTJaXDH4",
+	"70d4Y5LlKWsdlMWURS0OQ/0NZxC1BB2z1kGLp7IVtQr225QXLDVvymTExhTmyUs2xkUoZxN4X5YFF1et",
+	"u6g1pp9O1cPNbrcbtcZcmL8j8zYtCjqDl2U5g5G2hnkxhr+PaEmz/Ao+OE3f0XI0v6YfBP9tyghPmSj5",
+	"kLMC1w9WJ1EfExibuw7uMuBcJ9CwnWri9nnvpCe0LFkBLfz//knb/+q2n31c0/9pf/zcjXY378zv6//1",
+	"n62ovji1CQpZUpGwL5so4bqZB87YDuKpZ36asvEkL5lIZj+x2Y+MpqwInJjqLXLNZtXp+W3KZBkRGOEN",
+	"zZgo4Rzpny+5OkRJxuGkxoKKlFzRkt3SmSTliJZEspJQMsJeO+TNVJZkDMfXbeJ2xAQZ5OVIHb4rfsNE",
+	"7Ui1tpPd4Wa6w9rPBl3a3k43WXt/uEXbvcFe8iztss1hb8ssuuqtWnZnbu2f2KzlLvCYfnrNxBXQwWZv",
+	"H0+N/Tu0mmcTVlBYs9WpJzefehPbGvYG+8Mua+8km2l7G+b0jO6xdm+wn2ynu2x/uNkNU1NeDeWpaegd",
+	"LZgoG5jtBRNUlGq781shfbYbGR4KrIaWpMS35cZn9Z9Lnt51YuEQBryrnhkiTGiWAfWcCZJxiRwcxpaU",
+	"tivg3LEo86pbGAlLyWDmtrd2leUDmnnnGDk+YZ+SbJqydL0TizNBkoLRkkH/1Hs5IvmYlyUX8Kd+SxJK",
+	"dLv4SixuR1wTOC/gsSA0HXPBZVnQMi/qpG1WpGR03Kat8L0wwR1oNeyraeKh+/v3aV7S1Sn6N/jMm8vN",
+	"uJ3xMS9lmGR/U/08Nbm+ZzR9Q+V1A8Ee5eMxbUsGUkXJUvJ/z8/eEhioFRqGnGWpVJwOJAGydnjyrr25",
+	"s7ceETlNRoTKWEx5GqVcTjI6u4T5RXLCko5kxQ1P2CWMqkPeYavlqMinV8DeCmCMkmUsgQPDYoE9Qbco",
+	"iLCMjZkoO+QMyIylJC9I3PpL3NLjkITdsGKmxleno8XjaaCtgtH0ckzltUdeoWVFln2aNkld994hZg13",
+	"1iMyKdiQFXSQzQglHz4o+assOJOxuOXlqBK6oB29B/qsT3IhWbVRhSxND19yZ8wtibmdvui2OFeLfzGb",
+	"PEDa0DtH9M65hyx8uqTb21OfsfNrPrnIS5qd83+xBoJ4zegNIyW8dSn5vxjJp6Ujl+NORkTSG2CoiqEA",
+	"deNNkuRTFCngZ7wY4B3L3mNhlqC2cfKaTy6rHr3dS9mQTrOydTCkmWR2UoM8zxgVOKufacZTWrIzkc0a",
+	"JmVe8WgbBB9QWaYlI7yUMNEkHzMCxAyTnrBCcgkXBwhJsxJnow7E7tZ642xudF+Xuchmq87lFzYY5fn1",
+	"+XRgh786Ed6qRoh0WvGIccCzDGgiSJG3oSE8LWXeRS1DWqgnHWbA3mYnn7hUumSSi5KJEv5LJ5OMJyhD",
+	"bfwqYSU+VzODNSopz1oH7jHGHSU8JT/cjNuypCKlRfoDoaoXwlQ3sBhaOzhodZPdvavR7qi9x57ttvd2",
+	"EtZmW6P9Ntu82t3fGg23n+3DksmSllPZOtjuPotaJS9xdd9rgp/vQM/78PX7k8Pj/748+cfp+cV5685d",
+	"y/8s2LB10PqPjUqZ3lBP5cZJUeSFWi6fFPR6Eb1gd1HrBU0153/g8r3EO+4H9yb6gYxB4hN5CXo0G0/K",
+	"mb9oe8+2ttPhFmtvD3a32tu9Z4P2oDvcaQ/2062dLks2d3eYt2jdatFOBZ4bezgd64Fdt9O3Px++Pj2+",
+	"PHz/6sObk7cXj7ByL2hKzELdRa2XeTHgacrEA1ftg2QFSXMmcZVGwEknrBhzKXkuSJkTmiRMgnDBpWWM",
+	"/iLu0+0dNtwetneSve32zhZN2snmcLedPGPbu5vDtLe3O/QWcataxEPV+tDOwi7du5P3b07Pz0/P3l4e",
+	"n7w9PTl+hLWrFusuav1IpVGPH3piHXW/dlJHVFrV/SkOar19vWgvD09fnxxfvnt/cnT29vj04vTs7SMs",
+	"249UkmqpQNkXJSsEzYBjsUJ997AVPBRkKtinCUtKlhIGLZE8SaZFwUBj5xkjkyIHGjGXtz5u/pr22P4z",
+	"/uv+r+1nV5v77Wd77Kp9tfNrt321xfe7O7+Odje7vzpruuOfYzUZlIRYoQbhHuGLk/dvD18/wjrantS6",
+	"Ef1i1Hqbl0cwkyyjg4w9cClTljF4SZKECs3yEtUqS/3l2qbdzeusm7U3+Va3vfnsirf5XtZr853rbm8v",
+	"+3V/q5c1kaA1TTR086SU+DYvibtSau1e5lORPsKl6x9hyxTxMvQX8NlgZ3d4tXPV3k33d9q724O0nfau",
+	"9tppd7iz17tiW/t7V94CbgfOMLQ9xKHbVXt7dnH58uzD2+NHWiu1MneR7fTk04hOZckeulyoWoMdg7GU",
+	"pQfEtyps4GO5YfVzcpNMpuQ2n2Yp0MnWdkTwAeGSbPX8Nd1M9/ZHfI+394fdvfb+bjpsD7f5s/awN9p7",
+	"ts2vdrrPuLumPYco/+4Nq1rP9yfnZx/eH51cnvzjx8MP5xePcovYDawWU63wdMwu8msmTj5NePHgJQYm",
+	"x25gKGSYZ1l+W3E+6IGU0AWak0ROslxcsYLQG8rVifCWdGew2cvGm+N279ftzXavO/q1/ev+eKv96262",
+	"ubU/vn62vTV2l3Sz65Fp1RvTM7ILe/bh4vLs5eX7w7evTh5nSaEzXD1ilu8uan0QdFqO8oL/68HLiYoU",
+	"gWaYKPUHJCkYKiE0U4Y5oyksJ/DsJr2tlPXS9hbd6bW3e/u0TXe7O226l/a2u+mgu7Odeqd/0xF4/IGY",
+	"jquV/fD28MPFjydvL06PDh+HXr1FvLPtKb1lMslmh4l6s66v/QIaMhUElnpGUp5GJC/0aU5zpaN4uuOB",
+	"MWmi0cksXkSmE3iF8BIbELlSTKkkvLQqB2rfTNlax1TwIZOlsrSI6Ri8XUfvT3BBotaHd8fmf2+PfgQS",
+	"PG59tAuodbSo9akNn7ZvaCHomElow5nuEY4UFt758cMkDfwokhEVVyxtfbzTD47wB9Qki3zCipIrGZIO",
+	"y5Db42eaTZln9SP4Jv6Nq/ucTIVkJSrEBRvnNyxVL0pXDd6+i1oDNswLtlQf6tVwJzRNg1307iKlXc91",
+	"cJyXjjUT3jG96eUh1sromuY7BBWzWCS5GPKrqRIe1LGzlgBjQucFNhwhbYhYoG1RDfKfcJV00F7z8TnJ",
+	"yxErjKVT9Q/fUHI7yjNWN9E1NDOv15sfPluqOzw+Rkp7f/Lm7Gf835uz49OXp6uRnKKXwzStaEv99F7t",
+	"tf/jmzzFRWl9VHYGY8X4p7F7YLdV9/ngV5agNng4TXlZEWdN5yYpHw5ZwUTCyICVt4wJQu1GGXKhwlAn",
+	"1SvbilYh84qy1dfPlTeDaQ8cL8ktlYbIWwsp2iHi+9pDem41Eq81vtdp9gY6aSQW/P+9FBPaoMadObnR",
+	"l1d9YzQFj2nKrBkfRnn47nR+8Ru49U9c4OGze+YzTss3W1Hr+OT1Cf7n6PDt0cnr1kd3/vatZYgbZuXy",
+	"01bk/qbYqf/bMctY/Tcl0iN7pUmZhx3HouTlzHfWRWRY5GP84R/tQ/iyfXpMrGO2mhPNeML+j/67k+Tj",
+	"0NG3VE3TlEO/NHvnrLyyIdackg6ju4fwc0GMdtYK0EZ1AB7Y8z1nJNduxoau1esywO0tq5CWV9QZRASt",
+	"q7td4q9aD1QcvhOLQ8Wf8yFRPcoax6ea22tnl+sUA6doLHyvKC0Ymr8pSGraiQXN6P8eKO8WcoIoFprD",
+	"gMQx1gzVfsQlyYVZLLgzJFPcgYlUomATi3/G0253KzGfwGP8hX2MCOtcdch9jELdQBZGc5/c5vLsu3kM",
+	"TZifWR0ER+35vg/qzu/T9G6DQidtpVZsfKaWGZ2md/f6if0Pu8P9IU13Bu30WTJob+8+G7bp5u5Oe6+7",
+	"v7u319t/ttNloZPl+LkCIKq6Bw8dM5oVMoed2SHupdvb2/vb3fazNOm2NzfTzfagt73T3hkO04TtbQ9p",
+	"2gsPQ0vzc4N4F7gZHNm/6loTZBt3dsPB4TR2dmkkitr5nU1YU48HxDViRwYbcKkd/+6fl0YtiZSbPKrw",
+	"HyirK8/IpetXqe2321poHiUfh4bPx0yWdDzx50DW3r88IltbW8/WvU563d5uu7vZ3ty62Nw52OwedLv/",
+	"04paimDBdkVL1saeAiOY8nQZX5IeCBKsUqC9ITyMdsPy1xQdtuqqisyFXN/y6u+WXsU5uQDuVMombZcw",
+	"tWsKr9cAiiV0ki/xL3gKXUyyaUFB83XfBHWUi6tpRgv/STVlQ9pjKugVKzppMu7w3O0Pl6MSZF5z5avx",
+	"xRPBPpWXE3rFLtF0ECAd+FkrOmXBmXXLwpcEvuzE4gR8NUTtAuEi5QleMqiUc4mvZ1Ta172dZrP/e/M/",
+	"4//51//84+/87NcPt8O//+1vDScUED0BeQx4L95AFS3Jldi5EvTmuHmNmswAorlFC0mQiEdVUlYAZigd",
+	"55m/IZqtBuZpvyVlrjX3ZWfZOA7HNeVBRe9Fis6tix7xg5ZBTrPgKjRstrVnS1IWNLk21Dgp8hsueS7g",
+	"B4OcqbitunJjgXDd2g0ml7/8be9LE0vjmrxi5aMsyFEIfloB7EITZinglZemnflRPvbsv2zWTzTZL5qk",
+	"287crOiEX96wQgb1wp/VAzMLpyGiBkl4KVk2JGsg1UbkZpNmkxHdBJDi6Xg8LcGurJUbo0rUWa75phW5",
+	"0IqbfwKA4q+ApPj4V/X//wwxYmyVXS6SNFDdnwNIg/KvGkiXkT62D3r3Sh8FoynAcozWNTdYF/sWEEsk",
+	"K9rDgjORoskU3yXwbhDejZhUvcAirVxOgilb9ICRKQo69QU/B8mTHLMbluUT1E9+ftOKXOTY7lZg8A9Q",
+	"JnyJ97MHp7+Dt2Lh4FIlWngDCsi9zcRiaL9qTwp+o6zFbCw7YWl1Xv52yG5teZzqxvp/+S0uhwZaSCQF",
+	"U3dHgM+Aa1uUxLxRGTQcqiDnJS1KSWhJNpEwuIwFF0mBqqjSnRVEU1vW4Z0iz7IBTa5rS7blEDoX5Vav",
+	"efxclOyKoUca9NkVWNs5vL68qB48CuQChDtlQ+aIbZtMyza4FWB6seBNvIiALeT0mCRUwIHJJ8qCks1Q",
+	"Q1eK/w2nsVC4P4vTcW0jzwkf4snDax8MCBYcyQpyxQQrNAgbcaSxiMVLdM5JgvC6Xq+yxsBQcgESoDaD",
+	"eBS8u9Nl+9vdbpsB2mh7M91u073N3fb29u7uzs72drfb3Zw/yT4EdGX42kKCVXT0BSwYpXFrZ3kENXDB",
+	"kO9W1aXCDEgr0ekd3EsBbWvRV66+5b3rK1zuo4Ual/dyLaoIvQRNwk1lGb5XQXFcfnD5OlNaUZhpNB6+",
+	"VDY8Y9cYzCofVIecOQdbG/ss2N1yRWBoDk1qd3dpzX+r2Ncch90iCcyq8mZqtfVZIJkdwbG3Ypi/OWCp",
+	"XlpIc0HZ8+dtKplsEr/mTnaWSzm7VAsdNnz58Qik5qdLi3wyqTYxsVOEmx6A07S0u1PZbuHVkhZXrCT6",
+	"9bngRbCYKl97R8rR5WQ6yHhyec1msMrNAYhzMYYPu6nK/GGboeZfonhW24MBK8NbcMNzbRMPbECRDzI2",
+	"totWtR/cDXpFuUAbKdPSeyyCy10Z1TWGG53tBUMzPF6ZMwWOHWB4yCfmuYs9coN7dSpZoJNOcFO1Sbz7",
+	"kazh38oqXjC5fmAGU0m6VTSWt8xqbgdETMcDVpiREgp3jCzJ/io0Ujvj3kH0CEETU+3MePu3gAG8hEDX",
+	"uaNf4TeW9++gw/IcPyRraUGHJel1e932Zm/dkApLuZKH/GOn9y4WSqo6AbuFHs+McInKiIs/MF5RqilO",
+	"OXIKFSbDS0kQuhLZrcPnuZBlQbkoZYQ/QEP6hUv2aVIwxA9HsQB+PsjYJUoO8KbZCvWLj4mQsfjU1s20",
+	"nWbIp7Zup23b+dQ2LeFvSNKxOJswcfjulGx1uhDUc5sX1cIECEy7lPSRO4iFjmxQkXqS37A3XPDxdIxd",
+	"Vj/ST/jjgGH4hZiOWcET+HoqUr0iYqowiuCPkvgXKXN1hjBmq2+G38dwQ+kEasnG4dpoP2S7iNYWud0Z",
+	"4Mfzm/Dc0Afidfzlh+njRWx+joUR8XgBC6PIlYzyzMzL8HoY9FxM+hzl+24xL6Skh0IurGPrYHMXRVz9",
+	"R4WWOnr3gRzhl/NKyl3gKM79MOWXDzl97wommSiVH2UEZG7U4inXh1IHmuZDUjCalG3AoKmu2vDoIBZx",
+	"a8oP0IIUt6o9NrsRsjDV3J2g9aDZET/BdAAq0BlbvuXpFSvj1twWBBbdvt46AFE/vxVqcdTwLOd2Pvo4",
+	"v5o1PqrX1V3jBdzRWvsey4BlGvxmDFmuetBsA3h/j+6PPxirq2fcgnurE4vXFMev5FPDUrwW0hwPKB0O",
+	"dfynba82296DTAN/Xmudu47futlugQmubaZSs8XZfBELYAENbYUNcivY4xrafSQrh+tWt57zy9X8y0le",
+	"qMi/lIsr/5I2LcbCnlmkIi4byehemxfhzVzrD2Z/WlGLM3RqtDkDtV69AfXhl5kuqw39bsP8bsNcyYbp",
+	"GZ8cIah2c+kD8igAkgXXgA8rutfI2XYjEBusnW0ngdHyZs/qq4asSk9nafNkr4KJlBWKkT+Fxc22D9ur",
+	"cYiISJbklhWsZnxDDL1jfSMPNr49ot1teaXqvTdZ15AWGHEIrPqt2OyOKuuH7U8pIMHFr9n1YPaxsDKj",
+	"pCWXw1kEe6xQ6jkXJSvqW7aRTKYbaEb73VnFqkOr1VH/vDqmAS9+UU0yH1YgFCPkXhX5dOI5Obu+KrO7",
+	"3QqpLkDk9+NdTo8jj3Ycd7ZCAIu1AE+1JjkcWD34pBEkWtuCa0waptXvFVZUzi8pjuNyEJjtYVkWfDAt",
+	"fWanwnHwK+Q2TvRADR9aS/QD+a8KP35gEaAUe7kHjaZzspAJKxQvNItLzdDxrMDHTAnh+EEnFj9rzqmz",
+	"ofiTy9iwBIFsBc9RIwkH2KGTDGYpSqZJkUtJQB3UC+LGefWWIOca9dgt98Zil3tJgvrjYzqDgnuV8udR",
+	"QWzLkkVwTLJKSFQlLXLIBpZJxkKl99nqrVfpvNDQW0tRVFvQza29ndVpbDXAapO+NrcQ50rF0SGxQEU0",
+	"vCTohODCLIn3TsF0vIrKDxnynqk1qF0+vkc/QNqVydFcEfeo9NUw5CpWxyCjnEpWXCpp8B56nkrDJ+Vi",
+	"c8Oy1A2GMeSmC+WG+vr5w16WLKz6XsvsxYcsmSUZI0rBn0+VWE1O4wrQnYG6bZu8O3l7fPr21QGGdE9K",
+	"UIhvKce8ispIJ6cDfWS0DKo15wK/fn/28ynkn/GaMH5w82aEQpwKYZ6xEj7EVEkH3lukYJO80A4ASyuo",
+	"YNB0Bh+pfBkHNdhxYePGyJDyjKXPiWSKTV5inhL4FKP5cJD2ZQtVrWZsDBfVFOfPgvH3hGDSA7j5jRd6",
+	"APerO5fK3XzM5QT4FlOeREiSOFuaqZoBhFhpNen50WHsu5ETUBYuWMJEqVeN0LJk40kZET4kVMy8w+fs",
+	"ES6a/uaAvDs7vyCjspwcbEBGC/PeRsWgbQLnnW6PQGKmVyorbeg0AwV7kcWaOltRy6U0DDU+PP7vVqRT",
+	"qJhwTXjmyVm1r0KypW998Jw3OJoFx/NPJgx8iQzwOHf/0175292veOO/b/R+HQrHri0FnchRXs5z9nnu",
+	"tKLfyWLJlCEnAVfn17DqLXI6HbtuppAHkJYm+YhewiU8SAvHtLoLKRauZwjMVncbZkhy47P5791SCG3n",
+	"y96XAairk+NuckRkSQu86AA0/eVezgfByuYOj91AHTgYMur6HtUlTbyFqlnwz6UwpB+jVZCwwU1+GDw2",
+	"3FSjOdm+fY852VnTFczJ9qu7MJf609101UKvetNZxv640Y31s7SykljTDT0D8AN1wyYTvl3UUENhJQzo",
+	"gCYj/101YiZdOJ0CFBjIJrSlRhELLuYnJt1FWUG/Q4D2kTuW1t29EZo1w19QSz73XSl1NfQRNWPPj7/Q",
+	"JbDmONbWG/w+9cFCwM2EC4GqYYccmw3ReqLeIGvhDzQaC1pW0yJfA4AUAsBVKtUce0OFqiyokPjC8pKV",
+	"Vsbhe4vxXw7Ms7lS4P+YSUlDmYx+nI6paMMljiuqcr35kPi62vvzG9T587wM808qQyT0hoI0zqqu1Iu2",
+	"VVyCagm9EbxzNPkmhXAqXY3wosBURC9pJuHfD+JaAFLP0/rMw8bEVTVWBRzCZkPXG6f9UYouiPpgUAsp",
+	"MHr7/S4L7QXQU4nCJPUxTJbgDmsMWn/0E54bD1yzD/Ah583TqUNjDk5+UfD8vIss5Jt/oIk6ZOQ8mygX",
+	"LloT2xXkJGzjPD32VjCjgzYTN+1ubRFx9VZN+N9sWzRTCK3oyX1mIVvNoUqnCcxqb7+7R3T4BTlWLAQP",
+	"9o8XF+8g85auNITK9rMtla2XvNeNydDd62+aSUG5gHtBuS4qsBXbprpnuDS5kGHVNVmjBQwAiHQGJF1S",
+	"bs19bfu55ojQzIhlE5KywVTJRVzKeVji0qnT57iOS4vLQad4tXJ+vmdloT5SAKipNOg5k5NByUWD6dUV",
+	"F1f1CSyZx91eO9OCt608cj9zru0d0IZ6SJI8ZWTNzVtpKU294V2FmDt+ThGdVzw19H1O/B3lRRmRkU87",
+	"cjoe02Lm0YaKL4jF+cik3QXxksuSidIYk6olrxAMdFxrwFvhZbLdL7qM5m5T1R2sY4d8gDN1ePKOmAzM",
+	"zlMDQNP35FxW/Wgua2rkpFKO6uULokBy+SiUKzgKprGOWocvzt6r514eXBjG6Zt3r09gUPjYJg/HEf58",
+	"ePr68MVrlYHv8Pj16Vvo7OjkRKWYVMn4XqvMks7Kz892WTpecFsrUgvx04B+MHcn2UCOOQOXEY7ReW9P",
+	"vakmhJmvUjbBVGsaHYPPfpAGRrOmYZBqHpHGu0RER+dEOp1cpBKArpvKXqBc5cXYCOnBeB+Bvh6dtRZE",
+	"FPUAVJKhjaj6myp34unoKkYO51R7GU1I3rtc8JLTbENOr65UxhDzXc0wZYKElB0KM/fWw3cCgJWT16R6",
+	"rgvCGL039RY/HzprbwV40CX1Vc4rjFuEFzyjqQkGg/nq5jrktJTkhhYcRouAigNwPfWBlfcP5pd7QmdZ",
+	"TlMHQGcSNTr+ylgQGzzmdSexbTPI/gGBolPENZ7pKDXP3GQ4WQXDbqsoK9iCom8w8fgp5u6qJXYBNDYj",
+	"sLraWMM+lUygnYSsQQY1TY1ZfsuKQ5lwjlU+M5qwiHQ6nXVVWNDm4u4oPJciXlwzkuZTWD+d31YtX2fM",
+	"xnkx64y5IH8hvU6334lF/7cpxYSXa6rX9b6RXyWhxDy0Tre41Xv1Im4BaY/ZFR3MSqall77iFX83zXFR",
+	"um3ZtwmkjrB5d+tfVaNxBgw+hnUY8jqM+AT2VEkxXKos76qyaZLLMiIqihvj/8AsROBjFVDGSwz8xl/H",
+	"Kx3jWITO8VFVliAfD7gw3hFDyrWLzTqQXfLqVHu8tt7Rm7wWt0jcikjcasetdfJX9R/y18oHPeVpxxLK",
+	"Wjci++utBwal0AQoMKMDltVYKKzph9ONo9enig/pTJ8RSVnBb9yjhkZ9HQwV1yO84hb5f////w+JWz8n",
+	"k6kKsotb6/XVcQPwFkWpGIYYKhxVTyzOMH8zgyh/CYcDGD4ir2fuTBUTQ7alz6gTcyHV9C2rZhXuXjGT",
+	"uq0mwII967+tarU4iXCZux2qO8ytJAFrTaZYsyTNUYo2WgJeVAWTeYY1y2zUbUhptVBfIHcbU6Rq13ER",
+	"SiZ0oiYmD0L7bYnAOb+XVwP1YMxKmtKSdpDkZKfkrIhboTzaVZNNqT5tfOnCuytlCUcI362mCGfzkRdg",
+	"iCtVexfFgnF8izq3CMkLYCFmmyObFZpLooOGO+SCXjMdYYtyrnOT2bdwxOHM4Rm9yYsOepjkL7wcrcWt",
+	"q8kUuEAQOlxnSg+P/HYNw8AFTNPiyl2pWnw4FheFiBNVIQEEbVwTT2ABBWE6UaAUK3G7XZvo6QONg9Xh",
+	"uRHRQbtRLLSuHxEQzPENxR/wHfNfViZaW8UwGEGLIr9tZOxuPPgBTtkiTGJBTd8AQLlhc438IO0LKNf8",
+	"ilWFEAwE5QOlc11u7uJ9ufbmRURevQAaungRkQEXoE1NBS8l3uYEI7r1DaKqBH5qj7lo2xtYhaKP6afq",
+	"J7NykcLvJBktbAsmPM+8HJERKxQNi6ZFweAgXglNqmCFlqv0Z1j/W5Y4dBUWULCJCmJGuh8T9okmZTaD",
+	"QwTJi+NWr7u9/wYWoZIfcKneG33iAMEv8mAD62u09WDy4moD6W1D05v7tF3Rfj0qucmLCzcMwtrJ2mZ7",
+	"c3e9dU9Y+HialXySsbOh66Jwlee6IuOe7S/iRlwSOcpvdaSW4R+xUBKwCpBfRQyuJF2qL0G9vxGRuWHU",
+	"wJAvUy6vO0xAdymWaKWpE0hO8qFOwgIXU4f8iPXNbGlZes2IyJ32bQy/wUPrIxcLO0bsGoFbsBbmgsI+",
+	"uI5ZYAolJV3x+XksSiBBPGRuyoERlWvreBhg7Ioa8YGJclNvx0JVrMUbD2lUicf/dWXum06uQIlr3XWi",
+	"UnGTbr9DDjMsBK63Wmcw0FJRkJ/PLSv5299IqYzvD0zvjwrzGzqZwFfBiKRlC2pQX6rQ+AwrChQsoyVH",
+	"2SEWTfTWIXootjWayZyM6cShHBkLoZRTLgifk4u9a/7eCrRRq8zvT6ntzIhLM5cO+cXZKFeCG1EgM4h2",
+	"moqSFRNalEZT0EQMGkY+X4rXZpppLS6aWw9+CW7rj4xm5Wh+Q8Py4REVueAJzbxKE8E84iPV8DKRsU2W",
+	"SGyBWGNOve3Fzhv96cpBhXrsLozDTgdE3oyVuTDzcXAc9qX7gRv6Na8MfqhmBpS+ahdToZCy5k1T/3lz",
+	"XdNXmgukFxyOuQVJLhgmTlI2Rq2XKtstcHpWRlhNbKKK5djSehFRVddBLI2FEUdzAUwPI+pCboBcsFBt",
+	"JXPFOCX7kfLBnpMxVYXIbmpD/d2oZQGxS5SGiqyUv3Ra4jfmg0fLQmAnKzc+2/8vzDfgfLU17A32h13W",
+	"3kk20/Y2lNp+RvdYuzfYT7bTXbY/3OwuBzFTG/4gN1o4LzxutbPKjxKvG1ywubjc6i0fPGV/X3juqje9",
+	"o/fHh0ZVx29lBPDq6buXQ0PNn7+AS1gkLLu0SP9mFuNWZTGXUjVnhY41jSzDclYF4vp9PWL2l7m9ZiJ9",
+	"yLAsx11yUFsHmysMygL/w4mAMy/GhfkhxJGugcvLqiT3shEAUUu1cb9oZki+tiQ0KSXJH5ICZjxrKIgS",
+	"9gmaqlUun7KFq5pwEuBAYxkLP/OcZ/c1sZSzTK/hApDuXaRKkIZCmLAOai6as4bosCa1vGC80Zh+XUKV",
+	"FoxMBf7B0g451MHeuUBacX32KsdI3SsypjP0pbHyuSJ+I7YoQUdHGuQlVQYjsDCUuU4vbYZoqdKMcfU4",
+	"OjRJoUTvT3wOe7dk8PTqfEhN8mtnoBrTTxa7IkM+cWWwEvMxwl7wRih2w5pGuiFbCHSsLL3NvWKwCFFv",
+	"VZ6C3W0wBvlrAr/V4D0Ii1p78+J/X73434sX68GMaDAIWeZFEMbnj0K/RhI6oQkvnfH0LuaG07t46GhA",
+	"r100lBtllJr6JYS2eivvweOIzLqq8mf8d6GoXK/B/OBcXLqhJ8i9tSJ3cE0cy8TNrpbJCaf5O8vcVFuF",
+	"aqe/8ZRLFQv+5vPFh1Qx7yDOqWDqqa9+4W8LVS/11p2RIv74Kpeig5XVLSVjPa6qhW1+MDhzf8l/C8t0",
+	"rvxsNngVdhyK9E8Xzd1cGmqo9TmbYWBLoWn6nzdeSSoWZTo29TklK5sTVMwJgffIOW/vl2+2lsrf0yTO",
+	"XDhijLcX2/uvXlQtuTpZg0hyERRFvDa3ut1wo2HJ4uIeiWLzAXle3OXDHu2yVNMKEoAuD+OFcjWWjHtY",
+	"6tocjnSZF2xR/KWX63lRBLIeS2hSDubiy5IKh3zRtTTCEdGhAvAfcLKfg44Ee6ua0mqc19IYQdqouj/H",
+	"CnKxWPNre3qRCxPKUS2zyWO/Utpim1UunOzMThC9l6r0ujvpULQFXCixqBAsjtY6YUXNrbUoPGqpy8Gh",
+	"hWrMobj81QPJ9WhXVB27B1tfGEfehLD9xfVP61t7iZC0iOiQtsHML62s88nrxNFKbp1VtOxFtOhE+0Ne",
+	"SK+zGuVz6aJynusNRRw/EpClDQ+cpwwSOTpryBhBO7z0pqcxqkviuFI2KVhC7zWPuj5JGHb1TYccmVH7",
+	"qwV5Vyo1BdduKhmhzre2RfQMMeMLp+QXWggurmJh/A+6XnltRo3WV9MFOAEaA+ROnJgSm6REK5p6ALpb",
+	"v/i2GXSZkzG/KmjJ6uFSHyQjN+OKFSp/GNY4sLdmRqWqUDOvgDeasxXsrBkp9Tl0PDxDIpu1lXMYuKiC",
+	"S8FOXOUF/5daCRX7m5WsUCEkL/JyBGgdFXSLHytzm+pDzmXW1+3NWgctwcrbvLj2Uys6mfLnrqoHmAL0",
+	"gWpDW3Ljs6wYHBoBLpzTn1jXc0DDNQxsDgzhtX8zbhsgmH+N+K99FXPAEZBQFe0d4GUA9M3H41yYfeMi",
+	"yaYpOyA348gERgF5A7kNqGQRgeIdJR60wxQEEFkWtMwLibe0CsUmyVSW+Rh7kGTAZrmCp0u2ZGDyyklR",
+	"9a1VhW75EeJGFDESEcgdJwYE6cL18qHiu4rgFLOpThiib7CsCY4/FhooolPOarSOPQV6/lRXA0IrSC4Y",
+	"JqHJbxFVc+GV6vfZI3yngWAstSWLEGJSQVRBv3A3FOMJfn5zQECojbQwHxmmEpErrCCfy4io5LXw+pHZ",
+	"5gPCx/iWVSkjmD28FxF9VOGDY00MB4SJKy5Y5AJr9JfYsCKVg+qxyFOAqAFhFXlGgL2yiEC7rJDrsVAr",
+	"IstimpTTQiHEYJJUstRY/+u2er27l+FqSp8ddUdDdVsH+zXlhctrsFZ8bhlVBd/a6UYtBS5v1SKeZdq6",
+	"++joKrRIRrxkOObWQevT/u4lKiE6J2vvTqUFcKl4M8Dc5FRIVt4jUmmxTt0WIieC3c7dqV7mw5kuOQVS",
+	"5Pyt2iEnIFMj8kMw4yNRFS/mQV+9bm8PhLLu5kUXJLKnqD5veK3Ho76ng/8dpYP3xPyVzZO9g+2dp0oF",
+	"X8u5+7BU8GFhQpfCqFkyvXd9g6b7aKFd03v5ztfXn6CS5aOXo/waFSjnRaElldxlild6TS8woNybUh8W",
+	"5nKsQKXNK2zkNE+5V/rROIfQpLxQee0Lhn93yEuNRDU5mynRnZBrxia6Dhkim1dMbmOwuIH1Xq4+wOKc",
+	"Ek6pRsCPPmIWl8bE7Au28MkcB6rMGZrVV/chnE0oXJbYOWkbK8OEFhIDH1SMyzQpyZiKKVxy9/sdTm7f",
+	"/Nh9oN+hlhVKS3w6PsXkOVD3ppmvU6ESL9uHWaQenh/SHfLT5ofsPXV6SGc9PiBnDeeRMjJRpV7QYFxc",
+	"IOFGg73s3LEqgWkDoiI8s49rYQIXRSnryuY5K1Hc5NgSWmfcIKPnqlFt3Aq1G4unMF2xJstVaLwYBJBk",
+	"jBaOUO3YkZROUonyK470kSxSz90ql55JasCcKT6iVWo17cUdFOgpIkcoOitM3pKHTOALVJSQsanBn/nF",
+	"YQs13+YUu1lCFteu8UCsM24NxnlUlgKseYmf+LizldzE2k/awHa/zNuqmrDTehSYt1pLVwo3q3tfTIV5",
+	"5345fGpnUSV2X5I45mKenVAyfXwdj+I9gc///tDkGzPvmsDnpymp5vdUuUr8g98US6BGG7pGfwEZ4ASU",
+	"8VBYjBL+VWh5OH2XLAtGrYf/Flqbu0p1bw/LMAZSwbhR2vTEQeUyno6VoRHHYktTc0kYznJlMLFagw55",
+	"cXb205vD9z+pdiSWtUaGraaH952yxqQ3KmOI5nzTsR6gn3jo8Fhl6nlzdnz68rTKzY7/M535AGTnVX8S",
+	"wCOg3fYNLQQdM2QM1dYepileEdUvb7Q1wvtRwaD9317k+fWYFtetjw2YZm9/ghTGBqM8vz5mGQcYcFhM",
+	"S/VTWPBcMLXGiu5u1fdQX6H6qk5iOif/vVgR24d5mYxp6l1Jm1+lhq0dB9iGfpuy6VcCD+OaBuHUp8fm",
+	"btRjYyk5yvJpeqKPTDWizWcsGe7t7bV3B8l2e5sO99r7g+3Ndm+HJrS739t6xgbLD6Yh/yv6HpYcEM/x",
+	"YtLcqRNM89fBaIhLbbNZZnhL14eAF2uFIfzUoZp6dZlXoz3tdLdsitsPVZafZYaGypHuaiHR6VHCN1Xd",
+	"ChUGoktGPEay1SdIGB+CLuu1bLucQG581j+fO7/C25pyOBgo9f9nC/HO4S4GPMu4uHKb3Ev3BvvJJmv3",
+	"hl3a3h7ss/azZGen3R3u0q3h5qCXbKerBA5eJnnKlkgR6JKdV4ikijnVJQoYv/H1gl4waeBi/tYQc2To",
+	"ZypKnuGgmEix3iChCSSbzVgKFlJ8gux87fzDkUpQt46oB12HTfFiNOWxTyM6xTDyNZUsb927MqsqJ7al",
+	"qrSJd1O6z+/fg/DV6d9Z71RtuNbcZXau8BgsnX/0EnkClr5f0etjiDUiElaNSvKP9vHRm7buoH3qa3uP",
+	"RYlLujgCBLj87bX5SC4NLdbaG827TwzFRpVU8CRlEUK8ol4eIaSc3c/GLt2f8f0QI5vzsphGq5d9V0vt",
+	"+Wyhmjf3wd28LPfHx5ab69tb1qXsBnWx93GB5r/M33mBWZAP718TkZfK8ajM7urG1V4d5RuWLClYqSyQ",
+	"akAkF7ruvIE6CQaOXmNsCwbtrSgGh+T6rx5QV7EN2RBHpHfeaH2K/UltQod8MZl5hDkZrbgK84yFyRxD",
+	"foLs6xUUJhY1udV1rnU+6yWIFE9O76K59105d+79KFWa3J1GBiwjIs+14cnMtsVOvZTwA+TvlUpD/xtE",
+	"xy8QEZcU+XDslwo+uBzdKWyVnGr7j83IaKDzamljYRrXNYw0UhS55qRgQ/4pfkgcdrhuBnCNgN2E2RTS",
+	"P745PGqf/3jY29klkl8JimimShXntToB+8nmsDvcS3uDZ2yb7ia1zDu787LbbcFLVq326sJWiAvVoDax",
+	"qGFtyOpQm1h4WBuyMtQmFksG8FWE+I1DZBr5/9eO5ota0yJrUL10Bv9zvEmtJqN4/SSXgTLwJs2d3oeO",
+	"ftJJ8vEGzFeaM1ZLvb3Qdw+DfBTnwIryZ1DM9L4NS5o1e91y0qb30V1Y0PnzSJ3e0Vg5wDEkIz6mFHqH",
+	"JRuGua72WlK08c9h6YElHh+9sXWP36idh6IYhsUBLzNwcf4vEJ7oTPnW4VXF+qzDXlXm0rVjRVpDfqos",
+	"n8OCVuhZJ8WvxrlD18MKnkjW4IcTMaIiYVgGESCquaSZXLfjwqar+7WdF5yhozFlcLVh4//xH+R9hfwF",
+	"7O9f/uLgFORf/nJAjhU2HBTTDGkLRpzyIebJLLWEmA+bJhELQtZ+ftOASv9pOmCFYNCsBqgjWNsFoq+r",
+	"YTneFhzW0VRlDjRLncOAuLjSAoRFlIeqlJnU606C2blOjEsHO9NrYlIFVSI/xoT5ribVEnph8dt3rGgr",
+	"ZmZymuSickehvy7CCEkD+8ahace9aswmRMIGXwdTv8kq91tVNgQlLX1Nm0nbMsmQfjQwX9Vl4CyqZacq",
+	"kFLeq6apNg6nKS/RAI6fHk4mTKRKKIHF8iRB5dsg5ajIp1cKZnD47lTT6AUsXzKDv07QEaH3AZO4JPkE",
+	"LzWbRCbCxJ+iyjba/0cbWyjbp8d9jbOIxZqDWWbFDzZaS7dS3fvqA+hLK0frmGe9Ike8XHVamassH9CM",
+	"rOl8nMQmk1Gtgh2RTAp+o4KLlElRd4hIQUNY5YiNO8H9IVRl/BwwAF7gxGPhJvcvmFZ+nTEoYI56y/AV",
+	"23E1QZGXhE7LERMlXiTpgbNE8MKYUeXmGmDOVIqVrs2mUVIWyhh6pSpBqym5DUqS0CzDggBA5AwOHi9V",
+	"jm+4cCYY8gZ/9f/RxkCVNl6DfbWbScaZKO0xxIm8Uis+tyEgRCliK3Mz8wPj8HQjYLThgEWaFCNS5FmG",
+	"JQFsDXKmN+Q1v2a3XLL72nE+UlwgQpZJhSGC52Y4iKDBlkxuWF7EAvPsnunkZWqxrlhJ5qq2dEgtkgdm",
+	"bb6AxLjmQJCMm0ynHsH3D4/fnL69vDh5e/j24ryvVTZztsBdrPIhhb45++nkbd+0WN8rE1xH01Q52lEf",
+	"0ETRIb9ouGutf1QbCqbo0F9bpAh1tOpHT6R6lUlSlT7QWOcaN7G4sFjorg9fvzbdY17wvg67VEdWrSWh",
+	"wh8M1okYsGEOVhF93q2sYxC+QMwJZnbF65JNsnw2RkkcLyxMTqugLXB46RhJ+dCyBmeOsB6GKwxm5Irj",
+	"13CPmEOvxLy+MgD358Mi1vpYzN2vV/tf6vW/1QTuu/46KaiKUS1HVDiHu19/1W8Q6mpkOoWxDpNVwhkM",
+	"tw+6fx+msqCVjc88vevjapwem9AW1IU10tPwsCo6lTrBCxhlKkCjpRmcqRlWDp1KpjM24wt+QaTISXjt",
+	"xEEoGA9m0o+Fw0lVvCESRl4Aw1TMS+QlIlPHuaLgMs9SQgdA5jr06z/+w68RamLIYxEIpl/rOwDs/rqC",
+	"gbo1FpzY+qiC7Pdvxn0bsq8i2gwoUOaKGyO6U+fXp4KwG6zFYBDog4LRazwhzIQYuccNIo9sCtmF8c+x",
+	"0Feqd5FJMuGCUPu1rQQTirfua1tCPb7bzXJN3FKjsJo/m1XUVo0KdRi5wUSKWDTkE0u0QN5vLeDBWlmG",
+	"7o0+L8LXsiKYUBR0LHQYtOmyrzGffVILg1bGg73e1vZ6hxxqJBDTQ4wFjBF+mCE1q9YCNbo0L3FhmjoR",
+	"P+k72Q70nZpnaS2jgQMAjgXsxoEpSqFDIKqgBrzrinziwJVhwKpNW5Smf6C6Lfskh613uVt4OcmkYDec",
+	"3drKcoghBnCTaqgC99ZAxxFedm6ibzXYW10OLhYZVuQxlZfcGhNe8kkPxawGQG54jioQht/adVR2dJBK",
+	"p0I+t5yA25ibDjmUgQq53vGp1d+tLHYRoaTaNHikEhXqPAYVE/Ra0EZUN0wDzxhxxEgV0hMLHeuuZL2Z",
+	"DT1xF2BmrjWTyD8vDE97mRdjaQRKF7TubnI4JhaXxAbwIsMIId07pH8AhqcA8Sj3jpyLBgahUtqSH3pY",
+	"kVmVXLK5otNuVQJbFMCvsA2vUTLlqpeKo07yoqSZaubo9ampKWbrCqq6F5bFFqyNtYoUCVdquC5KXik5",
+	"RipVdkpsvtBpbOZXuGAJVN0gVFSkAxdwlbAGVkTXXiK2iBgQRWTkw6lAxagfuJGrWvb9Bq1EDUCtW99z",
+	"qphP+w4lufqzXklGiinWpBFOhTYwrzqEI5xiEriZwOGtPkSyPL+GeUyQ55jF6pu8PEAnk4KjQU2vC4Xf",
+	"IBw3F8xsBQaswf/7Bxglp+nOvX19pqVnI2uBlPoIxuKK3zCQSdC0oXZTau5Vhdeol8ZU8CGTpV4TWoJy",
+	"l/KCJWWuwIbmDXu7OTFnQLfF1HGyaB8XOS1jYc7KLbJDKlVueMsHtcisjoqS0UnfLP0lKCn9SOkC+bRM",
+	"8jFe8qr6HkstcU/gyCq5gYoZMsJIwVyBm+tTpRV+pGEzHS1Qq7hE/2icpmw8yUvmWQD0zUyThE3gCNta",
+	"d5c87c95K7RpZEcV4sBRgA3xhmZMlKTv9ND+ic0qBcZsgtI71RHXagyuP66qHY0kkg5ZNot0OgZlgQFu",
+	"o0xSqEO1jT8kJafH4I81bViWDTwDEnMQp9JVwUoVfgwrzPOUrPW2ySifFhKvAc251i1D9Oqx2joUhSwr",
+	"00lV7tBcIrEwl6nNmUF+wgQhBXPsLI7JIh868jBIT1oIioUZP6GOrdD07cjiAfFFc1q7Lgj+C0xAElny",
+	"LCNcgFn2qmBSelK+KlP6PBaDvByp31x02nb3mXJ/Vbqb4UrKfAD8zPAZJBmYExfVHmGpb6prttjzStSw",
+	"lQnG4QxjUt7yhB3Egg9dq4os8wmsrD4Aql9jvDN7GOm/ymJm9lgdxkQn0vbSZ1czMlFX5NCum2tmioWR",
+	"VjQzUZ6GlOQiYYSXWJJhwJjwFzkvTNAPqIigqZ28eXd2cfL26L8vNeTt8uL0zcnZhwuytknGXExLBsqr",
+	"oVNztF8g+xuzcpSnePPN6fbBu0YPdshKtD8NZqDwcUEG0+xal5rqHwyg7Ves7KvT39vaXIeDafVuzSTy",
+	"IZlOgKw3u92uOpLvdeyjgj2oDQdaqJfMVEe4kiUD5G3r8OKuU2E8zWOS5kzZ21DRArak7W9BXbNjZ4Rq",
+	"L7OT2lq3NGan0WDWpWU+5tDc7MBYK2t5ritLQ6SUW1U8xMwP4cUohVenEeYK9OOcSou5MYYZ5OlmPjgJ",
+	"VFYpGJwye+2+o0XJaWZJHunhFdNcV0n1+dC9hmVElqKXWKhrQt0SFEK55XXfXAp769i4OXFGacj1PFQ1",
+	"plhUX/4N6rG6VSyjOfW1bwx4ujVTcgo9884p1We7ui4cPBOTYG40uqgJHBYMTi18Dp3qpnjhpn6pEvf4",
+	"Snk9U40qvjWXx9BNUhmZbjFdDi875IO4RmCQnhhsv4pfJ/VKzWZfsYAmbubrL9hDvRtavqzCeM02bvXW",
+	"57mfGy/MC539SkbalBQLGzXcUaOEV5Nc6iqzKEKwAt94TiZUStKvBREroyGmjM8YvUGWmU/LDuk3AFMO",
+	"0N/T9+5pm/zcTat2VeTTieJtvhbib2lB8lt0qQGRKoffgKZXTC1kSuVokIMv0GyGOpHwhzWaTuphbKeh",
+	"zPyRYRmwZoaHITdACztKOToUpj5Cd/ydyt+ozNVagIyF9dZ4ldigTQyItDxqrUomqllv5TWLhYl3lcGK",
+	"h+uu1dBxwjkWAvYpwRMGrNopIaniMR3GPl8vXQkT+j6OhcokOym9dKHAyn+whltgrSaQgtCS9FWoYt9s",
+	"VoOjD+/JahrGowd/hLbRnBwqiPUjxqJyFjqCoFs/GvhIQqeSKZ83aEvQxYhOJgzGQOVMJKMiF/lUgiuk",
+	"9BiO9pMXHfIOfCn9VycXxKvlA1beKBa58nGQ/sEt5WU/0uD8PugmfVO46jk0LQwB9g3f7CP99fFW6ut6",
+	"DmbptPvS1aadyECH/0TLGKPABDyZDjIuQdxAnbEK7iFraHtQEQMKIAPGOxLwoMZChzlIF5qjdXPHhZcP",
+	"Sd2vEHnYLMXJFHtQHdusWoOZ/UgB7hSKDp0W6AYwzjPNttF1oCkdOthIYGZqaH+F4pZYL7s0fagsZnAi",
+	"XTmcXwlHUvZgIBArisC7AwUw6NdjA/oHZC6cC1OfqWOhrP5KLpWBBixQq39APgj+SdXa1s1VAScCRpGL",
+	"NNTEuUH69Q9IX45ob2f3b32Nt6gy5owYxF0keQrsgXhQwXxI+p9LM5C7zudBns7u+miGFTPS+/SpUsac",
+	"UBPpTdmIDOZNqWHMOn0bkrnxbCohHdebfVIwGhCdwNqRD4d4XqyarjloLExHqkJ5ZfEh/SaElQ/mB770",
+	"niljhDUf2mV6TijZcicK3K0WaIbEY44mtoC3h9Shk3jEEvAagpoBt7IHP9D+SQC2cal4hy4lDeJaRCjY",
+	"fyaqkrvxo4GmwsV1O8sTmpmW9SKq3BUN0ou6WrQfMBdC+bWUUZcl18aK6dlpRrkslWktoyUzo+PKSa/u",
+	"ImHHoO4SlSXWrLB2m1XO1l9OXvx4dvbTOTgqz365fPf+9OfDi5PLi8P3r07AY5vxYWmVffQ4Gh8I2LEA",
+	"fjHHAEMwC7Jm/CfSmBtlpK8VjVqKhVtIS64rQV+ryMARBangHk1aMor9lbVJ3ZgUTFvQ3Dxq4xAeOaCN",
+	"qEpCEQsHVgBLPCnyT6ieD4u8skzA5LiEQ4KbjRvBtPkfuHrcoiIXs3E+lXHLWrF4qYZmWNzp8fz4YtH/",
+	"R1u7i7wh5hXkNTUd1ZN+zH1cT4erFtPcEHBaPaxMZaD2JQoFLzNucAtJtYCE1N6PIBmjjRNv5gZhFSPS",
+	"+zpkXnoj8LTTH2QsAvcm1hc/V1avcyZKorBAHYL3h7q3NMoAjk8Vw61Pet8N1cYbHnO4mCAzLDt/fkL6",
+	"PO0/R2LEY6oPdO1jg/bpv6aybGMvzq6tK30P72bfSInHRXIrJeKolYnARMkjefGisvjqbUNSh9t4yArF",
+	"oGp5VHB5XaHy7MPF5dnLy/eHb1+dGK07Fhr0IkcooFpaqHQF5ETGfnSrdR4F9sx4wnSVR50S83BCkxEj",
+	"vQ6UiUQcsUUA397edig+xjrX+lu58fr06OTt+Um71+l2RuU4Q1AmLxFD2gCUbEUtm2Osygd2F7XyCRN0",
+	"wqGSQKfb2VYpwkYIDd2gQPNttXjwQ7B823uFhVXGfHrFBVXVwmQZBJQNZjU6NeqMYLdoq+GFqaTr1OPE",
+	"0mWydABsOFBtTlY5R78gi1granGB9VFUYJveGge8GrWq4vlzKOslqmiptObGhmHV2IaOoTIUdo7puNy+",
+	"bVKpzWAsblWrvAvP7y9sEIwaqdT6OmupO1K4JCaKsip57DmqnHJpoVk6OX1WWNymUVbUBXwJLipvZBRO",
+	"z/9xsPQNgzJfPtaIKJoSnSQiKA4vjklw8y6FxonBOZc6PqEa7HIpmlZbU8XHlh/89sLB28qYDxl6CLFe",
+	"sYKNdwij+jt2ePexio9HDtbrdg3YXCescTUt0K7gt2pM9+bRtMwI4wkQzV5L9KbKMA+nlREVWO52t9vU",
+	"th3sxguamoIo+Mnm4k8+oAgGCeZZqj7aWvzRy7wYYJF++GJnmZGdipIVgmZKkNDFnDFv2XhMMRs9rAeh",
+	"jsCEzxuEmgdeLOEaQJAabzqZ5EUpq9z6dZudfZ2cHjfdNCHp6fuV8+hXzkvco4bNnNs33C6HV0k9yVuE",
+	"qaLRf77caJmMTPyNyaPVVNMztC61BhftyirMafHr7xlN31B5vfQH59d8giWezvm/2FfggYFj8p0ZBphh",
+	"mMChl0kuA7zvSLsQ6Vxqevu1DRpAszyXDhjMaOzudz+YcFQLqrL6TJVjTofzB/yPxoJgcrYo97ZWpkuM",
+	"VJfTgcF/+qZoLT8aE3Qoe7GykH04BbdsWZ1ux6TBhflAJwnXY7yc8rRD3hlXONgQCgYXQDVm8+oP0kAN",
+	"KgextgLYCwBX1V4hsDLtKu4WANAQewuf/hCMU7/k6Q9zYBeMKKsQLaEr577C0PfeOWfaCF0fatN9twrr",
+	"q3G7WsDvgmjf1XmjPuGn6dLczoEJ/cRmP6LlQPM7bOpFns6ektUpNldFP+oA8hq37T3aEJz6/vP89Si4",
+	"48rRzlJ1sOfp3VabB/eDCbByzz8i80GFUcCg52ghdW3K7gdA2hVPf5Q5G5Y6N99TgWA4axMEEz+MU3E5",
+	"ZcT4epfFdvfZ4i8OVQjEiaqdD1/1lvjKOGhPTGqrR7ycjjRqL8wv7hPbNz4n8wfiNL1TdxlQVEiit2B+",
+	"5oU9NvRfu43G+Y0uBw/fY6rLuatIp/I3eClZC4PQTcXC4qi0Hdb4S62fNMCkm4v8zzHpBYzrKLR0UMc1",
+	"JK19Jf5xbPZjeZZhQzIdgLBdWFNUIRZf9RhuL/7ibV6+zKfiMc+RIo3mcxQtVnE1CC58cQ9mSMxhffUV",
+	"K5+YKFfWVL6yzrH8fTg0G//t6h3/Lhp+xcrHvAhMiBGKjmElR70gwyijhqE4IUZexE6d10c6/quKucE3",
+	"ddxVFQTrBxdF1uWIJlDTQHUh/azK+yl/GAoZcJfcMCJynTi+mNDCOlz91nVgkoIXGxAl6ikYBSVnlyYw",
+	"plJQMM6Jy4XROuHJ4DBVlJSNjLKdOg90wiKOfj8d6oVYgAPHHOM4MnWEUiDeK6jdqI3+GjfnE8j9avCW",
+	"Dywj8j8ps3PLCQfYno0v807Ud5YXYnnvqjAYl0k0c5/7GKELB7+H6c2BwxuMnxU6vIKWHygADJg4LNar",
+	"IRor49cmqitAQ1GFNeZeACIC+xybj5VLHNRIh5widL4aBrrMI+CBIdORB1dHPI7RGlP2qYKv+8D1Cqxe",
+	"MMLEMC+SqnSlwawTF7J+4sFSuaxwkjgVVTeBiVKnYANBFS6N/FbEwvIrDdUyf1p8l1chJhR7IEOM70VF",
+	"Ecs5FH7fJpIF012Jhfa+3qjgil1agpSOfoQU16QXqZDXe0wp340lv09jSQO/1uFOi2+IV0oTXKAQevj0",
+	"hi4tvskEtEoUJf2AqijIteBdG4bVIS8t5ikWNkSK3Bsh1cjvwuro6szONHaayq+gVd439JVZxHct814t",
+	"8wtOkK4E3XB8VGjTMmcnagzoiUUgogcVQSYoQA1fwYcaLV2kBvUPA1FJ/mOhc8ihLDWABA6zDjnUgdEq",
+	"pA3jnmwm/IbThLN5CBDhsCwLPpiWqCzjPGuS3mAWQm2hK6vBF4StXOJ3/s3teohMlYVae7WCwRgg1foY",
+	"dBEFtpPogCZ3/Lhw3N/bb8Sp//FrK4NTha/57m83tzQSTZPD/R7WokqPNbGWc425dkCRTYpbCGMdOTFC",
+	"qJplDSCjSpUBKLXCZHOJWlNqYYSMONhsG2mEsQu0pNor5wG4qcSMLB2CRcMiYgqB4Ye6aphfl8w361Pp",
+	"o3khoMqvZ0Yw4MvN06hg6rHQecShJ5UsQau1KpEAZvQiPM3YfHqHhApMfwPaKplO2mXextQrtZposfjF",
+	"Fmx2H0UV7/AxmXYZjTyMkeWmEl2IF+NSPoQXu8B0r/SJWjRTZqdDLjDR3QR+SBkseX7DdIkbDyJvIhJi",
+	"0cDcvHpqK4HIlhyrLiszmCnaPkeB28DiK6fQzA0BMGNVg68G603tAbBbtWd1uLIjIqus9wFoWCzmsWGx",
+	"+N3cIiX7VG7gvrTVGix/jVRsIXhvqBXNhw6Pkd+4LLvZXUoznI4ZYjRPMDzkMaVaXKqlL53HwLw2Q11r",
+	"paIXwVu/w1q/CqxVBrbmfiirKzYvgWNtZFM18fs7fDUoUH+HrS6ArT4Irbo8nHI54OSRd6JowUxc/lRk",
+	"GEhsAi9/UNl0fwDZEq1jaAbDUD+I0pQqgZVJ7lglAFfyp1b1lwFqPgpA85vGZa586H8vMM7lHBGbT9f1",
+	"PbZE4zuTlv1ks+/eghW8BU8JkAxIdD4K5n4YpAKLyVqjSyEOvwgv0Ygw3A6wdJcYDUZynhi/TVP1UhTz",
+	"I5WnbiDGUyIBHwwAXAH39xik8a3i/Bayy+8OlxVgfbooYhKoivhBJ8P1q/XIACJGoesw09IbVlwx8g5a",
+	"1PnSt57tqpSSb/NS52lw0ufbRMe+ikML1lwXL0D+aqxPwRyXkTrGMOk2LuNfn1gC+fccKV3F898rgahB",
+	"GEHkT3BaFVGvLm9U+cCXxBbY9+fPdkTGuVTmXlEl5Di0n3hxebqYki6EMJc236QvjYWhp7zArOngNaXJ",
+	"9RIGKpuJ/ZFuuO/WrdWsW1/pjjfbvLIh5g/ND+ajh6uDvpgrqHz5i8Gocyd3DlnUlCpfOaiqLPkKg1rl",
+	"UnX9biqgqF7yIBaOjCGILXPjjUehMCcZTQx0P7d51GNhulfBiY6kEdVCAiZciKoMDA5WmXpi4UkfHXIo",
+	"zN2jHJJ6GiY7t6nuIHK7H7rIy3y8ds285OZqt1Yq9JkpO1Us0D9s6vkynfrfy/yvso+bac+hgN2cbn5a",
+	"16quEINp6OoAYZcnll346grGitamn/W8wZTu6iTfgOHoSbgkbkozHu2syjEJJKCO/zeuFv0brTu4mnXN",
+	"xhyrZfjrajFODaFN80E0waJPy0U0kYaAJp1Bzzbg5YB+qTiwE9VEwkFNsZjvojmqibhBTbYE3ZhyxNM5",
+	"qdi9FJ6h0CbdpY34shFSvCCTIh9kbCybAptyk+8euq8WLrIlS5ar1LRUXNNXVUF/p3FMy8YvebvyPYbp",
+	"S2OYlmFnoCc06o/H+NdAi4vwKvq0EH2ARV9QbdF4KieZuzsSCIpg44O5+mAkWB7sms0UX6scD5EGqTeU",
+	"ivOgu2iiMVmZAVGHzUDOSS0tQX16qYuH+cXEKi6gUPPw9JanV8wpRuawNZ09x4wC10ZHU+EaXVbPaTAz",
+	"62LzLpR1exrr/5Mcchxu4HjD77VqWNYm+ScwxFrqeNDxNAXUmsWN96awWhnQtBz5o1Z1bf6I2kpM0Fxq",
+	"6Nurw2RLtZlmTN24WIy4xPJoXOqySwW7LXhZMgEFS7WCV4H0bIVD01IswpXp1Kj80pZ2El5Nw1h4soTL",
+	"FrRgQbHuu1LyzPG3bUH3sRC5KaukysJxabOUk6YSK3PH+L3etN+HlBAY7b9fYlhoq3YAveaIfJcVAizo",
+	"va2jvpD5rB6r5iJzgpFptUpfUbiWV2NkGgkEpsUiEJm2RO2uZSLXfocRa0tGqn0PUFshQK0WlzZiNCub",
+	"w0R+xMdKoUWvQnM+9jlpT33bekKS0T2ErOv6huSSqBnOasviTkythB3/l+SGrxppKMoUxSLkjQo6jc6q",
+	"EX3HNH85pvlbCXuz2/odoxtwDjnHsHYsNz47R+RuyXsc66k75UspyYJVzxo0VrtXK1+eZ1VTT6+p3psJ",
+	"zz50r8k/PkZIVJt7PyUdqMpD9xi98TkyfRUS7ZANUQ8za4jWCB/t/qsyNFbZNLx7whQxPjp8e3Ty+jVE",
+	"LcKEqrp7TPqhix1ybOsmVRms1BQylnoDctcAREuqagIq74Oqzz2i6PVjwyFLwghxbO6PdQ40GMspOPW7",
+	"h3++zUu98WByfEzEMLa6ynGCSovNh+kXykupyzD6J4HrkvG6klLJxwxcuyyjE8lkpNNyu974OnP32lPG",
+	"Fa95kZexECxhUtKCZ/oI6ABPW0VLhgNm+WNeBo1SlEIe5QQWMVI2qXSqZ1CVG93qyriF8SPKS1WS3a4p",
+	"QFgPpNrqNkl8eoXDIpf+zo3GgKiLv67FcUf9b/2/1sbyf+X/jtdDgRj/tmP++j6i+K4jhsM9OZYWDZxy",
+	"LEz7JRqRaqBBGwIcXgpyuywLWuaFU4qu+k5D8nRdrdxaVgzUReUSGGCkFRON2pTKv/Zdk/oDaVK4pd+1",
+	"qIAWpY/tkiGOuvp0XoTOKB4580z9pNJ3xGIwI3116KAgPUT/Uv80a0iCGs1z/bUkYzojBaMa8RALfdYH",
+	"05JcsZK8O3n/5vT8/PTs7eXxyVvI6nFr0zii9C3YYwdiNgRGIoE9NCRSrWotFvJm3M74mJfyezDk43t7",
+	"1HZ95TBIp1OfLvDBosjH7xC2hgDF3/SyWhlk4zP+u3QwIr4d9M+a0tIUlV6WKt4VixrzUl2odmRzOGMD",
+	"j1hwLv6u5rJCCKMip99b7OJjhyFqslg+/hA/WBh4+CSb2P1aPOZPZddzOYMGQrTh4nuokuKBKVI25ILr",
+	"SuVVOsRaEkUwzlX54a0YIksqUlqkphPQ2RXGFfUGVcy5ST3RHqsLnMljKimQmGVQUi7cUmrw4mWlp2hE",
+	"msbSsBueT6uqSM2puZ5e0enE4nSIzNrKVVFV8k1lHG8e37+x7rDVYzWdVEkeXUr66hlvvvkUNs4x+K7Y",
+	"BRQ7l3qW1u8aWBxqduoNA7Ndc6KN1mNRi2Gq5Xt6VA3s1MBlDNZFl65KIwt5g+L2gM29YoIVaN0TrFl3",
+	"cyjpoRrc6bFVfGtTfzOVYDHLsvyWHL89b29u9rZIRgcsI4qZkLUsv2UFpubB6vRiOmYFT5S/ZzSbjJiQ",
+	"62reuaoz6U3UzFHCBhixZQle8b3IYQM3+dpq4VzXYYAKHslvMjtOBSpXLsk/nQbqXdTz4ubGZ1lt8XKA",
+	"BKuUeAx5kW5yLyNbdH27Q/wGk6Ksckq+g/wWKEY+wS5MiqKqVqIcTIYZvbKFbVI2KVhSwRi8hqsqZYtT",
+	"ppALL0rQfAhFjW1s8nMymQ4yLkc1SYQLWTKaopjxhl5DV1UL7tCnQjIdkGhVF/0MsjrrT2Ihy3widaCw",
+	"+z3mVEYDcz1qccCSfOwvVHPilkc+pV8hcYvTq5rC14bEr3L4F6Rv+c4L5lOurHh/rZJcwbu/zHFZIsmC",
+	"TlJNVk2yADwkcqNdVfp1FLjnMi24cbwOm8nRxotJ5etMz2V4CRVOTngzSDU5/WEshjSTjGSM3jDp9W2a",
+	"DjCqCC7/hEHEnHmaq5TfYb7ksaRcMMuOeGlzxK+URoHUsijE4sFpFL66SPL18iKsrDI8CT/8nhfhKfIi",
+	"+OG0Xl6EqaRXbOlcU6ool8SQ3em4SlYw5zrHMOKSZiqNiYnhw+hdHSXc7F56xcoPUoE9nozkVAffXHqi",
+	"RxSLzWaRqZ5q1Nq4ZYNRnl+35XRg5/wlGCfdHvHas4HV85inoAPgF9XIuTem72ilPw5aKbDB303cARN3",
+	"8DQta+oOfdyEbPpKIKLAvj/UIB2cXQ1hNOAI/v+OL3p82TC0k1/ZrNw4hBrKPkQo36FIDzMEh07dPYLE",
+	"xufb+U1aGrYUPOJlfsVQD0Q1FMRGE4mUsozfsIIzqUpg679nJMuvmjFLS7GkBSfvl9AkV8AzBUn0zw5v",
+	"CpPa8minIPUscjB8dWrofhPs8M+FmnokJrZRMZwltWWXIyk/QGgoXpLmWNwbF69387gaySNS6/eMyt9U",
+	"RmV/r2ffsyk3qkvOwVz9WB+UTN4TtHnORIqG7j7PO2kyNsUnO7q1S7eTzoSLq74uslnqFFruCz9I8uH9",
+	"a5KLhNl8nvpwyUiJMa47QB0tR9iZ6czL+i8V0yxzm8rLJuJZJAxdMPkHuPzM2QidC/PMWKpga9TO/AmO",
+	"xwWmbmy6+e5UJW+zxdMiax20NuiEb9xsImJrs3X38e7/GwBe/vVYxpwBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -7,10 +7,56 @@ import (
 	"time"
 )
 
+// Defines values for ApplyAction.
+const (
+	ApplyActionCreated   ApplyAction = "CREATED"
+	ApplyActionUnchanged ApplyAction = "UNCHANGED"
+	ApplyActionUpdated   ApplyAction = "UPDATED"
+)
+
+// Defines values for ApplyChangeType.
+const (
+	ApplyChangeAdded    ApplyChangeType = "ADDED"
+	ApplyChangeModified ApplyChangeType = "MODIFIED"
+	ApplyChangeRemoved  ApplyChangeType = "REMOVED"
+)
+
+// Defines values for AuditEventAction.
+const (
+	AuditActionCancel AuditEventAction = "CANCEL"
+	AuditActionCreate AuditEventAction = "CREATE"
+	AuditActionDelete AuditEventAction = "DELETE"
+	AuditActionUpdate AuditEventAction = "UPDATE"
+)
+
+// Defines values for CatalogItemInstanceCountsGroupBy.
+const (
+	CatalogItemInstanceCountsGroupByCatalogItem CatalogItemInstanceCountsGroupBy = "catalog_item"
+	CatalogItemInstanceCountsGroupByOwner       CatalogItemInstanceCountsGroupBy = "owner"
+	CatalogItemInstanceCountsGroupByServiceType CatalogItemInstanceCountsGroupBy = "service_type"
+)
+
+// Defines values for CatalogItemInstanceStatusState.
+const (
+	DELETING     CatalogItemInstanceStatusState = "DELETING"
+	FAILED       CatalogItemInstanceStatusState = "FAILED"
+	PENDING      CatalogItemInstanceStatusState = "PENDING"
+	PROVISIONING CatalogItemInstanceStatusState = "PROVISIONING"
+	READY        CatalogItemInstanceStatusState = "READY"
+)
+
+// Defines values for ConditionStatus.
+const (
+	False   ConditionStatus = "False"
+	True    ConditionStatus = "True"
+	Unknown ConditionStatus = "Unknown"
+)
+
 // Defines values for ErrorType.
 const (
 	ABORTED            ErrorType = "ABORTED"
 	ALREADYEXISTS      ErrorType = "ALREADY_EXISTS"
+	CANCELLED          ErrorType = "CANCELLED"
 	DEADLINEEXCEEDED   ErrorType = "DEADLINE_EXCEEDED"
 	FAILEDPRECONDITION ErrorType = "FAILED_PRECONDITION"
 	INTERNAL           ErrorType = "INTERNAL"
@@ -24,6 +70,147 @@ const (
 	UNIMPLEMENTED      ErrorType = "UNIMPLEMENTED"
 )
 
+// Defines values for OperationMetadataType.
+const (
+	CreateCatalogItemInstance OperationMetadataType = "CreateCatalogItemInstance"
+	DeleteCatalogItemInstance OperationMetadataType = "DeleteCatalogItemInstance"
+)
+
+// Defines values for WatchEventType.
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventBookmark WatchEventType = "BOOKMARK"
+	WatchEventDeleted  WatchEventType = "DELETED"
+	WatchEventModified WatchEventType = "MODIFIED"
+)
+
+// Defines values for WebhookDeliveryState.
+const (
+	WebhookDeliveryFailed    WebhookDeliveryState = "FAILED"
+	WebhookDeliveryPending   WebhookDeliveryState = "PENDING"
+	WebhookDeliverySucceeded WebhookDeliveryState = "SUCCEEDED"
+)
+
+// Defines values for CountCatalogItemInstancesParamsGroupBy.
+const (
+	CountCatalogItemInstancesParamsGroupByCatalogItem CountCatalogItemInstancesParamsGroupBy = "catalog_item"
+	CountCatalogItemInstancesParamsGroupByOwner       CountCatalogItemInstancesParamsGroupBy = "owner"
+	CountCatalogItemInstancesParamsGroupByServiceType CountCatalogItemInstancesParamsGroupBy = "service_type"
+)
+
+// ApplyAction What an apply did, or would do with validate_only: create the
+// resource, update it, or nothing as it already matches the manifest
+type ApplyAction string
+
+// ApplyChange defines model for ApplyChange.
+type ApplyChange struct {
+	// After Value of the field after the apply; unset for removed fields
+	After interface{} `json:"after,omitempty"`
+
+	// Before Value of the field before the apply; unset for added fields
+	Before interface{} `json:"before,omitempty"`
+
+	// Path Dot-separated path of the changed field of the resource. Field
+	// configurations are identified by their path, as in
+	// spec.fields[vcpu.count]; other arrays change as a whole.
+	Path string          `json:"path"`
+	Type ApplyChangeType `json:"type"`
+}
+
+// ApplyChangeType defines model for ApplyChange.Type.
+type ApplyChangeType string
+
+// AuditChange A difference between a resource before and after a change
+type AuditChange struct {
+	// After Value after the change; omitted when it was removed
+	After interface{} `json:"after,omitempty"`
+
+	// Before Value before the change; omitted when it was added
+	Before interface{} `json:"before,omitempty"`
+
+	// Path JSON path of the changed value
+	Path string `json:"path"`
+}
+
+// AuditEvent A change made through the API
+type AuditEvent struct {
+	// Action Kind of change
+	Action AuditEventAction `json:"action"`
+
+	// Actor Identity of the caller, from the X-Actor-ID header
+	Actor string `json:"actor"`
+
+	// After The resource after the change; omitted on deletion
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before The resource before the change; omitted on creation
+	Before *map[string]interface{} `json:"before,omitempty"`
+
+	// Changes Differences between before and after, on updates and cancellations.
+	// Arrays of objects identified by a path, such as the fields of a
+	// catalog item, are compared element by element: each added,
+	// removed or modified element is one change whose path ends with
+	// [<element path>], e.g. spec.fields[spec.vcpu.count].
+	Changes *[]AuditChange `json:"changes,omitempty"`
+
+	// Path Resource path in the format: tenants/{tenantId}/audit-events/{auditEventId}
+	Path string `json:"path"`
+
+	// RequestId ID of the request that made the change
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Resource Path of the changed resource
+	Resource string `json:"resource"`
+
+	// ResourceType Type of the changed resource: service_type, catalog_item,
+	// catalog_item_instance, quota, operation or webhook_subscription
+	ResourceType string `json:"resource_type"`
+
+	// Time Timestamp of the change (RFC 3339)
+	Time time.Time `json:"time"`
+
+	// Uid Unique identifier of the audit event
+	Uid string `json:"uid"`
+}
+
+// AuditEventAction Kind of change
+type AuditEventAction string
+
+// AuditEventList defines model for AuditEventList.
+type AuditEventList struct {
+	// NextPageToken Token for retrieving the next page.
+	// Empty string indicates this is the last page.
+	NextPageToken string `json:"next_page_token"`
+
+	// Results Array of audit events
+	Results []AuditEvent `json:"results"`
+}
+
+// BatchCreateCatalogItemInstancesRequest defines model for BatchCreateCatalogItemInstancesRequest.
+type BatchCreateCatalogItemInstancesRequest struct {
+	// Requests Instances to create
+	Requests []CreateCatalogItemInstanceRequest `json:"requests"`
+}
+
+// BatchCreateCatalogItemInstancesResult defines model for BatchCreateCatalogItemInstancesResult.
+type BatchCreateCatalogItemInstancesResult struct {
+	// Results Operations tracking the provisioning of each instance, in the
+	// order of the requests
+	Results []Operation `json:"results"`
+}
+
+// BatchGetCatalogItemInstancesResult defines model for BatchGetCatalogItemInstancesResult.
+type BatchGetCatalogItemInstancesResult struct {
+	// Results Catalog item instances, in the order of the requested IDs
+	Results []CatalogItemInstance `json:"results"`
+}
+
+// BatchGetCatalogItemsResult defines model for BatchGetCatalogItemsResult.
+type BatchGetCatalogItemsResult struct {
+	// Results Catalog items, in the order of the requested IDs
+	Results []CatalogItem `json:"results"`
+}
+
 // CatalogItem defines model for CatalogItem.
 type CatalogItem struct {
 	// ApiVersion Version of the CatalogItem schema itself (e.g., v1alpha1).
@@ -37,9 +224,15 @@ type CatalogItem struct {
 	// Mutable and does not need to be unique.
 	DisplayName *string `json:"display_name,omitempty"`
 
-	// Path Resource path in the format: catalog-items/{catalogItemId}
+	// Path Resource path in the format: catalog-items/{catalogItemId} for
+	// global items, or tenants/{tenantId}/catalog-items/{catalogItemId}
+	// for tenant-private items.
 	Path *string `json:"path,omitempty"`
 
+	// Revision Current revision of the catalog item. Starts at 1 and is
+	// incremented by every update and rollback.
+	Revision *int32 `json:"revision,omitempty"`
+
 	// Spec Specification for a catalog item, defining the service type reference
 	// and field configurations.
 	Spec *CatalogItemSpec `json:"spec,omitempty"`
@@ -55,12 +248,71 @@ type CatalogItem struct {
 	UpdateTime *time.Time `json:"update_time,omitempty"`
 }
 
+// CatalogItemApplyResult defines model for CatalogItemApplyResult.
+type CatalogItemApplyResult struct {
+	// Action What an apply did, or would do with validate_only: create the
+	// resource, update it, or nothing as it already matches the manifest
+	Action      ApplyAction `json:"action"`
+	CatalogItem CatalogItem `json:"catalog_item"`
+
+	// Changes Fields changed by the apply. Output-only fields such as
+	// revision and update_time are not compared.
+	Changes []ApplyChange `json:"changes"`
+}
+
+// CatalogItemConversion defines model for CatalogItemConversion.
+type CatalogItemConversion struct {
+	// FromVersion Version of the service type the catalog item uses
+	FromVersion string `json:"from_version"`
+
+	// LossyFields Paths of the field configurations dropped by the conversion, or
+	// that are not fields of the target version
+	LossyFields []string `json:"lossy_fields"`
+
+	// Spec Specification for a catalog item, defining the service type reference
+	// and field configurations.
+	Spec CatalogItemSpec `json:"spec"`
+
+	// ToVersion Version of the service type converted to
+	ToVersion string `json:"to_version"`
+
+	// Violations Problems of the converted field configurations against the schema
+	// of the target version, such as defaults it rejects. They must be
+	// fixed before the catalog item can use the target version.
+	Violations []string `json:"violations"`
+}
+
+// CatalogItemForm defines model for CatalogItemForm.
+type CatalogItemForm struct {
+	// Schema JSON Schema (draft 2020-12) of the editable fields of the catalog
+	// item. Each property is named after the path of a field and carries
+	// its title, default and constraints, and the default_expression,
+	// visible_when and required_when of the field as
+	// x-default-expression, x-visible-when and x-required-when. The
+	// OpenAPI 3.0 keywords of the service type schema are converted:
+	// boolean exclusiveMinimum and exclusiveMaximum become numeric
+	// bounds, and nullable adds null to the type. `required` lists the
+	// fields the service type schema requires that have no default or
+	// default_expression; fields with required_when are only required
+	// when their condition holds, and are not listed.
+	Schema map[string]interface{} `json:"schema"`
+
+	// UiSchema Presentation hints in the uiSchema format of react-jsonschema-form:
+	// "ui:order" lists the fields in the order of the catalog item, and
+	// each field may set a "ui:widget".
+	UiSchema map[string]interface{} `json:"ui_schema"`
+}
+
 // CatalogItemInstance defines model for CatalogItemInstance.
 type CatalogItemInstance struct {
 	// ApiVersion Version of the CatalogItemInstance schema itself (e.g., v1alpha1).
 	// Immutable after creation.
 	ApiVersion string `json:"api_version"`
 
+	// CatalogItemRevision Revision of the catalog item the instance was created from.
+	// Later changes to the catalog item do not affect the instance.
+	CatalogItemRevision *int32 `json:"catalog_item_revision,omitempty"`
+
 	// CreateTime Timestamp when the catalog item was created (RFC 3339)
 	CreateTime *time.Time `json:"create_time,omitempty"`
 
@@ -68,7 +320,7 @@ type CatalogItemInstance struct {
 	// Mutable and does not need to be unique.
 	DisplayName string `json:"display_name"`
 
-	// Path Resource path in the format: catalog-item-instances/{catalogItemInstanceId}
+	// Path Resource path in the format: tenants/{tenantId}/catalog-item-instances/{catalogItemInstanceId}
 	Path *string `json:"path,omitempty"`
 
 	// ServiceTypeInstanceUid Unique identifier of the corresponding service type instance
@@ -82,6 +334,15 @@ type CatalogItemInstance struct {
 	// and field configurations.
 	Spec CatalogItemInstanceSpec `json:"spec"`
 
+	// Status Lifecycle status of a catalog item instance. Output only.
+	//
+	// - PENDING: accepted, waiting to be submitted to the provider
+	// - PROVISIONING: accepted by the provider, not ready yet
+	// - READY: the provider reports the instance as ready
+	// - FAILED: provisioning or deletion failed; see last_error
+	// - DELETING: deletion requested, waiting for the provider
+	Status *CatalogItemInstanceStatus `json:"status,omitempty"`
+
 	// Uid Unique identifier for the catalog item instance. This field is output-only and
 	// immutable after creation. The ID can be optionally specified via
 	// query parameter on creation; if not provided, the server generates a UUID.
@@ -93,6 +354,52 @@ type CatalogItemInstance struct {
 	UpdateTime *time.Time `json:"update_time,omitempty"`
 }
 
+// CatalogItemInstanceConversion defines model for CatalogItemInstanceConversion.
+type CatalogItemInstanceConversion struct {
+	// FromVersion Version of the service type the instance was rendered for
+	FromVersion string `json:"from_version"`
+
+	// LossyFields Paths of the rendered spec whose values were dropped by the
+	// conversion, or that are not fields of the target version
+	LossyFields []string `json:"lossy_fields"`
+
+	// Spec Rendered spec converted to the target version
+	Spec map[string]interface{} `json:"spec"`
+
+	// ToVersion Version of the service type converted to
+	ToVersion string `json:"to_version"`
+
+	// Violations Constraints of the schema of the target version the converted spec
+	// does not satisfy, by JSON pointer
+	Violations []string `json:"violations"`
+}
+
+// CatalogItemInstanceCount defines model for CatalogItemInstanceCount.
+type CatalogItemInstanceCount struct {
+	// Count Number of instances in the group
+	Count int64 `json:"count"`
+
+	// Key Catalog item ID, service type, or tenant path
+	// (tenants/{tenant_id}) of the group
+	Key string `json:"key"`
+}
+
+// CatalogItemInstanceCounts defines model for CatalogItemInstanceCounts.
+type CatalogItemInstanceCounts struct {
+	// GroupBy Attribute the instances are grouped by
+	GroupBy CatalogItemInstanceCountsGroupBy `json:"group_by"`
+
+	// Groups Instance counts per value of the attribute, by descending count.
+	// Values without instances are left out.
+	Groups []CatalogItemInstanceCount `json:"groups"`
+
+	// TotalSize Number of instances across all groups
+	TotalSize int64 `json:"total_size"`
+}
+
+// CatalogItemInstanceCountsGroupBy Attribute the instances are grouped by
+type CatalogItemInstanceCountsGroupBy string
+
 // CatalogItemInstanceList defines model for CatalogItemInstanceList.
 type CatalogItemInstanceList struct {
 	// NextPageToken Token for retrieving the next page.
@@ -101,6 +408,10 @@ type CatalogItemInstanceList struct {
 
 	// Results Array of catalog item instance resources
 	Results []CatalogItemInstance `json:"results"`
+
+	// TotalSize Number of catalog item instances matching the query across all pages
+	// (AEP-132). Omitted with skip_total_size.
+	TotalSize *int64 `json:"total_size,omitempty"`
 }
 
 // CatalogItemInstanceSpec Specification for a catalog item instance, defining the catalog item reference
@@ -114,6 +425,25 @@ type CatalogItemInstanceSpec struct {
 	UserValues []UserValue `json:"user_values"`
 }
 
+// CatalogItemInstanceStatus Lifecycle status of a catalog item instance. Output only.
+//
+// - PENDING: accepted, waiting to be submitted to the provider
+// - PROVISIONING: accepted by the provider, not ready yet
+// - READY: the provider reports the instance as ready
+// - FAILED: provisioning or deletion failed; see last_error
+// - DELETING: deletion requested, waiting for the provider
+type CatalogItemInstanceStatus struct {
+	// Conditions Observations about the instance, such as Dispatched and Ready
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// LastError Error of the most recent failed attempt, if any
+	LastError *string                        `json:"last_error,omitempty"`
+	State     CatalogItemInstanceStatusState `json:"state"`
+}
+
+// CatalogItemInstanceStatusState defines model for CatalogItemInstanceStatus.State.
+type CatalogItemInstanceStatusState string
+
 // CatalogItemList defines model for CatalogItemList.
 type CatalogItemList struct {
 	// NextPageToken Token for retrieving the next page.
@@ -122,6 +452,40 @@ type CatalogItemList struct {
 
 	// Results Array of catalog item resources
 	Results []CatalogItem `json:"results"`
+
+	// TotalSize Number of catalog items matching the query across all pages
+	// (AEP-132). Omitted with skip_total_size.
+	TotalSize *int64 `json:"total_size,omitempty"`
+}
+
+// CatalogItemRevision An immutable snapshot of a catalog item
+type CatalogItemRevision struct {
+	// CreateTime Timestamp when the revision was recorded (RFC 3339)
+	CreateTime *time.Time `json:"create_time,omitempty"`
+
+	// DisplayName Display name of the catalog item at this revision
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Path Resource path in the format:
+	// {catalogItemPath}/revisions/{revision}
+	Path *string `json:"path,omitempty"`
+
+	// Revision Number of the revision, starting at 1
+	Revision *int32 `json:"revision,omitempty"`
+
+	// Spec Specification for a catalog item, defining the service type reference
+	// and field configurations.
+	Spec CatalogItemSpec `json:"spec"`
+}
+
+// CatalogItemRevisionList defines model for CatalogItemRevisionList.
+type CatalogItemRevisionList struct {
+	// NextPageToken Token for retrieving the next page.
+	// Empty string indicates this is the last page.
+	NextPageToken string `json:"next_page_token"`
+
+	// Results Array of catalog item revisions
+	Results []CatalogItemRevision `json:"results"`
 }
 
 // CatalogItemSpec Specification for a catalog item, defining the service type reference
@@ -135,6 +499,44 @@ type CatalogItemSpec struct {
 	// ServiceType The Service type this catalog item references.
 	// Immutable after creation.
 	ServiceType *string `json:"service_type,omitempty"`
+
+	// ServiceTypeVersion Version (api_version) of the service type this catalog item is
+	// pinned to. Defaults to the default version of the service type
+	// at creation. Immutable after creation.
+	ServiceTypeVersion *string `json:"service_type_version,omitempty"`
+}
+
+// Condition defines model for Condition.
+type Condition struct {
+	// LastTransitionTime Timestamp when the status last changed (RFC 3339)
+	LastTransitionTime time.Time `json:"last_transition_time"`
+
+	// Message Human-readable details
+	Message *string `json:"message,omitempty"`
+
+	// Reason Machine-readable reason for the last transition
+	Reason *string         `json:"reason,omitempty"`
+	Status ConditionStatus `json:"status"`
+
+	// Type Aspect of the status the condition describes
+	Type string `json:"type"`
+}
+
+// ConditionStatus defines model for Condition.Status.
+type ConditionStatus string
+
+// ConvertRequest defines model for ConvertRequest.
+type ConvertRequest struct {
+	// ServiceTypeVersion Version (api_version) of the service type to convert to
+	ServiceTypeVersion string `json:"service_type_version"`
+}
+
+// CreateCatalogItemInstanceRequest defines model for CreateCatalogItemInstanceRequest.
+type CreateCatalogItemInstanceRequest struct {
+	CatalogItemInstance CatalogItemInstance `json:"catalog_item_instance"`
+
+	// Id Optional user-specified catalog item instance ID
+	Id *string `json:"id,omitempty"`
 }
 
 // Error Error response following RFC 7807 Problem Details for HTTP APIs
@@ -166,10 +568,28 @@ type ErrorType string
 type FieldConfiguration struct {
 	// Default Default value for this field.
 	// Type depends on the field's schema (can be string, number, boolean, object, array).
+	// Must conform to the service type schema and to validation_schema.
 	// If editable=false, this is the fixed value.
 	// If editable=true, this is the initial/suggested value.
 	Default interface{} `json:"default"`
 
+	// DefaultExpression CEL expression computing the default value of this field when the
+	// instance is rendered, instead of a fixed default. Its variables are:
+	// - `spec`: the service type payload rendered from the user values
+	//   and the fixed defaults
+	// - `instance`: uid, display_name and catalog_item_id of the instance
+	// - `requester`: tenant and actor of the request
+	// The CEL string extensions (substring, lowerAscii, replace, ...) are
+	// available. JSON numbers are doubles, as in `spec.memory.min * 2.0`.
+	// `quantity(string)` converts a quantity such as "2GB" to megabytes
+	// and `formatQuantity(int)` converts megabytes back, as in
+	// `formatQuantity(quantity(spec.memory.size) * 2)`.
+	// Evaluation is limited in cost, time and result size, and its
+	// result must conform to the service type schema and to
+	// validation_schema.
+	// Cannot be combined with default.
+	DefaultExpression *string `json:"default_expression,omitempty"`
+
 	// DisplayName User-facing label for this field in UI/CLI.
 	// If omitted, derived from the path (e.g., "spec.vcpu.count" → "Vcpu Count").
 	DisplayName *string `json:"display_name,omitempty"`
@@ -179,16 +599,53 @@ type FieldConfiguration struct {
 	Editable *bool `json:"editable,omitempty"`
 
 	// Path JSON path to the field in the ServiceType spec using dot notation.
+	// Must resolve to a field of the service type version, and be unique
+	// within the catalog item.
 	// Examples: "spec.vcpu.count", "spec.memory.size_gb", "metadata.labels.tier"
 	Path string `json:"path"`
 
+	// RequiredWhen CEL expression deciding whether this field must have a value,
+	// either a user value or its default, when it is visible. Takes the
+	// same variables as visible_when.
+	RequiredWhen *string `json:"required_when,omitempty"`
+
 	// ValidationSchema JSON Schema constraints for validating this field (draft 2020-12).
 	// Only applicable when editable=true.
 	// Supports standard JSON Schema keywords: type, minimum, maximum,
 	// pattern, enum, minLength, maxLength, etc.
+	// May only narrow the service type schema of the field: for instance,
+	// a maximum above the service type's maximum is rejected.
+	//
+	// Sizes such as "16GB" (MB, GB or TB, binary units) are bounded with
+	// the x-min-quantity and x-max-quantity keywords, or declared with
+	// format: quantity, here or in the service type schema. Their values
+	// are rendered in the largest unit that represents them exactly,
+	// e.g. "2048MB" as "2GB".
 	//
 	// Reference: https://json-schema.org/draft/2020-12/json-schema-validation
 	ValidationSchema *map[string]interface{} `json:"validation_schema,omitempty"`
+
+	// VisibleWhen CEL expression deciding whether this field is shown. The variable
+	// `spec` holds the service type payload rendered from the defaults
+	// and user values, so "spec.data_disk.enabled" reads the field of
+	// that path. Hidden fields take no user value and are left out of the
+	// rendered spec. Reading a field that is not set fails the request;
+	// test such fields with has(), or read them with optional field
+	// selection, e.g. `spec.?gpu.count.orValue(0) > 0`. Always visible
+	// when omitted.
+	VisibleWhen *string `json:"visible_when,omitempty"`
+}
+
+// FieldMapping defines model for FieldMapping.
+type FieldMapping struct {
+	// From Dot-separated path of a field in the source version, relative to
+	// the service type payload. Mapping a field also maps the fields
+	// nested in it.
+	From string `json:"from"`
+
+	// To Path of the field in this version. When omitted, the field has no
+	// counterpart and its value is lost.
+	To *string `json:"to,omitempty"`
 }
 
 // Health defines model for Health.
@@ -200,15 +657,164 @@ type Health struct {
 	Status string `json:"status"`
 }
 
+// Operation A long-running operation (AEP-151). When done is true, exactly one
+// of error and response is set, except for deletions, which have
+// neither on success.
+type Operation struct {
+	// Done Whether the operation has completed
+	Done bool `json:"done"`
+
+	// Error Error response following RFC 7807 Problem Details for HTTP APIs
+	// and AEP-193 Error Responses specification.
+	Error    *Error            `json:"error,omitempty"`
+	Metadata OperationMetadata `json:"metadata"`
+
+	// Path Resource path in the format: tenants/{tenantId}/operations/{operationId}
+	Path     *string              `json:"path,omitempty"`
+	Response *CatalogItemInstance `json:"response,omitempty"`
+}
+
+// OperationList defines model for OperationList.
+type OperationList struct {
+	// NextPageToken Token for retrieving the next page.
+	// Empty string indicates this is the last page.
+	NextPageToken string `json:"next_page_token"`
+
+	// Results Array of operation resources
+	Results []Operation `json:"results"`
+}
+
+// OperationMetadata defines model for OperationMetadata.
+type OperationMetadata struct {
+	// CancelRequested Whether cancellation of the operation was requested
+	CancelRequested *bool `json:"cancel_requested,omitempty"`
+
+	// CreateTime Timestamp when the operation was created (RFC 3339)
+	CreateTime time.Time `json:"create_time"`
+
+	// EndTime Timestamp when the operation completed (RFC 3339)
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// State Current lifecycle state of the target, while it exists
+	State *string `json:"state,omitempty"`
+
+	// Target Path of the resource the operation acts on
+	Target string `json:"target"`
+
+	// Type Kind of operation
+	Type OperationMetadataType `json:"type"`
+}
+
+// OperationMetadataType Kind of operation
+type OperationMetadataType string
+
+// Quota Limits on the catalog item instances of a tenant.
+// Omitted limits are unlimited. At most one of service_type and
+// catalog_item_id may be set; when neither is set the quota applies
+// to every instance of the tenant.
+type Quota struct {
+	// CatalogItemId Only count instances of this catalog item
+	CatalogItemId *string `json:"catalog_item_id,omitempty"`
+
+	// CreateTime Timestamp when the quota was created (RFC 3339)
+	CreateTime *time.Time `json:"create_time,omitempty"`
+
+	// MaxInstances Maximum number of instances
+	MaxInstances *int64 `json:"max_instances,omitempty"`
+
+	// MaxMemory Maximum total memory (e.g., "64GB")
+	MaxMemory *string `json:"max_memory,omitempty"`
+
+	// MaxStorage Maximum total storage capacity (e.g., "2TB")
+	MaxStorage *string `json:"max_storage,omitempty"`
+
+	// MaxVcpu Maximum total vCPU count
+	MaxVcpu *int64 `json:"max_vcpu,omitempty"`
+
+	// Path Resource path in the format: tenants/{tenantId}/quotas/{quotaId}
+	Path *string `json:"path,omitempty"`
+
+	// ServiceType Only count instances of this service type
+	ServiceType *string `json:"service_type,omitempty"`
+
+	// Uid Unique identifier for the quota. This field is output-only and
+	// immutable after creation. The ID can be optionally specified via
+	// query parameter on creation; if not provided, the server generates a UUID.
+	Uid *string `json:"uid,omitempty"`
+
+	// UpdateTime Timestamp when the quota was last modified (RFC 3339)
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+// QuotaList defines model for QuotaList.
+type QuotaList struct {
+	// NextPageToken Token for retrieving the next page.
+	// Empty string indicates this is the last page.
+	NextPageToken string `json:"next_page_token"`
+
+	// Results Array of quota resources
+	Results []Quota `json:"results"`
+}
+
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	// Quota Path of the quota
+	Quota string `json:"quota"`
+
+	// Used Resources consumed by a set of catalog item instances
+	Used ResourceUsage `json:"used"`
+}
+
+// ResourceUsage Resources consumed by a set of catalog item instances
+type ResourceUsage struct {
+	// Instances Number of instances
+	Instances int64 `json:"instances"`
+
+	// Memory Total memory
+	Memory string `json:"memory"`
+
+	// Storage Total storage capacity
+	Storage string `json:"storage"`
+
+	// Vcpu Total vCPU count
+	Vcpu int64 `json:"vcpu"`
+}
+
+// RollbackCatalogItemRequest defines model for RollbackCatalogItemRequest.
+type RollbackCatalogItemRequest struct {
+	// Revision Revision of the catalog item to restore
+	Revision int32 `json:"revision"`
+}
+
 // ServiceType defines model for ServiceType.
 type ServiceType struct {
 	// ApiVersion Version of the service type schema (e.g., v1alpha1, v1beta1, v1).
+	// Several versions of a service type may coexist; each
+	// (service_type, api_version) pair is unique.
 	// Immutable after creation.
 	ApiVersion string `json:"api_version"`
 
+	// Conversions Conversions from other versions of the service type to this
+	// version, at most one per source version. Immutable after creation.
+	Conversions *[]ServiceTypeConversion `json:"conversions,omitempty"`
+
 	// CreateTime Timestamp when the resource was created (RFC 3339)
 	CreateTime *time.Time `json:"create_time,omitempty"`
-	Metadata   *struct {
+
+	// Default Whether this is the default version of the service type, pinned by
+	// catalog items that do not specify a service_type_version. The
+	// first version of a service type is its default; creating another
+	// version with default set to true makes it the default instead.
+	Default *bool `json:"default,omitempty"`
+
+	// Deprecated Whether this version is deprecated. Creating catalog items or
+	// instances that use a deprecated version succeeds with a Warning
+	// response header.
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// DeprecationMessage Explanation returned in the Warning header, such as the version to migrate to
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+	Metadata           *struct {
 		// Labels Key-value pairs for categorization and filtering.
 		// Both keys and values are strings.
 		Labels *map[string]string `json:"labels,omitempty"`
@@ -224,7 +830,9 @@ type ServiceType struct {
 	ServiceType string `json:"service_type"`
 
 	// Spec Service-specific configuration schema (required).
-	// This is an opaque dictionary containing service type-specific fields.
+	// Either a JSON Schema of type object with properties, or an example
+	// payload whose fields and value types are the only ones allowed.
+	// The fields of catalog items are validated against it.
 	//
 	// Examples by service type:
 	// - VM: vcpu, memory, storage, guest_os, access
@@ -235,6 +843,10 @@ type ServiceType struct {
 	// The structure varies based on the service_type and schema_version.
 	Spec map[string]interface{} `json:"spec"`
 
+	// SunsetTime Time after which no new catalog items or instances may use this
+	// deprecated version. Existing ones are unaffected.
+	SunsetTime *time.Time `json:"sunset_time,omitempty"`
+
 	// Uid Unique identifier for the service type. This field is output-only and
 	// immutable after creation. The ID can be optionally specified via
 	// query parameter on creation; if not provided, the server generates a UUID.
@@ -246,6 +858,28 @@ type ServiceType struct {
 	UpdateTime *time.Time `json:"update_time,omitempty"`
 }
 
+// ServiceTypeApplyResult defines model for ServiceTypeApplyResult.
+type ServiceTypeApplyResult struct {
+	// Action What an apply did, or would do with validate_only: create the
+	// resource, update it, or nothing as it already matches the manifest
+	Action ApplyAction `json:"action"`
+
+	// Changes Fields changed by the apply. Output-only fields such as
+	// update_time are not compared.
+	Changes     []ApplyChange `json:"changes"`
+	ServiceType ServiceType   `json:"service_type"`
+}
+
+// ServiceTypeConversion defines model for ServiceTypeConversion.
+type ServiceTypeConversion struct {
+	// FieldMappings Fields of the source version that moved or were removed. Fields
+	// without a mapping keep their path.
+	FieldMappings *[]FieldMapping `json:"field_mappings,omitempty"`
+
+	// FromVersion Version (api_version) of the service type converted from
+	FromVersion string `json:"from_version"`
+}
+
 // ServiceTypeList defines model for ServiceTypeList.
 type ServiceTypeList struct {
 	// NextPageToken Token for retrieving the next page of results.
@@ -256,6 +890,40 @@ type ServiceTypeList struct {
 	// Results Array of service type resources.
 	// May be empty if no results match the query.
 	Results []ServiceType `json:"results"`
+
+	// TotalSize Number of service types matching the query across all pages
+	// (AEP-132). Omitted with skip_total_size.
+	TotalSize *int64 `json:"total_size,omitempty"`
+}
+
+// ServiceTypeUpdate The mutable fields of a service type version
+type ServiceTypeUpdate struct {
+	// Default Set to true to make this version the default of its service type.
+	// Setting it to false is rejected; make another version the default
+	// instead.
+	Default *bool `json:"default,omitempty"`
+
+	// Deprecated Whether the version is deprecated. Setting it to false also clears
+	// deprecation_message and sunset_time.
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// DeprecationMessage Explanation returned in the Warning header; requires the version to be deprecated
+	DeprecationMessage *string `json:"deprecation_message,omitempty"`
+
+	// SunsetTime Time after which the version may no longer be used; requires the version to be deprecated
+	SunsetTime *time.Time `json:"sunset_time,omitempty"`
+}
+
+// Usage defines model for Usage.
+type Usage struct {
+	// Path Canonical path of the resource
+	Path *string `json:"path,omitempty"`
+
+	// Quotas Usage counted against each quota of the tenant
+	Quotas []QuotaUsage `json:"quotas"`
+
+	// Total Resources consumed by a set of catalog item instances
+	Total ResourceUsage `json:"total"`
 }
 
 // UserValue defines model for UserValue.
@@ -269,15 +937,158 @@ type UserValue struct {
 	Value interface{} `json:"value"`
 }
 
+// WatchEvent A change to a catalog item instance streamed by a watch
+type WatchEvent struct {
+	Object *CatalogItemInstance `json:"object,omitempty"`
+
+	// ResumeToken Opaque token to resume the watch after this event
+	ResumeToken string `json:"resume_token"`
+
+	// Type Kind of change. BOOKMARK events carry no object and only advance
+	// the resume token.
+	Type WatchEventType `json:"type"`
+}
+
+// WatchEventType Kind of change. BOOKMARK events carry no object and only advance
+// the resume token.
+type WatchEventType string
+
+// WebhookDelivery The delivery of one event to a webhook subscription
+type WebhookDelivery struct {
+	// Attempts Number of delivery attempts made
+	Attempts *int32 `json:"attempts,omitempty"`
+
+	// CreateTime Timestamp when the delivery was queued (RFC 3339)
+	CreateTime *time.Time `json:"create_time,omitempty"`
+
+	// EventId ID of the delivered CloudEvent
+	EventId *string `json:"event_id,omitempty"`
+
+	// EventType Type of the delivered CloudEvent
+	EventType *string `json:"event_type,omitempty"`
+
+	// LastError Error of the last failed attempt
+	LastError *string `json:"last_error,omitempty"`
+
+	// NextAttemptTime Timestamp of the next attempt, while PENDING (RFC 3339)
+	NextAttemptTime *time.Time `json:"next_attempt_time,omitempty"`
+
+	// Path Resource path in the format: tenants/{tenantId}/webhook-subscriptions/{webhookSubscriptionId}/deliveries/{deliveryId}
+	Path *string `json:"path,omitempty"`
+
+	// ResponseCode HTTP status code of the last attempt, if a response was received
+	ResponseCode *int32 `json:"response_code,omitempty"`
+
+	// State PENDING until the endpoint acknowledged the event (SUCCEEDED) or
+	// the attempts were exhausted (FAILED)
+	State *WebhookDeliveryState `json:"state,omitempty"`
+
+	// Uid Unique identifier for the delivery, sent as X-DCM-Webhook-Id
+	Uid *string `json:"uid,omitempty"`
+
+	// UpdateTime Timestamp of the last attempt (RFC 3339)
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+}
+
+// WebhookDeliveryState PENDING until the endpoint acknowledged the event (SUCCEEDED) or
+// the attempts were exhausted (FAILED)
+type WebhookDeliveryState string
+
+// WebhookDeliveryList defines model for WebhookDeliveryList.
+type WebhookDeliveryList struct {
+	// NextPageToken Token for retrieving the next page.
+	// Empty string indicates this is the last page.
+	NextPageToken string `json:"next_page_token"`
+
+	// Results Array of webhook deliveries
+	Results []WebhookDelivery `json:"results"`
+}
+
+// WebhookSubscription A URL notified of resource changes. The secret is required on
+// creation and never returned.
+type WebhookSubscription struct {
+	// CreateTime Timestamp when the webhook subscription was created (RFC 3339)
+	CreateTime *time.Time `json:"create_time,omitempty"`
+
+	// EventTypes Only deliver events of these types. All events are delivered when
+	// omitted. Known types are
+	// io.dcm.catalog.service_type.{created,updated},
+	// io.dcm.catalog.catalog_item.{created,updated,deleted} and
+	// io.dcm.catalog.catalog_item_instance.{created,updated,state_changed,deleted}.
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// Path Resource path in the format: tenants/{tenantId}/webhook-subscriptions/{webhookSubscriptionId}
+	Path *string `json:"path,omitempty"`
+
+	// ResourceFilter Only deliver events whose subject, the path of the changed
+	// resource, starts with this prefix
+	ResourceFilter *string `json:"resource_filter,omitempty"`
+
+	// Secret Key of the HMAC-SHA256 signature of deliveries
+	Secret *string `json:"secret,omitempty"`
+
+	// Uid Unique identifier for the webhook subscription. This field is
+	// output-only and immutable after creation. The ID can be optionally
+	// specified via query parameter on creation; if not provided, the
+	// server generates a UUID.
+	Uid *string `json:"uid,omitempty"`
+
+	// UpdateTime Timestamp when the webhook subscription was last modified (RFC 3339)
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+
+	// Url HTTP or HTTPS URL the events are posted to
+	Url string `json:"url"`
+}
+
+// WebhookSubscriptionList defines model for WebhookSubscriptionList.
+type WebhookSubscriptionList struct {
+	// NextPageToken Token for retrieving the next page.
+	// Empty string indicates this is the last page.
+	NextPageToken string `json:"next_page_token"`
+
+	// Results Array of webhook subscription resources
+	Results []WebhookSubscription `json:"results"`
+}
+
+// BatchGetIdsQuery defines model for BatchGetIdsQuery.
+type BatchGetIdsQuery = []string
+
 // CatalogItemIdPath defines model for CatalogItemIdPath.
 type CatalogItemIdPath = string
 
 // CatalogItemInstanceIdPath defines model for CatalogItemInstanceIdPath.
 type CatalogItemInstanceIdPath = string
 
+// IdempotencyKeyHeader defines model for IdempotencyKeyHeader.
+type IdempotencyKeyHeader = string
+
+// OperationIdPath defines model for OperationIdPath.
+type OperationIdPath = string
+
+// ParentQuery defines model for ParentQuery.
+type ParentQuery = string
+
+// QuotaIdPath defines model for QuotaIdPath.
+type QuotaIdPath = string
+
+// ReadMaskQuery defines model for ReadMaskQuery.
+type ReadMaskQuery = string
+
+// RequestIdQuery defines model for RequestIdQuery.
+type RequestIdQuery = string
+
 // ServiceTypeIdPath defines model for ServiceTypeIdPath.
 type ServiceTypeIdPath = string
 
+// SkipTotalSizeQuery defines model for SkipTotalSizeQuery.
+type SkipTotalSizeQuery = bool
+
+// ValidateOnlyQuery defines model for ValidateOnlyQuery.
+type ValidateOnlyQuery = bool
+
+// WebhookSubscriptionIdPath defines model for WebhookSubscriptionIdPath.
+type WebhookSubscriptionIdPath = string
+
 // AlreadyExists Error response following RFC 7807 Problem Details for HTTP APIs
 // and AEP-193 Error Responses specification.
 type AlreadyExists = Error
@@ -298,14 +1109,54 @@ type HasInstances = Error
 // and AEP-193 Error Responses specification.
 type InternalServerError = Error
 
+// NotCancellable Error response following RFC 7807 Problem Details for HTTP APIs
+// and AEP-193 Error Responses specification.
+type NotCancellable = Error
+
 // NotFound Error response following RFC 7807 Problem Details for HTTP APIs
 // and AEP-193 Error Responses specification.
 type NotFound = Error
 
+// ResourceExhausted Error response following RFC 7807 Problem Details for HTTP APIs
+// and AEP-193 Error Responses specification.
+type ResourceExhausted = Error
+
+// ResumeTokenExpired Error response following RFC 7807 Problem Details for HTTP APIs
+// and AEP-193 Error Responses specification.
+type ResumeTokenExpired = Error
+
 // Unauthorized Error response following RFC 7807 Problem Details for HTTP APIs
 // and AEP-193 Error Responses specification.
 type Unauthorized = Error
 
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	// PageToken Token for retrieving the next page of results
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of items to return per page
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// Resource Only return the changes to the resource with this path
+	Resource *string `form:"resource,omitempty" json:"resource,omitempty"`
+
+	// Actor Only return the changes made by this actor
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// StartTime Only return the changes made at or after this time (RFC 3339)
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+
+	// EndTime Only return the changes made before this time (RFC 3339)
+	EndTime *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+}
+
 // ListCatalogItemInstancesParams defines parameters for ListCatalogItemInstances.
 type ListCatalogItemInstancesParams struct {
 	// PageToken Token for retrieving the next page of results
@@ -317,12 +1168,105 @@ type ListCatalogItemInstancesParams struct {
 	// CatalogItemId Filter catalog item instances by catalog item ID.
 	// Only returns items where spec.catalog_item_id matches this value.
 	CatalogItemId *string `form:"catalog_item_id,omitempty" json:"catalog_item_id,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+
+	// ReadMask Comma-separated JSON paths of the fields to return (AEP-157), such as
+	// uid,display_name,spec.service_type. Paths through arrays select the
+	// field of each element. Omitted or "*" returns every field.
+	ReadMask *ReadMaskQuery `form:"read_mask,omitempty" json:"read_mask,omitempty"`
+
+	// SkipTotalSize Leave total_size out of the response, saving the query that counts
+	// the matching resources
+	SkipTotalSize *SkipTotalSizeQuery `form:"skip_total_size,omitempty" json:"skip_total_size,omitempty"`
 }
 
 // CreateCatalogItemInstanceParams defines parameters for CreateCatalogItemInstance.
 type CreateCatalogItemInstanceParams struct {
 	// Id Optional user-specified catalog item instance ID
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// RequestId Idempotency key of the request (AEP-155), preferably a UUID. Retries
+	// with the same key return the response of the first request.
+	RequestId *RequestIdQuery `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// IdempotencyKey Idempotency key of the request, equivalent to request_id for clients
+	// and gateways that set a header. Must match request_id when both are
+	// given.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetCatalogItemInstanceParams defines parameters for GetCatalogItemInstance.
+type GetCatalogItemInstanceParams struct {
+	// ReadMask Comma-separated JSON paths of the fields to return (AEP-157), such as
+	// uid,display_name,spec.service_type. Paths through arrays select the
+	// field of each element. Omitted or "*" returns every field.
+	ReadMask *ReadMaskQuery `form:"read_mask,omitempty" json:"read_mask,omitempty"`
+}
+
+// BatchCreateCatalogItemInstancesParams defines parameters for BatchCreateCatalogItemInstances.
+type BatchCreateCatalogItemInstancesParams struct {
+	// RequestId Idempotency key of the request (AEP-155), preferably a UUID. Retries
+	// with the same key return the response of the first request.
+	RequestId *RequestIdQuery `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// IdempotencyKey Idempotency key of the request, equivalent to request_id for clients
+	// and gateways that set a header. Must match request_id when both are
+	// given.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// BatchGetCatalogItemInstancesParams defines parameters for BatchGetCatalogItemInstances.
+type BatchGetCatalogItemInstancesParams struct {
+	// Ids IDs of the resources to get, repeated for each ID. Results are
+	// returned in the same order; IDs may be repeated.
+	Ids BatchGetIdsQuery `form:"ids" json:"ids"`
+}
+
+// CountCatalogItemInstancesParams defines parameters for CountCatalogItemInstances.
+type CountCatalogItemInstancesParams struct {
+	// GroupBy Attribute to group the instances by
+	GroupBy CountCatalogItemInstancesParamsGroupBy `form:"group_by" json:"group_by"`
+
+	// CatalogItemId Count only the instances of this catalog item
+	CatalogItemId *string `form:"catalog_item_id,omitempty" json:"catalog_item_id,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+}
+
+// CountCatalogItemInstancesParamsGroupBy defines parameters for CountCatalogItemInstances.
+type CountCatalogItemInstancesParamsGroupBy string
+
+// WatchCatalogItemInstancesParams defines parameters for WatchCatalogItemInstances.
+type WatchCatalogItemInstancesParams struct {
+	// ResumeToken Resume token of the last event received. Takes precedence over
+	// the Last-Event-ID header.
+	ResumeToken *string `form:"resume_token,omitempty" json:"resume_token,omitempty"`
+
+	// CatalogItemId Only stream the changes to instances whose spec.catalog_item_id
+	// matches this value
+	CatalogItemId *string `form:"catalog_item_id,omitempty" json:"catalog_item_id,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+
+	// LastEventID Resume token of the last event received, sent by EventSource
+	// clients when they reconnect
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
 // ListCatalogItemsParams defines parameters for ListCatalogItems.
@@ -336,12 +1280,143 @@ type ListCatalogItemsParams struct {
 	// ServiceType Filter catalog items by service type.
 	// Only returns items where spec.service_type matches this value.
 	ServiceType *string `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+
+	// ReadMask Comma-separated JSON paths of the fields to return (AEP-157), such as
+	// uid,display_name,spec.service_type. Paths through arrays select the
+	// field of each element. Omitted or "*" returns every field.
+	ReadMask *ReadMaskQuery `form:"read_mask,omitempty" json:"read_mask,omitempty"`
+
+	// SkipTotalSize Leave total_size out of the response, saving the query that counts
+	// the matching resources
+	SkipTotalSize *SkipTotalSizeQuery `form:"skip_total_size,omitempty" json:"skip_total_size,omitempty"`
 }
 
 // CreateCatalogItemParams defines parameters for CreateCatalogItem.
 type CreateCatalogItemParams struct {
 	// Id Optional user-specified catalog item ID
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+
+	// RequestId Idempotency key of the request (AEP-155), preferably a UUID. Retries
+	// with the same key return the response of the first request.
+	RequestId *RequestIdQuery `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// IdempotencyKey Idempotency key of the request, equivalent to request_id for clients
+	// and gateways that set a header. Must match request_id when both are
+	// given.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetCatalogItemParams defines parameters for GetCatalogItem.
+type GetCatalogItemParams struct {
+	// ReadMask Comma-separated JSON paths of the fields to return (AEP-157), such as
+	// uid,display_name,spec.service_type. Paths through arrays select the
+	// field of each element. Omitted or "*" returns every field.
+	ReadMask *ReadMaskQuery `form:"read_mask,omitempty" json:"read_mask,omitempty"`
+}
+
+// ListCatalogItemRevisionsParams defines parameters for ListCatalogItemRevisions.
+type ListCatalogItemRevisionsParams struct {
+	// PageToken Token for retrieving the next page of results
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of items to return per page
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
+// ApplyCatalogItemParams defines parameters for ApplyCatalogItem.
+type ApplyCatalogItemParams struct {
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+
+	// ValidateOnly Validate the request and compute its outcome without persisting
+	// anything (AEP-163)
+	ValidateOnly *ValidateOnlyQuery `form:"validate_only,omitempty" json:"validate_only,omitempty"`
+}
+
+// BatchGetCatalogItemsParams defines parameters for BatchGetCatalogItems.
+type BatchGetCatalogItemsParams struct {
+	// Ids IDs of the resources to get, repeated for each ID. Results are
+	// returned in the same order; IDs may be repeated.
+	Ids BatchGetIdsQuery `form:"ids" json:"ids"`
+}
+
+// ListOperationsParams defines parameters for ListOperations.
+type ListOperationsParams struct {
+	// PageToken Token for retrieving the next page of results
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of items to return per page
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+}
+
+// WaitOperationParams defines parameters for WaitOperation.
+type WaitOperationParams struct {
+	// Timeout Maximum time to wait, as a duration such as "30s".
+	// Capped at 60 seconds.
+	Timeout *string `form:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// ListQuotasParams defines parameters for ListQuotas.
+type ListQuotasParams struct {
+	// PageToken Token for retrieving the next page of results
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of items to return per page
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+}
+
+// CreateQuotaParams defines parameters for CreateQuota.
+type CreateQuotaParams struct {
+	// Id Optional user-specified quota ID
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+
+	// RequestId Idempotency key of the request (AEP-155), preferably a UUID. Retries
+	// with the same key return the response of the first request.
+	RequestId *RequestIdQuery `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// IdempotencyKey Idempotency key of the request, equivalent to request_id for clients
+	// and gateways that set a header. Must match request_id when both are
+	// given.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
 }
 
 // ListServiceTypesParams defines parameters for ListServiceTypes.
@@ -353,6 +1428,18 @@ type ListServiceTypesParams struct {
 	// MaxPageSize Maximum number of items to return per page.
 	// If not specified, defaults to 100.
 	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// ServiceType Only list the versions of this service type
+	ServiceType *string `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// ReadMask Comma-separated JSON paths of the fields to return (AEP-157), such as
+	// uid,display_name,spec.service_type. Paths through arrays select the
+	// field of each element. Omitted or "*" returns every field.
+	ReadMask *ReadMaskQuery `form:"read_mask,omitempty" json:"read_mask,omitempty"`
+
+	// SkipTotalSize Leave total_size out of the response, saving the query that counts
+	// the matching resources
+	SkipTotalSize *SkipTotalSizeQuery `form:"skip_total_size,omitempty" json:"skip_total_size,omitempty"`
 }
 
 // CreateServiceTypeParams defines parameters for CreateServiceType.
@@ -361,16 +1448,114 @@ type CreateServiceTypeParams struct {
 	// Must follow DNS-1123 label format (lowercase alphanumeric with hyphens).
 	// If omitted, the server generates an ID.
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// RequestId Idempotency key of the request (AEP-155), preferably a UUID. Retries
+	// with the same key return the response of the first request.
+	RequestId *RequestIdQuery `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// IdempotencyKey Idempotency key of the request, equivalent to request_id for clients
+	// and gateways that set a header. Must match request_id when both are
+	// given.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetServiceTypeParams defines parameters for GetServiceType.
+type GetServiceTypeParams struct {
+	// ReadMask Comma-separated JSON paths of the fields to return (AEP-157), such as
+	// uid,display_name,spec.service_type. Paths through arrays select the
+	// field of each element. Omitted or "*" returns every field.
+	ReadMask *ReadMaskQuery `form:"read_mask,omitempty" json:"read_mask,omitempty"`
+}
+
+// ApplyServiceTypeParams defines parameters for ApplyServiceType.
+type ApplyServiceTypeParams struct {
+	// ValidateOnly Validate the request and compute its outcome without persisting
+	// anything (AEP-163)
+	ValidateOnly *ValidateOnlyQuery `form:"validate_only,omitempty" json:"validate_only,omitempty"`
+}
+
+// ListWebhookSubscriptionsParams defines parameters for ListWebhookSubscriptions.
+type ListWebhookSubscriptionsParams struct {
+	// PageToken Token for retrieving the next page of results
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of items to return per page
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+}
+
+// CreateWebhookSubscriptionParams defines parameters for CreateWebhookSubscription.
+type CreateWebhookSubscriptionParams struct {
+	// Id Optional user-specified webhook subscription ID
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// Parent Tenant that owns the resources, in the format tenants/{tenant_id}.
+	// Must match the tenant of the caller. On list, restricts the results
+	// to resources owned by the tenant (global catalog items are excluded).
+	// On create of a catalog item, omitting it creates a global item,
+	// which requires an administrator.
+	Parent *ParentQuery `form:"parent,omitempty" json:"parent,omitempty"`
+
+	// RequestId Idempotency key of the request (AEP-155), preferably a UUID. Retries
+	// with the same key return the response of the first request.
+	RequestId *RequestIdQuery `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// IdempotencyKey Idempotency key of the request, equivalent to request_id for clients
+	// and gateways that set a header. Must match request_id when both are
+	// given.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// PageToken Token for retrieving the next page of results
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of items to return per page
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
 }
 
 // CreateCatalogItemInstanceJSONRequestBody defines body for CreateCatalogItemInstance for application/json ContentType.
 type CreateCatalogItemInstanceJSONRequestBody = CatalogItemInstance
 
+// ConvertCatalogItemInstanceJSONRequestBody defines body for ConvertCatalogItemInstance for application/json ContentType.
+type ConvertCatalogItemInstanceJSONRequestBody = ConvertRequest
+
+// BatchCreateCatalogItemInstancesJSONRequestBody defines body for BatchCreateCatalogItemInstances for application/json ContentType.
+type BatchCreateCatalogItemInstancesJSONRequestBody = BatchCreateCatalogItemInstancesRequest
+
 // CreateCatalogItemJSONRequestBody defines body for CreateCatalogItem for application/json ContentType.
 type CreateCatalogItemJSONRequestBody = CatalogItem
 
 // UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody defines body for UpdateCatalogItem for application/merge-patch+json ContentType.
 type UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody = CatalogItem
 
+// ApplyCatalogItemJSONRequestBody defines body for ApplyCatalogItem for application/json ContentType.
+type ApplyCatalogItemJSONRequestBody = CatalogItem
+
+// ConvertCatalogItemJSONRequestBody defines body for ConvertCatalogItem for application/json ContentType.
+type ConvertCatalogItemJSONRequestBody = ConvertRequest
+
+// RollbackCatalogItemJSONRequestBody defines body for RollbackCatalogItem for application/json ContentType.
+type RollbackCatalogItemJSONRequestBody = RollbackCatalogItemRequest
+
+// CreateQuotaJSONRequestBody defines body for CreateQuota for application/json ContentType.
+type CreateQuotaJSONRequestBody = Quota
+
 // CreateServiceTypeJSONRequestBody defines body for CreateServiceType for application/json ContentType.
 type CreateServiceTypeJSONRequestBody = ServiceType
+
+// UpdateServiceTypeApplicationMergePatchPlusJSONRequestBody defines body for UpdateServiceType for application/merge-patch+json ContentType.
+type UpdateServiceTypeApplicationMergePatchPlusJSONRequestBody = ServiceTypeUpdate
+
+// ApplyServiceTypeJSONRequestBody defines body for ApplyServiceType for application/json ContentType.
+type ApplyServiceTypeJSONRequestBody = ServiceType
+
+// CreateWebhookSubscriptionJSONRequestBody defines body for CreateWebhookSubscription for application/json ContentType.
+type CreateWebhookSubscriptionJSONRequestBody = WebhookSubscription
@@ -2,16 +2,23 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os/signal"
 	"syscall"
 
 	"github.com/dcm-project/catalog-manager/internal/apiserver"
 	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/dcm-project/catalog-manager/internal/events"
 	"github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/provider"
+	"github.com/dcm-project/catalog-manager/internal/reconciler"
 	"github.com/dcm-project/catalog-manager/internal/service"
 	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/watch"
+	"github.com/dcm-project/catalog-manager/internal/webhooks"
 )
 
 func main() {
@@ -35,8 +42,73 @@ func main() {
 		}
 	}()
 
+	if cfg.Tenancy.AdminAllTenants {
+		log.Printf("ADMIN_ALL_TENANTS is set; every caller administers global catalog items and quotas")
+	} else if len(cfg.Tenancy.AdminTenants) == 0 {
+		log.Printf("No ADMIN_TENANTS configured; global catalog items and quotas are read-only")
+	}
+
+	// Create the reconciler, dispatching instances to providers when configured
+	var providerClient provider.Client
+	if len(cfg.Provider.Endpoints) > 0 {
+		httpClient := &http.Client{Timeout: cfg.Provider.Timeout}
+		providerClient = provider.NewHTTPClient(cfg.Provider.Endpoints, httpClient)
+	} else {
+		log.Printf("No provider endpoints configured; instances will not be dispatched")
+	}
+	rec := reconciler.New(dataStore, providerClient, reconciler.Config{
+		Workers:      cfg.Reconciler.Workers,
+		PollInterval: cfg.Reconciler.PollInterval,
+		MaxAttempts:  cfg.Reconciler.MaxAttempts,
+		BackoffBase:  cfg.Reconciler.BackoffBase,
+		BackoffMax:   cfg.Reconciler.BackoffMax,
+	})
+
+	// Create the dispatcher delivering events to webhook subscriptions
+	dispatcher := webhooks.NewDispatcher(dataStore, webhooks.Config{
+		Source:              cfg.Events.Source,
+		PollInterval:        cfg.Webhooks.PollInterval,
+		MaxAttempts:         cfg.Webhooks.MaxAttempts,
+		BackoffBase:         cfg.Webhooks.BackoffBase,
+		BackoffMax:          cfg.Webhooks.BackoffMax,
+		Timeout:             cfg.Webhooks.Timeout,
+		Workers:             cfg.Webhooks.Workers,
+		AllowPrivateTargets: cfg.Webhooks.AllowPrivateTargets,
+	})
+
+	// Create the relay delivering resource change events
+	sinks, closeSinks, err := newEventSinks(cfg.Events)
+	if err != nil {
+		log.Fatalf("Failed to create event sinks: %v", err)
+	}
+	defer closeSinks()
+	sinks = append(sinks, dispatcher.Sink())
+	relay := events.NewRelay(dataStore, sinks, events.Config{
+		Source:       cfg.Events.Source,
+		PollInterval: cfg.Events.PollInterval,
+		MaxAttempts:  cfg.Events.MaxAttempts,
+		BackoffBase:  cfg.Events.BackoffBase,
+		BackoffMax:   cfg.Events.BackoffMax,
+		Retention:    cfg.Events.Retention,
+	})
+
+	// Create the hub streaming resource changes to watchers
+	hub := watch.NewHub(dataStore, watch.Config{
+		PollInterval: cfg.Watch.PollInterval,
+		GapGrace:     cfg.Watch.GapGrace,
+		Retention:    cfg.Events.Retention,
+		BufferSize:   cfg.Watch.BufferSize,
+	})
+
 	// Create service layer
-	svc := service.NewService(dataStore)
+	svc := service.NewService(dataStore,
+		service.WithReconciler(rec),
+		service.WithWebhookTester(dispatcher),
+		service.WithWatchHub(hub),
+		service.WithIdempotencyTTL(cfg.Idempotency.TTL),
+		service.WithIdempotencyPendingTimeout(cfg.Idempotency.PendingTimeout),
+		service.WithCacheSize(cfg.Cache.CatalogItems),
+	)
 
 	// Create TCP listener
 	listener, err := net.Listen("tcp", cfg.Service.BindAddress)
@@ -51,8 +123,88 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
+	// Run the reconciler, the event relay, the webhook dispatcher and the watch hub until shutdown
+	reconcilerDone := make(chan struct{})
+	go func() {
+		defer close(reconcilerDone)
+		rec.Run(ctx)
+	}()
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		relay.Run(ctx)
+	}()
+	dispatcherDone := make(chan struct{})
+	go func() {
+		defer close(dispatcherDone)
+		dispatcher.Run(ctx)
+	}()
+	hubDone := make(chan struct{})
+	go func() {
+		defer close(hubDone)
+		hub.Run(ctx)
+	}()
+	// Other replicas notify their changes through Postgres
+	listenDone := make(chan struct{})
+	go func() {
+		defer close(listenDone)
+		if cfg.Database.Type == "pgsql" {
+			store.ListenChanges(ctx, cfg, hub.Notify)
+		}
+	}()
+	defer func() {
+		cancel()
+		<-reconcilerDone
+		<-relayDone
+		<-dispatcherDone
+		<-hubDone
+		<-listenDone
+	}()
+
 	// Create and run server
 	if err := srv.Run(ctx); err != nil {
 		log.Fatalf("Server failed: %v", err)
 	}
 }
+
+// newEventSinks creates the configured static event sinks and a function closing them.
+// Webhook subscriptions are served by the webhooks dispatcher's sink.
+func newEventSinks(cfg config.EventsConfig) ([]events.Sink, func(), error) {
+	var sinks []events.Sink
+	var files []*events.FileSink
+	closeSinks := func() {
+		for _, f := range files {
+			if err := f.Close(); err != nil {
+				log.Printf("Failed to close event file: %v", err)
+			}
+		}
+	}
+
+	for _, name := range cfg.Sinks {
+		switch name {
+		case "http":
+			if cfg.HTTPURL == "" {
+				closeSinks()
+				return nil, nil, fmt.Errorf("EVENTS_HTTP_URL is required by the http sink")
+			}
+			sinks = append(sinks, events.NewHTTPSink(cfg.HTTPURL, &http.Client{Timeout: cfg.HTTPTimeout}))
+		case "stdout":
+			sinks = append(sinks, events.NewStdoutSink())
+		case "file":
+			f, err := events.NewFileSink(cfg.FilePath)
+			if err != nil {
+				closeSinks()
+				return nil, nil, err
+			}
+			files = append(files, f)
+			sinks = append(sinks, f)
+		default:
+			closeSinks()
+			return nil, nil, fmt.Errorf("unknown event sink %q", name)
+		}
+	}
+	if len(sinks) == 0 {
+		log.Printf("No event sinks configured; resource change events are only delivered to webhook subscriptions")
+	}
+	return sinks, closeSinks, nil
+}
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/catalog"
+)
+
+func runGet(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("get", "<resource> <id>... [flags]")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return c.usageError(fs, "missing resource or id")
+	}
+	r, err := findResource(args[0])
+	if err != nil {
+		return err
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	objects := []any{}
+	for _, id := range args[1:] {
+		obj, err := r.get(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("%s/%s: %w", r.name, id, err)
+		}
+		objects = append(objects, obj)
+	}
+	return printObjects(c.stdout, c.output, r.columns, objects)
+}
+
+func runList(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("list", "<resource> [flags]")
+	var opts listOptions
+	fs.StringVar(&opts.serviceType, "service-type", "", "only list the service types or catalog items of this service type")
+	fs.StringVar(&opts.catalogItem, "catalog-item", "", "only list the instances of this catalog item")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return c.usageError(fs, "expected a resource")
+	}
+	r, err := findResource(args[0])
+	if err != nil {
+		return err
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	objects := []any{}
+	for obj, err := range r.list(ctx, client, opts) {
+		if err != nil {
+			return err
+		}
+		objects = append(objects, obj)
+	}
+	if c.output != outputTable {
+		// A list is printed as a list even with a single result
+		return printData(c.stdout, c.output, objects)
+	}
+	return printObjects(c.stdout, c.output, r.columns, objects)
+}
+
+func runDescribe(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("describe", "<resource> <id> [flags]")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return c.usageError(fs, "expected a resource and an id")
+	}
+	r, err := findResource(args[0])
+	if err != nil {
+		return err
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	obj, err := r.get(ctx, client, args[1])
+	if err != nil {
+		return fmt.Errorf("%s/%s: %w", r.name, args[1], err)
+	}
+	if err := printData(c.stdout, outputYAML, obj); err != nil {
+		return err
+	}
+	if r.describe == nil {
+		return nil
+	}
+	m, err := toMap(obj)
+	if err != nil {
+		return err
+	}
+	return r.describe(ctx, client, c.stdout, m)
+}
+
+func runCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("create", "<resource> -f FILE [--id ID] [--wait] [flags]")
+	file := fs.String("f", "", "manifest to create, in YAML or JSON; - reads the standard input")
+	id := fs.String("id", "", "ID of the resource, for manifests of a single resource without a path")
+	wait := fs.Bool("wait", false, "wait for the creation of instances to complete")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 || *file == "" {
+		return c.usageError(fs, "expected a resource and -f FILE")
+	}
+	r, err := findResource(args[0])
+	if err != nil {
+		return err
+	}
+	manifests, err := c.readManifests(*file)
+	if err != nil {
+		return err
+	}
+	if *id != "" && len(manifests) > 1 {
+		return c.usageError(fs, "--id requires a manifest of a single resource")
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range manifests {
+		resourceID := firstNonEmpty(*id, m.id)
+		created, err := r.create(ctx, client, resourceID, m.data)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", describeManifest(r, resourceID), err)
+		}
+		if err := c.printResult(ctx, client, r, created, "created", *wait); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runApply(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("apply", "<resource> -f FILE [--dry-run] [--wait] [flags]")
+	file := fs.String("f", "", "manifest to apply, in YAML or JSON; - reads the standard input")
+	dryRun := fs.Bool("dry-run", false, "print the changes without making them")
+	wait := fs.Bool("wait", false, "wait for the creation of instances to complete")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 || *file == "" {
+		return c.usageError(fs, "expected a resource and -f FILE")
+	}
+	r, err := findResource(args[0])
+	if err != nil {
+		return err
+	}
+	if *dryRun && r.apply == nil {
+		return fmt.Errorf("%s do not support --dry-run", r.name)
+	}
+	manifests, err := c.readManifests(*file)
+	if err != nil {
+		return err
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range manifests {
+		if m.id == "" {
+			return fmt.Errorf("apply %s: the manifest has no path to identify the resource", r.name)
+		}
+		if r.apply != nil {
+			result, err := r.apply(ctx, client, m.id, m.data, *dryRun)
+			if err != nil {
+				return fmt.Errorf("apply %s/%s: %w", r.name, m.id, err)
+			}
+			if err := c.printApplied(r, m.id, result, *dryRun); err != nil {
+				return err
+			}
+			continue
+		}
+		existing, err := r.get(ctx, client, m.id)
+		switch {
+		case errors.Is(err, catalog.ErrNotFound):
+			created, err := r.create(ctx, client, m.id, m.data)
+			if err != nil {
+				return fmt.Errorf("create %s/%s: %w", r.name, m.id, err)
+			}
+			if err := c.printResult(ctx, client, r, created, "created", *wait); err != nil {
+				return err
+			}
+		case err != nil:
+			return fmt.Errorf("get %s/%s: %w", r.name, m.id, err)
+		default:
+			updated, err := r.update(ctx, client, m.id, existing, m.data)
+			if err != nil {
+				return fmt.Errorf("update %s/%s: %w", r.name, m.id, err)
+			}
+			if err := c.printResult(ctx, client, r, updated, "configured", false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyVerbs are the verbs apply prints for the actions of server-side applies
+var applyVerbs = map[v1alpha1.ApplyAction]string{
+	v1alpha1.ApplyActionCreated:   "created",
+	v1alpha1.ApplyActionUpdated:   "configured",
+	v1alpha1.ApplyActionUnchanged: "unchanged",
+}
+
+// printApplied prints the outcome of a server-side apply, with its changes on a dry run
+func (c *cli) printApplied(r *resource, id string, result *applied, dryRun bool) error {
+	if c.output != outputTable {
+		return printData(c.stdout, c.output, result.result)
+	}
+	verb := applyVerbs[result.action]
+	if dryRun {
+		verb += " (dry run)"
+	}
+	fmt.Fprintf(c.stdout, "%s/%s %s\n", r.name, id, verb)
+	if !dryRun {
+		return nil
+	}
+	for _, change := range result.changes {
+		switch change.Type {
+		case v1alpha1.ApplyChangeAdded:
+			fmt.Fprintf(c.stdout, "  + %s: %s\n", change.Path, format(change.After))
+		case v1alpha1.ApplyChangeRemoved:
+			fmt.Fprintf(c.stdout, "  - %s: %s\n", change.Path, format(change.Before))
+		default:
+			fmt.Fprintf(c.stdout, "  ~ %s: %s -> %s\n", change.Path, format(change.Before), format(change.After))
+		}
+	}
+	return nil
+}
+
+func runDelete(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("delete", "<resource> <id>... [--wait] [flags]")
+	wait := fs.Bool("wait", false, "wait for the deletion of instances to complete")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return c.usageError(fs, "missing resource or id")
+	}
+	r, err := findResource(args[0])
+	if err != nil {
+		return err
+	}
+	if r.delete == nil {
+		return fmt.Errorf("%s cannot be deleted", r.name)
+	}
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range args[1:] {
+		result, err := r.delete(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("delete %s/%s: %w", r.name, id, err)
+		}
+		if result == nil {
+			fmt.Fprintf(c.stdout, "%s/%s deleted\n", r.name, id)
+			continue
+		}
+		if err := c.printResult(ctx, client, r, result, "deleted", *wait); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printResult prints the outcome of a change. Changes of instances are
+// operations, which are waited for when wait is set.
+func (c *cli) printResult(ctx context.Context, client *catalog.Client, r *resource, result any, verb string, wait bool) error {
+	op, isOperation := result.(*v1alpha1.Operation)
+	if isOperation && wait {
+		done, err := client.Operations().Wait(ctx, idOfPath(op.Path))
+		if err != nil && done == nil {
+			return err
+		}
+		op = done
+		result = done
+		if err != nil {
+			if c.output != outputTable {
+				_ = printData(c.stdout, c.output, result)
+			}
+			return err
+		}
+	}
+	if c.output != outputTable {
+		return printData(c.stdout, c.output, result)
+	}
+
+	if !isOperation {
+		obj, err := toMap(result)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdout, "%s/%s %s\n", r.name, idOf(obj), verb)
+		return nil
+	}
+	target := op.Metadata.Target
+	if op.Done {
+		fmt.Fprintf(c.stdout, "%s %s\n", target, verb)
+		return nil
+	}
+	fmt.Fprintf(c.stdout, "%s: operation %s in progress\n", target, derefString(op.Path))
+	return nil
+}
+
+// manifest is a resource of a manifest file, as JSON
+type manifest struct {
+	// id is the ID from the path of the resource, if it has one
+	id   string
+	data []byte
+}
+
+// readManifests reads the resources of a YAML or JSON file, which may hold
+// several YAML documents. A path of - reads the standard input.
+func (c *cli) readManifests(path string) ([]manifest, error) {
+	var r io.Reader = c.stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var manifests []manifest
+	dec := yaml.NewDecoder(r)
+	for {
+		var doc any
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		if doc == nil {
+			// Empty document, such as after a trailing ---
+			continue
+		}
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("parse %s: document %d is not an object", path, len(manifests)+1)
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		manifests = append(manifests, manifest{id: idOf(obj), data: data})
+	}
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("%s holds no resource", path)
+	}
+	return manifests, nil
+}
+
+// describeManifest names the resource of a manifest in errors
+func describeManifest(r *resource, id string) string {
+	if id == "" {
+		return r.name
+	}
+	return r.name + "/" + id
+}
+
+// idOfPath returns the ID at the end of a resource path
+func idOfPath(path *string) string {
+	return idOf(map[string]any{"path": derefString(path)})
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/dcm-project/catalog-manager/pkg/catalog"
+)
+
+// apiBasePath is the path the API is served under
+const apiBasePath = "/api/v1alpha1"
+
+// config is the configuration file of dcmctl:
+//
+//	current-context: prod
+//	contexts:
+//	  prod:
+//	    server: https://catalog.example.com
+//	    token: eyJhbGciOi...
+//	    tenant: team-a
+type config struct {
+	CurrentContext string                    `yaml:"current-context,omitempty"`
+	Contexts       map[string]*contextConfig `yaml:"contexts,omitempty"`
+}
+
+// contextConfig is a server to connect to and the credentials to use
+type contextConfig struct {
+	Server string `yaml:"server,omitempty"`
+	Token  string `yaml:"token,omitempty"`
+	Tenant string `yaml:"tenant,omitempty"`
+}
+
+// configFile returns the path of the configuration file
+func (c *cli) configFile() (string, error) {
+	if c.configPath != "" {
+		return c.configPath, nil
+	}
+	if path := os.Getenv("DCMCTL_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locate the configuration file: %w", err)
+	}
+	return filepath.Join(dir, "dcmctl", "config.yaml"), nil
+}
+
+// loadConfig reads a configuration file. A missing file is an empty configuration.
+func loadConfig(path string) (*config, error) {
+	cfg := &config{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// save writes a configuration file, readable only by its owner as it holds tokens
+func (cfg *config) save(path string) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// client creates a client of the server selected by the flags, the
+// environment and the configuration file, in that order of precedence
+func (c *cli) client() (*catalog.Client, error) {
+	path, err := c.configFile()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := loadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	current := &contextConfig{}
+	name := c.contextName
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	if name != "" {
+		ctx, ok := cfg.Contexts[name]
+		if !ok {
+			return nil, fmt.Errorf("context %q not found in %s", name, path)
+		}
+		current = ctx
+	}
+
+	server := firstNonEmpty(c.server, os.Getenv("DCMCTL_SERVER"), current.Server)
+	if server == "" {
+		return nil, errors.New("no server configured: use --server or 'dcmctl config set-context'")
+	}
+	server = strings.TrimSuffix(server, "/")
+	if !strings.HasSuffix(server, apiBasePath) {
+		server += apiBasePath
+	}
+
+	opts := []catalog.Option{catalog.WithUserAgent("dcmctl")}
+	if token := firstNonEmpty(c.token, os.Getenv("DCMCTL_TOKEN"), current.Token); token != "" {
+		opts = append(opts, catalog.WithToken(token))
+	}
+	if tenant := firstNonEmpty(c.tenant, os.Getenv("DCMCTL_TENANT"), current.Tenant); tenant != "" {
+		opts = append(opts, catalog.WithTenant(tenant))
+	}
+	return catalog.New(server, opts...)
+}
+
+func runConfig(_ context.Context, c *cli, args []string) error {
+	fs := c.flags("config", "view | get-contexts | use-context NAME | set-context NAME [--server URL] [--token TOKEN] [--tenant TENANT]")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return c.usageError(fs, "missing subcommand")
+	}
+	path, err := c.configFile()
+	if err != nil {
+		return err
+	}
+	cfg, err := loadConfig(path)
+	if err != nil {
+		return err
+	}
+
+	switch sub, rest := args[0], args[1:]; {
+	case sub == "view" && len(rest) == 0:
+		// Never print the tokens
+		redacted := &config{CurrentContext: cfg.CurrentContext, Contexts: map[string]*contextConfig{}}
+		for name, ctx := range cfg.Contexts {
+			copied := *ctx
+			if copied.Token != "" {
+				copied.Token = "REDACTED"
+			}
+			redacted.Contexts[name] = &copied
+		}
+		data, err := yaml.Marshal(redacted)
+		if err != nil {
+			return err
+		}
+		_, err = c.stdout.Write(data)
+		return err
+	case sub == "get-contexts" && len(rest) == 0:
+		names := make([]string, 0, len(cfg.Contexts))
+		for name := range cfg.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		w := tabwriter.NewWriter(c.stdout, 0, 4, 3, ' ', 0)
+		fmt.Fprintln(w, "CURRENT\tNAME\tSERVER\tTENANT")
+		for _, name := range names {
+			current := ""
+			if name == cfg.CurrentContext {
+				current = "*"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", current, name, cfg.Contexts[name].Server, cfg.Contexts[name].Tenant)
+		}
+		return w.Flush()
+	case sub == "use-context" && len(rest) == 1:
+		if _, ok := cfg.Contexts[rest[0]]; !ok {
+			return fmt.Errorf("context %q not found in %s", rest[0], path)
+		}
+		cfg.CurrentContext = rest[0]
+		if err := cfg.save(path); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdout, "Switched to context %q\n", rest[0])
+		return nil
+	case sub == "set-context" && len(rest) == 1:
+		if cfg.Contexts == nil {
+			cfg.Contexts = map[string]*contextConfig{}
+		}
+		ctx, ok := cfg.Contexts[rest[0]]
+		if !ok {
+			ctx = &contextConfig{}
+			cfg.Contexts[rest[0]] = ctx
+		}
+		// Only the given flags change the context
+		if c.server != "" {
+			ctx.Server = c.server
+		}
+		if c.token != "" {
+			ctx.Token = c.token
+		}
+		if c.tenant != "" {
+			ctx.Tenant = c.tenant
+		}
+		if cfg.CurrentContext == "" {
+			cfg.CurrentContext = rest[0]
+		}
+		if err := cfg.save(path); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdout, "Context %q saved to %s\n", rest[0], path)
+		return nil
+	default:
+		return c.usageError(fs, "invalid subcommand %q", strings.Join(args, " "))
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
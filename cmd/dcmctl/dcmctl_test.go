@@ -0,0 +1,306 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+)
+
+var _ = Describe("dcmctl", func() {
+	var (
+		server     *httptest.Server
+		handler    http.HandlerFunc
+		configPath string
+		stdout     *bytes.Buffer
+		stderr     *bytes.Buffer
+	)
+
+	writeJSON := func(w http.ResponseWriter, status int, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+
+	notFound := func(w http.ResponseWriter) {
+		writeJSON(w, http.StatusNotFound, v1alpha1.Error{Type: v1alpha1.NOTFOUND, Status: http.StatusNotFound, Title: "Not Found"})
+	}
+
+	// dcmctl runs a command line against the test server
+	dcmctl := func(stdin string, args ...string) int {
+		stdout.Reset()
+		stderr.Reset()
+		args = append(args, "--config", configPath, "--server", server.URL)
+		return run(context.Background(), args, strings.NewReader(stdin), stdout, stderr)
+	}
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+		configPath = filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("list", func() {
+		It("should print every page as a table", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/api/v1alpha1/catalog-items"))
+				if r.URL.Query().Get("page_token") == "" {
+					writeJSON(w, http.StatusOK, map[string]any{"results": []any{
+						map[string]any{"path": "catalog-items/small", "display_name": "Small VM", "spec": map[string]any{"service_type": "vm"}},
+					}, "next_page_token": "p2"})
+					return
+				}
+				writeJSON(w, http.StatusOK, map[string]any{"results": []any{
+					map[string]any{"path": "catalog-items/large", "display_name": "Large VM", "spec": map[string]any{"service_type": "vm"}},
+				}, "next_page_token": ""})
+			}
+
+			Expect(dcmctl("", "list", "ci")).To(Equal(0))
+			lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+			Expect(lines).To(HaveLen(3))
+			Expect(lines[0]).To(HavePrefix("ID"))
+			Expect(lines[1]).To(MatchRegexp(`^small\s+Small VM\s+vm`))
+			Expect(lines[2]).To(MatchRegexp(`^large\s+Large VM\s+vm`))
+		})
+
+		It("should print a list as YAML", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("service_type")).To(Equal("vm"))
+				writeJSON(w, http.StatusOK, map[string]any{"results": []any{
+					map[string]any{"path": "service-types/vm-v1", "service_type": "vm", "api_version": "v1alpha1", "spec": map[string]any{}},
+				}})
+			}
+
+			Expect(dcmctl("", "list", "service-types", "--service-type", "vm", "-o", "yaml")).To(Equal(0))
+			Expect(stdout.String()).To(HavePrefix("- api_version: v1alpha1\n"))
+		})
+	})
+
+	Describe("get", func() {
+		It("should print a resource as JSON", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/api/v1alpha1/catalog-item-instances/vm-1"))
+				writeJSON(w, http.StatusOK, map[string]any{"path": "catalog-item-instances/vm-1", "display_name": "My VM"})
+			}
+
+			Expect(dcmctl("", "get", "instance", "vm-1", "-o", "json")).To(Equal(0))
+			var obj map[string]any
+			Expect(json.Unmarshal(stdout.Bytes(), &obj)).To(Succeed())
+			Expect(obj["display_name"]).To(Equal("My VM"))
+		})
+
+		It("should report API errors", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) { notFound(w) }
+
+			Expect(dcmctl("", "get", "ci", "missing")).To(Equal(1))
+			Expect(stderr.String()).To(ContainSubstring("catalog-items/missing: NOT_FOUND"))
+		})
+
+		It("should reject unknown resources", func() {
+			Expect(dcmctl("", "get", "widgets", "a")).To(Equal(1))
+			Expect(stderr.String()).To(ContainSubstring(`unknown resource "widgets"`))
+		})
+	})
+
+	Describe("apply", func() {
+		var manifest string
+
+		BeforeEach(func() {
+			manifest = filepath.Join(GinkgoT().TempDir(), "items.yaml")
+			Expect(os.WriteFile(manifest, []byte(`path: catalog-items/small
+api_version: v1alpha1
+display_name: Small VM
+spec:
+  service_type: vm
+---
+path: catalog-items/large
+api_version: v1alpha1
+display_name: Large VM
+spec:
+  service_type: vm
+`), 0o600)).To(Succeed())
+		})
+
+		It("should apply every resource on the server", func() {
+			var requests []string
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				body, _ := io.ReadAll(r.Body)
+				Expect(string(body)).ToNot(ContainSubstring(`"path"`))
+				action := v1alpha1.ApplyActionCreated
+				if strings.Contains(r.URL.Path, "/small:") {
+					action = v1alpha1.ApplyActionUpdated
+				}
+				writeJSON(w, http.StatusOK, map[string]any{"action": action, "changes": []any{}, "catalog_item": map[string]any{}})
+			}
+
+			Expect(dcmctl("", "apply", "catalog-items", "-f", manifest)).To(Equal(0), stderr.String())
+			Expect(requests).To(Equal([]string{
+				"POST /api/v1alpha1/catalog-items/small:apply?validate_only=false",
+				"POST /api/v1alpha1/catalog-items/large:apply?validate_only=false",
+			}))
+			Expect(stdout.String()).To(Equal("catalog-items/small configured\ncatalog-items/large created\n"))
+		})
+
+		It("should print the changes of a dry run", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("validate_only")).To(Equal("true"))
+				if strings.Contains(r.URL.Path, "/small:") {
+					writeJSON(w, http.StatusOK, map[string]any{"action": "UPDATED", "catalog_item": map[string]any{}, "changes": []any{
+						map[string]any{"path": "display_name", "type": "MODIFIED", "before": "Tiny VM", "after": "Small VM"},
+						map[string]any{"path": "spec.fields[spec.vcpu.count]", "type": "REMOVED", "before": map[string]any{"path": "spec.vcpu.count"}},
+					}})
+					return
+				}
+				writeJSON(w, http.StatusOK, map[string]any{"action": "UNCHANGED", "changes": []any{}, "catalog_item": map[string]any{}})
+			}
+
+			Expect(dcmctl("", "apply", "catalog-items", "-f", manifest, "--dry-run")).To(Equal(0), stderr.String())
+			Expect(stdout.String()).To(Equal(`catalog-items/small configured (dry run)
+  ~ display_name: Tiny VM -> Small VM
+  - spec.fields[spec.vcpu.count]: {"path":"spec.vcpu.count"}
+catalog-items/large unchanged (dry run)
+`))
+		})
+
+		It("should create missing instances and leave the others alone", func() {
+			Expect(os.WriteFile(manifest, []byte(`path: catalog-item-instances/vm-1
+api_version: v1alpha1
+display_name: My VM
+spec:
+  catalog_item_id: small
+  user_values: []
+`), 0o600)).To(Succeed())
+			var requests []string
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				if r.Method == http.MethodGet {
+					notFound(w)
+					return
+				}
+				writeJSON(w, http.StatusAccepted, map[string]any{"path": "operations/op-1", "done": true, "metadata": map[string]any{"target": "catalog-item-instances/vm-1"}})
+			}
+
+			Expect(dcmctl("", "apply", "instances", "-f", manifest)).To(Equal(0), stderr.String())
+			Expect(requests).To(Equal([]string{
+				"GET /api/v1alpha1/catalog-item-instances/vm-1",
+				"POST /api/v1alpha1/catalog-item-instances?id=vm-1",
+			}))
+			Expect(dcmctl("", "apply", "instances", "-f", manifest, "--dry-run")).To(Equal(1))
+		})
+
+		It("should reject manifests with unknown fields", func() {
+			Expect(os.WriteFile(manifest, []byte("path: catalog-items/small\ndisplayName: Small VM\n"), 0o600)).To(Succeed())
+			handler = func(w http.ResponseWriter, r *http.Request) { notFound(w) }
+
+			Expect(dcmctl("", "apply", "catalog-items", "-f", manifest)).To(Equal(1))
+			Expect(stderr.String()).To(ContainSubstring(`unknown field "displayName"`))
+		})
+	})
+
+	Describe("request", func() {
+		var created *v1alpha1.CatalogItemInstance
+
+		BeforeEach(func() {
+			created = nil
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v1alpha1/catalog-items/small:form":
+					writeJSON(w, http.StatusOK, v1alpha1.CatalogItemForm{
+						Schema: map[string]any{"properties": map[string]any{
+							"vcpu.count":  map[string]any{"type": "integer", "title": "Vcpu Count", "maximum": 8, "default": 2},
+							"guest_os":    map[string]any{"type": "string", "title": "Guest OS", "enum": []any{"rhel", "fedora"}},
+							"memory.size": map[string]any{"type": "string", "title": "Memory"},
+						}},
+						UiSchema: map[string]any{"ui:order": []any{"vcpu.count", "guest_os", "memory.size"}},
+					})
+				case "/api/v1alpha1/catalog-item-instances":
+					created = &v1alpha1.CatalogItemInstance{}
+					Expect(json.NewDecoder(r.Body).Decode(created)).To(Succeed())
+					writeJSON(w, http.StatusAccepted, v1alpha1.Operation{Path: ptr("operations/op-1"), Metadata: v1alpha1.OperationMetadata{Target: "catalog-item-instances/vm-1"}})
+				default:
+					notFound(w)
+				}
+			}
+		})
+
+		It("should prompt for the editable fields until their values are valid", func() {
+			Expect(dcmctl("My VM\n12\n4\nubuntu\nrhel\n\n", "request", "small")).To(Equal(0), stderr.String())
+			Expect(stderr.String()).To(ContainSubstring("Vcpu Count (vcpu.count) [integer; default 2]: "))
+			Expect(stderr.String()).To(ContainSubstring("number must be at most 8"))
+			Expect(stderr.String()).To(ContainSubstring(`"ubuntu"`))
+			Expect(created.DisplayName).To(Equal("My VM"))
+			Expect(created.Spec.CatalogItemId).To(Equal("small"))
+			Expect(created.Spec.UserValues).To(Equal([]v1alpha1.UserValue{
+				{Path: "vcpu.count", Value: float64(4)},
+				{Path: "guest_os", Value: "rhel"},
+			}))
+			Expect(stdout.String()).To(ContainSubstring("catalog-item-instances/vm-1: operation operations/op-1 in progress"))
+		})
+
+		It("should not prompt with --set and --defaults", func() {
+			Expect(dcmctl("", "request", "small", "--name", "My VM", "--set", "guest_os=fedora", "--defaults")).To(Equal(0), stderr.String())
+			Expect(stderr.String()).To(BeEmpty())
+			Expect(created.Spec.UserValues).To(Equal([]v1alpha1.UserValue{{Path: "guest_os", Value: "fedora"}}))
+		})
+
+		It("should reject --set for fields that are not editable", func() {
+			Expect(dcmctl("", "request", "small", "--name", "My VM", "--set", "image=x", "--defaults")).To(Equal(1))
+			Expect(stderr.String()).To(ContainSubstring(`"image" is not an editable field`))
+			Expect(created).To(BeNil())
+		})
+	})
+
+	Describe("config", func() {
+		It("should save contexts and select the current one", func() {
+			Expect(dcmctl("", "config", "set-context", "dev", "--token", "secret")).To(Equal(0))
+			Expect(dcmctl("", "config", "set-context", "prod")).To(Equal(0))
+			Expect(dcmctl("", "config", "use-context", "prod")).To(Equal(0))
+
+			cfg, err := loadConfig(configPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.CurrentContext).To(Equal("prod"))
+			Expect(cfg.Contexts["dev"].Token).To(Equal("secret"))
+
+			Expect(dcmctl("", "config", "view")).To(Equal(0))
+			Expect(stdout.String()).ToNot(ContainSubstring("secret"))
+		})
+
+		It("should send the token and tenant of the current context", func() {
+			var headers http.Header
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				headers = r.Header
+				writeJSON(w, http.StatusOK, map[string]any{"results": []any{}})
+			}
+			cfg := &config{CurrentContext: "dev", Contexts: map[string]*contextConfig{
+				"dev": {Server: server.URL, Token: "secret", Tenant: "team-a"},
+			}}
+			Expect(cfg.save(configPath)).To(Succeed())
+
+			Expect(run(context.Background(), []string{"list", "st", "--config", configPath}, strings.NewReader(""), stdout, stderr)).To(Equal(0), stderr.String())
+			Expect(headers.Get("Authorization")).To(Equal("Bearer secret"))
+			Expect(headers.Get("X-Tenant-ID")).To(Equal("team-a"))
+		})
+	})
+})
+
+func ptr[T any](v T) *T {
+	return &v
+}
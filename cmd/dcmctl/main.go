@@ -0,0 +1,159 @@
+// Command dcmctl is a command-line client of the Catalog Manager API.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+const usage = `dcmctl manages service types, catalog items and catalog item instances.
+
+Usage:
+  dcmctl <command> [flags] [arguments]
+
+Commands:
+  get <resource> <id>...        Show resources
+  list <resource>               List resources, following every page
+  describe <resource> <id>      Show a resource in detail
+  create <resource> -f FILE     Create the resources of a manifest
+  apply <resource> -f FILE      Create or update the resources of a manifest
+  delete <resource> <id>...     Delete resources
+  request <catalog-item>        Request an instance of a catalog item, prompting
+                                for its editable fields
+  config <subcommand>           Manage the contexts of the configuration file
+
+Resources:
+  service-types (st), catalog-items (ci), catalog-item-instances (cii)
+
+Global flags:
+  -o, --output FORMAT   table, json or yaml (default table)
+  --context NAME        Context of the configuration file to use
+  --config PATH         Configuration file (default $DCMCTL_CONFIG or
+                        ~/.config/dcmctl/config.yaml)
+  --server URL          Server URL, overriding the context ($DCMCTL_SERVER)
+  --token TOKEN         Bearer token, overriding the context ($DCMCTL_TOKEN)
+  --tenant TENANT       Tenant, overriding the context ($DCMCTL_TENANT)
+`
+
+// errUsage is returned for invalid command lines, after their usage was printed
+var errUsage = errors.New("invalid usage")
+
+// command runs a command with the arguments following its name
+type command func(ctx context.Context, cli *cli, args []string) error
+
+var commands = map[string]command{
+	"get":      runGet,
+	"list":     runList,
+	"describe": runDescribe,
+	"create":   runCreate,
+	"apply":    runApply,
+	"delete":   runDelete,
+	"request":  runRequest,
+	"config":   runConfig,
+}
+
+// cli holds the streams and global flags of an invocation
+type cli struct {
+	stdin  *bufio.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	output      string
+	configPath  string
+	contextName string
+	server      string
+	token       string
+	tenant      string
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run runs a command line and returns the exit code of the process
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(stdout, usage)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "error: unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+
+	c := &cli{stdin: bufio.NewReader(stdin), stdout: stdout, stderr: stderr}
+	if err := cmd(ctx, c, args[1:]); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// flags creates the flag set of a command, with the global flags
+func (c *cli) flags(name, synopsis string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: dcmctl %s %s\n\nFlags:\n", name, synopsis)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&c.output, "output", "table", "output format: table, json or yaml")
+	fs.StringVar(&c.output, "o", "table", "shorthand for --output")
+	fs.StringVar(&c.configPath, "config", "", "configuration file")
+	fs.StringVar(&c.contextName, "context", "", "context of the configuration file to use")
+	fs.StringVar(&c.server, "server", "", "server URL, overriding the context")
+	fs.StringVar(&c.token, "token", "", "bearer token, overriding the context")
+	fs.StringVar(&c.tenant, "tenant", "", "tenant, overriding the context")
+	return fs
+}
+
+// parse parses the flags of a command, which may follow its arguments, and
+// returns the arguments
+func (c *cli) parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	switch c.output {
+	case outputTable, outputJSON, outputYAML:
+		return positional, nil
+	default:
+		fmt.Fprintf(c.stderr, "error: unknown output format %q\n", c.output)
+		return nil, errUsage
+	}
+}
+
+// usageError prints the usage of a command after a problem with its arguments
+func (c *cli) usageError(fs *flag.FlagSet, format string, args ...any) error {
+	fmt.Fprintf(c.stderr, "error: "+format+"\n", args...)
+	fs.Usage()
+	return errUsage
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// column is a column of the table of a resource
+type column struct {
+	header string
+	value  func(obj map[string]any) string
+}
+
+// field is the column showing the value at a dot-separated path
+func field(header, path string) column {
+	return column{header: header, value: func(obj map[string]any) string {
+		return format(lookup(obj, path))
+	}}
+}
+
+// idColumn shows the ID of a resource, the last segment of its path
+var idColumn = column{header: "ID", value: func(obj map[string]any) string {
+	return idOf(obj)
+}}
+
+// ageColumn shows the time since the creation of a resource
+var ageColumn = column{header: "AGE", value: func(obj map[string]any) string {
+	created, ok := lookup(obj, "create_time").(string)
+	if !ok {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339, created)
+	if err != nil {
+		return ""
+	}
+	return age(time.Since(t))
+}}
+
+// toMap converts a resource to its JSON representation
+func toMap(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	obj := map[string]any{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// printObjects prints resources in a format. The table format shows the
+// columns of the resource.
+func printObjects(w io.Writer, output string, columns []column, objects []any) error {
+	switch output {
+	case outputJSON, outputYAML:
+		var v any = objects
+		if len(objects) == 1 {
+			v = objects[0]
+		}
+		return printData(w, output, v)
+	default:
+		tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+		headers := make([]string, len(columns))
+		for i, col := range columns {
+			headers[i] = col.header
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		for _, o := range objects {
+			obj, err := toMap(o)
+			if err != nil {
+				return err
+			}
+			values := make([]string, len(columns))
+			for i, col := range columns {
+				values[i] = col.value(obj)
+			}
+			fmt.Fprintln(tw, strings.Join(values, "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+// printData prints a value as JSON, or as YAML for every other format
+func printData(w io.Writer, output string, v any) error {
+	if output == outputJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	// Go through JSON so that the YAML keys are the JSON field names
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(generic); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// lookup returns the value at a dot-separated path of a JSON object
+func lookup(obj map[string]any, path string) any {
+	var current any = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = m[key]
+	}
+	return current
+}
+
+// idOf returns the ID of a resource, the last segment of its path
+func idOf(obj map[string]any) string {
+	path, _ := obj["path"].(string)
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// format renders a JSON value in a table cell
+func format(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]any, []any:
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// age renders a duration like kubectl: 45s, 12m, 5h or 3d
+func age(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
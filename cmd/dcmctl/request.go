@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+)
+
+// setFlags collects the path=value pairs of repeated --set flags
+type setFlags []string
+
+func (s *setFlags) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *setFlags) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("expected path=value, got %q", value)
+	}
+	*s = append(*s, value)
+	return nil
+}
+
+func runRequest(ctx context.Context, c *cli, args []string) error {
+	fs := c.flags("request", "<catalog-item> [--name NAME] [--set path=value]... [--defaults] [--wait] [flags]")
+	var sets setFlags
+	fs.Var(&sets, "set", "value of an editable field, as path=value; may be repeated")
+	name := fs.String("name", "", "display name of the instance")
+	id := fs.String("id", "", "ID of the instance; generated by the server when empty")
+	defaults := fs.Bool("defaults", false, "keep the defaults of the fields not given with --set instead of prompting for them")
+	wait := fs.Bool("wait", false, "wait for the creation of the instance to complete")
+	args, err := c.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return c.usageError(fs, "expected a catalog item")
+	}
+	catalogItemID := args[0]
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	form, err := client.CatalogItems().Form(ctx, catalogItemID)
+	if err != nil {
+		return fmt.Errorf("catalog-items/%s: %w", catalogItemID, err)
+	}
+	properties, _ := form.Schema["properties"].(map[string]any)
+	order, _ := form.UiSchema["ui:order"].([]any)
+
+	given := map[string]any{}
+	for _, set := range sets {
+		path, raw, _ := strings.Cut(set, "=")
+		property, ok := properties[path].(map[string]any)
+		if !ok {
+			return fmt.Errorf("--set %s: %q is not an editable field of catalog-items/%s", set, path, catalogItemID)
+		}
+		value, err := parseValue(property, raw)
+		if err != nil {
+			return fmt.Errorf("--set %s: %w", set, err)
+		}
+		given[path] = value
+	}
+
+	displayName := *name
+	for displayName == "" {
+		if displayName, err = c.prompt("Display name: "); err != nil {
+			return err
+		}
+	}
+
+	userValues := []v1alpha1.UserValue{}
+	for _, p := range order {
+		path, _ := p.(string)
+		property, _ := properties[path].(map[string]any)
+		if value, ok := given[path]; ok {
+			userValues = append(userValues, v1alpha1.UserValue{Path: path, Value: value})
+			continue
+		}
+		if *defaults {
+			continue
+		}
+		value, ok, err := c.promptField(path, property)
+		if err != nil {
+			return err
+		}
+		if ok {
+			userValues = append(userValues, v1alpha1.UserValue{Path: path, Value: value})
+		}
+	}
+
+	instance := v1alpha1.CatalogItemInstance{
+		ApiVersion:  "v1alpha1",
+		DisplayName: displayName,
+		Spec: v1alpha1.CatalogItemInstanceSpec{
+			CatalogItemId: catalogItemID,
+			UserValues:    userValues,
+		},
+	}
+	op, err := client.CatalogItemInstances().Create(ctx, &v1alpha1.CreateCatalogItemInstanceParams{Id: optional(*id)}, instance)
+	if err != nil {
+		return fmt.Errorf("request catalog-items/%s: %w", catalogItemID, err)
+	}
+	r, err := findResource("catalog-item-instances")
+	if err != nil {
+		return err
+	}
+	return c.printResult(ctx, client, r, op, "created", *wait)
+}
+
+// promptField prompts for the value of an editable field until a valid one is
+// entered. It returns false when the answer is empty, leaving the field to
+// its default.
+func (c *cli) promptField(path string, property map[string]any) (any, bool, error) {
+	title, _ := property["title"].(string)
+	var hints []string
+	if t, ok := property["type"].(string); ok {
+		hints = append(hints, t)
+	}
+	if enum, ok := property["enum"].([]any); ok {
+		choices := make([]string, len(enum))
+		for i, v := range enum {
+			choices[i] = format(v)
+		}
+		hints = append(hints, "one of "+strings.Join(choices, ", "))
+	}
+	if def, ok := property["default"]; ok && def != nil {
+		hints = append(hints, "default "+format(def))
+	} else if expr, ok := property["x-default-expression"].(string); ok {
+		hints = append(hints, "default computed from "+expr)
+	}
+	if expr, ok := property["x-required-when"].(string); ok {
+		hints = append(hints, "required when "+expr)
+	}
+	if expr, ok := property["x-visible-when"].(string); ok {
+		hints = append(hints, "only when "+expr)
+	}
+
+	label := fmt.Sprintf("%s (%s)", title, path)
+	if len(hints) > 0 {
+		label += " [" + strings.Join(hints, "; ") + "]"
+	}
+	for {
+		answer, err := c.prompt(label + ": ")
+		if err != nil {
+			return nil, false, err
+		}
+		if answer == "" {
+			return nil, false, nil
+		}
+		value, err := parseValue(property, answer)
+		if err == nil {
+			return value, true, nil
+		}
+		fmt.Fprintf(c.stderr, "  %v\n", err)
+	}
+}
+
+// prompt asks a question on the standard error, so that the standard output
+// only holds the result, and returns the trimmed answer
+func (c *cli) prompt(question string) (string, error) {
+	fmt.Fprint(c.stderr, question)
+	line, err := c.stdin.ReadString('\n')
+	if errors.Is(err, io.EOF) && line == "" {
+		fmt.Fprintln(c.stderr)
+		return "", errors.New("no answer: the standard input is closed")
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// parseValue parses the answer for a field according to its JSON Schema and
+// validates it. Values of fields without a scalar type are parsed as JSON,
+// falling back to a string.
+func parseValue(property map[string]any, raw string) (any, error) {
+	var value any
+	switch property["type"] {
+	case "integer":
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", raw)
+		}
+		value = n
+	case "number":
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", raw)
+		}
+		value = n
+	case "boolean":
+		switch strings.ToLower(raw) {
+		case "true", "yes", "y":
+			value = true
+		case "false", "no", "n":
+			value = false
+		default:
+			return nil, fmt.Errorf("%q is not a boolean: answer true or false", raw)
+		}
+	case "string":
+		value = raw
+	default:
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			value = raw
+		}
+	}
+
+	// Check the constraints of the field early rather than after every prompt
+	s, err := schema.Parse(property)
+	if err != nil {
+		return value, nil
+	}
+	if err := schema.Validate(s, value); err != nil {
+		return nil, fmt.Errorf("invalid value %q: %w", raw, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/catalog"
+)
+
+// listOptions filters the resources of list
+type listOptions struct {
+	serviceType string
+	catalogItem string
+}
+
+// resource is a kind of resource managed by dcmctl
+type resource struct {
+	name    string
+	aliases []string
+	columns []column
+
+	get  func(ctx context.Context, c *catalog.Client, id string) (any, error)
+	list func(ctx context.Context, c *catalog.Client, opts listOptions) iter.Seq2[any, error]
+	// create creates the resource of a manifest, with a server-generated ID
+	// when id is empty. Instances return the operation creating them.
+	create func(ctx context.Context, c *catalog.Client, id string, manifest []byte) (any, error)
+	// apply creates or updates the resource of a manifest on the server, which
+	// reports the changes. It is nil for the resources apply creates or
+	// updates itself with create and update.
+	apply func(ctx context.Context, c *catalog.Client, id string, manifest []byte, dryRun bool) (*applied, error)
+	// update updates an existing resource to match a manifest
+	update func(ctx context.Context, c *catalog.Client, id string, existing any, manifest []byte) (any, error)
+	// delete deletes a resource. Instances return the operation deleting them.
+	// It is nil for the resources that cannot be deleted.
+	delete func(ctx context.Context, c *catalog.Client, id string) (any, error)
+	// describe prints the details describe shows below a resource, if any
+	describe func(ctx context.Context, c *catalog.Client, w io.Writer, obj map[string]any) error
+}
+
+var resources = []*resource{
+	{
+		name:    "service-types",
+		aliases: []string{"service-type", "servicetypes", "servicetype", "st"},
+		columns: []column{
+			idColumn,
+			field("SERVICE TYPE", "service_type"),
+			field("VERSION", "api_version"),
+			field("DEFAULT", "default"),
+			field("DEPRECATED", "deprecated"),
+			ageColumn,
+		},
+		get: func(ctx context.Context, c *catalog.Client, id string) (any, error) {
+			return c.ServiceTypes().Get(ctx, id)
+		},
+		list: func(ctx context.Context, c *catalog.Client, opts listOptions) iter.Seq2[any, error] {
+			params := &v1alpha1.ListServiceTypesParams{}
+			if opts.serviceType != "" {
+				params.ServiceType = &opts.serviceType
+			}
+			return anySeq(c.ServiceTypes().List(ctx, params))
+		},
+		create: func(ctx context.Context, c *catalog.Client, id string, manifest []byte) (any, error) {
+			st, err := decodeManifest[v1alpha1.ServiceType](manifest)
+			if err != nil {
+				return nil, err
+			}
+			st.Path, st.Uid, st.CreateTime, st.UpdateTime = nil, nil, nil, nil
+			return c.ServiceTypes().Create(ctx, &v1alpha1.CreateServiceTypeParams{Id: optional(id)}, st)
+		},
+		apply: func(ctx context.Context, c *catalog.Client, id string, manifest []byte, dryRun bool) (*applied, error) {
+			st, err := decodeManifest[v1alpha1.ServiceType](manifest)
+			if err != nil {
+				return nil, err
+			}
+			st.Path, st.Uid, st.CreateTime, st.UpdateTime = nil, nil, nil, nil
+			result, err := c.ServiceTypes().Apply(ctx, id, &v1alpha1.ApplyServiceTypeParams{ValidateOnly: &dryRun}, st)
+			if err != nil {
+				return nil, err
+			}
+			return &applied{action: result.Action, changes: result.Changes, result: result}, nil
+		},
+		describe: func(ctx context.Context, c *catalog.Client, w io.Writer, obj map[string]any) error {
+			serviceType, _ := obj["service_type"].(string)
+			items := []any{}
+			for item, err := range c.CatalogItems().List(ctx, &v1alpha1.ListCatalogItemsParams{ServiceType: &serviceType}) {
+				if err != nil {
+					return err
+				}
+				if item.Spec != nil && item.Spec.ServiceTypeVersion != nil && *item.Spec.ServiceTypeVersion == obj["api_version"] {
+					items = append(items, item)
+				}
+			}
+			fmt.Fprintln(w, "\nCatalog items:")
+			return printObjects(w, outputTable, catalogItemColumns, items)
+		},
+	},
+	{
+		name:    "catalog-items",
+		aliases: []string{"catalog-item", "catalogitems", "catalogitem", "ci"},
+		columns: catalogItemColumns,
+		get: func(ctx context.Context, c *catalog.Client, id string) (any, error) {
+			return c.CatalogItems().Get(ctx, id)
+		},
+		list: func(ctx context.Context, c *catalog.Client, opts listOptions) iter.Seq2[any, error] {
+			params := &v1alpha1.ListCatalogItemsParams{}
+			if opts.serviceType != "" {
+				params.ServiceType = &opts.serviceType
+			}
+			return anySeq(c.CatalogItems().List(ctx, params))
+		},
+		create: func(ctx context.Context, c *catalog.Client, id string, manifest []byte) (any, error) {
+			item, err := decodeManifest[v1alpha1.CatalogItem](manifest)
+			if err != nil {
+				return nil, err
+			}
+			clearCatalogItemOutputs(&item)
+			return c.CatalogItems().Create(ctx, &v1alpha1.CreateCatalogItemParams{Id: optional(id)}, item)
+		},
+		apply: func(ctx context.Context, c *catalog.Client, id string, manifest []byte, dryRun bool) (*applied, error) {
+			item, err := decodeManifest[v1alpha1.CatalogItem](manifest)
+			if err != nil {
+				return nil, err
+			}
+			clearCatalogItemOutputs(&item)
+			result, err := c.CatalogItems().Apply(ctx, id, &v1alpha1.ApplyCatalogItemParams{ValidateOnly: &dryRun}, item)
+			if err != nil {
+				return nil, err
+			}
+			return &applied{action: result.Action, changes: result.Changes, result: result}, nil
+		},
+		delete: func(ctx context.Context, c *catalog.Client, id string) (any, error) {
+			return nil, c.CatalogItems().Delete(ctx, id)
+		},
+		describe: func(ctx context.Context, c *catalog.Client, w io.Writer, obj map[string]any) error {
+			fields, _ := lookup(obj, "spec.fields").([]any)
+			editable := []any{}
+			for _, f := range fields {
+				if f, ok := f.(map[string]any); ok && f["editable"] == true {
+					editable = append(editable, f)
+				}
+			}
+			fmt.Fprintln(w, "\nEditable fields:")
+			if err := printObjects(w, outputTable, []column{
+				field("PATH", "path"),
+				field("DISPLAY NAME", "display_name"),
+				field("DEFAULT", "default"),
+				field("VISIBLE WHEN", "visible_when"),
+				field("REQUIRED WHEN", "required_when"),
+			}, editable); err != nil {
+				return err
+			}
+
+			id := idOf(obj)
+			instances := []any{}
+			for instance, err := range c.CatalogItemInstances().List(ctx, &v1alpha1.ListCatalogItemInstancesParams{CatalogItemId: &id}) {
+				if err != nil {
+					return err
+				}
+				instances = append(instances, instance)
+			}
+			fmt.Fprintln(w, "\nInstances:")
+			return printObjects(w, outputTable, instanceColumns, instances)
+		},
+	},
+	{
+		name:    "catalog-item-instances",
+		aliases: []string{"catalog-item-instance", "catalogiteminstances", "catalogiteminstance", "instances", "instance", "cii"},
+		columns: instanceColumns,
+		get: func(ctx context.Context, c *catalog.Client, id string) (any, error) {
+			return c.CatalogItemInstances().Get(ctx, id)
+		},
+		list: func(ctx context.Context, c *catalog.Client, opts listOptions) iter.Seq2[any, error] {
+			params := &v1alpha1.ListCatalogItemInstancesParams{}
+			if opts.catalogItem != "" {
+				params.CatalogItemId = &opts.catalogItem
+			}
+			return anySeq(c.CatalogItemInstances().List(ctx, params))
+		},
+		create: func(ctx context.Context, c *catalog.Client, id string, manifest []byte) (any, error) {
+			instance, err := decodeManifest[v1alpha1.CatalogItemInstance](manifest)
+			if err != nil {
+				return nil, err
+			}
+			instance.Path, instance.Uid, instance.CreateTime, instance.UpdateTime = nil, nil, nil, nil
+			instance.Status, instance.CatalogItemRevision, instance.ServiceTypeInstanceUid = nil, nil, nil
+			return c.CatalogItemInstances().Create(ctx, &v1alpha1.CreateCatalogItemInstanceParams{Id: optional(id)}, instance)
+		},
+		update: func(_ context.Context, _ *catalog.Client, _ string, existing any, manifest []byte) (any, error) {
+			instance, err := decodeManifest[v1alpha1.CatalogItemInstance](manifest)
+			if err != nil {
+				return nil, err
+			}
+			current := existing.(*v1alpha1.CatalogItemInstance)
+			if instance.DisplayName != current.DisplayName || !sameJSON(instance.Spec, current.Spec) {
+				return nil, fmt.Errorf("catalog item instances cannot be updated; delete the instance and create it again")
+			}
+			return current, nil
+		},
+		delete: func(ctx context.Context, c *catalog.Client, id string) (any, error) {
+			return c.CatalogItemInstances().Delete(ctx, id)
+		},
+	},
+}
+
+// applied is the outcome of a server-side apply
+type applied struct {
+	action  v1alpha1.ApplyAction
+	changes []v1alpha1.ApplyChange
+	result  any // the apply result, printed as is by the json and yaml output formats
+}
+
+var catalogItemColumns = []column{
+	idColumn,
+	field("DISPLAY NAME", "display_name"),
+	field("SERVICE TYPE", "spec.service_type"),
+	field("VERSION", "spec.service_type_version"),
+	field("REVISION", "revision"),
+	ageColumn,
+}
+
+var instanceColumns = []column{
+	idColumn,
+	field("DISPLAY NAME", "display_name"),
+	field("CATALOG ITEM", "spec.catalog_item_id"),
+	field("STATE", "status.state"),
+	ageColumn,
+}
+
+// findResource returns the resource with a name or alias
+func findResource(name string) (*resource, error) {
+	for _, r := range resources {
+		if r.name == name {
+			return r, nil
+		}
+		for _, alias := range r.aliases {
+			if alias == name {
+				return r, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown resource %q: use service-types, catalog-items or catalog-item-instances", name)
+}
+
+// decodeManifest decodes the JSON of a manifest, rejecting unknown fields
+func decodeManifest[T any](manifest []byte) (T, error) {
+	var v T
+	dec := json.NewDecoder(bytes.NewReader(manifest))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		return v, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return v, nil
+}
+
+// clearCatalogItemOutputs removes the output-only fields of a catalog item manifest
+func clearCatalogItemOutputs(item *v1alpha1.CatalogItem) {
+	item.Path, item.Uid, item.CreateTime, item.UpdateTime, item.Revision = nil, nil, nil, nil, nil
+}
+
+// anySeq converts an iterator of resources to an iterator of any
+func anySeq[T any](seq iter.Seq2[T, error]) iter.Seq2[any, error] {
+	return func(yield func(any, error) bool) {
+		for v, err := range seq {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(&v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// sameJSON reports whether two values have the same JSON representation
+func sameJSON(a, b any) bool {
+	aj, errA := toMap(map[string]any{"v": a})
+	bj, errB := toMap(map[string]any{"v": b})
+	return errA == nil && errB == nil && reflect.DeepEqual(aj, bj)
+}
+
+// optional returns a pointer to s, or nil when it is empty
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	. "github.com/dcm-project/catalog-manager/api/v1alpha1"
@@ -17,6 +18,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List audit events
+	// (GET /audit-events)
+	ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams)
 	// List catalog item instances
 	// (GET /catalog-item-instances)
 	ListCatalogItemInstances(w http.ResponseWriter, r *http.Request, params ListCatalogItemInstancesParams)
@@ -28,7 +32,22 @@ type ServerInterface interface {
 	DeleteCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
 	// Get a catalog item instance
 	// (GET /catalog-item-instances/{catalogItemInstanceId})
-	GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
+	GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params GetCatalogItemInstanceParams)
+	// Preview the conversion of a catalog item instance
+	// (POST /catalog-item-instances/{catalogItemInstanceId}:convert)
+	ConvertCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
+	// Create catalog item instances in bulk
+	// (POST /catalog-item-instances:batchCreate)
+	BatchCreateCatalogItemInstances(w http.ResponseWriter, r *http.Request, params BatchCreateCatalogItemInstancesParams)
+	// Get catalog item instances in bulk
+	// (GET /catalog-item-instances:batchGet)
+	BatchGetCatalogItemInstances(w http.ResponseWriter, r *http.Request, params BatchGetCatalogItemInstancesParams)
+	// Count catalog item instances
+	// (GET /catalog-item-instances:count)
+	CountCatalogItemInstances(w http.ResponseWriter, r *http.Request, params CountCatalogItemInstancesParams)
+	// Watch catalog item instances
+	// (GET /catalog-item-instances:watch)
+	WatchCatalogItemInstances(w http.ResponseWriter, r *http.Request, params WatchCatalogItemInstancesParams)
 	// List catalog items
 	// (GET /catalog-items)
 	ListCatalogItems(w http.ResponseWriter, r *http.Request, params ListCatalogItemsParams)
@@ -40,13 +59,55 @@ type ServerInterface interface {
 	DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
 	// Get a catalog item
 	// (GET /catalog-items/{catalogItemId})
-	GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params GetCatalogItemParams)
 	// Update a catalog item
 	// (PATCH /catalog-items/{catalogItemId})
 	UpdateCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// List catalog item revisions
+	// (GET /catalog-items/{catalogItemId}/revisions)
+	ListCatalogItemRevisions(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params ListCatalogItemRevisionsParams)
+	// Apply a catalog item manifest
+	// (POST /catalog-items/{catalogItemId}:apply)
+	ApplyCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params ApplyCatalogItemParams)
+	// Preview the conversion of a catalog item
+	// (POST /catalog-items/{catalogItemId}:convert)
+	ConvertCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Get the form of a catalog item
+	// (GET /catalog-items/{catalogItemId}:form)
+	GetCatalogItemForm(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Roll back a catalog item
+	// (POST /catalog-items/{catalogItemId}:rollback)
+	RollbackCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Get catalog items in bulk
+	// (GET /catalog-items:batchGet)
+	BatchGetCatalogItems(w http.ResponseWriter, r *http.Request, params BatchGetCatalogItemsParams)
 	// Health check
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
+	// List operations
+	// (GET /operations)
+	ListOperations(w http.ResponseWriter, r *http.Request, params ListOperationsParams)
+	// Get an operation
+	// (GET /operations/{operationId})
+	GetOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath)
+	// Cancel an operation
+	// (POST /operations/{operationId}:cancel)
+	CancelOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath)
+	// Wait for an operation
+	// (POST /operations/{operationId}:wait)
+	WaitOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath, params WaitOperationParams)
+	// List quotas
+	// (GET /quotas)
+	ListQuotas(w http.ResponseWriter, r *http.Request, params ListQuotasParams)
+	// Create a quota
+	// (POST /quotas)
+	CreateQuota(w http.ResponseWriter, r *http.Request, params CreateQuotaParams)
+	// Delete a quota
+	// (DELETE /quotas/{quotaId})
+	DeleteQuota(w http.ResponseWriter, r *http.Request, quotaId QuotaIdPath)
+	// Get a quota
+	// (GET /quotas/{quotaId})
+	GetQuota(w http.ResponseWriter, r *http.Request, quotaId QuotaIdPath)
 	// List service types
 	// (GET /service-types)
 	ListServiceTypes(w http.ResponseWriter, r *http.Request, params ListServiceTypesParams)
@@ -55,13 +116,46 @@ type ServerInterface interface {
 	CreateServiceType(w http.ResponseWriter, r *http.Request, params CreateServiceTypeParams)
 	// Get a service type
 	// (GET /service-types/{serviceTypeId})
-	GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath)
+	GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params GetServiceTypeParams)
+	// Update a service type
+	// (PATCH /service-types/{serviceTypeId})
+	UpdateServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath)
+	// Apply a service type manifest
+	// (POST /service-types/{serviceTypeId}:apply)
+	ApplyServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params ApplyServiceTypeParams)
+	// Get resource usage
+	// (GET /usage)
+	GetUsage(w http.ResponseWriter, r *http.Request)
+	// List webhook subscriptions
+	// (GET /webhook-subscriptions)
+	ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params ListWebhookSubscriptionsParams)
+	// Create a webhook subscription
+	// (POST /webhook-subscriptions)
+	CreateWebhookSubscription(w http.ResponseWriter, r *http.Request, params CreateWebhookSubscriptionParams)
+	// Delete a webhook subscription
+	// (DELETE /webhook-subscriptions/{webhookSubscriptionId})
+	DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath)
+	// Get a webhook subscription
+	// (GET /webhook-subscriptions/{webhookSubscriptionId})
+	GetWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath)
+	// List webhook deliveries
+	// (GET /webhook-subscriptions/{webhookSubscriptionId}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath, params ListWebhookDeliveriesParams)
+	// Test a webhook subscription
+	// (POST /webhook-subscriptions/{webhookSubscriptionId}:test)
+	TestWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List audit events
+// (GET /audit-events)
+func (_ Unimplemented) ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List catalog item instances
 // (GET /catalog-item-instances)
 func (_ Unimplemented) ListCatalogItemInstances(w http.ResponseWriter, r *http.Request, params ListCatalogItemInstancesParams) {
@@ -82,7 +176,37 @@ func (_ Unimplemented) DeleteCatalogItemInstance(w http.ResponseWriter, r *http.
 
 // Get a catalog item instance
 // (GET /catalog-item-instances/{catalogItemInstanceId})
-func (_ Unimplemented) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+func (_ Unimplemented) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params GetCatalogItemInstanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview the conversion of a catalog item instance
+// (POST /catalog-item-instances/{catalogItemInstanceId}:convert)
+func (_ Unimplemented) ConvertCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create catalog item instances in bulk
+// (POST /catalog-item-instances:batchCreate)
+func (_ Unimplemented) BatchCreateCatalogItemInstances(w http.ResponseWriter, r *http.Request, params BatchCreateCatalogItemInstancesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get catalog item instances in bulk
+// (GET /catalog-item-instances:batchGet)
+func (_ Unimplemented) BatchGetCatalogItemInstances(w http.ResponseWriter, r *http.Request, params BatchGetCatalogItemInstancesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Count catalog item instances
+// (GET /catalog-item-instances:count)
+func (_ Unimplemented) CountCatalogItemInstances(w http.ResponseWriter, r *http.Request, params CountCatalogItemInstancesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch catalog item instances
+// (GET /catalog-item-instances:watch)
+func (_ Unimplemented) WatchCatalogItemInstances(w http.ResponseWriter, r *http.Request, params WatchCatalogItemInstancesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -106,7 +230,7 @@ func (_ Unimplemented) DeleteCatalogItem(w http.ResponseWriter, r *http.Request,
 
 // Get a catalog item
 // (GET /catalog-items/{catalogItemId})
-func (_ Unimplemented) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+func (_ Unimplemented) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params GetCatalogItemParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -116,12 +240,96 @@ func (_ Unimplemented) UpdateCatalogItem(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List catalog item revisions
+// (GET /catalog-items/{catalogItemId}/revisions)
+func (_ Unimplemented) ListCatalogItemRevisions(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params ListCatalogItemRevisionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply a catalog item manifest
+// (POST /catalog-items/{catalogItemId}:apply)
+func (_ Unimplemented) ApplyCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params ApplyCatalogItemParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview the conversion of a catalog item
+// (POST /catalog-items/{catalogItemId}:convert)
+func (_ Unimplemented) ConvertCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the form of a catalog item
+// (GET /catalog-items/{catalogItemId}:form)
+func (_ Unimplemented) GetCatalogItemForm(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Roll back a catalog item
+// (POST /catalog-items/{catalogItemId}:rollback)
+func (_ Unimplemented) RollbackCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get catalog items in bulk
+// (GET /catalog-items:batchGet)
+func (_ Unimplemented) BatchGetCatalogItems(w http.ResponseWriter, r *http.Request, params BatchGetCatalogItemsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check
 // (GET /health)
 func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List operations
+// (GET /operations)
+func (_ Unimplemented) ListOperations(w http.ResponseWriter, r *http.Request, params ListOperationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an operation
+// (GET /operations/{operationId})
+func (_ Unimplemented) GetOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel an operation
+// (POST /operations/{operationId}:cancel)
+func (_ Unimplemented) CancelOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Wait for an operation
+// (POST /operations/{operationId}:wait)
+func (_ Unimplemented) WaitOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath, params WaitOperationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List quotas
+// (GET /quotas)
+func (_ Unimplemented) ListQuotas(w http.ResponseWriter, r *http.Request, params ListQuotasParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a quota
+// (POST /quotas)
+func (_ Unimplemented) CreateQuota(w http.ResponseWriter, r *http.Request, params CreateQuotaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a quota
+// (DELETE /quotas/{quotaId})
+func (_ Unimplemented) DeleteQuota(w http.ResponseWriter, r *http.Request, quotaId QuotaIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a quota
+// (GET /quotas/{quotaId})
+func (_ Unimplemented) GetQuota(w http.ResponseWriter, r *http.Request, quotaId QuotaIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List service types
 // (GET /service-types)
 func (_ Unimplemented) ListServiceTypes(w http.ResponseWriter, r *http.Request, params ListServiceTypesParams) {
@@ -136,7 +344,61 @@ func (_ Unimplemented) CreateServiceType(w http.ResponseWriter, r *http.Request,
 
 // Get a service type
 // (GET /service-types/{serviceTypeId})
-func (_ Unimplemented) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath) {
+func (_ Unimplemented) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params GetServiceTypeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a service type
+// (PATCH /service-types/{serviceTypeId})
+func (_ Unimplemented) UpdateServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply a service type manifest
+// (POST /service-types/{serviceTypeId}:apply)
+func (_ Unimplemented) ApplyServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params ApplyServiceTypeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get resource usage
+// (GET /usage)
+func (_ Unimplemented) GetUsage(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhook subscriptions
+// (GET /webhook-subscriptions)
+func (_ Unimplemented) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params ListWebhookSubscriptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a webhook subscription
+// (POST /webhook-subscriptions)
+func (_ Unimplemented) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request, params CreateWebhookSubscriptionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook subscription
+// (DELETE /webhook-subscriptions/{webhookSubscriptionId})
+func (_ Unimplemented) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a webhook subscription
+// (GET /webhook-subscriptions/{webhookSubscriptionId})
+func (_ Unimplemented) GetWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhook deliveries
+// (GET /webhook-subscriptions/{webhookSubscriptionId}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Test a webhook subscription
+// (POST /webhook-subscriptions/{webhookSubscriptionId}:test)
+func (_ Unimplemented) TestWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -149,6 +411,81 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEventsParams
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource", r.URL.Query(), &params.Resource)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_time", r.URL.Query(), &params.StartTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_time", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_time", r.URL.Query(), &params.EndTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_time", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "parent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListCatalogItemInstances operation middleware
 func (siw *ServerInterfaceWrapper) ListCatalogItemInstances(w http.ResponseWriter, r *http.Request) {
 
@@ -181,6 +518,30 @@ func (siw *ServerInterfaceWrapper) ListCatalogItemInstances(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Optional query parameter "parent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "read_mask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "read_mask", r.URL.Query(), &params.ReadMask)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "read_mask", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "skip_total_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "skip_total_size", r.URL.Query(), &params.SkipTotalSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "skip_total_size", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListCatalogItemInstances(w, r, params)
 	}))
@@ -208,6 +569,35 @@ func (siw *ServerInterfaceWrapper) CreateCatalogItemInstance(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Optional query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateCatalogItemInstance(w, r, params)
 	}))
@@ -258,8 +648,19 @@ func (siw *ServerInterfaceWrapper) GetCatalogItemInstance(w http.ResponseWriter,
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatalogItemInstanceParams
+
+	// ------------- Optional query parameter "read_mask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "read_mask", r.URL.Query(), &params.ReadMask)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "read_mask", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCatalogItemInstance(w, r, catalogItemInstanceId)
+		siw.Handler.GetCatalogItemInstance(w, r, catalogItemInstanceId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -269,40 +670,22 @@ func (siw *ServerInterfaceWrapper) GetCatalogItemInstance(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListCatalogItems operation middleware
-func (siw *ServerInterfaceWrapper) ListCatalogItems(w http.ResponseWriter, r *http.Request) {
+// ConvertCatalogItemInstance operation middleware
+func (siw *ServerInterfaceWrapper) ConvertCatalogItemInstance(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCatalogItemsParams
-
-	// ------------- Optional query parameter "page_token" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "max_page_size" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "service_type" -------------
+	// ------------- Path parameter "catalogItemInstanceId" -------------
+	var catalogItemInstanceId CatalogItemInstanceIdPath
 
-	err = runtime.BindQueryParameter("form", true, false, "service_type", r.URL.Query(), &params.ServiceType)
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemInstanceId", chi.URLParam(r, "catalogItemInstanceId"), &catalogItemInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemInstanceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCatalogItems(w, r, params)
+		siw.Handler.ConvertCatalogItemInstance(w, r, catalogItemInstanceId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -312,24 +695,45 @@ func (siw *ServerInterfaceWrapper) ListCatalogItems(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCatalogItem operation middleware
-func (siw *ServerInterfaceWrapper) CreateCatalogItem(w http.ResponseWriter, r *http.Request) {
+// BatchCreateCatalogItemInstances operation middleware
+func (siw *ServerInterfaceWrapper) BatchCreateCatalogItemInstances(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateCatalogItemParams
+	var params BatchCreateCatalogItemInstancesParams
 
-	// ------------- Optional query parameter "id" -------------
+	// ------------- Optional query parameter "request_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCatalogItem(w, r, params)
+		siw.Handler.BatchCreateCatalogItemInstances(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -339,22 +743,31 @@ func (siw *ServerInterfaceWrapper) CreateCatalogItem(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCatalogItem operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCatalogItem(w http.ResponseWriter, r *http.Request) {
+// BatchGetCatalogItemInstances operation middleware
+func (siw *ServerInterfaceWrapper) BatchGetCatalogItemInstances(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "catalogItemId" -------------
-	var catalogItemId CatalogItemIdPath
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchGetCatalogItemInstancesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Required query parameter "ids" -------------
+
+	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ids"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "ids", r.URL.Query(), &params.Ids)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCatalogItem(w, r, catalogItemId)
+		siw.Handler.BatchGetCatalogItemInstances(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -364,47 +777,47 @@ func (siw *ServerInterfaceWrapper) DeleteCatalogItem(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetCatalogItem operation middleware
-func (siw *ServerInterfaceWrapper) GetCatalogItem(w http.ResponseWriter, r *http.Request) {
+// CountCatalogItemInstances operation middleware
+func (siw *ServerInterfaceWrapper) CountCatalogItemInstances(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "catalogItemId" -------------
-	var catalogItemId CatalogItemIdPath
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CountCatalogItemInstancesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Required query parameter "group_by" -------------
+
+	if paramValue := r.URL.Query().Get("group_by"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "group_by"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "group_by", r.URL.Query(), &params.GroupBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCatalogItem(w, r, catalogItemId)
-	}))
+	// ------------- Optional query parameter "catalog_item_id" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "catalog_item_id", r.URL.Query(), &params.CatalogItemId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalog_item_id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateCatalogItem operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCatalogItem(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "catalogItemId" -------------
-	var catalogItemId CatalogItemIdPath
+	// ------------- Optional query parameter "parent" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCatalogItem(w, r, catalogItemId)
+		siw.Handler.CountCatalogItemInstances(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -414,11 +827,61 @@ func (siw *ServerInterfaceWrapper) UpdateCatalogItem(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// WatchCatalogItemInstances operation middleware
+func (siw *ServerInterfaceWrapper) WatchCatalogItemInstances(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchCatalogItemInstancesParams
+
+	// ------------- Optional query parameter "resume_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resume_token", r.URL.Query(), &params.ResumeToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resume_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "catalog_item_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "catalog_item_id", r.URL.Query(), &params.CatalogItemId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalog_item_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "parent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.WatchCatalogItemInstances(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -428,13 +891,13 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListServiceTypes operation middleware
-func (siw *ServerInterfaceWrapper) ListServiceTypes(w http.ResponseWriter, r *http.Request) {
+// ListCatalogItems operation middleware
+func (siw *ServerInterfaceWrapper) ListCatalogItems(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListServiceTypesParams
+	var params ListCatalogItemsParams
 
 	// ------------- Optional query parameter "page_token" -------------
 
@@ -452,8 +915,40 @@ func (siw *ServerInterfaceWrapper) ListServiceTypes(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", r.URL.Query(), &params.ServiceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "parent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "read_mask" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "read_mask", r.URL.Query(), &params.ReadMask)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "read_mask", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "skip_total_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "skip_total_size", r.URL.Query(), &params.SkipTotalSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "skip_total_size", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListServiceTypes(w, r, params)
+		siw.Handler.ListCatalogItems(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -463,13 +958,13 @@ func (siw *ServerInterfaceWrapper) ListServiceTypes(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateServiceType operation middleware
-func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *http.Request) {
+// CreateCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateCatalogItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateServiceTypeParams
+	var params CreateCatalogItemParams
 
 	// ------------- Optional query parameter "id" -------------
 
@@ -479,8 +974,45 @@ func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "parent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateServiceType(w, r, params)
+		siw.Handler.CreateCatalogItem(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -490,22 +1022,22 @@ func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetServiceType operation middleware
-func (siw *ServerInterfaceWrapper) GetServiceType(w http.ResponseWriter, r *http.Request) {
+// DeleteCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCatalogItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "serviceTypeId" -------------
-	var serviceTypeId ServiceTypeIdPath
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-	err = runtime.BindStyledParameterWithOptions("simple", "serviceTypeId", chi.URLParam(r, "serviceTypeId"), &serviceTypeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceTypeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServiceType(w, r, serviceTypeId)
+		siw.Handler.DeleteCatalogItem(w, r, catalogItemId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -515,895 +1047,3816 @@ func (siw *ServerInterfaceWrapper) GetServiceType(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// GetCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) GetCatalogItem(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatalogItemParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Optional query parameter "read_mask" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "read_mask", r.URL.Query(), &params.ReadMask)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "read_mask", Err: err})
+		return
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCatalogItem(w, r, catalogItemId, params)
+	}))
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// UpdateCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCatalogItem(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	var err error
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCatalogItem(w, r, catalogItemId)
+	}))
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+// ListCatalogItemRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ListCatalogItemRevisions(w http.ResponseWriter, r *http.Request) {
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	var err error
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-item-instances", wrapper.ListCatalogItemInstances)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/catalog-item-instances", wrapper.CreateCatalogItemInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.DeleteCatalogItemInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.GetCatalogItemInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-items", wrapper.ListCatalogItems)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/catalog-items", wrapper.CreateCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.DeleteCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.GetCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.UpdateCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/service-types", wrapper.ListServiceTypes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/service-types", wrapper.CreateServiceType)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/service-types/{serviceTypeId}", wrapper.GetServiceType)
-	})
-
-	return r
-}
-
-type AlreadyExistsJSONResponse Error
-
-type BadRequestJSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCatalogItemRevisionsParams
 
-type ForbiddenJSONResponse Error
+	// ------------- Optional query parameter "page_token" -------------
 
-type HasInstancesJSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
 
-type InternalServerErrorJSONResponse Error
+	// ------------- Optional query parameter "max_page_size" -------------
 
-type NotFoundJSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
 
-type UnauthorizedJSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCatalogItemRevisions(w, r, catalogItemId, params)
+	}))
 
-type ListCatalogItemInstancesRequestObject struct {
-	Params ListCatalogItemInstancesParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListCatalogItemInstancesResponseObject interface {
-	VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListCatalogItemInstances200JSONResponse CatalogItemInstanceList
-
-func (response ListCatalogItemInstances200JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ApplyCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) ApplyCatalogItem(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type ListCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-func (response ListCatalogItemInstances401JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApplyCatalogItemParams
 
-type ListCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+	// ------------- Optional query parameter "parent" -------------
 
-func (response ListCatalogItemInstances403JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "validate_only" -------------
 
-type ListCatalogItemInstances500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindQueryParameter("form", true, false, "validate_only", r.URL.Query(), &params.ValidateOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "validate_only", Err: err})
+		return
+	}
 
-func (response ListCatalogItemInstances500JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyCatalogItem(w, r, catalogItemId, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateCatalogItemInstanceRequestObject struct {
-	Params CreateCatalogItemInstanceParams
-	Body   *CreateCatalogItemInstanceJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCatalogItemInstanceResponseObject interface {
-	VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error
-}
+// ConvertCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) ConvertCatalogItem(w http.ResponseWriter, r *http.Request) {
 
-type CreateCatalogItemInstance201JSONResponse CatalogItemInstance
+	var err error
 
-func (response CreateCatalogItemInstance201JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
 
-type CreateCatalogItemInstance400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConvertCatalogItem(w, r, catalogItemId)
+	}))
 
-func (response CreateCatalogItemInstance400JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetCatalogItemForm operation middleware
+func (siw *ServerInterfaceWrapper) GetCatalogItemForm(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCatalogItemInstance401JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-type CreateCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
 
-func (response CreateCatalogItemInstance403JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCatalogItemForm(w, r, catalogItemId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCatalogItemInstance409JSONResponse struct{ AlreadyExistsJSONResponse }
+// RollbackCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) RollbackCatalogItem(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCatalogItemInstance409JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-type CreateCatalogItemInstance500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
 
-func (response CreateCatalogItemInstance500JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackCatalogItem(w, r, catalogItemId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteCatalogItemInstanceRequestObject struct {
-	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteCatalogItemInstanceResponseObject interface {
-	VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error
-}
+// BatchGetCatalogItems operation middleware
+func (siw *ServerInterfaceWrapper) BatchGetCatalogItems(w http.ResponseWriter, r *http.Request) {
 
-type DeleteCatalogItemInstance204Response struct {
-}
+	var err error
 
-func (response DeleteCatalogItemInstance204Response) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchGetCatalogItemsParams
 
-type DeleteCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Required query parameter "ids" -------------
 
-func (response DeleteCatalogItemInstance401JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ids"})
+		return
+	}
 
-type DeleteCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameter("form", true, true, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ids", Err: err})
+		return
+	}
 
-func (response DeleteCatalogItemInstance403JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchGetCatalogItems(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteCatalogItemInstance404JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteCatalogItemInstance500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	handler.ServeHTTP(w, r)
 }
 
-func (response DeleteCatalogItemInstance500JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ListOperations operation middleware
+func (siw *ServerInterfaceWrapper) ListOperations(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetCatalogItemInstanceRequestObject struct {
-	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
-}
-
-type GetCatalogItemInstanceResponseObject interface {
-	VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOperationsParams
 
-type GetCatalogItemInstance200JSONResponse CatalogItemInstance
+	// ------------- Optional query parameter "page_token" -------------
 
-func (response GetCatalogItemInstance200JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "max_page_size" -------------
 
-type GetCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
 
-func (response GetCatalogItemInstance401JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Optional query parameter "parent" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
 
-type GetCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOperations(w, r, params)
+	}))
 
-func (response GetCatalogItemInstance403JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+// GetOperation operation middleware
+func (siw *ServerInterfaceWrapper) GetOperation(w http.ResponseWriter, r *http.Request) {
 
-func (response GetCatalogItemInstance404JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "operationId" -------------
+	var operationId OperationIdPath
 
-type GetCatalogItemInstance500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "operationId", chi.URLParam(r, "operationId"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operationId", Err: err})
+		return
+	}
 
-func (response GetCatalogItemInstance500JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOperation(w, r, operationId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListCatalogItemsRequestObject struct {
-	Params ListCatalogItemsParams
+	handler.ServeHTTP(w, r)
 }
 
-type ListCatalogItemsResponseObject interface {
-	VisitListCatalogItemsResponse(w http.ResponseWriter) error
-}
+// CancelOperation operation middleware
+func (siw *ServerInterfaceWrapper) CancelOperation(w http.ResponseWriter, r *http.Request) {
 
-type ListCatalogItems200JSONResponse CatalogItemList
+	var err error
 
-func (response ListCatalogItems200JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "operationId" -------------
+	var operationId OperationIdPath
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "operationId", chi.URLParam(r, "operationId"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operationId", Err: err})
+		return
+	}
 
-type ListCatalogItems401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelOperation(w, r, operationId)
+	}))
 
-func (response ListCatalogItems401JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListCatalogItems403JSONResponse struct{ ForbiddenJSONResponse }
-
-func (response ListCatalogItems403JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// WaitOperation operation middleware
+func (siw *ServerInterfaceWrapper) WaitOperation(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type ListCatalogItems500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	// ------------- Path parameter "operationId" -------------
+	var operationId OperationIdPath
 
-func (response ListCatalogItems500JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "operationId", chi.URLParam(r, "operationId"), &operationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operationId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WaitOperationParams
 
-type CreateCatalogItemRequestObject struct {
-	Params CreateCatalogItemParams
-	Body   *CreateCatalogItemJSONRequestBody
-}
+	// ------------- Optional query parameter "timeout" -------------
 
-type CreateCatalogItemResponseObject interface {
-	VisitCreateCatalogItemResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "timeout", r.URL.Query(), &params.Timeout)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeout", Err: err})
+		return
+	}
 
-type CreateCatalogItem201JSONResponse CatalogItem
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WaitOperation(w, r, operationId, params)
+	}))
 
-func (response CreateCatalogItem201JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCatalogItem400JSONResponse Error
+// ListQuotas operation middleware
+func (siw *ServerInterfaceWrapper) ListQuotas(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCatalogItem400JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuotasParams
 
-type CreateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "page_token" -------------
 
-func (response CreateCatalogItem401JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "max_page_size" -------------
 
-type CreateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
 
-func (response CreateCatalogItem403JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "parent" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
 
-type CreateCatalogItem409JSONResponse struct{ AlreadyExistsJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuotas(w, r, params)
+	}))
 
-func (response CreateCatalogItem409JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCatalogItem500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// CreateQuota operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuota(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCatalogItem500JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateQuotaParams
 
-type DeleteCatalogItemRequestObject struct {
-	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
-}
+	// ------------- Optional query parameter "id" -------------
 
-type DeleteCatalogItemResponseObject interface {
-	VisitDeleteCatalogItemResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type DeleteCatalogItem204Response struct {
-}
+	// ------------- Optional query parameter "parent" -------------
 
-func (response DeleteCatalogItem204Response) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
 
-type DeleteCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "request_id" -------------
 
-func (response DeleteCatalogItem401JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	headers := r.Header
 
-type DeleteCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-func (response DeleteCatalogItem403JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		params.IdempotencyKey = &IdempotencyKey
 
-type DeleteCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+	}
 
-func (response DeleteCatalogItem404JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateQuota(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteCatalogItem409JSONResponse struct{ HasInstancesJSONResponse }
+	handler.ServeHTTP(w, r)
+}
 
-func (response DeleteCatalogItem409JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// DeleteQuota operation middleware
+func (siw *ServerInterfaceWrapper) DeleteQuota(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type DeleteCatalogItem500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	// ------------- Path parameter "quotaId" -------------
+	var quotaId QuotaIdPath
 
-func (response DeleteCatalogItem500JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "quotaId", chi.URLParam(r, "quotaId"), &quotaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quotaId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteQuota(w, r, quotaId)
+	}))
 
-type GetCatalogItemRequestObject struct {
-	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCatalogItemResponseObject interface {
-	VisitGetCatalogItemResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetCatalogItem200JSONResponse CatalogItem
-
-func (response GetCatalogItem200JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetQuota operation middleware
+func (siw *ServerInterfaceWrapper) GetQuota(w http.ResponseWriter, r *http.Request) {
 
-type GetCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+	var err error
 
-func (response GetCatalogItem401JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Path parameter "quotaId" -------------
+	var quotaId QuotaIdPath
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "quotaId", chi.URLParam(r, "quotaId"), &quotaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quotaId", Err: err})
+		return
+	}
 
-type GetCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQuota(w, r, quotaId)
+	}))
 
-func (response GetCatalogItem403JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetCatalogItem404JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ListServiceTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceTypes(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetCatalogItem500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServiceTypesParams
 
-func (response GetCatalogItem500JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "page_token" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
 
-type UpdateCatalogItemRequestObject struct {
-	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
-	Body          *UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody
-}
+	// ------------- Optional query parameter "max_page_size" -------------
 
-type UpdateCatalogItemResponseObject interface {
-	VisitUpdateCatalogItemResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
 
-type UpdateCatalogItem200JSONResponse CatalogItem
+	// ------------- Optional query parameter "service_type" -------------
 
-func (response UpdateCatalogItem200JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "service_type", r.URL.Query(), &params.ServiceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_type", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "read_mask" -------------
 
-type UpdateCatalogItem400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "read_mask", r.URL.Query(), &params.ReadMask)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "read_mask", Err: err})
+		return
+	}
 
-func (response UpdateCatalogItem400JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "skip_total_size" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "skip_total_size", r.URL.Query(), &params.SkipTotalSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "skip_total_size", Err: err})
+		return
+	}
 
-type UpdateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServiceTypes(w, r, params)
+	}))
 
-func (response UpdateCatalogItem401JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+// CreateServiceType operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateCatalogItem403JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateServiceTypeParams
 
-type UpdateCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+	// ------------- Optional query parameter "id" -------------
 
-func (response UpdateCatalogItem404JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "request_id" -------------
 
-type UpdateCatalogItem500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
 
-func (response UpdateCatalogItem500JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	headers := r.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-type GetHealthRequestObject struct {
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+		params.IdempotencyKey = &IdempotencyKey
 
-type GetHealth200JSONResponse Health
+	}
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceType(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListServiceTypesRequestObject struct {
-	Params ListServiceTypesParams
+	handler.ServeHTTP(w, r)
 }
 
-type ListServiceTypesResponseObject interface {
-	VisitListServiceTypesResponse(w http.ResponseWriter) error
-}
+// GetServiceType operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceType(w http.ResponseWriter, r *http.Request) {
 
-type ListServiceTypes200JSONResponse ServiceTypeList
+	var err error
 
-func (response ListServiceTypes200JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "serviceTypeId" -------------
+	var serviceTypeId ServiceTypeIdPath
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceTypeId", chi.URLParam(r, "serviceTypeId"), &serviceTypeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceTypeId", Err: err})
+		return
+	}
 
-type ListServiceTypes400JSONResponse struct{ BadRequestJSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServiceTypeParams
 
-func (response ListServiceTypes400JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "read_mask" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "read_mask", r.URL.Query(), &params.ReadMask)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "read_mask", Err: err})
+		return
+	}
 
-type ListServiceTypes401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServiceType(w, r, serviceTypeId, params)
+	}))
 
-func (response ListServiceTypes401JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListServiceTypes403JSONResponse struct{ ForbiddenJSONResponse }
+// UpdateServiceType operation middleware
+func (siw *ServerInterfaceWrapper) UpdateServiceType(w http.ResponseWriter, r *http.Request) {
 
-func (response ListServiceTypes403JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "serviceTypeId" -------------
+	var serviceTypeId ServiceTypeIdPath
 
-type ListServiceTypes500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceTypeId", chi.URLParam(r, "serviceTypeId"), &serviceTypeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceTypeId", Err: err})
+		return
+	}
 
-func (response ListServiceTypes500JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateServiceType(w, r, serviceTypeId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateServiceTypeRequestObject struct {
-	Params CreateServiceTypeParams
-	Body   *CreateServiceTypeJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateServiceTypeResponseObject interface {
-	VisitCreateServiceTypeResponse(w http.ResponseWriter) error
-}
+// ApplyServiceType operation middleware
+func (siw *ServerInterfaceWrapper) ApplyServiceType(w http.ResponseWriter, r *http.Request) {
 
-type CreateServiceType201JSONResponse ServiceType
+	var err error
 
-func (response CreateServiceType201JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "serviceTypeId" -------------
+	var serviceTypeId ServiceTypeIdPath
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "serviceTypeId", chi.URLParam(r, "serviceTypeId"), &serviceTypeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "serviceTypeId", Err: err})
+		return
+	}
 
-type CreateServiceType400JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApplyServiceTypeParams
 
-func (response CreateServiceType400JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "validate_only" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "validate_only", r.URL.Query(), &params.ValidateOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "validate_only", Err: err})
+		return
+	}
 
-type CreateServiceType401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyServiceType(w, r, serviceTypeId, params)
+	}))
 
-func (response CreateServiceType401JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateServiceType403JSONResponse struct{ ForbiddenJSONResponse }
+// GetUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetUsage(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateServiceType403JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsage(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateServiceType409JSONResponse struct{ AlreadyExistsJSONResponse }
+// ListWebhookSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateServiceType409JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookSubscriptionsParams
 
-type CreateServiceType500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	// ------------- Optional query parameter "page_token" -------------
 
-func (response CreateServiceType500JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "parent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookSubscriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetServiceTypeRequestObject struct {
-	ServiceTypeId ServiceTypeIdPath `json:"serviceTypeId"`
+// CreateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateWebhookSubscriptionParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "parent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parent", r.URL.Query(), &params.Parent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "parent", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhookSubscription(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetServiceTypeResponseObject interface {
-	VisitGetServiceTypeResponse(w http.ResponseWriter) error
+// DeleteWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId WebhookSubscriptionIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhookSubscription(w, r, webhookSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetServiceType200JSONResponse ServiceType
+// GetWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookSubscription(w http.ResponseWriter, r *http.Request) {
 
-func (response GetServiceType200JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId WebhookSubscriptionIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookSubscription(w, r, webhookSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetServiceType401JSONResponse struct{ UnauthorizedJSONResponse }
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 
-func (response GetServiceType401JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId WebhookSubscriptionIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, webhookSubscriptionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetServiceType403JSONResponse struct{ ForbiddenJSONResponse }
+// TestWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) TestWebhookSubscription(w http.ResponseWriter, r *http.Request) {
 
-func (response GetServiceType403JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "webhookSubscriptionId" -------------
+	var webhookSubscriptionId WebhookSubscriptionIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookSubscriptionId", chi.URLParam(r, "webhookSubscriptionId"), &webhookSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookSubscriptionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestWebhookSubscription(w, r, webhookSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetServiceType404JSONResponse struct{ NotFoundJSONResponse }
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
 
-func (response GetServiceType404JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetServiceType500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-func (response GetServiceType500JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List catalog item instances
-	// (GET /catalog-item-instances)
-	ListCatalogItemInstances(ctx context.Context, request ListCatalogItemInstancesRequestObject) (ListCatalogItemInstancesResponseObject, error)
-	// Create a catalog item instance
-	// (POST /catalog-item-instances)
-	CreateCatalogItemInstance(ctx context.Context, request CreateCatalogItemInstanceRequestObject) (CreateCatalogItemInstanceResponseObject, error)
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit-events", wrapper.ListAuditEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances", wrapper.ListCatalogItemInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-item-instances", wrapper.CreateCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.DeleteCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.GetCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}:convert", wrapper.ConvertCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-item-instances:batchCreate", wrapper.BatchCreateCatalogItemInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances:batchGet", wrapper.BatchGetCatalogItemInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances:count", wrapper.CountCatalogItemInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances:watch", wrapper.WatchCatalogItemInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items", wrapper.ListCatalogItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items", wrapper.CreateCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.DeleteCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.GetCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.UpdateCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}/revisions", wrapper.ListCatalogItemRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items/{catalogItemId}:apply", wrapper.ApplyCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items/{catalogItemId}:convert", wrapper.ConvertCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}:form", wrapper.GetCatalogItemForm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items/{catalogItemId}:rollback", wrapper.RollbackCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items:batchGet", wrapper.BatchGetCatalogItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/operations", wrapper.ListOperations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/operations/{operationId}", wrapper.GetOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/operations/{operationId}:cancel", wrapper.CancelOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/operations/{operationId}:wait", wrapper.WaitOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quotas", wrapper.ListQuotas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/quotas", wrapper.CreateQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/quotas/{quotaId}", wrapper.DeleteQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/quotas/{quotaId}", wrapper.GetQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/service-types", wrapper.ListServiceTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/service-types", wrapper.CreateServiceType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/service-types/{serviceTypeId}", wrapper.GetServiceType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/service-types/{serviceTypeId}", wrapper.UpdateServiceType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/service-types/{serviceTypeId}:apply", wrapper.ApplyServiceType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/usage", wrapper.GetUsage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions", wrapper.ListWebhookSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook-subscriptions", wrapper.CreateWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}", wrapper.DeleteWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}", wrapper.GetWebhookSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhook-subscriptions/{webhookSubscriptionId}:test", wrapper.TestWebhookSubscription)
+	})
+
+	return r
+}
+
+type AlreadyExistsJSONResponse Error
+
+type BadRequestJSONResponse Error
+
+type ForbiddenJSONResponse Error
+
+type HasInstancesJSONResponse Error
+
+type InternalServerErrorJSONResponse Error
+
+type NotCancellableJSONResponse Error
+
+type NotFoundJSONResponse Error
+
+type ResourceExhaustedJSONResponse Error
+
+type ResumeTokenExpiredJSONResponse Error
+
+type UnauthorizedJSONResponse Error
+
+type ListAuditEventsRequestObject struct {
+	Params ListAuditEventsParams
+}
+
+type ListAuditEventsResponseObject interface {
+	VisitListAuditEventsResponse(w http.ResponseWriter) error
+}
+
+type ListAuditEvents200JSONResponse AuditEventList
+
+func (response ListAuditEvents200JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListAuditEvents400JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListAuditEvents401JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListAuditEvents403JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEvents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListAuditEvents500JSONResponse) VisitListAuditEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstancesRequestObject struct {
+	Params ListCatalogItemInstancesParams
+}
+
+type ListCatalogItemInstancesResponseObject interface {
+	VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error
+}
+
+type ListCatalogItemInstances200JSONResponse CatalogItemInstanceList
+
+func (response ListCatalogItemInstances200JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstances400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListCatalogItemInstances400JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCatalogItemInstances401JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListCatalogItemInstances403JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstances500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListCatalogItemInstances500JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstanceRequestObject struct {
+	Params CreateCatalogItemInstanceParams
+	Body   *CreateCatalogItemInstanceJSONRequestBody
+}
+
+type CreateCatalogItemInstanceResponseObject interface {
+	VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type CreateCatalogItemInstance202JSONResponse Operation
+
+func (response CreateCatalogItemInstance202JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance400JSONResponse Error
+
+func (response CreateCatalogItemInstance400JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCatalogItemInstance401JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateCatalogItemInstance403JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateCatalogItemInstance409JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance429JSONResponse struct{ ResourceExhaustedJSONResponse }
+
+func (response CreateCatalogItemInstance429JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateCatalogItemInstance500JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+}
+
+type DeleteCatalogItemInstanceResponseObject interface {
+	VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type DeleteCatalogItemInstance202JSONResponse Operation
+
+func (response DeleteCatalogItemInstance202JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteCatalogItemInstance401JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteCatalogItemInstance403JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCatalogItemInstance404JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteCatalogItemInstance500JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+	Params                GetCatalogItemInstanceParams
+}
+
+type GetCatalogItemInstanceResponseObject interface {
+	VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogItemInstance200JSONResponse CatalogItemInstance
+
+func (response GetCatalogItemInstance200JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCatalogItemInstance400JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCatalogItemInstance401JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCatalogItemInstance403JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCatalogItemInstance404JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCatalogItemInstance500JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+	Body                  *ConvertCatalogItemInstanceJSONRequestBody
+}
+
+type ConvertCatalogItemInstanceResponseObject interface {
+	VisitConvertCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type ConvertCatalogItemInstance200JSONResponse CatalogItemInstanceConversion
+
+func (response ConvertCatalogItemInstance200JSONResponse) VisitConvertCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItemInstance400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConvertCatalogItemInstance400JSONResponse) VisitConvertCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ConvertCatalogItemInstance401JSONResponse) VisitConvertCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ConvertCatalogItemInstance403JSONResponse) VisitConvertCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ConvertCatalogItemInstance404JSONResponse) VisitConvertCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ConvertCatalogItemInstance500JSONResponse) VisitConvertCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateCatalogItemInstancesRequestObject struct {
+	Params BatchCreateCatalogItemInstancesParams
+	Body   *BatchCreateCatalogItemInstancesJSONRequestBody
+}
+
+type BatchCreateCatalogItemInstancesResponseObject interface {
+	VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error
+}
+
+type BatchCreateCatalogItemInstances202JSONResponse BatchCreateCatalogItemInstancesResult
+
+func (response BatchCreateCatalogItemInstances202JSONResponse) VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateCatalogItemInstances400JSONResponse Error
+
+func (response BatchCreateCatalogItemInstances400JSONResponse) VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response BatchCreateCatalogItemInstances401JSONResponse) VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response BatchCreateCatalogItemInstances403JSONResponse) VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateCatalogItemInstances409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response BatchCreateCatalogItemInstances409JSONResponse) VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateCatalogItemInstances429JSONResponse struct{ ResourceExhaustedJSONResponse }
+
+func (response BatchCreateCatalogItemInstances429JSONResponse) VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchCreateCatalogItemInstances500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response BatchCreateCatalogItemInstances500JSONResponse) VisitBatchCreateCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItemInstancesRequestObject struct {
+	Params BatchGetCatalogItemInstancesParams
+}
+
+type BatchGetCatalogItemInstancesResponseObject interface {
+	VisitBatchGetCatalogItemInstancesResponse(w http.ResponseWriter) error
+}
+
+type BatchGetCatalogItemInstances200JSONResponse BatchGetCatalogItemInstancesResult
+
+func (response BatchGetCatalogItemInstances200JSONResponse) VisitBatchGetCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItemInstances400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BatchGetCatalogItemInstances400JSONResponse) VisitBatchGetCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response BatchGetCatalogItemInstances401JSONResponse) VisitBatchGetCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response BatchGetCatalogItemInstances403JSONResponse) VisitBatchGetCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItemInstances404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response BatchGetCatalogItemInstances404JSONResponse) VisitBatchGetCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItemInstances500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response BatchGetCatalogItemInstances500JSONResponse) VisitBatchGetCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CountCatalogItemInstancesRequestObject struct {
+	Params CountCatalogItemInstancesParams
+}
+
+type CountCatalogItemInstancesResponseObject interface {
+	VisitCountCatalogItemInstancesResponse(w http.ResponseWriter) error
+}
+
+type CountCatalogItemInstances200JSONResponse CatalogItemInstanceCounts
+
+func (response CountCatalogItemInstances200JSONResponse) VisitCountCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CountCatalogItemInstances400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CountCatalogItemInstances400JSONResponse) VisitCountCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CountCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CountCatalogItemInstances401JSONResponse) VisitCountCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CountCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CountCatalogItemInstances403JSONResponse) VisitCountCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CountCatalogItemInstances500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CountCatalogItemInstances500JSONResponse) VisitCountCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchCatalogItemInstancesRequestObject struct {
+	Params WatchCatalogItemInstancesParams
+}
+
+type WatchCatalogItemInstancesResponseObject interface {
+	VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error
+}
+
+type WatchCatalogItemInstances200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response WatchCatalogItemInstances200TexteventStreamResponse) VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type WatchCatalogItemInstances400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response WatchCatalogItemInstances400JSONResponse) VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response WatchCatalogItemInstances401JSONResponse) VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response WatchCatalogItemInstances403JSONResponse) VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchCatalogItemInstances410JSONResponse struct{ ResumeTokenExpiredJSONResponse }
+
+func (response WatchCatalogItemInstances410JSONResponse) VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchCatalogItemInstances500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response WatchCatalogItemInstances500JSONResponse) VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemsRequestObject struct {
+	Params ListCatalogItemsParams
+}
+
+type ListCatalogItemsResponseObject interface {
+	VisitListCatalogItemsResponse(w http.ResponseWriter) error
+}
+
+type ListCatalogItems200JSONResponse CatalogItemList
+
+func (response ListCatalogItems200JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItems400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListCatalogItems400JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItems401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCatalogItems401JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItems403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListCatalogItems403JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItems500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListCatalogItems500JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemRequestObject struct {
+	Params CreateCatalogItemParams
+	Body   *CreateCatalogItemJSONRequestBody
+}
+
+type CreateCatalogItemResponseObject interface {
+	VisitCreateCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type CreateCatalogItem201JSONResponse CatalogItem
+
+func (response CreateCatalogItem201JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem400JSONResponse Error
+
+func (response CreateCatalogItem400JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCatalogItem401JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateCatalogItem403JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateCatalogItem409JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateCatalogItem500JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+}
+
+type DeleteCatalogItemResponseObject interface {
+	VisitDeleteCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type DeleteCatalogItem204Response struct {
+}
+
+func (response DeleteCatalogItem204Response) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteCatalogItem401JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteCatalogItem403JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCatalogItem404JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem409JSONResponse struct{ HasInstancesJSONResponse }
+
+func (response DeleteCatalogItem409JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteCatalogItem500JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Params        GetCatalogItemParams
+}
+
+type GetCatalogItemResponseObject interface {
+	VisitGetCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogItem200JSONResponse CatalogItem
+
+func (response GetCatalogItem200JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCatalogItem400JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCatalogItem401JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCatalogItem403JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCatalogItem404JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCatalogItem500JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Body          *UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateCatalogItemResponseObject interface {
+	VisitUpdateCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type UpdateCatalogItem200JSONResponse CatalogItem
+
+func (response UpdateCatalogItem200JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem400JSONResponse Error
+
+func (response UpdateCatalogItem400JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateCatalogItem401JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateCatalogItem403JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateCatalogItem404JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateCatalogItem500JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemRevisionsRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Params        ListCatalogItemRevisionsParams
+}
+
+type ListCatalogItemRevisionsResponseObject interface {
+	VisitListCatalogItemRevisionsResponse(w http.ResponseWriter) error
+}
+
+type ListCatalogItemRevisions200JSONResponse CatalogItemRevisionList
+
+func (response ListCatalogItemRevisions200JSONResponse) VisitListCatalogItemRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemRevisions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCatalogItemRevisions401JSONResponse) VisitListCatalogItemRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemRevisions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListCatalogItemRevisions403JSONResponse) VisitListCatalogItemRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemRevisions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListCatalogItemRevisions404JSONResponse) VisitListCatalogItemRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemRevisions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListCatalogItemRevisions500JSONResponse) VisitListCatalogItemRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Params        ApplyCatalogItemParams
+	Body          *ApplyCatalogItemJSONRequestBody
+}
+
+type ApplyCatalogItemResponseObject interface {
+	VisitApplyCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type ApplyCatalogItem200JSONResponse CatalogItemApplyResult
+
+func (response ApplyCatalogItem200JSONResponse) VisitApplyCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCatalogItem400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApplyCatalogItem400JSONResponse) VisitApplyCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApplyCatalogItem401JSONResponse) VisitApplyCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ApplyCatalogItem403JSONResponse) VisitApplyCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCatalogItem409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response ApplyCatalogItem409JSONResponse) VisitApplyCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApplyCatalogItem500JSONResponse) VisitApplyCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Body          *ConvertCatalogItemJSONRequestBody
+}
+
+type ConvertCatalogItemResponseObject interface {
+	VisitConvertCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type ConvertCatalogItem200JSONResponse CatalogItemConversion
+
+func (response ConvertCatalogItem200JSONResponse) VisitConvertCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItem400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConvertCatalogItem400JSONResponse) VisitConvertCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ConvertCatalogItem401JSONResponse) VisitConvertCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ConvertCatalogItem403JSONResponse) VisitConvertCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ConvertCatalogItem404JSONResponse) VisitConvertCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ConvertCatalogItem500JSONResponse) VisitConvertCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemFormRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+}
+
+type GetCatalogItemFormResponseObject interface {
+	VisitGetCatalogItemFormResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogItemForm200JSONResponse CatalogItemForm
+
+func (response GetCatalogItemForm200JSONResponse) VisitGetCatalogItemFormResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemForm401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCatalogItemForm401JSONResponse) VisitGetCatalogItemFormResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemForm403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCatalogItemForm403JSONResponse) VisitGetCatalogItemFormResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemForm404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCatalogItemForm404JSONResponse) VisitGetCatalogItemFormResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemForm500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCatalogItemForm500JSONResponse) VisitGetCatalogItemFormResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Body          *RollbackCatalogItemJSONRequestBody
+}
+
+type RollbackCatalogItemResponseObject interface {
+	VisitRollbackCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type RollbackCatalogItem200JSONResponse CatalogItem
+
+func (response RollbackCatalogItem200JSONResponse) VisitRollbackCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackCatalogItem400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RollbackCatalogItem400JSONResponse) VisitRollbackCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RollbackCatalogItem401JSONResponse) VisitRollbackCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RollbackCatalogItem403JSONResponse) VisitRollbackCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RollbackCatalogItem404JSONResponse) VisitRollbackCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RollbackCatalogItem500JSONResponse) VisitRollbackCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItemsRequestObject struct {
+	Params BatchGetCatalogItemsParams
+}
+
+type BatchGetCatalogItemsResponseObject interface {
+	VisitBatchGetCatalogItemsResponse(w http.ResponseWriter) error
+}
+
+type BatchGetCatalogItems200JSONResponse BatchGetCatalogItemsResult
+
+func (response BatchGetCatalogItems200JSONResponse) VisitBatchGetCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItems400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BatchGetCatalogItems400JSONResponse) VisitBatchGetCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItems401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response BatchGetCatalogItems401JSONResponse) VisitBatchGetCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItems403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response BatchGetCatalogItems403JSONResponse) VisitBatchGetCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItems404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response BatchGetCatalogItems404JSONResponse) VisitBatchGetCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchGetCatalogItems500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response BatchGetCatalogItems500JSONResponse) VisitBatchGetCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse Health
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperationsRequestObject struct {
+	Params ListOperationsParams
+}
+
+type ListOperationsResponseObject interface {
+	VisitListOperationsResponse(w http.ResponseWriter) error
+}
+
+type ListOperations200JSONResponse OperationList
+
+func (response ListOperations200JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperations400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListOperations400JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperations401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOperations401JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperations403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListOperations403JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOperations500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListOperations500JSONResponse) VisitListOperationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperationRequestObject struct {
+	OperationId OperationIdPath `json:"operationId"`
+}
+
+type GetOperationResponseObject interface {
+	VisitGetOperationResponse(w http.ResponseWriter) error
+}
+
+type GetOperation200JSONResponse Operation
+
+func (response GetOperation200JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOperation401JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetOperation403JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOperation404JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOperation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetOperation500JSONResponse) VisitGetOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperationRequestObject struct {
+	OperationId OperationIdPath `json:"operationId"`
+}
+
+type CancelOperationResponseObject interface {
+	VisitCancelOperationResponse(w http.ResponseWriter) error
+}
+
+type CancelOperation200JSONResponse Operation
+
+func (response CancelOperation200JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CancelOperation401JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CancelOperation403JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelOperation404JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation409JSONResponse struct{ NotCancellableJSONResponse }
+
+func (response CancelOperation409JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelOperation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CancelOperation500JSONResponse) VisitCancelOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaitOperationRequestObject struct {
+	OperationId OperationIdPath `json:"operationId"`
+	Params      WaitOperationParams
+}
+
+type WaitOperationResponseObject interface {
+	VisitWaitOperationResponse(w http.ResponseWriter) error
+}
+
+type WaitOperation200JSONResponse Operation
+
+func (response WaitOperation200JSONResponse) VisitWaitOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaitOperation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response WaitOperation400JSONResponse) VisitWaitOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaitOperation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response WaitOperation401JSONResponse) VisitWaitOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaitOperation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response WaitOperation403JSONResponse) VisitWaitOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaitOperation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response WaitOperation404JSONResponse) VisitWaitOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaitOperation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response WaitOperation500JSONResponse) VisitWaitOperationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotasRequestObject struct {
+	Params ListQuotasParams
+}
+
+type ListQuotasResponseObject interface {
+	VisitListQuotasResponse(w http.ResponseWriter) error
+}
+
+type ListQuotas200JSONResponse QuotaList
+
+func (response ListQuotas200JSONResponse) VisitListQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotas400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListQuotas400JSONResponse) VisitListQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotas401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListQuotas401JSONResponse) VisitListQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotas403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListQuotas403JSONResponse) VisitListQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListQuotas500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListQuotas500JSONResponse) VisitListQuotasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuotaRequestObject struct {
+	Params CreateQuotaParams
+	Body   *CreateQuotaJSONRequestBody
+}
+
+type CreateQuotaResponseObject interface {
+	VisitCreateQuotaResponse(w http.ResponseWriter) error
+}
+
+type CreateQuota201JSONResponse Quota
+
+func (response CreateQuota201JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateQuota400JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateQuota401JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateQuota403JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateQuota409JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateQuota500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateQuota500JSONResponse) VisitCreateQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuotaRequestObject struct {
+	QuotaId QuotaIdPath `json:"quotaId"`
+}
+
+type DeleteQuotaResponseObject interface {
+	VisitDeleteQuotaResponse(w http.ResponseWriter) error
+}
+
+type DeleteQuota204Response struct {
+}
+
+func (response DeleteQuota204Response) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteQuota401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteQuota401JSONResponse) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuota403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteQuota403JSONResponse) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuota404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteQuota404JSONResponse) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteQuota500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteQuota500JSONResponse) VisitDeleteQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuotaRequestObject struct {
+	QuotaId QuotaIdPath `json:"quotaId"`
+}
+
+type GetQuotaResponseObject interface {
+	VisitGetQuotaResponse(w http.ResponseWriter) error
+}
+
+type GetQuota200JSONResponse Quota
+
+func (response GetQuota200JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuota401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetQuota401JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuota403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetQuota403JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuota404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetQuota404JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetQuota500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetQuota500JSONResponse) VisitGetQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceTypesRequestObject struct {
+	Params ListServiceTypesParams
+}
+
+type ListServiceTypesResponseObject interface {
+	VisitListServiceTypesResponse(w http.ResponseWriter) error
+}
+
+type ListServiceTypes200JSONResponse ServiceTypeList
+
+func (response ListServiceTypes200JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceTypes400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListServiceTypes400JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceTypes401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListServiceTypes401JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceTypes403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListServiceTypes403JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceTypes500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListServiceTypes500JSONResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceTypeRequestObject struct {
+	Params CreateServiceTypeParams
+	Body   *CreateServiceTypeJSONRequestBody
+}
+
+type CreateServiceTypeResponseObject interface {
+	VisitCreateServiceTypeResponse(w http.ResponseWriter) error
+}
+
+type CreateServiceType201JSONResponse ServiceType
+
+func (response CreateServiceType201JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceType400JSONResponse Error
+
+func (response CreateServiceType400JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceType401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateServiceType401JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceType403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateServiceType403JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceType409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateServiceType409JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceType500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateServiceType500JSONResponse) VisitCreateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceTypeRequestObject struct {
+	ServiceTypeId ServiceTypeIdPath `json:"serviceTypeId"`
+	Params        GetServiceTypeParams
+}
+
+type GetServiceTypeResponseObject interface {
+	VisitGetServiceTypeResponse(w http.ResponseWriter) error
+}
+
+type GetServiceType200JSONResponse ServiceType
+
+func (response GetServiceType200JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceType400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetServiceType400JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceType401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetServiceType401JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceType403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetServiceType403JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceType404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetServiceType404JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServiceType500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetServiceType500JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServiceTypeRequestObject struct {
+	ServiceTypeId ServiceTypeIdPath `json:"serviceTypeId"`
+	Body          *UpdateServiceTypeApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateServiceTypeResponseObject interface {
+	VisitUpdateServiceTypeResponse(w http.ResponseWriter) error
+}
+
+type UpdateServiceType200JSONResponse ServiceType
+
+func (response UpdateServiceType200JSONResponse) VisitUpdateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServiceType400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateServiceType400JSONResponse) VisitUpdateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServiceType401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateServiceType401JSONResponse) VisitUpdateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServiceType403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateServiceType403JSONResponse) VisitUpdateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServiceType404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateServiceType404JSONResponse) VisitUpdateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateServiceType500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateServiceType500JSONResponse) VisitUpdateServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyServiceTypeRequestObject struct {
+	ServiceTypeId ServiceTypeIdPath `json:"serviceTypeId"`
+	Params        ApplyServiceTypeParams
+	Body          *ApplyServiceTypeJSONRequestBody
+}
+
+type ApplyServiceTypeResponseObject interface {
+	VisitApplyServiceTypeResponse(w http.ResponseWriter) error
+}
+
+type ApplyServiceType200JSONResponse ServiceTypeApplyResult
+
+func (response ApplyServiceType200JSONResponse) VisitApplyServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyServiceType400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApplyServiceType400JSONResponse) VisitApplyServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyServiceType401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApplyServiceType401JSONResponse) VisitApplyServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyServiceType403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ApplyServiceType403JSONResponse) VisitApplyServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyServiceType409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response ApplyServiceType409JSONResponse) VisitApplyServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyServiceType500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApplyServiceType500JSONResponse) VisitApplyServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsageRequestObject struct {
+}
+
+type GetUsageResponseObject interface {
+	VisitGetUsageResponse(w http.ResponseWriter) error
+}
+
+type GetUsage200JSONResponse Usage
+
+func (response GetUsage200JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsage401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUsage401JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsage403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetUsage403JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsage500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetUsage500JSONResponse) VisitGetUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptionsRequestObject struct {
+	Params ListWebhookSubscriptionsParams
+}
+
+type ListWebhookSubscriptionsResponseObject interface {
+	VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookSubscriptions200JSONResponse WebhookSubscriptionList
+
+func (response ListWebhookSubscriptions200JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListWebhookSubscriptions400JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhookSubscriptions401JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListWebhookSubscriptions403JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookSubscriptions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhookSubscriptions500JSONResponse) VisitListWebhookSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscriptionRequestObject struct {
+	Params CreateWebhookSubscriptionParams
+	Body   *CreateWebhookSubscriptionJSONRequestBody
+}
+
+type CreateWebhookSubscriptionResponseObject interface {
+	VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhookSubscription201JSONResponse WebhookSubscription
+
+func (response CreateWebhookSubscription201JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateWebhookSubscription400JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateWebhookSubscription401JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateWebhookSubscription403JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateWebhookSubscription409JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookSubscription500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateWebhookSubscription500JSONResponse) VisitCreateWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookSubscriptionRequestObject struct {
+	WebhookSubscriptionId WebhookSubscriptionIdPath `json:"webhookSubscriptionId"`
+}
+
+type DeleteWebhookSubscriptionResponseObject interface {
+	VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhookSubscription204Response struct {
+}
+
+func (response DeleteWebhookSubscription204Response) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhookSubscription401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteWebhookSubscription401JSONResponse) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookSubscription403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteWebhookSubscription403JSONResponse) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookSubscription404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteWebhookSubscription404JSONResponse) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookSubscription500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteWebhookSubscription500JSONResponse) VisitDeleteWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscriptionRequestObject struct {
+	WebhookSubscriptionId WebhookSubscriptionIdPath `json:"webhookSubscriptionId"`
+}
+
+type GetWebhookSubscriptionResponseObject interface {
+	VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookSubscription200JSONResponse WebhookSubscription
+
+func (response GetWebhookSubscription200JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscription401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetWebhookSubscription401JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscription403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetWebhookSubscription403JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscription404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetWebhookSubscription404JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookSubscription500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetWebhookSubscription500JSONResponse) VisitGetWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	WebhookSubscriptionId WebhookSubscriptionIdPath `json:"webhookSubscriptionId"`
+	Params                ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveryList
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWebhookSubscriptionRequestObject struct {
+	WebhookSubscriptionId WebhookSubscriptionIdPath `json:"webhookSubscriptionId"`
+}
+
+type TestWebhookSubscriptionResponseObject interface {
+	VisitTestWebhookSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type TestWebhookSubscription200JSONResponse WebhookDelivery
+
+func (response TestWebhookSubscription200JSONResponse) VisitTestWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWebhookSubscription401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response TestWebhookSubscription401JSONResponse) VisitTestWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWebhookSubscription403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response TestWebhookSubscription403JSONResponse) VisitTestWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWebhookSubscription404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response TestWebhookSubscription404JSONResponse) VisitTestWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestWebhookSubscription500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response TestWebhookSubscription500JSONResponse) VisitTestWebhookSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List audit events
+	// (GET /audit-events)
+	ListAuditEvents(ctx context.Context, request ListAuditEventsRequestObject) (ListAuditEventsResponseObject, error)
+	// List catalog item instances
+	// (GET /catalog-item-instances)
+	ListCatalogItemInstances(ctx context.Context, request ListCatalogItemInstancesRequestObject) (ListCatalogItemInstancesResponseObject, error)
+	// Create a catalog item instance
+	// (POST /catalog-item-instances)
+	CreateCatalogItemInstance(ctx context.Context, request CreateCatalogItemInstanceRequestObject) (CreateCatalogItemInstanceResponseObject, error)
 	// Delete a catalog item instance
 	// (DELETE /catalog-item-instances/{catalogItemInstanceId})
 	DeleteCatalogItemInstance(ctx context.Context, request DeleteCatalogItemInstanceRequestObject) (DeleteCatalogItemInstanceResponseObject, error)
 	// Get a catalog item instance
 	// (GET /catalog-item-instances/{catalogItemInstanceId})
 	GetCatalogItemInstance(ctx context.Context, request GetCatalogItemInstanceRequestObject) (GetCatalogItemInstanceResponseObject, error)
+	// Preview the conversion of a catalog item instance
+	// (POST /catalog-item-instances/{catalogItemInstanceId}:convert)
+	ConvertCatalogItemInstance(ctx context.Context, request ConvertCatalogItemInstanceRequestObject) (ConvertCatalogItemInstanceResponseObject, error)
+	// Create catalog item instances in bulk
+	// (POST /catalog-item-instances:batchCreate)
+	BatchCreateCatalogItemInstances(ctx context.Context, request BatchCreateCatalogItemInstancesRequestObject) (BatchCreateCatalogItemInstancesResponseObject, error)
+	// Get catalog item instances in bulk
+	// (GET /catalog-item-instances:batchGet)
+	BatchGetCatalogItemInstances(ctx context.Context, request BatchGetCatalogItemInstancesRequestObject) (BatchGetCatalogItemInstancesResponseObject, error)
+	// Count catalog item instances
+	// (GET /catalog-item-instances:count)
+	CountCatalogItemInstances(ctx context.Context, request CountCatalogItemInstancesRequestObject) (CountCatalogItemInstancesResponseObject, error)
+	// Watch catalog item instances
+	// (GET /catalog-item-instances:watch)
+	WatchCatalogItemInstances(ctx context.Context, request WatchCatalogItemInstancesRequestObject) (WatchCatalogItemInstancesResponseObject, error)
 	// List catalog items
 	// (GET /catalog-items)
 	ListCatalogItems(ctx context.Context, request ListCatalogItemsRequestObject) (ListCatalogItemsResponseObject, error)
@@ -1419,9 +4872,51 @@ type StrictServerInterface interface {
 	// Update a catalog item
 	// (PATCH /catalog-items/{catalogItemId})
 	UpdateCatalogItem(ctx context.Context, request UpdateCatalogItemRequestObject) (UpdateCatalogItemResponseObject, error)
+	// List catalog item revisions
+	// (GET /catalog-items/{catalogItemId}/revisions)
+	ListCatalogItemRevisions(ctx context.Context, request ListCatalogItemRevisionsRequestObject) (ListCatalogItemRevisionsResponseObject, error)
+	// Apply a catalog item manifest
+	// (POST /catalog-items/{catalogItemId}:apply)
+	ApplyCatalogItem(ctx context.Context, request ApplyCatalogItemRequestObject) (ApplyCatalogItemResponseObject, error)
+	// Preview the conversion of a catalog item
+	// (POST /catalog-items/{catalogItemId}:convert)
+	ConvertCatalogItem(ctx context.Context, request ConvertCatalogItemRequestObject) (ConvertCatalogItemResponseObject, error)
+	// Get the form of a catalog item
+	// (GET /catalog-items/{catalogItemId}:form)
+	GetCatalogItemForm(ctx context.Context, request GetCatalogItemFormRequestObject) (GetCatalogItemFormResponseObject, error)
+	// Roll back a catalog item
+	// (POST /catalog-items/{catalogItemId}:rollback)
+	RollbackCatalogItem(ctx context.Context, request RollbackCatalogItemRequestObject) (RollbackCatalogItemResponseObject, error)
+	// Get catalog items in bulk
+	// (GET /catalog-items:batchGet)
+	BatchGetCatalogItems(ctx context.Context, request BatchGetCatalogItemsRequestObject) (BatchGetCatalogItemsResponseObject, error)
 	// Health check
 	// (GET /health)
 	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// List operations
+	// (GET /operations)
+	ListOperations(ctx context.Context, request ListOperationsRequestObject) (ListOperationsResponseObject, error)
+	// Get an operation
+	// (GET /operations/{operationId})
+	GetOperation(ctx context.Context, request GetOperationRequestObject) (GetOperationResponseObject, error)
+	// Cancel an operation
+	// (POST /operations/{operationId}:cancel)
+	CancelOperation(ctx context.Context, request CancelOperationRequestObject) (CancelOperationResponseObject, error)
+	// Wait for an operation
+	// (POST /operations/{operationId}:wait)
+	WaitOperation(ctx context.Context, request WaitOperationRequestObject) (WaitOperationResponseObject, error)
+	// List quotas
+	// (GET /quotas)
+	ListQuotas(ctx context.Context, request ListQuotasRequestObject) (ListQuotasResponseObject, error)
+	// Create a quota
+	// (POST /quotas)
+	CreateQuota(ctx context.Context, request CreateQuotaRequestObject) (CreateQuotaResponseObject, error)
+	// Delete a quota
+	// (DELETE /quotas/{quotaId})
+	DeleteQuota(ctx context.Context, request DeleteQuotaRequestObject) (DeleteQuotaResponseObject, error)
+	// Get a quota
+	// (GET /quotas/{quotaId})
+	GetQuota(ctx context.Context, request GetQuotaRequestObject) (GetQuotaResponseObject, error)
 	// List service types
 	// (GET /service-types)
 	ListServiceTypes(ctx context.Context, request ListServiceTypesRequestObject) (ListServiceTypesResponseObject, error)
@@ -1431,56 +4926,818 @@ type StrictServerInterface interface {
 	// Get a service type
 	// (GET /service-types/{serviceTypeId})
 	GetServiceType(ctx context.Context, request GetServiceTypeRequestObject) (GetServiceTypeResponseObject, error)
+	// Update a service type
+	// (PATCH /service-types/{serviceTypeId})
+	UpdateServiceType(ctx context.Context, request UpdateServiceTypeRequestObject) (UpdateServiceTypeResponseObject, error)
+	// Apply a service type manifest
+	// (POST /service-types/{serviceTypeId}:apply)
+	ApplyServiceType(ctx context.Context, request ApplyServiceTypeRequestObject) (ApplyServiceTypeResponseObject, error)
+	// Get resource usage
+	// (GET /usage)
+	GetUsage(ctx context.Context, request GetUsageRequestObject) (GetUsageResponseObject, error)
+	// List webhook subscriptions
+	// (GET /webhook-subscriptions)
+	ListWebhookSubscriptions(ctx context.Context, request ListWebhookSubscriptionsRequestObject) (ListWebhookSubscriptionsResponseObject, error)
+	// Create a webhook subscription
+	// (POST /webhook-subscriptions)
+	CreateWebhookSubscription(ctx context.Context, request CreateWebhookSubscriptionRequestObject) (CreateWebhookSubscriptionResponseObject, error)
+	// Delete a webhook subscription
+	// (DELETE /webhook-subscriptions/{webhookSubscriptionId})
+	DeleteWebhookSubscription(ctx context.Context, request DeleteWebhookSubscriptionRequestObject) (DeleteWebhookSubscriptionResponseObject, error)
+	// Get a webhook subscription
+	// (GET /webhook-subscriptions/{webhookSubscriptionId})
+	GetWebhookSubscription(ctx context.Context, request GetWebhookSubscriptionRequestObject) (GetWebhookSubscriptionResponseObject, error)
+	// List webhook deliveries
+	// (GET /webhook-subscriptions/{webhookSubscriptionId}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// Test a webhook subscription
+	// (POST /webhook-subscriptions/{webhookSubscriptionId}:test)
+	TestWebhookSubscription(ctx context.Context, request TestWebhookSubscriptionRequestObject) (TestWebhookSubscriptionResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAuditEvents operation middleware
+func (sh *strictHandler) ListAuditEvents(w http.ResponseWriter, r *http.Request, params ListAuditEventsParams) {
+	var request ListAuditEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditEvents(ctx, request.(ListAuditEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditEventsResponseObject); ok {
+		if err := validResponse.VisitListAuditEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCatalogItemInstances operation middleware
+func (sh *strictHandler) ListCatalogItemInstances(w http.ResponseWriter, r *http.Request, params ListCatalogItemInstancesParams) {
+	var request ListCatalogItemInstancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCatalogItemInstances(ctx, request.(ListCatalogItemInstancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCatalogItemInstances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCatalogItemInstancesResponseObject); ok {
+		if err := validResponse.VisitListCatalogItemInstancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCatalogItemInstance operation middleware
+func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *http.Request, params CreateCatalogItemInstanceParams) {
+	var request CreateCatalogItemInstanceRequestObject
+
+	request.Params = params
+
+	var body CreateCatalogItemInstanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCatalogItemInstance(ctx, request.(CreateCatalogItemInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCatalogItemInstance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitCreateCatalogItemInstanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCatalogItemInstance operation middleware
+func (sh *strictHandler) DeleteCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	var request DeleteCatalogItemInstanceRequestObject
+
+	request.CatalogItemInstanceId = catalogItemInstanceId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCatalogItemInstance(ctx, request.(DeleteCatalogItemInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCatalogItemInstance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitDeleteCatalogItemInstanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCatalogItemInstance operation middleware
+func (sh *strictHandler) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params GetCatalogItemInstanceParams) {
+	var request GetCatalogItemInstanceRequestObject
+
+	request.CatalogItemInstanceId = catalogItemInstanceId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogItemInstance(ctx, request.(GetCatalogItemInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogItemInstance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitGetCatalogItemInstanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConvertCatalogItemInstance operation middleware
+func (sh *strictHandler) ConvertCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	var request ConvertCatalogItemInstanceRequestObject
+
+	request.CatalogItemInstanceId = catalogItemInstanceId
+
+	var body ConvertCatalogItemInstanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConvertCatalogItemInstance(ctx, request.(ConvertCatalogItemInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConvertCatalogItemInstance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConvertCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitConvertCatalogItemInstanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BatchCreateCatalogItemInstances operation middleware
+func (sh *strictHandler) BatchCreateCatalogItemInstances(w http.ResponseWriter, r *http.Request, params BatchCreateCatalogItemInstancesParams) {
+	var request BatchCreateCatalogItemInstancesRequestObject
+
+	request.Params = params
+
+	var body BatchCreateCatalogItemInstancesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchCreateCatalogItemInstances(ctx, request.(BatchCreateCatalogItemInstancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchCreateCatalogItemInstances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchCreateCatalogItemInstancesResponseObject); ok {
+		if err := validResponse.VisitBatchCreateCatalogItemInstancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BatchGetCatalogItemInstances operation middleware
+func (sh *strictHandler) BatchGetCatalogItemInstances(w http.ResponseWriter, r *http.Request, params BatchGetCatalogItemInstancesParams) {
+	var request BatchGetCatalogItemInstancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchGetCatalogItemInstances(ctx, request.(BatchGetCatalogItemInstancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchGetCatalogItemInstances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchGetCatalogItemInstancesResponseObject); ok {
+		if err := validResponse.VisitBatchGetCatalogItemInstancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CountCatalogItemInstances operation middleware
+func (sh *strictHandler) CountCatalogItemInstances(w http.ResponseWriter, r *http.Request, params CountCatalogItemInstancesParams) {
+	var request CountCatalogItemInstancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CountCatalogItemInstances(ctx, request.(CountCatalogItemInstancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CountCatalogItemInstances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CountCatalogItemInstancesResponseObject); ok {
+		if err := validResponse.VisitCountCatalogItemInstancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WatchCatalogItemInstances operation middleware
+func (sh *strictHandler) WatchCatalogItemInstances(w http.ResponseWriter, r *http.Request, params WatchCatalogItemInstancesParams) {
+	var request WatchCatalogItemInstancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WatchCatalogItemInstances(ctx, request.(WatchCatalogItemInstancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WatchCatalogItemInstances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WatchCatalogItemInstancesResponseObject); ok {
+		if err := validResponse.VisitWatchCatalogItemInstancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCatalogItems operation middleware
+func (sh *strictHandler) ListCatalogItems(w http.ResponseWriter, r *http.Request, params ListCatalogItemsParams) {
+	var request ListCatalogItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCatalogItems(ctx, request.(ListCatalogItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCatalogItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCatalogItemsResponseObject); ok {
+		if err := validResponse.VisitListCatalogItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCatalogItem operation middleware
+func (sh *strictHandler) CreateCatalogItem(w http.ResponseWriter, r *http.Request, params CreateCatalogItemParams) {
+	var request CreateCatalogItemRequestObject
+
+	request.Params = params
+
+	var body CreateCatalogItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCatalogItem(ctx, request.(CreateCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCatalogItemResponseObject); ok {
+		if err := validResponse.VisitCreateCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCatalogItem operation middleware
+func (sh *strictHandler) DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request DeleteCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCatalogItem(ctx, request.(DeleteCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCatalogItemResponseObject); ok {
+		if err := validResponse.VisitDeleteCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCatalogItem operation middleware
+func (sh *strictHandler) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params GetCatalogItemParams) {
+	var request GetCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogItem(ctx, request.(GetCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCatalogItemResponseObject); ok {
+		if err := validResponse.VisitGetCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCatalogItem operation middleware
+func (sh *strictHandler) UpdateCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request UpdateCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	var body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCatalogItem(ctx, request.(UpdateCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCatalogItemResponseObject); ok {
+		if err := validResponse.VisitUpdateCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCatalogItemRevisions operation middleware
+func (sh *strictHandler) ListCatalogItemRevisions(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params ListCatalogItemRevisionsParams) {
+	var request ListCatalogItemRevisionsRequestObject
+
+	request.CatalogItemId = catalogItemId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCatalogItemRevisions(ctx, request.(ListCatalogItemRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCatalogItemRevisions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCatalogItemRevisionsResponseObject); ok {
+		if err := validResponse.VisitListCatalogItemRevisionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyCatalogItem operation middleware
+func (sh *strictHandler) ApplyCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params ApplyCatalogItemParams) {
+	var request ApplyCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+	request.Params = params
+
+	var body ApplyCatalogItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyCatalogItem(ctx, request.(ApplyCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyCatalogItemResponseObject); ok {
+		if err := validResponse.VisitApplyCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConvertCatalogItem operation middleware
+func (sh *strictHandler) ConvertCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request ConvertCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	var body ConvertCatalogItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConvertCatalogItem(ctx, request.(ConvertCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConvertCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConvertCatalogItemResponseObject); ok {
+		if err := validResponse.VisitConvertCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCatalogItemForm operation middleware
+func (sh *strictHandler) GetCatalogItemForm(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request GetCatalogItemFormRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogItemForm(ctx, request.(GetCatalogItemFormRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogItemForm")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCatalogItemFormResponseObject); ok {
+		if err := validResponse.VisitGetCatalogItemFormResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RollbackCatalogItem operation middleware
+func (sh *strictHandler) RollbackCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request RollbackCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	var body RollbackCatalogItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RollbackCatalogItem(ctx, request.(RollbackCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RollbackCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RollbackCatalogItemResponseObject); ok {
+		if err := validResponse.VisitRollbackCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BatchGetCatalogItems operation middleware
+func (sh *strictHandler) BatchGetCatalogItems(w http.ResponseWriter, r *http.Request, params BatchGetCatalogItemsParams) {
+	var request BatchGetCatalogItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchGetCatalogItems(ctx, request.(BatchGetCatalogItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchGetCatalogItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchGetCatalogItemsResponseObject); ok {
+		if err := validResponse.VisitBatchGetCatalogItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOperations operation middleware
+func (sh *strictHandler) ListOperations(w http.ResponseWriter, r *http.Request, params ListOperationsParams) {
+	var request ListOperationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOperations(ctx, request.(ListOperationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOperations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOperationsResponseObject); ok {
+		if err := validResponse.VisitListOperationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetOperation operation middleware
+func (sh *strictHandler) GetOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath) {
+	var request GetOperationRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.OperationId = operationId
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOperation(ctx, request.(GetOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOperationResponseObject); ok {
+		if err := validResponse.VisitGetOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// CancelOperation operation middleware
+func (sh *strictHandler) CancelOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath) {
+	var request CancelOperationRequestObject
+
+	request.OperationId = operationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelOperation(ctx, request.(CancelOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelOperationResponseObject); ok {
+		if err := validResponse.VisitCancelOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// WaitOperation operation middleware
+func (sh *strictHandler) WaitOperation(w http.ResponseWriter, r *http.Request, operationId OperationIdPath, params WaitOperationParams) {
+	var request WaitOperationRequestObject
+
+	request.OperationId = operationId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WaitOperation(ctx, request.(WaitOperationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WaitOperation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WaitOperationResponseObject); ok {
+		if err := validResponse.VisitWaitOperationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListCatalogItemInstances operation middleware
-func (sh *strictHandler) ListCatalogItemInstances(w http.ResponseWriter, r *http.Request, params ListCatalogItemInstancesParams) {
-	var request ListCatalogItemInstancesRequestObject
+// ListQuotas operation middleware
+func (sh *strictHandler) ListQuotas(w http.ResponseWriter, r *http.Request, params ListQuotasParams) {
+	var request ListQuotasRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCatalogItemInstances(ctx, request.(ListCatalogItemInstancesRequestObject))
+		return sh.ssi.ListQuotas(ctx, request.(ListQuotasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCatalogItemInstances")
+		handler = middleware(handler, "ListQuotas")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCatalogItemInstancesResponseObject); ok {
-		if err := validResponse.VisitListCatalogItemInstancesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListQuotasResponseObject); ok {
+		if err := validResponse.VisitListQuotasResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1488,13 +5745,13 @@ func (sh *strictHandler) ListCatalogItemInstances(w http.ResponseWriter, r *http
 	}
 }
 
-// CreateCatalogItemInstance operation middleware
-func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *http.Request, params CreateCatalogItemInstanceParams) {
-	var request CreateCatalogItemInstanceRequestObject
+// CreateQuota operation middleware
+func (sh *strictHandler) CreateQuota(w http.ResponseWriter, r *http.Request, params CreateQuotaParams) {
+	var request CreateQuotaRequestObject
 
 	request.Params = params
 
-	var body CreateCatalogItemInstanceJSONRequestBody
+	var body CreateQuotaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1502,18 +5759,18 @@ func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *htt
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCatalogItemInstance(ctx, request.(CreateCatalogItemInstanceRequestObject))
+		return sh.ssi.CreateQuota(ctx, request.(CreateQuotaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCatalogItemInstance")
+		handler = middleware(handler, "CreateQuota")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCatalogItemInstanceResponseObject); ok {
-		if err := validResponse.VisitCreateCatalogItemInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateQuotaResponseObject); ok {
+		if err := validResponse.VisitCreateQuotaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1521,25 +5778,25 @@ func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *htt
 	}
 }
 
-// DeleteCatalogItemInstance operation middleware
-func (sh *strictHandler) DeleteCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
-	var request DeleteCatalogItemInstanceRequestObject
+// DeleteQuota operation middleware
+func (sh *strictHandler) DeleteQuota(w http.ResponseWriter, r *http.Request, quotaId QuotaIdPath) {
+	var request DeleteQuotaRequestObject
 
-	request.CatalogItemInstanceId = catalogItemInstanceId
+	request.QuotaId = quotaId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCatalogItemInstance(ctx, request.(DeleteCatalogItemInstanceRequestObject))
+		return sh.ssi.DeleteQuota(ctx, request.(DeleteQuotaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCatalogItemInstance")
+		handler = middleware(handler, "DeleteQuota")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCatalogItemInstanceResponseObject); ok {
-		if err := validResponse.VisitDeleteCatalogItemInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteQuotaResponseObject); ok {
+		if err := validResponse.VisitDeleteQuotaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1547,25 +5804,25 @@ func (sh *strictHandler) DeleteCatalogItemInstance(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetCatalogItemInstance operation middleware
-func (sh *strictHandler) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
-	var request GetCatalogItemInstanceRequestObject
+// GetQuota operation middleware
+func (sh *strictHandler) GetQuota(w http.ResponseWriter, r *http.Request, quotaId QuotaIdPath) {
+	var request GetQuotaRequestObject
 
-	request.CatalogItemInstanceId = catalogItemInstanceId
+	request.QuotaId = quotaId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCatalogItemInstance(ctx, request.(GetCatalogItemInstanceRequestObject))
+		return sh.ssi.GetQuota(ctx, request.(GetQuotaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCatalogItemInstance")
+		handler = middleware(handler, "GetQuota")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCatalogItemInstanceResponseObject); ok {
-		if err := validResponse.VisitGetCatalogItemInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetQuotaResponseObject); ok {
+		if err := validResponse.VisitGetQuotaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1573,25 +5830,25 @@ func (sh *strictHandler) GetCatalogItemInstance(w http.ResponseWriter, r *http.R
 	}
 }
 
-// ListCatalogItems operation middleware
-func (sh *strictHandler) ListCatalogItems(w http.ResponseWriter, r *http.Request, params ListCatalogItemsParams) {
-	var request ListCatalogItemsRequestObject
+// ListServiceTypes operation middleware
+func (sh *strictHandler) ListServiceTypes(w http.ResponseWriter, r *http.Request, params ListServiceTypesParams) {
+	var request ListServiceTypesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCatalogItems(ctx, request.(ListCatalogItemsRequestObject))
+		return sh.ssi.ListServiceTypes(ctx, request.(ListServiceTypesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCatalogItems")
+		handler = middleware(handler, "ListServiceTypes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCatalogItemsResponseObject); ok {
-		if err := validResponse.VisitListCatalogItemsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListServiceTypesResponseObject); ok {
+		if err := validResponse.VisitListServiceTypesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1599,13 +5856,13 @@ func (sh *strictHandler) ListCatalogItems(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateCatalogItem operation middleware
-func (sh *strictHandler) CreateCatalogItem(w http.ResponseWriter, r *http.Request, params CreateCatalogItemParams) {
-	var request CreateCatalogItemRequestObject
+// CreateServiceType operation middleware
+func (sh *strictHandler) CreateServiceType(w http.ResponseWriter, r *http.Request, params CreateServiceTypeParams) {
+	var request CreateServiceTypeRequestObject
 
 	request.Params = params
 
-	var body CreateCatalogItemJSONRequestBody
+	var body CreateServiceTypeJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1613,18 +5870,18 @@ func (sh *strictHandler) CreateCatalogItem(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCatalogItem(ctx, request.(CreateCatalogItemRequestObject))
+		return sh.ssi.CreateServiceType(ctx, request.(CreateServiceTypeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCatalogItem")
+		handler = middleware(handler, "CreateServiceType")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCatalogItemResponseObject); ok {
-		if err := validResponse.VisitCreateCatalogItemResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateServiceTypeResponseObject); ok {
+		if err := validResponse.VisitCreateServiceTypeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1632,25 +5889,26 @@ func (sh *strictHandler) CreateCatalogItem(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// DeleteCatalogItem operation middleware
-func (sh *strictHandler) DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
-	var request DeleteCatalogItemRequestObject
+// GetServiceType operation middleware
+func (sh *strictHandler) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params GetServiceTypeParams) {
+	var request GetServiceTypeRequestObject
 
-	request.CatalogItemId = catalogItemId
+	request.ServiceTypeId = serviceTypeId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCatalogItem(ctx, request.(DeleteCatalogItemRequestObject))
+		return sh.ssi.GetServiceType(ctx, request.(GetServiceTypeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCatalogItem")
+		handler = middleware(handler, "GetServiceType")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCatalogItemResponseObject); ok {
-		if err := validResponse.VisitDeleteCatalogItemResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetServiceTypeResponseObject); ok {
+		if err := validResponse.VisitGetServiceTypeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1658,25 +5916,32 @@ func (sh *strictHandler) DeleteCatalogItem(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetCatalogItem operation middleware
-func (sh *strictHandler) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
-	var request GetCatalogItemRequestObject
+// UpdateServiceType operation middleware
+func (sh *strictHandler) UpdateServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath) {
+	var request UpdateServiceTypeRequestObject
 
-	request.CatalogItemId = catalogItemId
+	request.ServiceTypeId = serviceTypeId
+
+	var body UpdateServiceTypeApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCatalogItem(ctx, request.(GetCatalogItemRequestObject))
+		return sh.ssi.UpdateServiceType(ctx, request.(UpdateServiceTypeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCatalogItem")
+		handler = middleware(handler, "UpdateServiceType")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCatalogItemResponseObject); ok {
-		if err := validResponse.VisitGetCatalogItemResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateServiceTypeResponseObject); ok {
+		if err := validResponse.VisitUpdateServiceTypeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1684,13 +5949,14 @@ func (sh *strictHandler) GetCatalogItem(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateCatalogItem operation middleware
-func (sh *strictHandler) UpdateCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
-	var request UpdateCatalogItemRequestObject
+// ApplyServiceType operation middleware
+func (sh *strictHandler) ApplyServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params ApplyServiceTypeParams) {
+	var request ApplyServiceTypeRequestObject
 
-	request.CatalogItemId = catalogItemId
+	request.ServiceTypeId = serviceTypeId
+	request.Params = params
 
-	var body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody
+	var body ApplyServiceTypeJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1698,18 +5964,18 @@ func (sh *strictHandler) UpdateCatalogItem(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCatalogItem(ctx, request.(UpdateCatalogItemRequestObject))
+		return sh.ssi.ApplyServiceType(ctx, request.(ApplyServiceTypeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCatalogItem")
+		handler = middleware(handler, "ApplyServiceType")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCatalogItemResponseObject); ok {
-		if err := validResponse.VisitUpdateCatalogItemResponse(w); err != nil {
+	} else if validResponse, ok := response.(ApplyServiceTypeResponseObject); ok {
+		if err := validResponse.VisitApplyServiceTypeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1717,23 +5983,23 @@ func (sh *strictHandler) UpdateCatalogItem(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// GetUsage operation middleware
+func (sh *strictHandler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	var request GetUsageRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.GetUsage(ctx, request.(GetUsageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "GetUsage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsageResponseObject); ok {
+		if err := validResponse.VisitGetUsageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1741,25 +6007,25 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListServiceTypes operation middleware
-func (sh *strictHandler) ListServiceTypes(w http.ResponseWriter, r *http.Request, params ListServiceTypesParams) {
-	var request ListServiceTypesRequestObject
+// ListWebhookSubscriptions operation middleware
+func (sh *strictHandler) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request, params ListWebhookSubscriptionsParams) {
+	var request ListWebhookSubscriptionsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListServiceTypes(ctx, request.(ListServiceTypesRequestObject))
+		return sh.ssi.ListWebhookSubscriptions(ctx, request.(ListWebhookSubscriptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListServiceTypes")
+		handler = middleware(handler, "ListWebhookSubscriptions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListServiceTypesResponseObject); ok {
-		if err := validResponse.VisitListServiceTypesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhookSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitListWebhookSubscriptionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1767,13 +6033,13 @@ func (sh *strictHandler) ListServiceTypes(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateServiceType operation middleware
-func (sh *strictHandler) CreateServiceType(w http.ResponseWriter, r *http.Request, params CreateServiceTypeParams) {
-	var request CreateServiceTypeRequestObject
+// CreateWebhookSubscription operation middleware
+func (sh *strictHandler) CreateWebhookSubscription(w http.ResponseWriter, r *http.Request, params CreateWebhookSubscriptionParams) {
+	var request CreateWebhookSubscriptionRequestObject
 
 	request.Params = params
 
-	var body CreateServiceTypeJSONRequestBody
+	var body CreateWebhookSubscriptionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1781,18 +6047,18 @@ func (sh *strictHandler) CreateServiceType(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateServiceType(ctx, request.(CreateServiceTypeRequestObject))
+		return sh.ssi.CreateWebhookSubscription(ctx, request.(CreateWebhookSubscriptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateServiceType")
+		handler = middleware(handler, "CreateWebhookSubscription")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateServiceTypeResponseObject); ok {
-		if err := validResponse.VisitCreateServiceTypeResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookSubscriptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1800,25 +6066,104 @@ func (sh *strictHandler) CreateServiceType(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetServiceType operation middleware
-func (sh *strictHandler) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath) {
-	var request GetServiceTypeRequestObject
+// DeleteWebhookSubscription operation middleware
+func (sh *strictHandler) DeleteWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath) {
+	var request DeleteWebhookSubscriptionRequestObject
 
-	request.ServiceTypeId = serviceTypeId
+	request.WebhookSubscriptionId = webhookSubscriptionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetServiceType(ctx, request.(GetServiceTypeRequestObject))
+		return sh.ssi.DeleteWebhookSubscription(ctx, request.(DeleteWebhookSubscriptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetServiceType")
+		handler = middleware(handler, "DeleteWebhookSubscription")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetServiceTypeResponseObject); ok {
-		if err := validResponse.VisitGetServiceTypeResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWebhookSubscription operation middleware
+func (sh *strictHandler) GetWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath) {
+	var request GetWebhookSubscriptionRequestObject
+
+	request.WebhookSubscriptionId = webhookSubscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookSubscription(ctx, request.(GetWebhookSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitGetWebhookSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.WebhookSubscriptionId = webhookSubscriptionId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TestWebhookSubscription operation middleware
+func (sh *strictHandler) TestWebhookSubscription(w http.ResponseWriter, r *http.Request, webhookSubscriptionId WebhookSubscriptionIdPath) {
+	var request TestWebhookSubscriptionRequestObject
+
+	request.WebhookSubscriptionId = webhookSubscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TestWebhookSubscription(ctx, request.(TestWebhookSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TestWebhookSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TestWebhookSubscriptionResponseObject); ok {
+		if err := validResponse.VisitTestWebhookSubscriptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
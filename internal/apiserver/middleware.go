@@ -0,0 +1,124 @@
+package apiserver
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/audit"
+	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/dcm-project/catalog-manager/internal/warning"
+	"github.com/google/uuid"
+)
+
+// tenantMiddleware scopes every request to the tenant named in the tenant header,
+// falling back to the default tenant when the header is absent. Requests are
+// marked as administrators' by isAdmin.
+func tenantMiddleware(cfg config.TenancyConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant := r.Header.Get(tenancy.Header)
+			if tenant == "" {
+				tenant = cfg.DefaultTenant
+			}
+			if err := tenancy.Validate(tenant); err != nil {
+				writeError(w, http.StatusBadRequest, v1alpha1.INVALIDARGUMENT, "Bad Request", err.Error())
+				return
+			}
+			ctx := tenancy.NewContext(r.Context(), tenant)
+			if isAdmin(cfg, tenant, r.Header.Get(tenancy.AdminTokenHeader)) {
+				ctx = tenancy.NewAdminContext(ctx)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// isAdmin reports whether the caller of tenant is an administrator: every
+// caller is with AdminAllTenants, otherwise only the callers of the admin
+// tenants whose request carries the admin token. As any client can set the
+// tenant header, the token is what the trusted gateway vouches for.
+func isAdmin(cfg config.TenancyConfig, tenant, token string) bool {
+	if cfg.AdminAllTenants {
+		return true
+	}
+	if cfg.AdminToken == "" || !slices.Contains(cfg.AdminTenants, tenant) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(cfg.AdminToken)) == 1
+}
+
+// auditMiddleware identifies the caller and the request, from the actor and
+// request ID headers, so that the changes made by the request are audited.
+// The request ID is generated when absent and returned in the response.
+func auditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := audit.Request{
+			Actor: r.Header.Get(audit.ActorHeader),
+			ID:    r.Header.Get(audit.RequestIDHeader),
+		}
+		if req.Actor == "" {
+			req.Actor = audit.Anonymous
+		}
+		if req.ID == "" {
+			req.ID = uuid.New().String()
+		}
+		w.Header().Set(audit.RequestIDHeader, req.ID)
+		next.ServeHTTP(w, r.WithContext(audit.NewContext(r.Context(), req)))
+	})
+}
+
+// warningMiddleware returns the warnings raised while serving a request in
+// Warning headers. The headers are added when the response header is written,
+// since handlers raise warnings before writing their response.
+func warningMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, recorder := warning.NewContext(r.Context())
+		next.ServeHTTP(&warningWriter{ResponseWriter: w, recorder: recorder}, r.WithContext(ctx))
+	})
+}
+
+// warningWriter adds the recorded warnings to the response header
+type warningWriter struct {
+	http.ResponseWriter
+	recorder    *warning.Recorder
+	wroteHeader bool
+}
+
+func (w *warningWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		for _, message := range w.recorder.Messages() {
+			w.Header().Add(warning.Header, warning.HeaderValue(message))
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *warningWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, so that
+// streaming handlers can flush
+func (w *warningWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// writeError writes an RFC 7807 error response
+func writeError(w http.ResponseWriter, status int, errType v1alpha1.ErrorType, title, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v1alpha1.Error{
+		Type:   errType,
+		Status: int32(status),
+		Title:  title,
+		Detail: &detail,
+	})
+}
@@ -0,0 +1,50 @@
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("tenantMiddleware", func() {
+	cfg := config.TenancyConfig{DefaultTenant: "default", AdminTenants: []string{"ops"}, AdminToken: "s3cret"}
+
+	// serve returns whether the request was served as an administrator's
+	serve := func(cfg config.TenancyConfig, tenant, token string) bool {
+		var privileged bool
+		handler := tenantMiddleware(cfg)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			privileged = tenancy.IsPrivileged(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(tenancy.Header, tenant)
+		if token != "" {
+			req.Header.Set(tenancy.AdminTokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		return privileged
+	}
+
+	It("should only make admin tenants with the admin token administrators", func() {
+		Expect(serve(cfg, "ops", "s3cret")).To(BeTrue())
+		Expect(serve(cfg, "ops", "")).To(BeFalse())
+		Expect(serve(cfg, "ops", "guess")).To(BeFalse())
+		Expect(serve(cfg, "team-a", "s3cret")).To(BeFalse())
+	})
+
+	It("should not make anyone an administrator without an admin token", func() {
+		cfg := cfg
+		cfg.AdminToken = ""
+		Expect(serve(cfg, "ops", "")).To(BeFalse())
+	})
+
+	It("should make every caller an administrator with AdminAllTenants", func() {
+		Expect(serve(config.TenancyConfig{AdminAllTenants: true}, "team-a", "")).To(BeTrue())
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
 	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -38,6 +39,9 @@ func (s *Server) Run(ctx context.Context) error {
 	router := chi.NewRouter()
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer)
+	router.Use(tenantMiddleware(s.config.Tenancy))
+	router.Use(auditMiddleware)
+	router.Use(warningMiddleware)
 
 	swagger, err := v1alpha1.GetSwagger()
 	if err != nil {
@@ -49,8 +53,12 @@ func (s *Server) Run(ctx context.Context) error {
 		baseURL = swagger.Servers[0].URL
 	}
 
-	// Disable server URL validation to avoid Host header mismatch issues
-	swagger.Servers = nil
+	// Keep only the relative base URL so that requests are matched on the path
+	// prefix without validating the Host header
+	swagger.Servers = openapi3.Servers{{URL: baseURL}}
+
+	// Merge patches are JSON documents
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
 
 	// Add OpenAPI request validation middleware
 	router.Use(nethttpmiddleware.OapiRequestValidatorWithOptions(swagger, &nethttpmiddleware.Options{
@@ -0,0 +1,38 @@
+// Package audit identifies who makes a request, so that the store can record
+// an audit entry for every change the request makes.
+package audit
+
+import (
+	"context"
+)
+
+const (
+	// ActorHeader is the HTTP header carrying the identity of the caller, set
+	// by the authenticating proxy in front of the API
+	ActorHeader = "X-Actor-ID"
+	// RequestIDHeader is the HTTP header carrying the ID of the request
+	RequestIDHeader = "X-Request-ID"
+	// Anonymous is the actor of requests without an actor header
+	Anonymous = "anonymous"
+)
+
+// Request identifies a request and its caller
+type Request struct {
+	Actor string
+	ID    string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying req.
+// Changes made with the returned context are audited.
+func NewContext(ctx context.Context, req Request) context.Context {
+	return context.WithValue(ctx, contextKey{}, req)
+}
+
+// FromContext returns the request carried by ctx, if any.
+// Changes made by internal callers, such as the reconciler, are not audited.
+func FromContext(ctx context.Context) (Request, bool) {
+	req, ok := ctx.Value(contextKey{}).(Request)
+	return req, ok
+}
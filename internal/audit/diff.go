@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Change is a difference between two versions of a resource. Before is nil
+// when the value was added and After is nil when it was removed.
+type Change struct {
+	Path   string `json:"path"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// Diff returns the changes between two versions of a resource, compared as
+// JSON. Objects are compared member by member. Arrays of objects that all
+// have a "path" member, such as the fields of a catalog item, are compared
+// element by element, so that each added, removed or modified element is a
+// single change at "<array>[<path>]". Other values are compared as a whole.
+func Diff(before, after any) ([]Change, error) {
+	b, err := normalize(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := normalize(after)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	diff("", b, a, &changes)
+	return changes, nil
+}
+
+// normalize converts v to the generic types produced by encoding/json
+func normalize(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func diff(path string, before, after any, changes *[]Change) {
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+
+	if b, ok := before.(map[string]any); ok {
+		if a, ok := after.(map[string]any); ok {
+			for _, key := range unionKeys(b, a) {
+				diff(join(path, key), b[key], a[key], changes)
+			}
+			return
+		}
+	}
+
+	if b, ok := keyedByPath(before); ok {
+		if a, ok := keyedByPath(after); ok {
+			for _, key := range unionKeys(b, a) {
+				if !reflect.DeepEqual(b[key], a[key]) {
+					*changes = append(*changes, Change{Path: path + "[" + key + "]", Before: b[key], After: a[key]})
+				}
+			}
+			return
+		}
+	}
+
+	*changes = append(*changes, Change{Path: path, Before: before, After: after})
+}
+
+// keyedByPath indexes an array of objects by their "path" member. It fails
+// unless every element is an object with a distinct string path.
+func keyedByPath(v any) (map[string]any, bool) {
+	if v == nil {
+		return map[string]any{}, true
+	}
+	elements, ok := v.([]any)
+	if !ok {
+		return nil, false
+	}
+	keyed := make(map[string]any, len(elements))
+	for _, e := range elements {
+		obj, ok := e.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		key, ok := obj["path"].(string)
+		if !ok {
+			return nil, false
+		}
+		if _, dup := keyed[key]; dup {
+			return nil, false
+		}
+		keyed[key] = obj
+	}
+	return keyed, true
+}
+
+// unionKeys returns the keys of a and b, sorted
+func unionKeys(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,64 @@
+package audit_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/audit"
+)
+
+var _ = Describe("Diff", func() {
+	It("should compare objects member by member", func() {
+		changes, err := audit.Diff(
+			map[string]any{"display_name": "Small VM", "spec": map[string]any{"service_type": "vm", "tier": 1}},
+			map[string]any{"display_name": "Small VM", "spec": map[string]any{"service_type": "vm", "tier": 2, "zone": "a"}},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]audit.Change{
+			{Path: "spec.tier", Before: float64(1), After: float64(2)},
+			{Path: "spec.zone", After: "a"},
+		}))
+	})
+
+	It("should compare arrays of objects with a path element by element", func() {
+		vcpu := map[string]any{"path": "spec.vcpu.count", "default": 2}
+		memory := map[string]any{"path": "spec.memory.size", "default": "4GB"}
+		os := map[string]any{"path": "spec.guest_os.type", "default": "rhel-9"}
+
+		changes, err := audit.Diff(
+			map[string]any{"fields": []any{vcpu, memory, os}},
+			map[string]any{"fields": []any{
+				os,
+				map[string]any{"path": "spec.vcpu.count", "default": 4},
+				map[string]any{"path": "spec.storage.size", "default": "20GB"},
+			}},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]audit.Change{
+			{Path: "fields[spec.memory.size]", Before: map[string]any{"path": "spec.memory.size", "default": "4GB"}},
+			{Path: "fields[spec.storage.size]", After: map[string]any{"path": "spec.storage.size", "default": "20GB"}},
+			{
+				Path:   "fields[spec.vcpu.count]",
+				Before: map[string]any{"path": "spec.vcpu.count", "default": float64(2)},
+				After:  map[string]any{"path": "spec.vcpu.count", "default": float64(4)},
+			},
+		}))
+	})
+
+	It("should compare other arrays as a whole", func() {
+		changes, err := audit.Diff(
+			map[string]any{"event_types": []string{"a", "b"}},
+			map[string]any{"event_types": []string{"b", "a"}},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]audit.Change{
+			{Path: "event_types", Before: []any{"a", "b"}, After: []any{"b", "a"}},
+		}))
+	})
+
+	It("should return no changes for equal values", func() {
+		changes, err := audit.Diff(map[string]any{"a": 1}, map[string]any{"a": 1.0})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+})
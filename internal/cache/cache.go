@@ -0,0 +1,94 @@
+// Package cache holds the values compiled from catalog items and service
+// types, such as their schemas and expressions, so that they are not compiled
+// again for every instance request.
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache holds the values compiled from resources such as catalog items.
+// Entries are keyed by resource ID and update time: the values of a resource
+// updated by another replica are compiled again on first use, while the
+// replica making the update invalidates them right away. Once the cache holds
+// its capacity, the least recently used resource is evicted.
+type Cache[V any] struct {
+	mu       sync.Mutex
+	capacity int
+	// Most recently used first
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type entry[V any] struct {
+	id         string
+	updateTime time.Time
+	value      V
+}
+
+// New creates an empty Cache of the values of at most capacity resources.
+// A capacity below 1 holds a single resource.
+func New[V any](capacity int) *Cache[V] {
+	return &Cache[V]{
+		capacity: max(capacity, 1),
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+// Get returns the value of a resource as of its update time. On a miss it
+// is compiled with compile and replaces the entry of any other update time.
+// Compilation errors are not cached.
+func (c *Cache[V]) Get(id string, updateTime time.Time, compile func() (V, error)) (V, error) {
+	c.mu.Lock()
+	if element, ok := c.entries[id]; ok && element.Value.(*entry[V]).updateTime.Equal(updateTime) {
+		c.order.MoveToFront(element)
+		c.mu.Unlock()
+		return element.Value.(*entry[V]).value, nil
+	}
+	c.mu.Unlock()
+
+	value, err := compile()
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[id]; ok {
+		current := element.Value.(*entry[V])
+		// Keep the entry of a newer update compiled concurrently
+		if !current.updateTime.After(updateTime) {
+			current.updateTime = updateTime
+			current.value = value
+		}
+		c.order.MoveToFront(element)
+		return value, nil
+	}
+	c.entries[id] = c.order.PushFront(&entry[V]{id: id, updateTime: updateTime, value: value})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry[V]).id)
+	}
+	return value, nil
+}
+
+// Invalidate drops the value of a resource
+func (c *Cache[V]) Invalidate(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[id]; ok {
+		c.order.Remove(element)
+		delete(c.entries, id)
+	}
+}
+
+// Len returns the number of resources held
+func (c *Cache[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
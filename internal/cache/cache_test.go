@@ -0,0 +1,59 @@
+package cache_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/cache"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		c        *cache.Cache[string]
+		compiled []string
+		updated  time.Time
+	)
+
+	get := func(id string) {
+		value, err := c.Get(id, updated, func() (string, error) {
+			compiled = append(compiled, id)
+			return "compiled " + id, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("compiled " + id))
+	}
+
+	BeforeEach(func() {
+		c = cache.New[string](2)
+		compiled = nil
+		updated = time.Now()
+	})
+
+	It("should evict the least recently used catalog item", func() {
+		get("small-vm")
+		get("large-vm")
+		get("small-vm")
+		get("gpu-vm")
+		Expect(c.Len()).To(Equal(2))
+
+		get("small-vm")
+		get("large-vm")
+		Expect(compiled).To(Equal([]string{"small-vm", "large-vm", "gpu-vm", "large-vm"}))
+	})
+
+	It("should replace the entry of an older update", func() {
+		get("small-vm")
+		updated = updated.Add(time.Second)
+		get("small-vm")
+		Expect(c.Len()).To(Equal(1))
+		Expect(compiled).To(HaveLen(2))
+	})
+
+	It("should forget invalidated catalog items", func() {
+		get("small-vm")
+		c.Invalidate("small-vm")
+		Expect(c.Len()).To(BeZero())
+	})
+})
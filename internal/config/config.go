@@ -1,6 +1,11 @@
 package config
 
-import "github.com/kelseyhightower/envconfig"
+import (
+	"errors"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
 
 // ServiceConfig holds HTTP server configuration
 type ServiceConfig struct {
@@ -17,10 +22,98 @@ type DBConfig struct {
 	Password string `envconfig:"DB_PASSWORD" default:"adminpass"`
 }
 
+// TenancyConfig holds multi-tenancy configuration
+type TenancyConfig struct {
+	DefaultTenant string `envconfig:"DEFAULT_TENANT" default:"default"`
+	// AdminTenants are the tenants whose callers administer global catalog
+	// items and quotas, when their requests carry AdminToken
+	AdminTenants []string `envconfig:"ADMIN_TENANTS"`
+	// AdminToken is the secret the trusted gateway sends in the X-Admin-Token
+	// header of the requests of administrators. The tenant header is not
+	// authenticated, so being an admin tenant is not enough on its own.
+	AdminToken string `envconfig:"ADMIN_TOKEN"`
+	// AdminAllTenants makes every caller an administrator, so that any tenant
+	// may change global catalog items and quotas, as before they were
+	// restricted. Meant for single-team deployments.
+	AdminAllTenants bool `envconfig:"ADMIN_ALL_TENANTS" default:"false"`
+}
+
+// ProviderConfig holds the service provider endpoints instances are dispatched to
+type ProviderConfig struct {
+	// Endpoints maps a service type to its provider endpoint, e.g. "vm:http://vm-provider/instances"
+	Endpoints map[string]string `envconfig:"PROVIDER_ENDPOINTS"`
+	Timeout   time.Duration     `envconfig:"PROVIDER_TIMEOUT" default:"30s"`
+}
+
+// ReconcilerConfig holds the tuning of the instance reconciler
+type ReconcilerConfig struct {
+	Workers      int           `envconfig:"RECONCILER_WORKERS" default:"4"`
+	PollInterval time.Duration `envconfig:"RECONCILER_POLL_INTERVAL" default:"5s"`
+	MaxAttempts  int           `envconfig:"RECONCILER_MAX_ATTEMPTS" default:"10"`
+	BackoffBase  time.Duration `envconfig:"RECONCILER_BACKOFF_BASE" default:"1s"`
+	BackoffMax   time.Duration `envconfig:"RECONCILER_BACKOFF_MAX" default:"5m"`
+}
+
+// EventsConfig holds the delivery of resource change events
+type EventsConfig struct {
+	// Sinks lists the sinks events are delivered to: http, stdout and/or file
+	Sinks        []string      `envconfig:"EVENTS_SINKS"`
+	HTTPURL      string        `envconfig:"EVENTS_HTTP_URL"`
+	HTTPTimeout  time.Duration `envconfig:"EVENTS_HTTP_TIMEOUT" default:"10s"`
+	FilePath     string        `envconfig:"EVENTS_FILE_PATH" default:"events.jsonl"`
+	Source       string        `envconfig:"EVENTS_SOURCE" default:"/catalog-manager"`
+	PollInterval time.Duration `envconfig:"EVENTS_POLL_INTERVAL" default:"1s"`
+	MaxAttempts  int           `envconfig:"EVENTS_MAX_ATTEMPTS" default:"10"`
+	BackoffBase  time.Duration `envconfig:"EVENTS_BACKOFF_BASE" default:"1s"`
+	BackoffMax   time.Duration `envconfig:"EVENTS_BACKOFF_MAX" default:"5m"`
+	Retention    time.Duration `envconfig:"EVENTS_RETENTION" default:"24h"`
+}
+
+// WebhooksConfig holds the tuning of deliveries to webhook subscriptions
+type WebhooksConfig struct {
+	Workers      int           `envconfig:"WEBHOOKS_WORKERS" default:"4"`
+	Timeout      time.Duration `envconfig:"WEBHOOKS_TIMEOUT" default:"10s"`
+	PollInterval time.Duration `envconfig:"WEBHOOKS_POLL_INTERVAL" default:"5s"`
+	MaxAttempts  int           `envconfig:"WEBHOOKS_MAX_ATTEMPTS" default:"8"`
+	BackoffBase  time.Duration `envconfig:"WEBHOOKS_BACKOFF_BASE" default:"5s"`
+	BackoffMax   time.Duration `envconfig:"WEBHOOKS_BACKOFF_MAX" default:"1h"`
+	// AllowPrivateTargets lets subscriptions target loopback, private and link-local addresses
+	AllowPrivateTargets bool `envconfig:"WEBHOOKS_ALLOW_PRIVATE_TARGETS" default:"false"`
+}
+
+// WatchConfig holds the tuning of the watch streams
+type WatchConfig struct {
+	PollInterval time.Duration `envconfig:"WATCH_POLL_INTERVAL" default:"5s"`
+	GapGrace     time.Duration `envconfig:"WATCH_GAP_GRACE" default:"2s"`
+	BufferSize   int           `envconfig:"WATCH_BUFFER_SIZE" default:"256"`
+}
+
+// IdempotencyConfig holds the retention of the idempotency keys of create
+// requests. PendingTimeout is how long the key of a request that created
+// nothing stays reserved, e.g. because the server stopped while serving it.
+type IdempotencyConfig struct {
+	TTL            time.Duration `envconfig:"IDEMPOTENCY_TTL" default:"24h"`
+	PendingTimeout time.Duration `envconfig:"IDEMPOTENCY_PENDING_TIMEOUT" default:"1m"`
+}
+
+// CacheConfig holds the size of the caches of compiled catalog items and
+// service type schemas
+type CacheConfig struct {
+	CatalogItems int `envconfig:"CATALOG_ITEM_CACHE_SIZE" default:"1000"`
+}
+
 // Config holds all configuration for the application
 type Config struct {
-	Service  ServiceConfig
-	Database DBConfig
+	Service     ServiceConfig
+	Database    DBConfig
+	Tenancy     TenancyConfig
+	Provider    ProviderConfig
+	Reconciler  ReconcilerConfig
+	Events      EventsConfig
+	Webhooks    WebhooksConfig
+	Watch       WatchConfig
+	Idempotency IdempotencyConfig
+	Cache       CacheConfig
 }
 
 func Load() (*Config, error) {
@@ -31,5 +124,32 @@ func Load() (*Config, error) {
 	if err := envconfig.Process("", &cfg.Database); err != nil {
 		return nil, err
 	}
+	if err := envconfig.Process("", &cfg.Tenancy); err != nil {
+		return nil, err
+	}
+	if len(cfg.Tenancy.AdminTenants) > 0 && cfg.Tenancy.AdminToken == "" {
+		return nil, errors.New("ADMIN_TENANTS requires ADMIN_TOKEN")
+	}
+	if err := envconfig.Process("", &cfg.Provider); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Reconciler); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Events); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Webhooks); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Watch); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Idempotency); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Cache); err != nil {
+		return nil, err
+	}
 	return &cfg, nil
 }
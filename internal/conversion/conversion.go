@@ -0,0 +1,165 @@
+// Package conversion migrates service type payloads and field paths between
+// versions of a service type, following declarative field mappings.
+package conversion
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Mapping moves the field at From, and the fields nested in it, to To.
+// An empty To drops the field: it has no counterpart in the target version.
+type Mapping struct {
+	From string
+	To   string
+}
+
+// Mappings converts from one version of a service type to another. Fields
+// without a mapping keep their path.
+type Mappings []Mapping
+
+// Result is a converted payload
+type Result struct {
+	Spec map[string]any
+	// Lossy lists the paths of the source payload whose values were dropped
+	Lossy []string
+}
+
+// Path returns the path of a field of the source version in the target
+// version. It returns false when the field was dropped.
+func (m Mappings) Path(path string) (string, bool) {
+	for _, mapping := range m.mostSpecificFirst() {
+		rest, ok := under(path, mapping.From)
+		if !ok {
+			continue
+		}
+		if mapping.To == "" {
+			return "", false
+		}
+		return mapping.To + rest, true
+	}
+	return path, true
+}
+
+// Spec converts a payload of the source version. The payload is not modified.
+func (m Mappings) Spec(spec map[string]any) (*Result, error) {
+	converted := deepCopy(spec).(map[string]any)
+	result := &Result{Spec: converted, Lossy: []string{}}
+
+	// Take the mapped values out before putting them back, so that mappings
+	// swapping two fields do not overwrite each other
+	type move struct {
+		to    string
+		value any
+	}
+	var moves []move
+	for _, mapping := range m.mostSpecificFirst() {
+		value, ok := remove(converted, mapping.From)
+		if !ok {
+			continue
+		}
+		if mapping.To == "" {
+			result.Lossy = append(result.Lossy, mapping.From)
+			continue
+		}
+		moves = append(moves, move{to: mapping.To, value: value})
+	}
+	// Put objects back before the fields nested in them
+	for i := len(moves) - 1; i >= 0; i-- {
+		if err := set(converted, moves[i].to, moves[i].value); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(result.Lossy)
+	return result, nil
+}
+
+// mostSpecificFirst orders the mappings so that nested fields are handled
+// before the objects containing them
+func (m Mappings) mostSpecificFirst() Mappings {
+	sorted := append(Mappings(nil), m...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.Count(sorted[i].From, ".") > strings.Count(sorted[j].From, ".")
+	})
+	return sorted
+}
+
+// under reports whether path is prefix or a field nested in it, and returns
+// the remainder of path after prefix
+func under(path, prefix string) (string, bool) {
+	if path == prefix {
+		return "", true
+	}
+	if strings.HasPrefix(path, prefix+".") {
+		return path[len(prefix):], true
+	}
+	return "", false
+}
+
+// remove deletes a dot-separated path from a nested map and returns its value.
+// Objects left empty by the removal are removed as well.
+func remove(obj map[string]any, path string) (any, bool) {
+	key, rest, nested := strings.Cut(path, ".")
+	value, ok := obj[key]
+	if !ok {
+		return nil, false
+	}
+	if !nested {
+		delete(obj, key)
+		return value, true
+	}
+	child, ok := value.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	removed, ok := remove(child, rest)
+	if ok && len(child) == 0 {
+		delete(obj, key)
+	}
+	return removed, ok
+}
+
+// set sets a dot-separated path in a nested map, creating intermediate objects
+func set(obj map[string]any, path string, value any) error {
+	keys := strings.Split(path, ".")
+	for i, key := range keys[:len(keys)-1] {
+		next, ok := obj[key]
+		if !ok {
+			child := map[string]any{}
+			obj[key] = child
+			obj = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("field %q conflicts with the value of %q", path, strings.Join(keys[:i+1], "."))
+		}
+		obj = child
+	}
+	if _, ok := obj[keys[len(keys)-1]]; ok {
+		return fmt.Errorf("field %q is already set", path)
+	}
+	obj[keys[len(keys)-1]] = value
+	return nil
+}
+
+// deepCopy copies the maps and slices of a decoded JSON value
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for key, child := range v {
+			copied[key] = deepCopy(child)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, child := range v {
+			copied[i] = deepCopy(child)
+		}
+		return copied
+	default:
+		return v
+	}
+}
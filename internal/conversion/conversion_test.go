@@ -0,0 +1,66 @@
+package conversion_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/conversion"
+)
+
+var _ = Describe("Mappings", func() {
+	mappings := conversion.Mappings{
+		{From: "vcpu", To: "cpu"},
+		{From: "vcpu.count", To: "cpu.cores"},
+		{From: "access"},
+	}
+
+	DescribeTable("Path",
+		func(path, expected string, kept bool) {
+			converted, ok := mappings.Path(path)
+			Expect(ok).To(Equal(kept))
+			Expect(converted).To(Equal(expected))
+		},
+		Entry("unmapped field", "memory.size", "memory.size", true),
+		Entry("mapped object", "vcpu", "cpu", true),
+		Entry("field nested in a mapped object", "vcpu.architecture", "cpu.architecture", true),
+		Entry("mapped nested field", "vcpu.count", "cpu.cores", true),
+		Entry("field sharing a prefix", "vcpus", "vcpus", true),
+		Entry("dropped field", "access.ssh_public_key", "", false),
+	)
+
+	It("should convert a spec and report the dropped values", func() {
+		spec := map[string]any{
+			"service_type": "vm",
+			"vcpu":         map[string]any{"count": 2, "architecture": "x86_64"},
+			"memory":       map[string]any{"size": "4GB"},
+			"access":       map[string]any{"ssh_public_key": "ssh-ed25519 AAAA"},
+		}
+
+		result, err := mappings.Spec(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Spec).To(Equal(map[string]any{
+			"service_type": "vm",
+			"cpu":          map[string]any{"cores": 2, "architecture": "x86_64"},
+			"memory":       map[string]any{"size": "4GB"},
+		}))
+		Expect(result.Lossy).To(ConsistOf("access"))
+
+		// The source spec is left untouched
+		Expect(spec).To(HaveKey("vcpu"))
+		Expect(spec).To(HaveKey("access"))
+	})
+
+	It("should swap fields", func() {
+		swap := conversion.Mappings{{From: "a", To: "b"}, {From: "b", To: "a"}}
+
+		result, err := swap.Spec(map[string]any{"a": 1, "b": 2})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Spec).To(Equal(map[string]any{"a": 2, "b": 1}))
+		Expect(result.Lossy).To(BeEmpty())
+	})
+
+	It("should reject mappings onto existing fields", func() {
+		_, err := conversion.Mappings{{From: "a", To: "b"}}.Spec(map[string]any{"a": 1, "b": 2})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,42 @@
+// Package events delivers the resource changes recorded in the outbox to
+// external systems as CloudEvents.
+package events
+
+import (
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// SpecVersion is the CloudEvents specification version of emitted events
+const SpecVersion = "1.0"
+
+// Event is a CloudEvent in the JSON event format (structured content mode)
+type Event struct {
+	SpecVersion     string         `json:"specversion"`
+	ID              string         `json:"id"`
+	Source          string         `json:"source"`
+	Type            string         `json:"type"`
+	Subject         string         `json:"subject,omitempty"`
+	Time            time.Time      `json:"time"`
+	DataContentType string         `json:"datacontenttype"`
+	Data            map[string]any `json:"data,omitempty"`
+	// Tenant is an extension attribute naming the tenant owning the resource;
+	// empty for global resources
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// NewEvent builds the CloudEvent for an outbox event
+func NewEvent(source string, e *model.OutboxEvent) Event {
+	return Event{
+		SpecVersion:     SpecVersion,
+		ID:              e.ID,
+		Source:          source,
+		Type:            e.Type,
+		Subject:         e.Subject,
+		Time:            e.Time,
+		DataContentType: "application/json",
+		Data:            e.Data,
+		Tenant:          e.Tenant,
+	}
+}
@@ -0,0 +1,184 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// Config holds the tuning parameters of the relay. Zero values use the defaults.
+type Config struct {
+	// Source is the CloudEvents source attribute of emitted events
+	Source string
+	// PollInterval is how often the outbox is scanned for due events
+	PollInterval time.Duration
+	// MaxAttempts is the number of failed deliveries after which an event is dead-lettered
+	MaxAttempts int
+	// BackoffBase is the delay after the first failed delivery; it doubles with every further failure
+	BackoffBase time.Duration
+	// BackoffMax caps the delay between deliveries
+	BackoffMax time.Duration
+	// Retention is how long delivered events are kept in the outbox
+	Retention time.Duration
+	// Lease is how long a claimed event is withheld from the relays of other replicas
+	Lease time.Duration
+}
+
+// batchSize is the maximum number of due events fetched per scan
+const batchSize = 100
+
+// pruneInterval is how often delivered events past their retention are deleted
+const pruneInterval = time.Minute
+
+// Relay delivers the events recorded in the outbox to its sinks.
+// Delivery is at-least-once: an event is retried with exponential backoff until
+// every sink accepted it, and only the sinks that have not accepted it yet are
+// retried. Events that exhaust their attempts are left in the outbox in the
+// DEAD state. Relays of several replicas may share an outbox: each event is
+// claimed before it is sent, so that only one of them delivers it.
+type Relay struct {
+	store store.Store
+	sinks []Sink
+	cfg   Config
+}
+
+// NewRelay creates a Relay. A relay without sinks marks events delivered
+// without sending them, so that the outbox does not grow unbounded.
+func NewRelay(store store.Store, sinks []Sink, cfg Config) *Relay {
+	if cfg.Source == "" {
+		cfg.Source = "/catalog-manager"
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = time.Second
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = 5 * time.Minute
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 24 * time.Hour
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = time.Minute
+	}
+	return &Relay{store: store, sinks: sinks, cfg: cfg}
+}
+
+// Run delivers due events until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	var lastPrune time.Time
+	for {
+		for {
+			n, err := r.Deliver(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("Failed to deliver events: %v", err)
+				}
+				break
+			}
+			if n < batchSize {
+				break
+			}
+		}
+		if time.Since(lastPrune) >= pruneInterval {
+			if _, err := r.store.Outbox().Prune(ctx, time.Now().Add(-r.cfg.Retention)); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to prune delivered events: %v", err)
+			}
+			lastPrune = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Deliver sends one batch of due events to the sinks and returns the number of
+// events attempted
+func (r *Relay) Deliver(ctx context.Context) (int, error) {
+	due, err := r.store.Outbox().ListDue(ctx, time.Now(), batchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i := range due {
+		now := time.Now()
+		event, err := r.store.Outbox().Claim(ctx, due[i].Sequence, now, now.Add(r.cfg.Lease))
+		if err != nil {
+			if errors.Is(err, store.ErrOutboxEventNotDue) {
+				// Claimed by another replica
+				continue
+			}
+			return i, err
+		}
+		if err := r.deliver(ctx, event); err != nil {
+			return i, err
+		}
+	}
+	return len(due), nil
+}
+
+// deliver sends an event to the sinks that have not accepted it yet and records the outcome
+func (r *Relay) deliver(ctx context.Context, e *model.OutboxEvent) error {
+	event := NewEvent(r.cfg.Source, e)
+	var errs []error
+	for _, sink := range r.sinks {
+		if slices.Contains(e.DeliveredSinks, sink.Name()) {
+			continue
+		}
+		if err := sink.Send(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+			continue
+		}
+		e.DeliveredSinks = append(e.DeliveredSinks, sink.Name())
+	}
+	if ctx.Err() != nil {
+		// Shutting down; the event is retried once its lease expires
+		return ctx.Err()
+	}
+
+	now := time.Now()
+	if len(errs) == 0 {
+		e.State = model.OutboxEventStateDelivered
+		e.DeliveredTime = &now
+		e.LastError = ""
+		return r.store.Outbox().UpdateDelivery(ctx, e)
+	}
+
+	e.Attempts++
+	e.LastError = errors.Join(errs...).Error()
+	if e.Attempts >= r.cfg.MaxAttempts {
+		e.State = model.OutboxEventStateDead
+		log.Printf("Dead-lettering event %s (%s %s) after %d attempts: %s", e.ID, e.Type, e.Subject, e.Attempts, e.LastError)
+	} else {
+		e.NextAttemptTime = now.Add(Backoff(e.Attempts, r.cfg.BackoffBase, r.cfg.BackoffMax))
+	}
+	return r.store.Outbox().UpdateDelivery(ctx, e)
+}
+
+// Backoff returns the delay after the given failed attempt: base doubled with
+// every further attempt, capped at max, with up to 10% jitter
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	delay := max
+	if shift := attempt - 1; shift < 32 {
+		if d := base << shift; d > 0 && d < delay {
+			delay = d
+		}
+	}
+	return delay + rand.N(delay/10+1)
+}
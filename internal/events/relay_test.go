@@ -0,0 +1,161 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/internal/events"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// fakeSink records the events it receives and fails while err is set
+type fakeSink struct {
+	name   string
+	err    error
+	events []events.Event
+}
+
+func (s *fakeSink) Name() string {
+	return s.name
+}
+
+func (s *fakeSink) Send(_ context.Context, event events.Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+var _ = Describe("Relay", func() {
+	var (
+		ctx   context.Context
+		db    *gorm.DB
+		str   store.Store
+		good  *fakeSink
+		flaky *fakeSink
+		relay *events.Relay
+	)
+
+	outboxEvent := func() model.OutboxEvent {
+		var e model.OutboxEvent
+		Expect(db.First(&e).Error).To(Succeed())
+		return e
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+
+		_, err = str.ServiceType().Create(ctx, model.ServiceType{
+			ID: "vm", ApiVersion: "v1alpha1", ServiceType: "vm", Spec: map[string]any{}, Path: "service-types/vm",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		good = &fakeSink{name: "good"}
+		flaky = &fakeSink{name: "flaky"}
+		relay = events.NewRelay(str, []events.Sink{good, flaky}, events.Config{
+			Source:      "/test",
+			MaxAttempts: 3,
+			// Keep failed events due so that they are retried on the next Deliver
+			BackoffBase: time.Nanosecond,
+			BackoffMax:  time.Nanosecond,
+		})
+	})
+
+	AfterEach(func() {
+		Expect(str.Close()).To(Succeed())
+	})
+
+	It("should deliver CloudEvents to every sink", func() {
+		n, err := relay.Deliver(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(1))
+
+		Expect(good.events).To(HaveLen(1))
+		event := good.events[0]
+		Expect(event.SpecVersion).To(Equal("1.0"))
+		Expect(event.Source).To(Equal("/test"))
+		Expect(event.Type).To(Equal(model.EventServiceTypeCreated))
+		Expect(event.Subject).To(Equal("service-types/vm"))
+		Expect(event.Data).To(HaveKeyWithValue("service_type", "vm"))
+		Expect(flaky.events).To(Equal(good.events))
+
+		Expect(outboxEvent().State).To(Equal(model.OutboxEventStateDelivered))
+		n, err = relay.Deliver(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(BeZero())
+	})
+
+	It("should skip events claimed by another replica", func() {
+		now := time.Now()
+		e := outboxEvent()
+		_, err := str.Outbox().Claim(ctx, e.Sequence, now, now.Add(time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = relay.Deliver(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(good.events).To(BeEmpty())
+		Expect(outboxEvent().State).To(Equal(model.OutboxEventStatePending))
+	})
+
+	It("should only retry the sinks that failed", func() {
+		flaky.err = errors.New("unavailable")
+		_, err := relay.Deliver(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		e := outboxEvent()
+		Expect(e.State).To(Equal(model.OutboxEventStatePending))
+		Expect(e.Attempts).To(Equal(1))
+		Expect(e.LastError).To(ContainSubstring("flaky: unavailable"))
+		Expect(e.DeliveredSinks).To(Equal([]string{"good"}))
+
+		flaky.err = nil
+		time.Sleep(time.Millisecond)
+		_, err = relay.Deliver(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(good.events).To(HaveLen(1))
+		Expect(flaky.events).To(HaveLen(1))
+		Expect(outboxEvent().State).To(Equal(model.OutboxEventStateDelivered))
+	})
+
+	It("should dead-letter events that exhaust their attempts", func() {
+		flaky.err = errors.New("unavailable")
+		for range 3 {
+			time.Sleep(time.Millisecond)
+			_, err := relay.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		e := outboxEvent()
+		Expect(e.State).To(Equal(model.OutboxEventStateDead))
+		Expect(e.Attempts).To(Equal(3))
+
+		flaky.err = nil
+		n, err := relay.Deliver(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(BeZero())
+	})
+
+	It("should mark events delivered without sinks", func() {
+		_, err := events.NewRelay(str, nil, events.Config{}).Deliver(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outboxEvent().State).To(Equal(model.OutboxEventStateDelivered))
+	})
+})
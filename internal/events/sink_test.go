@@ -0,0 +1,94 @@
+package events_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/events"
+)
+
+var _ = Describe("Sinks", func() {
+	var event events.Event
+
+	BeforeEach(func() {
+		event = events.Event{
+			SpecVersion:     events.SpecVersion,
+			ID:              "3f2b8f0e",
+			Source:          "/catalog-manager",
+			Type:            "io.dcm.catalog.catalog_item.created",
+			Subject:         "catalog-items/small-vm",
+			Time:            time.Date(2026, 1, 13, 14, 20, 0, 0, time.UTC),
+			DataContentType: "application/json",
+			Data:            map[string]any{"uid": "small-vm"},
+		}
+	})
+
+	Describe("HTTPSink", func() {
+		It("should post the event in structured content mode", func() {
+			var contentType string
+			var received events.Event
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				contentType = r.Header.Get("Content-Type")
+				Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			Expect(events.NewHTTPSink(server.URL, nil).Send(context.Background(), event)).To(Succeed())
+			Expect(contentType).To(Equal(events.ContentType))
+			Expect(received).To(Equal(event))
+		})
+
+		It("should fail on non-2xx responses", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(io.Discard, r.Body)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			err := events.NewHTTPSink(server.URL, nil).Send(context.Background(), event)
+			Expect(err).To(MatchError(ContainSubstring("503")))
+		})
+	})
+
+	Describe("WriterSink", func() {
+		It("should write one JSON line per event", func() {
+			var buf bytes.Buffer
+			sink := events.NewWriterSink("buffer", &buf)
+			Expect(sink.Send(context.Background(), event)).To(Succeed())
+			Expect(sink.Send(context.Background(), event)).To(Succeed())
+
+			lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+			Expect(lines).To(HaveLen(2))
+			var decoded events.Event
+			Expect(json.Unmarshal(lines[0], &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(event))
+		})
+	})
+
+	Describe("FileSink", func() {
+		It("should append events to the file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "events.jsonl")
+			for range 2 {
+				sink, err := events.NewFileSink(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sink.Send(context.Background(), event)).To(Succeed())
+				Expect(sink.Close()).To(Succeed())
+			}
+
+			content, err := os.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes.Count(content, []byte("\n"))).To(Equal(2))
+		})
+	})
+})
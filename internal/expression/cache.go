@@ -0,0 +1,24 @@
+package expression
+
+import (
+	"github.com/dcm-project/catalog-manager/internal/cache"
+)
+
+// Programs are the compiled expressions of the fields of a catalog item, by path
+type Programs map[string]FieldPrograms
+
+// FieldPrograms are the compiled expressions of a field, nil when it has none
+type FieldPrograms struct {
+	DefaultExpression *Program
+	VisibleWhen       *Program
+	RequiredWhen      *Program
+}
+
+// Cache holds the compiled expressions of catalog items, so that they are not
+// compiled again for every instance request
+type Cache = cache.Cache[Programs]
+
+// NewCache creates an empty Cache of the expressions of at most size catalog items
+func NewCache(size int) *Cache {
+	return cache.New[Programs](size)
+}
@@ -0,0 +1,52 @@
+package expression_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/expression"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		cache    *expression.Cache
+		compiled int
+		updated  time.Time
+	)
+
+	compile := func() (expression.Programs, error) {
+		compiled++
+		program, err := expression.CompileCondition("spec.vcpu.count > 4.0")
+		return expression.Programs{"spec.memory.size": {VisibleWhen: program}}, err
+	}
+
+	BeforeEach(func() {
+		cache = expression.NewCache(10)
+		compiled = 0
+		updated = time.Now()
+	})
+
+	It("should compile the expressions of a catalog item once per update", func() {
+		for range 3 {
+			programs, err := cache.Get("small-vm", updated, compile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(programs["spec.memory.size"].VisibleWhen.String()).To(Equal("spec.vcpu.count > 4.0"))
+		}
+		Expect(compiled).To(Equal(1))
+
+		_, err := cache.Get("small-vm", updated.Add(time.Second), compile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(compiled).To(Equal(2))
+	})
+
+	It("should compile the expressions again once invalidated", func() {
+		_, err := cache.Get("small-vm", updated, compile)
+		Expect(err).ToNot(HaveOccurred())
+		cache.Invalidate("small-vm")
+		_, err = cache.Get("small-vm", updated, compile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(compiled).To(Equal(2))
+	})
+})
@@ -0,0 +1,245 @@
+// Package expression evaluates the CEL expressions of catalog item fields:
+// the conditions under which a field is visible or required, and computed
+// defaults.
+//
+// Expressions only read the variables they are given and run with a cost
+// limit and a timeout, and the size of their sources and results is capped,
+// so that a catalog item cannot stall instance requests.
+package expression
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/dcm-project/catalog-manager/internal/quantity"
+)
+
+var (
+	// ErrInvalidExpression is returned for expressions that do not compile or have the wrong type
+	ErrInvalidExpression = errors.New("invalid expression")
+	// ErrEvaluation is returned for expressions that fail at evaluation time
+	ErrEvaluation = errors.New("expression evaluation failed")
+)
+
+const (
+	// costLimit caps the work of an evaluation, in CEL cost units
+	costLimit = 100_000
+	// timeout caps the duration of an evaluation
+	timeout = 50 * time.Millisecond
+	// maxSourceLength caps the length of an expression
+	maxSourceLength = 4096
+	// maxResultSize caps the size of the JSON encoding of a computed value
+	maxResultSize = 64 << 10
+)
+
+// Program is a compiled expression
+type Program struct {
+	source  string
+	program cel.Program
+}
+
+// Vars are the variables of computed defaults
+type Vars struct {
+	// Spec is the service type payload rendered from the user values and the
+	// literal defaults of the catalog item
+	Spec map[string]any
+	// Instance describes the instance being created
+	Instance Instance
+	// Requester identifies the caller creating the instance
+	Requester Requester
+}
+
+// Instance describes the instance being created
+type Instance struct {
+	UID           string
+	DisplayName   string
+	CatalogItemID string
+}
+
+// Requester identifies the caller creating an instance
+type Requester struct {
+	Tenant string
+	Actor  string
+}
+
+var (
+	// conditionEnv declares the variables of conditions: spec, the service
+	// type payload rendered from the defaults and user values of a catalog item
+	conditionEnv = mustEnv(
+		cel.Variable("spec", cel.MapType(cel.StringType, cel.DynType)),
+		ext.Strings(),
+		cel.OptionalTypes(),
+		quantities(),
+	)
+	// valueEnv declares the variables of computed defaults, see Vars
+	valueEnv = mustEnv(
+		cel.Variable("spec", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("instance", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("requester", cel.MapType(cel.StringType, cel.StringType)),
+		ext.Strings(),
+		cel.OptionalTypes(),
+		quantities(),
+	)
+)
+
+// quantities declares the functions over sizes such as "16GB", which are
+// strings in the spec: quantity("2GB") is the size in megabytes, 2048, and
+// formatQuantity(4096) formats a size in megabytes with its largest exact
+// unit, "4GB".
+func quantities() cel.EnvOption {
+	return cel.Lib(quantityLib{})
+}
+
+type quantityLib struct{}
+
+func (quantityLib) CompileOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("quantity",
+			cel.Overload("quantity_string", []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(func(value ref.Val) ref.Val {
+					q, err := quantity.Parse(string(value.(types.String)))
+					if err != nil {
+						return types.NewErr("%v", err)
+					}
+					return types.Int(q)
+				}))),
+		cel.Function("formatQuantity",
+			cel.Overload("format_quantity_int", []*cel.Type{cel.IntType}, cel.StringType,
+				cel.UnaryBinding(func(value ref.Val) ref.Val {
+					mb := value.(types.Int)
+					if mb < 0 {
+						return types.NewErr("formatQuantity: negative size %d", int64(mb))
+					}
+					return types.String(quantity.Quantity(mb).String())
+				}))),
+	}
+}
+
+func (quantityLib) ProgramOptions() []cel.ProgramOption {
+	return nil
+}
+
+func mustEnv(opts ...cel.EnvOption) *cel.Env {
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		panic(err)
+	}
+	return env
+}
+
+// CompileCondition compiles a boolean expression over the rendered spec, such
+// as `spec.data_disk.enabled == true`
+func CompileCondition(source string) (*Program, error) {
+	ast, err := compile(conditionEnv, source)
+	if err != nil {
+		return nil, err
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("%w: must evaluate to a bool, not %s", ErrInvalidExpression, ast.OutputType())
+	}
+	return newProgram(conditionEnv, source, ast)
+}
+
+// CompileValue compiles a computed default, such as
+// `instance.display_name.lowerAscii() + "-" + instance.uid.substring(0, 8)`
+func CompileValue(source string) (*Program, error) {
+	ast, err := compile(valueEnv, source)
+	if err != nil {
+		return nil, err
+	}
+	return newProgram(valueEnv, source, ast)
+}
+
+func compile(env *cel.Env, source string) (*cel.Ast, error) {
+	if len(source) > maxSourceLength {
+		return nil, fmt.Errorf("%w: longer than %d characters", ErrInvalidExpression, maxSourceLength)
+	}
+	ast, issues := env.Compile(source)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, issues.Err())
+	}
+	return ast, nil
+}
+
+func newProgram(env *cel.Env, source string, ast *cel.Ast) (*Program, error) {
+	program, err := env.Program(ast, cel.CostLimit(costLimit), cel.InterruptCheckFrequency(100))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExpression, err)
+	}
+	return &Program{source: source, program: program}, nil
+}
+
+// String returns the source of the expression
+func (p *Program) String() string {
+	return p.source
+}
+
+// Condition evaluates a condition against a rendered spec. Reading a field
+// that is not set is an evaluation error: conditions test such fields with
+// has(), or read them with optional field selection, such as
+// `spec.?gpu.count.orValue(0) > 0`.
+func (p *Program) Condition(spec map[string]any) (bool, error) {
+	out, err := p.eval(map[string]any{"spec": spec})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %q evaluated to %v, not a bool", ErrEvaluation, p.source, out)
+	}
+	return result, nil
+}
+
+// Value evaluates a computed default. The value is decoded as JSON would be:
+// numbers are float64, lists []any and maps map[string]any.
+func (p *Program) Value(vars Vars) (any, error) {
+	out, err := p.eval(map[string]any{
+		"spec": vars.Spec,
+		"instance": map[string]string{
+			"uid":             vars.Instance.UID,
+			"display_name":    vars.Instance.DisplayName,
+			"catalog_item_id": vars.Instance.CatalogItemID,
+		},
+		"requester": map[string]string{
+			"tenant": vars.Requester.Tenant,
+			"actor":  vars.Requester.Actor,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	native, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrEvaluation, p.source, err)
+	}
+	value := native.(*structpb.Value).AsInterface()
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrEvaluation, p.source, err)
+	}
+	if len(data) > maxResultSize {
+		return nil, fmt.Errorf("%w: %q: result larger than %d bytes", ErrEvaluation, p.source, maxResultSize)
+	}
+	return value, nil
+}
+
+// eval evaluates the expression within its time limit
+func (p *Program) eval(vars map[string]any) (ref.Val, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	out, _, err := p.program.ContextEval(ctx, vars)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrEvaluation, p.source, err)
+	}
+	return out, nil
+}
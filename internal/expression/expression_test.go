@@ -0,0 +1,120 @@
+package expression_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/expression"
+)
+
+var _ = Describe("Condition", func() {
+	spec := map[string]any{
+		"flavor":    "gpu-large",
+		"data_disk": map[string]any{"enabled": true, "size": "100GB"},
+		"vcpu":      map[string]any{"count": float64(4)},
+	}
+
+	DescribeTable("evaluation",
+		func(source string, expected bool) {
+			program, err := expression.CompileCondition(source)
+			Expect(err).ToNot(HaveOccurred())
+			result, err := program.Condition(spec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("boolean field", "spec.data_disk.enabled", true),
+		Entry("comparison", "spec.vcpu.count > 8.0", false),
+		Entry("string function", `spec.flavor.startsWith("gpu-")`, true),
+		Entry("has", "has(spec.gpu) || spec.vcpu.count == 4.0", true),
+		Entry("optional field that is not set", "spec.?gpu.count.orValue(0) > 0", false),
+		Entry("optional field that is set", `spec.?data_disk.size.orValue("") == "100GB"`, true),
+	)
+
+	It("should report reading a field that is not set", func() {
+		program, err := expression.CompileCondition("spec.gpu.count > 0")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = program.Condition(spec)
+		Expect(err).To(MatchError(expression.ErrEvaluation))
+		Expect(err).To(MatchError(ContainSubstring("no such key")))
+	})
+
+	It("should reject expressions that do not compile", func() {
+		_, err := expression.CompileCondition("spec.flavor ==")
+		Expect(err).To(MatchError(expression.ErrInvalidExpression))
+	})
+
+	It("should reject expressions that are not booleans", func() {
+		_, err := expression.CompileCondition(`"gpu"`)
+		Expect(err).To(MatchError(expression.ErrInvalidExpression))
+	})
+
+	It("should reject unknown variables", func() {
+		_, err := expression.CompileCondition("env.HOME == ''")
+		Expect(err).To(MatchError(expression.ErrInvalidExpression))
+	})
+
+	It("should reject dynamic results that are not booleans", func() {
+		program, err := expression.CompileCondition("spec.flavor")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = program.Condition(spec)
+		Expect(err).To(MatchError(expression.ErrEvaluation))
+	})
+
+	It("should stop expressions exceeding the cost limit", func() {
+		program, err := expression.CompileCondition("[1,2,3,4,5,6,7,8,9,10].all(a, [1,2,3,4,5,6,7,8,9,10].all(b, [1,2,3,4,5,6,7,8,9,10].all(c, [1,2,3,4,5,6,7,8,9,10].all(d, [1,2,3,4,5,6,7,8,9,10].all(e, a > 0)))))")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = program.Condition(spec)
+		Expect(err).To(MatchError(expression.ErrEvaluation))
+	})
+})
+
+var _ = Describe("Value", func() {
+	vars := expression.Vars{
+		Spec: map[string]any{
+			"resources": map[string]any{"memory": map[string]any{"min": float64(512)}},
+		},
+		Instance:  expression.Instance{UID: "0f8e2c1a-9b7d-4e2f-8c3a-1d2e3f4a5b6c", DisplayName: "Web Server"},
+		Requester: expression.Requester{Tenant: "team-a", Actor: "alice"},
+	}
+
+	DescribeTable("evaluation",
+		func(source string, expected any) {
+			program, err := expression.CompileValue(source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(program.Value(vars)).To(Equal(expected))
+		},
+		Entry("name from the instance", `instance.display_name.lowerAscii().replace(" ", "-") + "-" + instance.uid.substring(0, 8)`, "web-server-0f8e2c1a"),
+		Entry("arithmetic over the spec", "spec.resources.memory.min * 2.0", float64(1024)),
+		Entry("requester", `{"owner": requester.actor, "tenant": requester.tenant}`, map[string]any{"owner": "alice", "tenant": "team-a"}),
+		Entry("list", "[1, 2]", []any{float64(1), float64(2)}),
+		Entry("quantity arithmetic", `formatQuantity(quantity("2GB") * 2 + quantity("512MB"))`, "4608MB"),
+		Entry("quantity in the largest exact unit", `formatQuantity(quantity("1024GB"))`, "1TB"),
+	)
+
+	It("should report sizes that are not quantities", func() {
+		program, err := expression.CompileValue(`formatQuantity(quantity("lots"))`)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = program.Value(vars)
+		Expect(err).To(MatchError(expression.ErrEvaluation))
+		Expect(err).To(MatchError(ContainSubstring("invalid quantity")))
+	})
+
+	It("should reject results larger than the size limit", func() {
+		program, err := expression.CompileValue("spec.blob + spec.blob")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = program.Value(expression.Vars{Spec: map[string]any{"blob": strings.Repeat("x", 40<<10)}})
+		Expect(err).To(MatchError(ContainSubstring("result larger than")))
+	})
+
+	It("should reject expressions longer than the size limit", func() {
+		_, err := expression.CompileValue(strings.Repeat("1 + ", 2000) + "1")
+		Expect(err).To(MatchError(expression.ErrInvalidExpression))
+	})
+
+	It("should not expose other variables", func() {
+		_, err := expression.CompileValue("env")
+		Expect(err).To(MatchError(expression.ErrInvalidExpression))
+	})
+})
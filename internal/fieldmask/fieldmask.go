@@ -0,0 +1,154 @@
+package fieldmask
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrInvalidReadMask is returned when a read mask is malformed or names a field
+// the resource does not have
+var ErrInvalidReadMask = errors.New("invalid read mask")
+
+// Mask is a read mask (AEP-157): the dot-separated JSON paths of the fields
+// to return. A nil Mask returns every field.
+type Mask []string
+
+// Parse parses a comma-separated read mask such as "uid,spec.service_type".
+// An empty mask and "*" select every field.
+func Parse(s string) (Mask, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "*" {
+		return nil, nil
+	}
+	var m Mask
+	for _, path := range strings.Split(s, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" || strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") || strings.Contains(path, "..") {
+			return nil, fmt.Errorf("%w: %q is not a field path", ErrInvalidReadMask, path)
+		}
+		m = append(m, path)
+	}
+	return m, nil
+}
+
+// Validate checks that every path of the mask names a field of resource, a
+// value of the resource type. Fields of maps and of untyped values are not
+// checked.
+func (m Mask) Validate(resource any) error {
+	t := reflect.TypeOf(resource)
+	for _, path := range m {
+		if !hasPath(t, strings.Split(path, ".")) {
+			return fmt.Errorf("%w: %q is not a field of %s", ErrInvalidReadMask, path, indirect(t).Name())
+		}
+	}
+	return nil
+}
+
+// Selects reports whether the mask returns the field at path, or any field
+// under it
+func (m Mask) Selects(path string) bool {
+	if m == nil {
+		return true
+	}
+	for _, p := range m {
+		if p == path || strings.HasPrefix(p, path+".") || strings.HasPrefix(path, p+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns the JSON representation of v with only the fields of the
+// mask. Paths through arrays apply to each of their elements.
+func (m Mask) Apply(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var full map[string]any
+	if err := json.Unmarshal(data, &full); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return full, nil
+	}
+	masked := map[string]any{}
+	for _, path := range m {
+		copyPath(masked, full, strings.Split(path, "."))
+	}
+	return masked, nil
+}
+
+// copyPath copies the value at path from src to dst, creating the objects
+// and arrays leading to it
+func copyPath(dst, src map[string]any, path []string) {
+	value, ok := src[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		dst[path[0]] = value
+		return
+	}
+	switch value := value.(type) {
+	case map[string]any:
+		child, _ := dst[path[0]].(map[string]any)
+		if child == nil {
+			child = map[string]any{}
+			dst[path[0]] = child
+		}
+		copyPath(child, value, path[1:])
+	case []any:
+		elems, _ := dst[path[0]].([]any)
+		if elems == nil {
+			elems = make([]any, len(value))
+			dst[path[0]] = elems
+		}
+		for i, elem := range value {
+			obj, ok := elem.(map[string]any)
+			if !ok {
+				continue
+			}
+			child, _ := elems[i].(map[string]any)
+			if child == nil {
+				child = map[string]any{}
+				elems[i] = child
+			}
+			copyPath(child, obj, path[1:])
+		}
+	}
+}
+
+// hasPath reports whether type t has a field at path, following JSON field names
+func hasPath(t reflect.Type, path []string) bool {
+	t = indirect(t)
+	for t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = indirect(t.Elem())
+	}
+	if len(path) == 0 {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Map, reflect.Interface:
+		return true
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name == path[0] {
+				return hasPath(f.Type, path[1:])
+			}
+		}
+	}
+	return false
+}
+
+// indirect returns the type pointed to by pointer types
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,99 @@
+package fieldmask_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+)
+
+type resource struct {
+	Uid         *string        `json:"uid,omitempty"`
+	DisplayName string         `json:"display_name"`
+	Spec        resourceSpec   `json:"spec"`
+	Labels      map[string]any `json:"labels,omitempty"`
+}
+
+type resourceSpec struct {
+	ServiceType string  `json:"service_type"`
+	Fields      []field `json:"fields"`
+}
+
+type field struct {
+	Path    string `json:"path"`
+	Default any    `json:"default,omitempty"`
+}
+
+var _ = Describe("Mask", func() {
+	uid := "small-vm"
+	r := resource{
+		Uid:         &uid,
+		DisplayName: "Small VM",
+		Spec: resourceSpec{
+			ServiceType: "vm",
+			Fields:      []field{{Path: "spec.vcpu.count", Default: 2}, {Path: "spec.memory.size", Default: "4GB"}},
+		},
+	}
+
+	DescribeTable("Parse",
+		func(s string, expected fieldmask.Mask) {
+			m, err := fieldmask.Parse(s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m).To(Equal(expected))
+		},
+		Entry("empty", "", nil),
+		Entry("wildcard", "*", nil),
+		Entry("paths", "uid, spec.service_type", fieldmask.Mask{"uid", "spec.service_type"}),
+	)
+
+	DescribeTable("Parse rejects malformed masks",
+		func(s string) {
+			_, err := fieldmask.Parse(s)
+			Expect(err).To(MatchError(fieldmask.ErrInvalidReadMask))
+		},
+		Entry("empty path", "uid,,display_name"),
+		Entry("empty segment", "spec..service_type"),
+		Entry("trailing dot", "spec."),
+	)
+
+	It("should validate paths against the JSON fields of the resource", func() {
+		Expect(fieldmask.Mask{"uid", "spec.fields.path", "labels.team"}.Validate(r)).To(Succeed())
+		Expect(fieldmask.Mask{"spec.serviceType"}.Validate(r)).To(MatchError(fieldmask.ErrInvalidReadMask))
+		Expect(fieldmask.Mask{"display_name.first"}.Validate(r)).To(MatchError(fieldmask.ErrInvalidReadMask))
+	})
+
+	It("should report the fields it selects", func() {
+		m := fieldmask.Mask{"uid", "spec.service_type"}
+		Expect(m.Selects("spec")).To(BeTrue())
+		Expect(m.Selects("spec.service_type")).To(BeTrue())
+		Expect(m.Selects("spec.fields")).To(BeFalse())
+		Expect(fieldmask.Mask{"spec"}.Selects("spec.fields")).To(BeTrue())
+		Expect(fieldmask.Mask(nil).Selects("spec.fields")).To(BeTrue())
+	})
+
+	It("should keep only the fields of the mask", func() {
+		masked, err := fieldmask.Mask{"uid", "spec.service_type"}.Apply(r)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(masked).To(Equal(map[string]any{
+			"uid":  "small-vm",
+			"spec": map[string]any{"service_type": "vm"},
+		}))
+	})
+
+	It("should apply paths through arrays to each element", func() {
+		masked, err := fieldmask.Mask{"spec.fields.path"}.Apply(r)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(masked).To(Equal(map[string]any{
+			"spec": map[string]any{"fields": []any{
+				map[string]any{"path": "spec.vcpu.count"},
+				map[string]any{"path": "spec.memory.size"},
+			}},
+		}))
+	})
+
+	It("should leave out fields the resource does not set", func() {
+		masked, err := fieldmask.Mask{"uid", "labels"}.Apply(r)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(masked).To(Equal(map[string]any{"uid": "small-vm"}))
+	})
+})
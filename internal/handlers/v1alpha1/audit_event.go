@@ -0,0 +1,38 @@
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+func (h *Handler) ListAuditEvents(ctx context.Context, request server.ListAuditEventsRequestObject) (server.ListAuditEventsResponseObject, error) {
+	// Build service request from HTTP params
+	opts := &service.AuditEventListOptions{
+		PageToken:   request.Params.PageToken,
+		MaxPageSize: request.Params.MaxPageSize,
+		Resource:    request.Params.Resource,
+		Actor:       request.Params.Actor,
+		StartTime:   request.Params.StartTime,
+		EndTime:     request.Params.EndTime,
+		Parent:      request.Params.Parent,
+	}
+
+	// Call service layer
+	result, err := h.service.AuditEvent().List(ctx, opts)
+	if err != nil {
+		return mapListAuditEventsErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	response := server.ListAuditEvents200JSONResponse(v1alpha1.AuditEventList{
+		Results: result.AuditEvents,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
+}
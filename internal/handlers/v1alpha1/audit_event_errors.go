@@ -0,0 +1,23 @@
+package v1alpha1
+
+import (
+	"errors"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// mapListAuditEventsErrorToHTTP converts service domain errors to ListAuditEvents HTTP responses
+func mapListAuditEventsErrorToHTTP(err error) server.ListAuditEventsResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidParent), errors.Is(err, service.ErrInvalidAuditEventFilter):
+		return server.ListAuditEvents400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.ListAuditEvents403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.ListAuditEvents500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
@@ -0,0 +1,88 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1alpha1API "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// Mock AuditEventService for testing
+type mockAuditEventService struct {
+	listFunc func(ctx context.Context, opts *service.AuditEventListOptions) (*service.AuditEventListResult, error)
+}
+
+func (m *mockAuditEventService) List(ctx context.Context, opts *service.AuditEventListOptions) (*service.AuditEventListResult, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
+	return &service.AuditEventListResult{}, nil
+}
+
+var _ = Describe("AuditEvent Handler", func() {
+	var (
+		ctx          context.Context
+		handler      *v1alpha1.Handler
+		mockAService *mockAuditEventService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockAService = &mockAuditEventService{}
+		handler = v1alpha1.NewHandler(&mockService{auditEventService: mockAService})
+	})
+
+	Describe("ListAuditEvents", func() {
+		It("should pass the filters to the service", func() {
+			resource, actor := "catalog-items/small-vm", "alice"
+			start := time.Now().Add(-time.Hour)
+			var received *service.AuditEventListOptions
+			mockAService.listFunc = func(ctx context.Context, opts *service.AuditEventListOptions) (*service.AuditEventListResult, error) {
+				received = opts
+				nextPageToken := "next"
+				return &service.AuditEventListResult{
+					AuditEvents:   []v1alpha1API.AuditEvent{{Uid: "1", Action: v1alpha1API.AuditActionUpdate}},
+					NextPageToken: &nextPageToken,
+				}, nil
+			}
+
+			resp, err := handler.ListAuditEvents(ctx, server.ListAuditEventsRequestObject{
+				Params: v1alpha1API.ListAuditEventsParams{Resource: &resource, Actor: &actor, StartTime: &start},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*received.Resource).To(Equal(resource))
+			Expect(*received.Actor).To(Equal(actor))
+			Expect(*received.StartTime).To(Equal(start))
+			Expect(received.EndTime).To(BeNil())
+
+			list, ok := resp.(server.ListAuditEvents200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(list.Results).To(HaveLen(1))
+			Expect(list.NextPageToken).To(Equal("next"))
+		})
+
+		It("should return 400 for invalid filters", func() {
+			mockAService.listFunc = func(ctx context.Context, opts *service.AuditEventListOptions) (*service.AuditEventListResult, error) {
+				return nil, service.ErrInvalidAuditEventFilter
+			}
+			resp, err := handler.ListAuditEvents(ctx, server.ListAuditEventsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.ListAuditEvents400JSONResponse{}))
+		})
+
+		It("should return 403 for the parent of another tenant", func() {
+			mockAService.listFunc = func(ctx context.Context, opts *service.AuditEventListOptions) (*service.AuditEventListResult, error) {
+				return nil, service.ErrTenantMismatch
+			}
+			resp, err := handler.ListAuditEvents(ctx, server.ListAuditEventsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.ListAuditEvents403JSONResponse{}))
+		})
+	})
+})
@@ -5,64 +5,217 @@ import (
 
 	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
 )
 
 func (h *Handler) ListCatalogItems(ctx context.Context, request server.ListCatalogItemsRequestObject) (server.ListCatalogItemsResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.ListCatalogItems500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Build service request from HTTP params
+	mask, err := readMask(request.Params.ReadMask, v1alpha1.CatalogItem{})
+	if err != nil {
+		return mapListCatalogItemsErrorToHTTP(err), nil
+	}
+	opts := &service.CatalogItemListOptions{
+		PageToken:     request.Params.PageToken,
+		MaxPageSize:   request.Params.MaxPageSize,
+		ServiceType:   request.Params.ServiceType,
+		Parent:        request.Params.Parent,
+		ReadMask:      mask,
+		SkipTotalSize: request.Params.SkipTotalSize != nil && *request.Params.SkipTotalSize,
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItem().List(ctx, opts)
+	if err != nil {
+		return mapListCatalogItemsErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	if mask != nil {
+		return maskList(mask, result.CatalogItems, result.NextPageToken, result.TotalSize)
+	}
+	response := server.ListCatalogItems200JSONResponse(v1alpha1.CatalogItemList{
+		Results:   result.CatalogItems,
+		TotalSize: result.TotalSize,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
 }
 
 func (h *Handler) CreateCatalogItem(ctx context.Context, request server.CreateCatalogItemRequestObject) (server.CreateCatalogItemResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.CreateCatalogItem500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Build service request from HTTP params
+	req := &service.CreateCatalogItemRequest{
+		ID:          request.Params.Id,
+		Parent:      request.Params.Parent,
+		ApiVersion:  derefString(request.Body.ApiVersion),
+		DisplayName: derefString(request.Body.DisplayName),
+	}
+	if request.Body.Spec != nil {
+		req.ServiceType = derefString(request.Body.Spec.ServiceType)
+		req.ServiceTypeVersion = derefString(request.Body.Spec.ServiceTypeVersion)
+		if request.Body.Spec.Fields != nil {
+			req.Fields = *request.Body.Spec.Fields
+		}
+	}
+	var err error
+	if req.RequestID, err = requestID(request.Params.RequestId, request.Params.IdempotencyKey); err != nil {
+		return mapCreateCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItem().Create(ctx, req)
+	if err != nil {
+		return mapCreateCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.CreateCatalogItem201JSONResponse(*result), nil
 }
 
 func (h *Handler) GetCatalogItem(ctx context.Context, request server.GetCatalogItemRequestObject) (server.GetCatalogItemResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.GetCatalogItem500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Build service request from HTTP params
+	mask, err := readMask(request.Params.ReadMask, v1alpha1.CatalogItem{})
+	if err != nil {
+		return mapGetCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItem().Get(ctx, request.CatalogItemId)
+	if err != nil {
+		return mapGetCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	if mask != nil {
+		return maskResource(mask, result)
+	}
+	return server.GetCatalogItem200JSONResponse(*result), nil
+}
+
+func (h *Handler) BatchGetCatalogItems(ctx context.Context, request server.BatchGetCatalogItemsRequestObject) (server.BatchGetCatalogItemsResponseObject, error) {
+	// Call service layer
+	result, err := h.service.CatalogItem().BatchGet(ctx, request.Params.Ids)
+	if err != nil {
+		return mapBatchGetCatalogItemsErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.BatchGetCatalogItems200JSONResponse(*result), nil
 }
 
 func (h *Handler) UpdateCatalogItem(ctx context.Context, request server.UpdateCatalogItemRequestObject) (server.UpdateCatalogItemResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.UpdateCatalogItem500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Build service request from the merge patch body
+	req := &service.UpdateCatalogItemRequest{
+		ApiVersion:  request.Body.ApiVersion,
+		DisplayName: request.Body.DisplayName,
+	}
+	if request.Body.Spec != nil {
+		req.ServiceType = request.Body.Spec.ServiceType
+		req.ServiceTypeVersion = request.Body.Spec.ServiceTypeVersion
+		req.Fields = request.Body.Spec.Fields
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItem().Update(ctx, request.CatalogItemId, req)
+	if err != nil {
+		return mapUpdateCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.UpdateCatalogItem200JSONResponse(*result), nil
+}
+
+func (h *Handler) ApplyCatalogItem(ctx context.Context, request server.ApplyCatalogItemRequestObject) (server.ApplyCatalogItemResponseObject, error) {
+	// Build service request from HTTP params
+	req := &service.CreateCatalogItemRequest{
+		Parent:      request.Params.Parent,
+		ApiVersion:  derefString(request.Body.ApiVersion),
+		DisplayName: derefString(request.Body.DisplayName),
+	}
+	if request.Body.Spec != nil {
+		req.ServiceType = derefString(request.Body.Spec.ServiceType)
+		req.ServiceTypeVersion = derefString(request.Body.Spec.ServiceTypeVersion)
+		if request.Body.Spec.Fields != nil {
+			req.Fields = *request.Body.Spec.Fields
+		}
+	}
+	validateOnly := request.Params.ValidateOnly != nil && *request.Params.ValidateOnly
+
+	// Call service layer
+	result, err := h.service.CatalogItem().Apply(ctx, request.CatalogItemId, req, validateOnly)
+	if err != nil {
+		return mapApplyCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.ApplyCatalogItem200JSONResponse(*result), nil
 }
 
 func (h *Handler) DeleteCatalogItem(ctx context.Context, request server.DeleteCatalogItemRequestObject) (server.DeleteCatalogItemResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.DeleteCatalogItem500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Call service layer
+	if err := h.service.CatalogItem().Delete(ctx, request.CatalogItemId); err != nil {
+		return mapDeleteCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.DeleteCatalogItem204Response{}, nil
+}
+
+func (h *Handler) ListCatalogItemRevisions(ctx context.Context, request server.ListCatalogItemRevisionsRequestObject) (server.ListCatalogItemRevisionsResponseObject, error) {
+	// Build service request from HTTP params
+	opts := &service.CatalogItemRevisionListOptions{
+		PageToken:   request.Params.PageToken,
+		MaxPageSize: request.Params.MaxPageSize,
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItem().ListRevisions(ctx, request.CatalogItemId, opts)
+	if err != nil {
+		return mapListCatalogItemRevisionsErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	response := server.ListCatalogItemRevisions200JSONResponse(v1alpha1.CatalogItemRevisionList{
+		Results: result.CatalogItemRevisions,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
+}
+
+func (h *Handler) RollbackCatalogItem(ctx context.Context, request server.RollbackCatalogItemRequestObject) (server.RollbackCatalogItemResponseObject, error) {
+	// Call service layer
+	result, err := h.service.CatalogItem().Rollback(ctx, request.CatalogItemId, int(request.Body.Revision))
+	if err != nil {
+		return mapRollbackCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.RollbackCatalogItem200JSONResponse(*result), nil
+}
+
+func (h *Handler) ConvertCatalogItem(ctx context.Context, request server.ConvertCatalogItemRequestObject) (server.ConvertCatalogItemResponseObject, error) {
+	// Call service layer
+	result, err := h.service.CatalogItem().Convert(ctx, request.CatalogItemId, request.Body.ServiceTypeVersion)
+	if err != nil {
+		return mapConvertCatalogItemErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.ConvertCatalogItem200JSONResponse(*result), nil
+}
+
+func (h *Handler) GetCatalogItemForm(ctx context.Context, request server.GetCatalogItemFormRequestObject) (server.GetCatalogItemFormResponseObject, error) {
+	// Call service layer
+	result, err := h.service.CatalogItem().Form(ctx, request.CatalogItemId)
+	if err != nil {
+		return mapGetCatalogItemFormErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.GetCatalogItemForm200JSONResponse(*result), nil
 }
@@ -0,0 +1,206 @@
+package v1alpha1
+
+import (
+	"errors"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// mapListCatalogItemsErrorToHTTP converts service domain errors to ListCatalogItems HTTP responses
+func mapListCatalogItemsErrorToHTTP(err error) server.ListCatalogItemsResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidParent), errors.Is(err, fieldmask.ErrInvalidReadMask):
+		return server.ListCatalogItems400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.ListCatalogItems403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.ListCatalogItems500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapCreateCatalogItemErrorToHTTP converts service domain errors to CreateCatalogItem HTTP responses
+func mapCreateCatalogItemErrorToHTTP(err error) server.CreateCatalogItemResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogItem),
+		errors.Is(err, service.ErrServiceTypeNotFound),
+		errors.Is(err, service.ErrInvalidParent),
+		errors.Is(err, service.ErrInvalidRequestID):
+		// Validation errors -> 400 Bad Request
+		return server.CreateCatalogItem400JSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err))
+	case errors.Is(err, service.ErrServiceTypeSunset):
+		return server.CreateCatalogItem400JSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 400, "Bad Request", err))
+	case errors.Is(err, service.ErrTenantMismatch), errors.Is(err, service.ErrAdminRequired):
+		return server.CreateCatalogItem403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrCatalogItemIDTaken):
+		// Conflict errors -> 409 Conflict
+		return server.CreateCatalogItem409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(newError(v1alpha1.ALREADYEXISTS, 409, "Conflict", err)),
+		}
+	case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return server.CreateCatalogItem409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(idempotencyConflict(err)),
+		}
+	default:
+		return server.CreateCatalogItem500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapApplyCatalogItemErrorToHTTP converts service domain errors to ApplyCatalogItem HTTP responses
+func mapApplyCatalogItemErrorToHTTP(err error) server.ApplyCatalogItemResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogItem),
+		errors.Is(err, service.ErrServiceTypeNotFound),
+		errors.Is(err, service.ErrInvalidParent):
+		// Validation errors -> 400 Bad Request
+		return server.ApplyCatalogItem400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrServiceTypeSunset):
+		return server.ApplyCatalogItem400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch), errors.Is(err, service.ErrAdminRequired):
+		return server.ApplyCatalogItem403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrCatalogItemIDTaken):
+		// Conflict errors -> 409 Conflict
+		return server.ApplyCatalogItem409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(newError(v1alpha1.ALREADYEXISTS, 409, "Conflict", err)),
+		}
+	default:
+		return server.ApplyCatalogItem500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapGetCatalogItemErrorToHTTP converts service domain errors to GetCatalogItem HTTP responses
+func mapGetCatalogItemErrorToHTTP(err error) server.GetCatalogItemResponseObject {
+	switch {
+	case errors.Is(err, fieldmask.ErrInvalidReadMask):
+		return server.GetCatalogItem400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrCatalogItemNotFound):
+		return server.GetCatalogItem404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.GetCatalogItem500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapBatchGetCatalogItemsErrorToHTTP converts service domain errors to BatchGetCatalogItems HTTP responses
+func mapBatchGetCatalogItemsErrorToHTTP(err error) server.BatchGetCatalogItemsResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidBatchRequest):
+		return server.BatchGetCatalogItems400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrCatalogItemNotFound):
+		return server.BatchGetCatalogItems404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.BatchGetCatalogItems500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapUpdateCatalogItemErrorToHTTP converts service domain errors to UpdateCatalogItem HTTP responses
+func mapUpdateCatalogItemErrorToHTTP(err error) server.UpdateCatalogItemResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogItem):
+		return server.UpdateCatalogItem400JSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err))
+	case errors.Is(err, service.ErrAdminRequired):
+		return server.UpdateCatalogItem403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrCatalogItemNotFound):
+		return server.UpdateCatalogItem404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.UpdateCatalogItem500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapDeleteCatalogItemErrorToHTTP converts service domain errors to DeleteCatalogItem HTTP responses
+func mapDeleteCatalogItemErrorToHTTP(err error) server.DeleteCatalogItemResponseObject {
+	switch {
+	case errors.Is(err, service.ErrAdminRequired):
+		return server.DeleteCatalogItem403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrCatalogItemNotFound):
+		return server.DeleteCatalogItem404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	case errors.Is(err, service.ErrCatalogItemHasInstances):
+		return server.DeleteCatalogItem409JSONResponse{
+			HasInstancesJSONResponse: server.HasInstancesJSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 409, "Conflict", err)),
+		}
+	default:
+		return server.DeleteCatalogItem500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapListCatalogItemRevisionsErrorToHTTP converts service domain errors to ListCatalogItemRevisions HTTP responses
+func mapListCatalogItemRevisionsErrorToHTTP(err error) server.ListCatalogItemRevisionsResponseObject {
+	switch {
+	case errors.Is(err, service.ErrCatalogItemNotFound):
+		return server.ListCatalogItemRevisions404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.ListCatalogItemRevisions500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapRollbackCatalogItemErrorToHTTP converts service domain errors to RollbackCatalogItem HTTP responses
+func mapRollbackCatalogItemErrorToHTTP(err error) server.RollbackCatalogItemResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogItem):
+		return server.RollbackCatalogItem400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrAdminRequired):
+		return server.RollbackCatalogItem403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrCatalogItemNotFound),
+		errors.Is(err, service.ErrCatalogItemRevisionNotFound):
+		return server.RollbackCatalogItem404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.RollbackCatalogItem500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapConvertCatalogItemErrorToHTTP converts service domain errors to ConvertCatalogItem HTTP responses
+func mapConvertCatalogItemErrorToHTTP(err error) server.ConvertCatalogItemResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidConversion):
+		return server.ConvertCatalogItem400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrConversionUnavailable):
+		return server.ConvertCatalogItem400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrCatalogItemNotFound):
+		return server.ConvertCatalogItem404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.ConvertCatalogItem500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapGetCatalogItemFormErrorToHTTP converts service domain errors to GetCatalogItemForm HTTP responses
+func mapGetCatalogItemFormErrorToHTTP(err error) server.GetCatalogItemFormResponseObject {
+	switch {
+	case errors.Is(err, service.ErrCatalogItemNotFound):
+		return server.GetCatalogItemForm404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.GetCatalogItemForm500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
@@ -5,52 +5,165 @@ import (
 
 	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
 )
 
 func (h *Handler) ListCatalogItemInstances(ctx context.Context, request server.ListCatalogItemInstancesRequestObject) (server.ListCatalogItemInstancesResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.ListCatalogItemInstances500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Build service request from HTTP params
+	mask, err := readMask(request.Params.ReadMask, v1alpha1.CatalogItemInstance{})
+	if err != nil {
+		return mapListCatalogItemInstancesErrorToHTTP(err), nil
+	}
+	opts := &service.CatalogItemInstanceListOptions{
+		PageToken:     request.Params.PageToken,
+		MaxPageSize:   request.Params.MaxPageSize,
+		CatalogItemId: request.Params.CatalogItemId,
+		Parent:        request.Params.Parent,
+		ReadMask:      mask,
+		SkipTotalSize: request.Params.SkipTotalSize != nil && *request.Params.SkipTotalSize,
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().List(ctx, opts)
+	if err != nil {
+		return mapListCatalogItemInstancesErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	if mask != nil {
+		return maskList(mask, result.CatalogItemInstances, result.NextPageToken, result.TotalSize)
+	}
+	response := server.ListCatalogItemInstances200JSONResponse(v1alpha1.CatalogItemInstanceList{
+		Results:   result.CatalogItemInstances,
+		TotalSize: result.TotalSize,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
+}
+
+func (h *Handler) CountCatalogItemInstances(ctx context.Context, request server.CountCatalogItemInstancesRequestObject) (server.CountCatalogItemInstancesResponseObject, error) {
+	// Build service request from HTTP params
+	opts := &service.CatalogItemInstanceCountOptions{
+		GroupBy:       v1alpha1.CatalogItemInstanceCountsGroupBy(request.Params.GroupBy),
+		CatalogItemId: request.Params.CatalogItemId,
+		Parent:        request.Params.Parent,
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().Count(ctx, opts)
+	if err != nil {
+		return mapCountCatalogItemInstancesErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.CountCatalogItemInstances200JSONResponse(*result), nil
 }
 
 func (h *Handler) CreateCatalogItemInstance(ctx context.Context, request server.CreateCatalogItemInstanceRequestObject) (server.CreateCatalogItemInstanceResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.CreateCatalogItemInstance500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Build service request from HTTP params
+	req := &service.CreateCatalogItemInstanceRequest{
+		ID:            request.Params.Id,
+		ApiVersion:    request.Body.ApiVersion,
+		DisplayName:   request.Body.DisplayName,
+		CatalogItemId: request.Body.Spec.CatalogItemId,
+		UserValues:    request.Body.Spec.UserValues,
+	}
+	var err error
+	if req.RequestID, err = requestID(request.Params.RequestId, request.Params.IdempotencyKey); err != nil {
+		return mapCreateCatalogItemInstanceErrorToHTTP(err), nil
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().Create(ctx, req)
+	if err != nil {
+		return mapCreateCatalogItemInstanceErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.CreateCatalogItemInstance202JSONResponse(*result), nil
+}
+
+func (h *Handler) BatchCreateCatalogItemInstances(ctx context.Context, request server.BatchCreateCatalogItemInstancesRequestObject) (server.BatchCreateCatalogItemInstancesResponseObject, error) {
+	// Build service request from HTTP params
+	req := &service.BatchCreateCatalogItemInstancesRequest{
+		Requests: make([]service.CreateCatalogItemInstanceRequest, len(request.Body.Requests)),
+	}
+	for i, r := range request.Body.Requests {
+		req.Requests[i] = service.CreateCatalogItemInstanceRequest{
+			ID:            r.Id,
+			ApiVersion:    r.CatalogItemInstance.ApiVersion,
+			DisplayName:   r.CatalogItemInstance.DisplayName,
+			CatalogItemId: r.CatalogItemInstance.Spec.CatalogItemId,
+			UserValues:    r.CatalogItemInstance.Spec.UserValues,
+		}
+	}
+	var err error
+	if req.RequestID, err = requestID(request.Params.RequestId, request.Params.IdempotencyKey); err != nil {
+		return mapBatchCreateCatalogItemInstancesErrorToHTTP(err), nil
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().BatchCreate(ctx, req)
+	if err != nil {
+		return mapBatchCreateCatalogItemInstancesErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.BatchCreateCatalogItemInstances202JSONResponse(*result), nil
 }
 
 func (h *Handler) GetCatalogItemInstance(ctx context.Context, request server.GetCatalogItemInstanceRequestObject) (server.GetCatalogItemInstanceResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.GetCatalogItemInstance500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Build service request from HTTP params
+	mask, err := readMask(request.Params.ReadMask, v1alpha1.CatalogItemInstance{})
+	if err != nil {
+		return mapGetCatalogItemInstanceErrorToHTTP(err), nil
+	}
+
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().Get(ctx, request.CatalogItemInstanceId)
+	if err != nil {
+		return mapGetCatalogItemInstanceErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	if mask != nil {
+		return maskResource(mask, result)
+	}
+	return server.GetCatalogItemInstance200JSONResponse(*result), nil
+}
+
+func (h *Handler) BatchGetCatalogItemInstances(ctx context.Context, request server.BatchGetCatalogItemInstancesRequestObject) (server.BatchGetCatalogItemInstancesResponseObject, error) {
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().BatchGet(ctx, request.Params.Ids)
+	if err != nil {
+		return mapBatchGetCatalogItemInstancesErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.BatchGetCatalogItemInstances200JSONResponse(*result), nil
+}
+
+func (h *Handler) ConvertCatalogItemInstance(ctx context.Context, request server.ConvertCatalogItemInstanceRequestObject) (server.ConvertCatalogItemInstanceResponseObject, error) {
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().Convert(ctx, request.CatalogItemInstanceId, request.Body.ServiceTypeVersion)
+	if err != nil {
+		return mapConvertCatalogItemInstanceErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.ConvertCatalogItemInstance200JSONResponse(*result), nil
 }
 
 func (h *Handler) DeleteCatalogItemInstance(ctx context.Context, request server.DeleteCatalogItemInstanceRequestObject) (server.DeleteCatalogItemInstanceResponseObject, error) {
-	detail := "endpoint not implemented"
-	return server.DeleteCatalogItemInstance500JSONResponse{
-		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-			Type:   v1alpha1.UNIMPLEMENTED,
-			Status: 500,
-			Title:  "Not Implemented",
-			Detail: &detail,
-		},
-	}, nil
+	// Call service layer
+	result, err := h.service.CatalogItemInstance().Delete(ctx, request.CatalogItemInstanceId)
+	if err != nil {
+		return mapDeleteCatalogItemInstanceErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.DeleteCatalogItemInstance202JSONResponse(*result), nil
 }
@@ -0,0 +1,177 @@
+package v1alpha1
+
+import (
+	"errors"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// mapListCatalogItemInstancesErrorToHTTP converts service domain errors to ListCatalogItemInstances HTTP responses
+func mapListCatalogItemInstancesErrorToHTTP(err error) server.ListCatalogItemInstancesResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidParent), errors.Is(err, fieldmask.ErrInvalidReadMask):
+		return server.ListCatalogItemInstances400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.ListCatalogItemInstances403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.ListCatalogItemInstances500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapCountCatalogItemInstancesErrorToHTTP converts service domain errors to CountCatalogItemInstances HTTP responses
+func mapCountCatalogItemInstancesErrorToHTTP(err error) server.CountCatalogItemInstancesResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogItemInstance), errors.Is(err, service.ErrInvalidParent):
+		return server.CountCatalogItemInstances400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.CountCatalogItemInstances403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.CountCatalogItemInstances500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapCreateCatalogItemInstanceErrorToHTTP converts service domain errors to CreateCatalogItemInstance HTTP responses
+func mapCreateCatalogItemInstanceErrorToHTTP(err error) server.CreateCatalogItemInstanceResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogItemInstance),
+		errors.Is(err, service.ErrInvalidRequestID):
+		// Validation errors -> 400 Bad Request
+		return server.CreateCatalogItemInstance400JSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err))
+	case errors.Is(err, service.ErrServiceTypeSunset):
+		return server.CreateCatalogItemInstance400JSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 400, "Bad Request", err))
+	case errors.Is(err, service.ErrCatalogItemInstanceIDTaken):
+		// Conflict errors -> 409 Conflict
+		return server.CreateCatalogItemInstance409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(newError(v1alpha1.ALREADYEXISTS, 409, "Conflict", err)),
+		}
+	case errors.Is(err, service.ErrQuotaExceeded):
+		// Quota errors -> 429 Too Many Requests
+		return server.CreateCatalogItemInstance429JSONResponse{
+			ResourceExhaustedJSONResponse: server.ResourceExhaustedJSONResponse(newError(v1alpha1.RESOURCEEXHAUSTED, 429, "Quota Exceeded", err)),
+		}
+	case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return server.CreateCatalogItemInstance409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(idempotencyConflict(err)),
+		}
+	default:
+		return server.CreateCatalogItemInstance500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapBatchCreateCatalogItemInstancesErrorToHTTP converts service domain errors to BatchCreateCatalogItemInstances HTTP responses
+func mapBatchCreateCatalogItemInstancesErrorToHTTP(err error) server.BatchCreateCatalogItemInstancesResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidCatalogItemInstance),
+		errors.Is(err, service.ErrInvalidBatchRequest),
+		errors.Is(err, service.ErrInvalidRequestID):
+		// Validation errors -> 400 Bad Request
+		return server.BatchCreateCatalogItemInstances400JSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err))
+	case errors.Is(err, service.ErrServiceTypeSunset):
+		return server.BatchCreateCatalogItemInstances400JSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 400, "Bad Request", err))
+	case errors.Is(err, service.ErrCatalogItemInstanceIDTaken):
+		// Conflict errors -> 409 Conflict
+		return server.BatchCreateCatalogItemInstances409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(newError(v1alpha1.ALREADYEXISTS, 409, "Conflict", err)),
+		}
+	case errors.Is(err, service.ErrQuotaExceeded):
+		// Quota errors -> 429 Too Many Requests
+		return server.BatchCreateCatalogItemInstances429JSONResponse{
+			ResourceExhaustedJSONResponse: server.ResourceExhaustedJSONResponse(newError(v1alpha1.RESOURCEEXHAUSTED, 429, "Quota Exceeded", err)),
+		}
+	case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return server.BatchCreateCatalogItemInstances409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(idempotencyConflict(err)),
+		}
+	default:
+		return server.BatchCreateCatalogItemInstances500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapGetCatalogItemInstanceErrorToHTTP converts service domain errors to GetCatalogItemInstance HTTP responses
+func mapGetCatalogItemInstanceErrorToHTTP(err error) server.GetCatalogItemInstanceResponseObject {
+	switch {
+	case errors.Is(err, fieldmask.ErrInvalidReadMask):
+		return server.GetCatalogItemInstance400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrCatalogItemInstanceNotFound):
+		return server.GetCatalogItemInstance404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.GetCatalogItemInstance500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapBatchGetCatalogItemInstancesErrorToHTTP converts service domain errors to BatchGetCatalogItemInstances HTTP responses
+func mapBatchGetCatalogItemInstancesErrorToHTTP(err error) server.BatchGetCatalogItemInstancesResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidBatchRequest):
+		return server.BatchGetCatalogItemInstances400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrCatalogItemInstanceNotFound):
+		return server.BatchGetCatalogItemInstances404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.BatchGetCatalogItemInstances500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapConvertCatalogItemInstanceErrorToHTTP converts service domain errors to ConvertCatalogItemInstance HTTP responses
+func mapConvertCatalogItemInstanceErrorToHTTP(err error) server.ConvertCatalogItemInstanceResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidConversion):
+		return server.ConvertCatalogItemInstance400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrConversionUnavailable):
+		return server.ConvertCatalogItemInstance400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrCatalogItemInstanceNotFound):
+		return server.ConvertCatalogItemInstance404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.ConvertCatalogItemInstance500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapDeleteCatalogItemInstanceErrorToHTTP converts service domain errors to DeleteCatalogItemInstance HTTP responses
+func mapDeleteCatalogItemInstanceErrorToHTTP(err error) server.DeleteCatalogItemInstanceResponseObject {
+	switch {
+	case errors.Is(err, service.ErrCatalogItemInstanceNotFound):
+		return server.DeleteCatalogItemInstance404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.DeleteCatalogItemInstance500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapWatchCatalogItemInstancesErrorToHTTP converts service domain errors to WatchCatalogItemInstances HTTP responses
+func mapWatchCatalogItemInstancesErrorToHTTP(err error) server.WatchCatalogItemInstancesResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidParent), errors.Is(err, service.ErrInvalidResumeToken):
+		return server.WatchCatalogItemInstances400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.WatchCatalogItemInstances403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrResumeTokenExpired):
+		return server.WatchCatalogItemInstances410JSONResponse{
+			ResumeTokenExpiredJSONResponse: server.ResumeTokenExpiredJSONResponse(newError(v1alpha1.OUTOFRANGE, 410, "Resume Token Expired", err)),
+		}
+	default:
+		return server.WatchCatalogItemInstances500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
@@ -0,0 +1,94 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// watchHeartbeat is how long a watch stream may stay idle before a BOOKMARK
+// event is sent, keeping proxies from closing it and the resume token fresh
+const watchHeartbeat = 30 * time.Second
+
+func (h *Handler) WatchCatalogItemInstances(ctx context.Context, request server.WatchCatalogItemInstancesRequestObject) (server.WatchCatalogItemInstancesResponseObject, error) {
+	// Build service request from HTTP params
+	req := &service.WatchCatalogItemInstancesRequest{
+		ResumeToken:   request.Params.ResumeToken,
+		CatalogItemId: request.Params.CatalogItemId,
+		Parent:        request.Params.Parent,
+	}
+	if req.ResumeToken == nil {
+		req.ResumeToken = request.Params.LastEventID
+	}
+
+	// Call service layer
+	watcher, err := h.service.CatalogItemInstance().Watch(ctx, req)
+	if err != nil {
+		return mapWatchCatalogItemInstancesErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return watchCatalogItemInstancesResponse{ctx: ctx, watcher: watcher, heartbeat: watchHeartbeat}, nil
+}
+
+// watchCatalogItemInstancesResponse streams the events of a watcher as
+// Server-Sent Events until the request is cancelled or the watch ends
+type watchCatalogItemInstancesResponse struct {
+	ctx       context.Context
+	watcher   service.CatalogItemInstanceWatcher
+	heartbeat time.Duration
+}
+
+func (r watchCatalogItemInstancesResponse) VisitWatchCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	defer r.watcher.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(200)
+
+	rc := http.NewResponseController(w)
+	send := func(event v1alpha1.WatchEvent) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ResumeToken, event.Type, data); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if err := send(r.watcher.Bookmark()); err != nil {
+		return nil
+	}
+	for {
+		ctx, cancel := context.WithTimeout(r.ctx, r.heartbeat)
+		event, err := r.watcher.Next(ctx)
+		cancel()
+		switch {
+		case err == nil:
+			err = send(*event)
+		case errors.Is(err, context.DeadlineExceeded) && r.ctx.Err() == nil:
+			err = send(r.watcher.Bookmark())
+		default:
+			// The client reconnects with the resume token of the last event
+			if r.ctx.Err() == nil {
+				log.Printf("Watch of catalog item instances ended: %v", err)
+			}
+			return nil
+		}
+		if err != nil {
+			// The client went away
+			return nil
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1alpha1API "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// Mock CatalogItemInstanceService for testing
+type mockCatalogItemInstanceService struct {
+	watchFunc func(ctx context.Context, req *service.WatchCatalogItemInstancesRequest) (service.CatalogItemInstanceWatcher, error)
+}
+
+func (m *mockCatalogItemInstanceService) List(ctx context.Context, opts *service.CatalogItemInstanceListOptions) (*service.CatalogItemInstanceListResult, error) {
+	return &service.CatalogItemInstanceListResult{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) Count(ctx context.Context, opts *service.CatalogItemInstanceCountOptions) (*v1alpha1API.CatalogItemInstanceCounts, error) {
+	return &v1alpha1API.CatalogItemInstanceCounts{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) Create(ctx context.Context, req *service.CreateCatalogItemInstanceRequest) (*v1alpha1API.Operation, error) {
+	return &v1alpha1API.Operation{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) Get(ctx context.Context, id string) (*v1alpha1API.CatalogItemInstance, error) {
+	return &v1alpha1API.CatalogItemInstance{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) BatchGet(ctx context.Context, ids []string) (*v1alpha1API.BatchGetCatalogItemInstancesResult, error) {
+	return &v1alpha1API.BatchGetCatalogItemInstancesResult{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) BatchCreate(ctx context.Context, req *service.BatchCreateCatalogItemInstancesRequest) (*v1alpha1API.BatchCreateCatalogItemInstancesResult, error) {
+	return &v1alpha1API.BatchCreateCatalogItemInstancesResult{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) Convert(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1API.CatalogItemInstanceConversion, error) {
+	return &v1alpha1API.CatalogItemInstanceConversion{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) Delete(ctx context.Context, id string) (*v1alpha1API.Operation, error) {
+	return &v1alpha1API.Operation{}, nil
+}
+
+func (m *mockCatalogItemInstanceService) Watch(ctx context.Context, req *service.WatchCatalogItemInstancesRequest) (service.CatalogItemInstanceWatcher, error) {
+	return m.watchFunc(ctx, req)
+}
+
+// mockWatcher returns its events, then ends the watch
+type mockWatcher struct {
+	events []v1alpha1API.WatchEvent
+	closed bool
+}
+
+func (m *mockWatcher) Next(ctx context.Context) (*v1alpha1API.WatchEvent, error) {
+	if len(m.events) == 0 {
+		return nil, errors.New("watch closed")
+	}
+	event := m.events[0]
+	m.events = m.events[1:]
+	return &event, nil
+}
+
+func (m *mockWatcher) Bookmark() v1alpha1API.WatchEvent {
+	return v1alpha1API.WatchEvent{Type: v1alpha1API.WatchEventBookmark, ResumeToken: "bookmark"}
+}
+
+func (m *mockWatcher) Close() {
+	m.closed = true
+}
+
+var _ = Describe("WatchCatalogItemInstances Handler", func() {
+	var (
+		ctx            context.Context
+		handler        *v1alpha1.Handler
+		mockCIIService *mockCatalogItemInstanceService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockCIIService = &mockCatalogItemInstanceService{}
+		handler = v1alpha1.NewHandler(&mockService{catalogItemInstanceService: mockCIIService})
+	})
+
+	It("should stream the events of the watcher", func() {
+		uid := "my-vm"
+		watcher := &mockWatcher{events: []v1alpha1API.WatchEvent{{
+			Type:        v1alpha1API.WatchEventAdded,
+			Object:      &v1alpha1API.CatalogItemInstance{Uid: &uid},
+			ResumeToken: "token-1",
+		}}}
+		var received *service.WatchCatalogItemInstancesRequest
+		mockCIIService.watchFunc = func(ctx context.Context, req *service.WatchCatalogItemInstancesRequest) (service.CatalogItemInstanceWatcher, error) {
+			received = req
+			return watcher, nil
+		}
+
+		lastEventID := "token-0"
+		resp, err := handler.WatchCatalogItemInstances(ctx, server.WatchCatalogItemInstancesRequestObject{
+			Params: v1alpha1API.WatchCatalogItemInstancesParams{LastEventID: &lastEventID},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*received.ResumeToken).To(Equal("token-0"))
+
+		recorder := httptest.NewRecorder()
+		Expect(resp.VisitWatchCatalogItemInstancesResponse(recorder)).To(Succeed())
+		Expect(recorder.Code).To(Equal(200))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("text/event-stream"))
+		Expect(recorder.Flushed).To(BeTrue())
+		Expect(watcher.closed).To(BeTrue())
+
+		frames := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n\n")
+		Expect(frames).To(HaveLen(2))
+		Expect(frames[0]).To(HavePrefix("id: bookmark\nevent: BOOKMARK\ndata: "))
+		Expect(frames[1]).To(HavePrefix("id: token-1\nevent: ADDED\ndata: "))
+		Expect(frames[1]).To(ContainSubstring(`"uid":"my-vm"`))
+	})
+
+	It("should prefer the resume_token parameter over Last-Event-ID", func() {
+		var received *service.WatchCatalogItemInstancesRequest
+		mockCIIService.watchFunc = func(ctx context.Context, req *service.WatchCatalogItemInstancesRequest) (service.CatalogItemInstanceWatcher, error) {
+			received = req
+			return &mockWatcher{}, nil
+		}
+
+		resumeToken, lastEventID := "token-2", "token-1"
+		_, err := handler.WatchCatalogItemInstances(ctx, server.WatchCatalogItemInstancesRequestObject{
+			Params: v1alpha1API.WatchCatalogItemInstancesParams{ResumeToken: &resumeToken, LastEventID: &lastEventID},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*received.ResumeToken).To(Equal("token-2"))
+	})
+
+	DescribeTable("should map errors",
+		func(serviceErr error, expected any) {
+			mockCIIService.watchFunc = func(ctx context.Context, req *service.WatchCatalogItemInstancesRequest) (service.CatalogItemInstanceWatcher, error) {
+				return nil, serviceErr
+			}
+			resp, err := handler.WatchCatalogItemInstances(ctx, server.WatchCatalogItemInstancesRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(expected))
+		},
+		Entry("invalid resume token", service.ErrInvalidResumeToken, server.WatchCatalogItemInstances400JSONResponse{}),
+		Entry("tenant mismatch", service.ErrTenantMismatch, server.WatchCatalogItemInstances403JSONResponse{}),
+		Entry("expired resume token", service.ErrResumeTokenExpired, server.WatchCatalogItemInstances410JSONResponse{}),
+		Entry("unavailable", service.ErrWatchUnavailable, server.WatchCatalogItemInstances500JSONResponse{}),
+	)
+})
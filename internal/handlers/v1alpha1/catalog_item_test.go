@@ -0,0 +1,588 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1alpha1API "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// Mock CatalogItemService for testing
+type mockCatalogItemService struct {
+	listFunc     func(ctx context.Context, opts *service.CatalogItemListOptions) (*service.CatalogItemListResult, error)
+	createFunc   func(ctx context.Context, req *service.CreateCatalogItemRequest) (*v1alpha1API.CatalogItem, error)
+	getFunc      func(ctx context.Context, id string) (*v1alpha1API.CatalogItem, error)
+	batchGetFunc func(ctx context.Context, ids []string) (*v1alpha1API.BatchGetCatalogItemsResult, error)
+	updateFunc   func(ctx context.Context, id string, req *service.UpdateCatalogItemRequest) (*v1alpha1API.CatalogItem, error)
+	deleteFunc   func(ctx context.Context, id string) error
+	applyFunc    func(ctx context.Context, id string, req *service.CreateCatalogItemRequest, validateOnly bool) (*v1alpha1API.CatalogItemApplyResult, error)
+
+	listRevisionsFunc func(ctx context.Context, id string, opts *service.CatalogItemRevisionListOptions) (*service.CatalogItemRevisionListResult, error)
+	rollbackFunc      func(ctx context.Context, id string, revision int) (*v1alpha1API.CatalogItem, error)
+	convertFunc       func(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1API.CatalogItemConversion, error)
+	formFunc          func(ctx context.Context, id string) (*v1alpha1API.CatalogItemForm, error)
+}
+
+func (m *mockCatalogItemService) Form(ctx context.Context, id string) (*v1alpha1API.CatalogItemForm, error) {
+	if m.formFunc != nil {
+		return m.formFunc(ctx, id)
+	}
+	return &v1alpha1API.CatalogItemForm{}, nil
+}
+
+func (m *mockCatalogItemService) Convert(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1API.CatalogItemConversion, error) {
+	if m.convertFunc != nil {
+		return m.convertFunc(ctx, id, serviceTypeVersion)
+	}
+	return &v1alpha1API.CatalogItemConversion{}, nil
+}
+
+func (m *mockCatalogItemService) List(ctx context.Context, opts *service.CatalogItemListOptions) (*service.CatalogItemListResult, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
+	return &service.CatalogItemListResult{}, nil
+}
+
+func (m *mockCatalogItemService) Create(ctx context.Context, req *service.CreateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, req)
+	}
+	return &v1alpha1API.CatalogItem{}, nil
+}
+
+func (m *mockCatalogItemService) Get(ctx context.Context, id string) (*v1alpha1API.CatalogItem, error) {
+	if m.getFunc != nil {
+		return m.getFunc(ctx, id)
+	}
+	return &v1alpha1API.CatalogItem{}, nil
+}
+
+func (m *mockCatalogItemService) BatchGet(ctx context.Context, ids []string) (*v1alpha1API.BatchGetCatalogItemsResult, error) {
+	if m.batchGetFunc != nil {
+		return m.batchGetFunc(ctx, ids)
+	}
+	return &v1alpha1API.BatchGetCatalogItemsResult{}, nil
+}
+
+func (m *mockCatalogItemService) Update(ctx context.Context, id string, req *service.UpdateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, id, req)
+	}
+	return &v1alpha1API.CatalogItem{}, nil
+}
+
+func (m *mockCatalogItemService) Apply(ctx context.Context, id string, req *service.CreateCatalogItemRequest, validateOnly bool) (*v1alpha1API.CatalogItemApplyResult, error) {
+	if m.applyFunc != nil {
+		return m.applyFunc(ctx, id, req, validateOnly)
+	}
+	return &v1alpha1API.CatalogItemApplyResult{}, nil
+}
+
+func (m *mockCatalogItemService) Delete(ctx context.Context, id string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockCatalogItemService) ListRevisions(ctx context.Context, id string, opts *service.CatalogItemRevisionListOptions) (*service.CatalogItemRevisionListResult, error) {
+	if m.listRevisionsFunc != nil {
+		return m.listRevisionsFunc(ctx, id, opts)
+	}
+	return &service.CatalogItemRevisionListResult{}, nil
+}
+
+func (m *mockCatalogItemService) Rollback(ctx context.Context, id string, revision int) (*v1alpha1API.CatalogItem, error) {
+	if m.rollbackFunc != nil {
+		return m.rollbackFunc(ctx, id, revision)
+	}
+	return &v1alpha1API.CatalogItem{}, nil
+}
+
+var _ = Describe("CatalogItem Handler", func() {
+	var (
+		ctx           context.Context
+		handler       *v1alpha1.Handler
+		mockCIService *mockCatalogItemService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockCIService = &mockCatalogItemService{}
+		handler = v1alpha1.NewHandler(&mockService{catalogItemService: mockCIService})
+	})
+
+	Describe("CreateCatalogItem", func() {
+		It("should pass the parent and spec to the service and return 201", func() {
+			parent := "tenants/team-a"
+			mockCIService.createFunc = func(ctx context.Context, req *service.CreateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+				Expect(*req.Parent).To(Equal(parent))
+				Expect(req.ServiceType).To(Equal("vm"))
+				Expect(req.Fields).To(HaveLen(1))
+				path := "tenants/team-a/catalog-items/small-vm"
+				return &v1alpha1API.CatalogItem{Path: &path}, nil
+			}
+
+			serviceType := "vm"
+			response, err := handler.CreateCatalogItem(ctx, server.CreateCatalogItemRequestObject{
+				Params: v1alpha1API.CreateCatalogItemParams{Parent: &parent},
+				Body: &v1alpha1API.CatalogItem{
+					Spec: &v1alpha1API.CatalogItemSpec{
+						ServiceType: &serviceType,
+						Fields:      &[]v1alpha1API.FieldConfiguration{{Path: "spec.vcpu.count"}},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			created := response.(server.CreateCatalogItem201JSONResponse)
+			Expect(*created.Path).To(Equal("tenants/team-a/catalog-items/small-vm"))
+		})
+
+		It("should return 400 for validation errors", func() {
+			mockCIService.createFunc = func(ctx context.Context, req *service.CreateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+				return nil, fmt.Errorf("%w: display_name is required", service.ErrInvalidCatalogItem)
+			}
+
+			response, err := handler.CreateCatalogItem(ctx, server.CreateCatalogItemRequestObject{Body: &v1alpha1API.CatalogItem{}})
+			Expect(err).ToNot(HaveOccurred())
+			badRequest := response.(server.CreateCatalogItem400JSONResponse)
+			Expect(badRequest.Type).To(Equal(v1alpha1API.INVALIDARGUMENT))
+		})
+
+		It("should return 403 for a parent of another tenant", func() {
+			mockCIService.createFunc = func(ctx context.Context, req *service.CreateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+				return nil, service.ErrTenantMismatch
+			}
+
+			response, err := handler.CreateCatalogItem(ctx, server.CreateCatalogItemRequestObject{Body: &v1alpha1API.CatalogItem{}})
+			Expect(err).ToNot(HaveOccurred())
+			forbidden := response.(server.CreateCatalogItem403JSONResponse)
+			Expect(forbidden.Type).To(Equal(v1alpha1API.PERMISSIONDENIED))
+		})
+
+		It("should return 409 for duplicate ID", func() {
+			mockCIService.createFunc = func(ctx context.Context, req *service.CreateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+				return nil, service.ErrCatalogItemIDTaken
+			}
+
+			response, err := handler.CreateCatalogItem(ctx, server.CreateCatalogItemRequestObject{Body: &v1alpha1API.CatalogItem{}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.CreateCatalogItem409JSONResponse{}))
+		})
+	})
+
+	Describe("ListCatalogItems", func() {
+		It("should return results and next page token", func() {
+			token := "next"
+			mockCIService.listFunc = func(ctx context.Context, opts *service.CatalogItemListOptions) (*service.CatalogItemListResult, error) {
+				return &service.CatalogItemListResult{
+					CatalogItems:  []v1alpha1API.CatalogItem{{}, {}},
+					NextPageToken: &token,
+				}, nil
+			}
+
+			response, err := handler.ListCatalogItems(ctx, server.ListCatalogItemsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			list := v1alpha1API.CatalogItemList(response.(server.ListCatalogItems200JSONResponse))
+			Expect(list.Results).To(HaveLen(2))
+			Expect(list.NextPageToken).To(Equal("next"))
+		})
+
+		It("should return the total size unless skipped", func() {
+			var total int64 = 14
+			mockCIService.listFunc = func(ctx context.Context, opts *service.CatalogItemListOptions) (*service.CatalogItemListResult, error) {
+				if opts.SkipTotalSize {
+					return &service.CatalogItemListResult{}, nil
+				}
+				return &service.CatalogItemListResult{TotalSize: &total}, nil
+			}
+
+			response, err := handler.ListCatalogItems(ctx, server.ListCatalogItemsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			list := v1alpha1API.CatalogItemList(response.(server.ListCatalogItems200JSONResponse))
+			Expect(list.TotalSize).To(HaveValue(BeEquivalentTo(14)))
+
+			skip := true
+			response, err = handler.ListCatalogItems(ctx, server.ListCatalogItemsRequestObject{
+				Params: v1alpha1API.ListCatalogItemsParams{SkipTotalSize: &skip},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			list = v1alpha1API.CatalogItemList(response.(server.ListCatalogItems200JSONResponse))
+			Expect(list.TotalSize).To(BeNil())
+		})
+
+		It("should return 400 for an invalid parent", func() {
+			mockCIService.listFunc = func(ctx context.Context, opts *service.CatalogItemListOptions) (*service.CatalogItemListResult, error) {
+				return nil, service.ErrInvalidParent
+			}
+
+			response, err := handler.ListCatalogItems(ctx, server.ListCatalogItemsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ListCatalogItems400JSONResponse{}))
+		})
+	})
+
+	Describe("Read masks", func() {
+		uid := "small-vm"
+		apiVersion := "v1alpha1"
+		displayName := "Small VM"
+		serviceType := "vm"
+		fields := []v1alpha1API.FieldConfiguration{{Path: "spec.vcpu.count"}}
+		item := v1alpha1API.CatalogItem{
+			Uid:         &uid,
+			ApiVersion:  &apiVersion,
+			DisplayName: &displayName,
+			Spec:        &v1alpha1API.CatalogItemSpec{ServiceType: &serviceType, Fields: &fields},
+		}
+
+		body := func(visit func(*httptest.ResponseRecorder) error) map[string]any {
+			rec := httptest.NewRecorder()
+			Expect(visit(rec)).To(Succeed())
+			Expect(rec.Code).To(Equal(200))
+			var decoded map[string]any
+			Expect(json.Unmarshal(rec.Body.Bytes(), &decoded)).To(Succeed())
+			return decoded
+		}
+
+		It("should return only the requested fields of a catalog item", func() {
+			mockCIService.getFunc = func(ctx context.Context, id string) (*v1alpha1API.CatalogItem, error) {
+				return &item, nil
+			}
+
+			mask := "uid,spec.service_type"
+			response, err := handler.GetCatalogItem(ctx, server.GetCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Params:        v1alpha1API.GetCatalogItemParams{ReadMask: &mask},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body(func(rec *httptest.ResponseRecorder) error { return response.VisitGetCatalogItemResponse(rec) })).To(Equal(map[string]any{
+				"uid":  "small-vm",
+				"spec": map[string]any{"service_type": "vm"},
+			}))
+		})
+
+		It("should mask every result of a list and pass the mask to the service", func() {
+			token := "next"
+			mockCIService.listFunc = func(ctx context.Context, opts *service.CatalogItemListOptions) (*service.CatalogItemListResult, error) {
+				Expect(opts.ReadMask.Selects("spec.fields")).To(BeFalse())
+				var total int64 = 2
+				return &service.CatalogItemListResult{CatalogItems: []v1alpha1API.CatalogItem{item, item}, NextPageToken: &token, TotalSize: &total}, nil
+			}
+
+			mask := "uid,display_name"
+			response, err := handler.ListCatalogItems(ctx, server.ListCatalogItemsRequestObject{
+				Params: v1alpha1API.ListCatalogItemsParams{ReadMask: &mask},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			list := body(func(rec *httptest.ResponseRecorder) error { return response.VisitListCatalogItemsResponse(rec) })
+			Expect(list["next_page_token"]).To(Equal("next"))
+			Expect(list["total_size"]).To(BeEquivalentTo(2))
+			Expect(list["results"]).To(ConsistOf(
+				map[string]any{"uid": "small-vm", "display_name": "Small VM"},
+				map[string]any{"uid": "small-vm", "display_name": "Small VM"},
+			))
+		})
+
+		It("should return 400 for a field the catalog item does not have", func() {
+			mask := "uid,spec.serviceType"
+			response, err := handler.GetCatalogItem(ctx, server.GetCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Params:        v1alpha1API.GetCatalogItemParams{ReadMask: &mask},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.GetCatalogItem400JSONResponse{}))
+		})
+	})
+
+	Describe("BatchGetCatalogItems", func() {
+		It("should pass the IDs to the service and return 200", func() {
+			mockCIService.batchGetFunc = func(ctx context.Context, ids []string) (*v1alpha1API.BatchGetCatalogItemsResult, error) {
+				Expect(ids).To(Equal([]string{"small-vm", "large-vm"}))
+				return &v1alpha1API.BatchGetCatalogItemsResult{Results: []v1alpha1API.CatalogItem{{}, {}}}, nil
+			}
+
+			response, err := handler.BatchGetCatalogItems(ctx, server.BatchGetCatalogItemsRequestObject{
+				Params: v1alpha1API.BatchGetCatalogItemsParams{Ids: []string{"small-vm", "large-vm"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			result := v1alpha1API.BatchGetCatalogItemsResult(response.(server.BatchGetCatalogItems200JSONResponse))
+			Expect(result.Results).To(HaveLen(2))
+		})
+
+		It("should return 404 when any catalog item does not exist", func() {
+			mockCIService.batchGetFunc = func(ctx context.Context, ids []string) (*v1alpha1API.BatchGetCatalogItemsResult, error) {
+				return nil, service.ErrCatalogItemNotFound
+			}
+
+			response, err := handler.BatchGetCatalogItems(ctx, server.BatchGetCatalogItemsRequestObject{
+				Params: v1alpha1API.BatchGetCatalogItemsParams{Ids: []string{"missing"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.BatchGetCatalogItems404JSONResponse{}))
+		})
+
+		It("should return 400 for too many IDs", func() {
+			mockCIService.batchGetFunc = func(ctx context.Context, ids []string) (*v1alpha1API.BatchGetCatalogItemsResult, error) {
+				return nil, service.ErrInvalidBatchRequest
+			}
+
+			response, err := handler.BatchGetCatalogItems(ctx, server.BatchGetCatalogItemsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.BatchGetCatalogItems400JSONResponse{}))
+		})
+	})
+
+	Describe("UpdateCatalogItem", func() {
+		It("should forward the merge patch fields", func() {
+			displayName := "Renamed"
+			mockCIService.updateFunc = func(ctx context.Context, id string, req *service.UpdateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+				Expect(id).To(Equal("small-vm"))
+				Expect(*req.DisplayName).To(Equal(displayName))
+				Expect(req.Fields).To(BeNil())
+				return &v1alpha1API.CatalogItem{DisplayName: req.DisplayName}, nil
+			}
+
+			response, err := handler.UpdateCatalogItem(ctx, server.UpdateCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Body:          &v1alpha1API.CatalogItem{DisplayName: &displayName},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.UpdateCatalogItem200JSONResponse{}))
+		})
+
+		It("should return 404 when the catalog item does not exist", func() {
+			mockCIService.updateFunc = func(ctx context.Context, id string, req *service.UpdateCatalogItemRequest) (*v1alpha1API.CatalogItem, error) {
+				return nil, service.ErrCatalogItemNotFound
+			}
+
+			response, err := handler.UpdateCatalogItem(ctx, server.UpdateCatalogItemRequestObject{
+				CatalogItemId: "missing",
+				Body:          &v1alpha1API.CatalogItem{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.UpdateCatalogItem404JSONResponse{}))
+		})
+	})
+
+	Describe("ApplyCatalogItem", func() {
+		It("should forward the manifest, parent and validate_only", func() {
+			displayName := "Small VM"
+			serviceType := "vm"
+			parent := "tenants/acme"
+			validateOnly := true
+			mockCIService.applyFunc = func(ctx context.Context, id string, req *service.CreateCatalogItemRequest, dryRun bool) (*v1alpha1API.CatalogItemApplyResult, error) {
+				Expect(id).To(Equal("small-vm"))
+				Expect(*req.Parent).To(Equal(parent))
+				Expect(req.DisplayName).To(Equal(displayName))
+				Expect(req.ServiceType).To(Equal(serviceType))
+				Expect(dryRun).To(BeTrue())
+				return &v1alpha1API.CatalogItemApplyResult{
+					Action:      v1alpha1API.ApplyActionCreated,
+					Changes:     []v1alpha1API.ApplyChange{},
+					CatalogItem: v1alpha1API.CatalogItem{DisplayName: &displayName},
+				}, nil
+			}
+
+			response, err := handler.ApplyCatalogItem(ctx, server.ApplyCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Params:        v1alpha1API.ApplyCatalogItemParams{Parent: &parent, ValidateOnly: &validateOnly},
+				Body: &v1alpha1API.CatalogItem{
+					DisplayName: &displayName,
+					Spec:        &v1alpha1API.CatalogItemSpec{ServiceType: &serviceType},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ApplyCatalogItem200JSONResponse{}))
+			Expect(response.(server.ApplyCatalogItem200JSONResponse).Action).To(Equal(v1alpha1API.ApplyActionCreated))
+		})
+
+		It("should return 400 for invalid manifests", func() {
+			mockCIService.applyFunc = func(ctx context.Context, id string, req *service.CreateCatalogItemRequest, validateOnly bool) (*v1alpha1API.CatalogItemApplyResult, error) {
+				return nil, service.ErrInvalidCatalogItem
+			}
+
+			response, err := handler.ApplyCatalogItem(ctx, server.ApplyCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Body:          &v1alpha1API.CatalogItem{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ApplyCatalogItem400JSONResponse{}))
+		})
+
+		It("should return 403 for the parent of another tenant", func() {
+			mockCIService.applyFunc = func(ctx context.Context, id string, req *service.CreateCatalogItemRequest, validateOnly bool) (*v1alpha1API.CatalogItemApplyResult, error) {
+				return nil, service.ErrTenantMismatch
+			}
+
+			response, err := handler.ApplyCatalogItem(ctx, server.ApplyCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Body:          &v1alpha1API.CatalogItem{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ApplyCatalogItem403JSONResponse{}))
+		})
+	})
+
+	Describe("DeleteCatalogItem", func() {
+		It("should return 204 on success", func() {
+			response, err := handler.DeleteCatalogItem(ctx, server.DeleteCatalogItemRequestObject{CatalogItemId: "small-vm"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.DeleteCatalogItem204Response{}))
+		})
+
+		It("should return 409 when instances exist", func() {
+			mockCIService.deleteFunc = func(ctx context.Context, id string) error {
+				return service.ErrCatalogItemHasInstances
+			}
+
+			response, err := handler.DeleteCatalogItem(ctx, server.DeleteCatalogItemRequestObject{CatalogItemId: "small-vm"})
+			Expect(err).ToNot(HaveOccurred())
+			conflict := response.(server.DeleteCatalogItem409JSONResponse)
+			Expect(conflict.Type).To(Equal(v1alpha1API.FAILEDPRECONDITION))
+		})
+
+		It("should return 500 for unknown errors", func() {
+			mockCIService.deleteFunc = func(ctx context.Context, id string) error {
+				return errors.New("database connection failed")
+			}
+
+			response, err := handler.DeleteCatalogItem(ctx, server.DeleteCatalogItemRequestObject{CatalogItemId: "small-vm"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.DeleteCatalogItem500JSONResponse{}))
+		})
+	})
+
+	Describe("ListCatalogItemRevisions", func() {
+		It("should return results and next page token", func() {
+			nextToken := "abc"
+			mockCIService.listRevisionsFunc = func(ctx context.Context, id string, opts *service.CatalogItemRevisionListOptions) (*service.CatalogItemRevisionListResult, error) {
+				Expect(id).To(Equal("small-vm"))
+				return &service.CatalogItemRevisionListResult{
+					CatalogItemRevisions: []v1alpha1API.CatalogItemRevision{{}, {}},
+					NextPageToken:        &nextToken,
+				}, nil
+			}
+
+			response, err := handler.ListCatalogItemRevisions(ctx, server.ListCatalogItemRevisionsRequestObject{CatalogItemId: "small-vm"})
+			Expect(err).ToNot(HaveOccurred())
+			list := response.(server.ListCatalogItemRevisions200JSONResponse)
+			Expect(list.Results).To(HaveLen(2))
+			Expect(list.NextPageToken).To(Equal("abc"))
+		})
+
+		It("should return 404 when the catalog item does not exist", func() {
+			mockCIService.listRevisionsFunc = func(ctx context.Context, id string, opts *service.CatalogItemRevisionListOptions) (*service.CatalogItemRevisionListResult, error) {
+				return nil, service.ErrCatalogItemNotFound
+			}
+
+			response, err := handler.ListCatalogItemRevisions(ctx, server.ListCatalogItemRevisionsRequestObject{CatalogItemId: "missing"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ListCatalogItemRevisions404JSONResponse{}))
+		})
+	})
+
+	Describe("RollbackCatalogItem", func() {
+		It("should pass the revision to the service and return 200", func() {
+			revision := int32(3)
+			mockCIService.rollbackFunc = func(ctx context.Context, id string, r int) (*v1alpha1API.CatalogItem, error) {
+				Expect(id).To(Equal("small-vm"))
+				Expect(r).To(Equal(1))
+				return &v1alpha1API.CatalogItem{Revision: &revision}, nil
+			}
+
+			response, err := handler.RollbackCatalogItem(ctx, server.RollbackCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Body:          &v1alpha1API.RollbackCatalogItemRequest{Revision: 1},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			item := response.(server.RollbackCatalogItem200JSONResponse)
+			Expect(*item.Revision).To(Equal(int32(3)))
+		})
+
+		It("should return 404 when the revision does not exist", func() {
+			mockCIService.rollbackFunc = func(ctx context.Context, id string, r int) (*v1alpha1API.CatalogItem, error) {
+				return nil, service.ErrCatalogItemRevisionNotFound
+			}
+
+			response, err := handler.RollbackCatalogItem(ctx, server.RollbackCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Body:          &v1alpha1API.RollbackCatalogItemRequest{Revision: 9},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.RollbackCatalogItem404JSONResponse{}))
+		})
+	})
+
+	Describe("ConvertCatalogItem", func() {
+		It("should pass the target version to the service and return 200", func() {
+			mockCIService.convertFunc = func(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1API.CatalogItemConversion, error) {
+				Expect(id).To(Equal("small-vm"))
+				Expect(serviceTypeVersion).To(Equal("v1beta1"))
+				return &v1alpha1API.CatalogItemConversion{
+					FromVersion: "v1alpha1",
+					ToVersion:   "v1beta1",
+					LossyFields: []string{"spec.access.ssh_public_key"},
+				}, nil
+			}
+
+			response, err := handler.ConvertCatalogItem(ctx, server.ConvertCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Body:          &v1alpha1API.ConvertRequest{ServiceTypeVersion: "v1beta1"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			conversion := response.(server.ConvertCatalogItem200JSONResponse)
+			Expect(conversion.LossyFields).To(ConsistOf("spec.access.ssh_public_key"))
+		})
+
+		It("should return 400 FAILED_PRECONDITION when no conversion is declared", func() {
+			mockCIService.convertFunc = func(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1API.CatalogItemConversion, error) {
+				return nil, service.ErrConversionUnavailable
+			}
+
+			response, err := handler.ConvertCatalogItem(ctx, server.ConvertCatalogItemRequestObject{
+				CatalogItemId: "small-vm",
+				Body:          &v1alpha1API.ConvertRequest{ServiceTypeVersion: "v1beta1"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			badRequest := response.(server.ConvertCatalogItem400JSONResponse)
+			Expect(badRequest.Type).To(Equal(v1alpha1API.FAILEDPRECONDITION))
+		})
+	})
+
+	Describe("GetCatalogItemForm", func() {
+		It("should return the form of the catalog item", func() {
+			mockCIService.formFunc = func(ctx context.Context, id string) (*v1alpha1API.CatalogItemForm, error) {
+				Expect(id).To(Equal("small-vm"))
+				return &v1alpha1API.CatalogItemForm{
+					Schema:   map[string]any{"type": "object"},
+					UiSchema: map[string]any{"ui:order": []string{"spec.vcpu.count"}},
+				}, nil
+			}
+
+			response, err := handler.GetCatalogItemForm(ctx, server.GetCatalogItemFormRequestObject{CatalogItemId: "small-vm"})
+			Expect(err).ToNot(HaveOccurred())
+			form := response.(server.GetCatalogItemForm200JSONResponse)
+			Expect(form.Schema).To(HaveKeyWithValue("type", "object"))
+		})
+
+		It("should return 404 for unknown catalog items", func() {
+			mockCIService.formFunc = func(ctx context.Context, id string) (*v1alpha1API.CatalogItemForm, error) {
+				return nil, service.ErrCatalogItemNotFound
+			}
+
+			response, err := handler.GetCatalogItemForm(ctx, server.GetCatalogItemFormRequestObject{CatalogItemId: "missing"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.GetCatalogItemForm404JSONResponse{}))
+		})
+	})
+})
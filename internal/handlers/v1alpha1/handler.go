@@ -1,6 +1,10 @@
 package v1alpha1
 
 import (
+	"errors"
+	"fmt"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
 	"github.com/dcm-project/catalog-manager/internal/service"
 )
@@ -26,3 +30,52 @@ var _ server.StrictServerInterface = (*Handler)(nil)
 func stringPtr(s string) *string {
 	return &s
 }
+
+// derefString returns the value of a string pointer, or the empty string when nil
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// newError builds an RFC 7807 error body from a service error
+func newError(errType v1alpha1.ErrorType, status int32, title string, err error) v1alpha1.Error {
+	return v1alpha1.Error{
+		Type:   errType,
+		Status: status,
+		Title:  title,
+		Detail: stringPtr(err.Error()),
+	}
+}
+
+// internalError builds the 500 Internal Server Error body shared by all operations
+func internalError(err error) server.InternalServerErrorJSONResponse {
+	return server.InternalServerErrorJSONResponse(newError(v1alpha1.INTERNAL, 500, "Internal Server Error", err))
+}
+
+// forbiddenError builds the 403 Forbidden body for a parent of another tenant
+func forbiddenError(err error) server.ForbiddenJSONResponse {
+	return server.ForbiddenJSONResponse(newError(v1alpha1.PERMISSIONDENIED, 403, "Forbidden", err))
+}
+
+// requestID returns the idempotency key of a create request, given as the
+// request_id query parameter or the Idempotency-Key header
+func requestID(query, header *string) (string, error) {
+	if query != nil && header != nil && *query != *header {
+		return "", fmt.Errorf("%w: request_id and the Idempotency-Key header differ", service.ErrInvalidRequestID)
+	}
+	if query != nil {
+		return *query, nil
+	}
+	return derefString(header), nil
+}
+
+// idempotencyConflict builds the 409 Conflict body of a create retried with
+// an idempotency key used by another request, or whose request is in progress
+func idempotencyConflict(err error) v1alpha1.Error {
+	if errors.Is(err, service.ErrIdempotencyKeyInProgress) {
+		return newError(v1alpha1.ABORTED, 409, "Conflict", err)
+	}
+	return newError(v1alpha1.FAILEDPRECONDITION, 409, "Conflict", err)
+}
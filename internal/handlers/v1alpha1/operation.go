@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+func (h *Handler) ListOperations(ctx context.Context, request server.ListOperationsRequestObject) (server.ListOperationsResponseObject, error) {
+	// Build service request from HTTP params
+	opts := &service.OperationListOptions{
+		PageToken:   request.Params.PageToken,
+		MaxPageSize: request.Params.MaxPageSize,
+		Parent:      request.Params.Parent,
+	}
+
+	// Call service layer
+	result, err := h.service.Operation().List(ctx, opts)
+	if err != nil {
+		return mapListOperationsErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	response := server.ListOperations200JSONResponse(v1alpha1.OperationList{
+		Results: result.Operations,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
+}
+
+func (h *Handler) GetOperation(ctx context.Context, request server.GetOperationRequestObject) (server.GetOperationResponseObject, error) {
+	// Call service layer
+	result, err := h.service.Operation().Get(ctx, request.OperationId)
+	if err != nil {
+		return mapGetOperationErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.GetOperation200JSONResponse(*result), nil
+}
+
+func (h *Handler) CancelOperation(ctx context.Context, request server.CancelOperationRequestObject) (server.CancelOperationResponseObject, error) {
+	// Call service layer
+	result, err := h.service.Operation().Cancel(ctx, request.OperationId)
+	if err != nil {
+		return mapCancelOperationErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.CancelOperation200JSONResponse(*result), nil
+}
+
+func (h *Handler) WaitOperation(ctx context.Context, request server.WaitOperationRequestObject) (server.WaitOperationResponseObject, error) {
+	// Call service layer
+	result, err := h.service.Operation().Wait(ctx, request.OperationId, request.Params.Timeout)
+	if err != nil {
+		return mapWaitOperationErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.WaitOperation200JSONResponse(*result), nil
+}
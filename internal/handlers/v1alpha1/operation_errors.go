@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	"errors"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// mapListOperationsErrorToHTTP converts service domain errors to ListOperations HTTP responses
+func mapListOperationsErrorToHTTP(err error) server.ListOperationsResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidParent):
+		return server.ListOperations400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.ListOperations403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.ListOperations500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapGetOperationErrorToHTTP converts service domain errors to GetOperation HTTP responses
+func mapGetOperationErrorToHTTP(err error) server.GetOperationResponseObject {
+	switch {
+	case errors.Is(err, service.ErrOperationNotFound):
+		return server.GetOperation404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.GetOperation500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapCancelOperationErrorToHTTP converts service domain errors to CancelOperation HTTP responses
+func mapCancelOperationErrorToHTTP(err error) server.CancelOperationResponseObject {
+	switch {
+	case errors.Is(err, service.ErrOperationNotFound):
+		return server.CancelOperation404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	case errors.Is(err, service.ErrOperationNotCancellable):
+		return server.CancelOperation409JSONResponse{
+			NotCancellableJSONResponse: server.NotCancellableJSONResponse(newError(v1alpha1.FAILEDPRECONDITION, 409, "Operation cannot be cancelled", err)),
+		}
+	default:
+		return server.CancelOperation500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapWaitOperationErrorToHTTP converts service domain errors to WaitOperation HTTP responses
+func mapWaitOperationErrorToHTTP(err error) server.WaitOperationResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidTimeout):
+		return server.WaitOperation400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrOperationNotFound):
+		return server.WaitOperation404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.WaitOperation500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
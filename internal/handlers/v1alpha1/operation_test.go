@@ -0,0 +1,154 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1alpha1API "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// Mock OperationService for testing
+type mockOperationService struct {
+	listFunc   func(ctx context.Context, opts *service.OperationListOptions) (*service.OperationListResult, error)
+	getFunc    func(ctx context.Context, id string) (*v1alpha1API.Operation, error)
+	cancelFunc func(ctx context.Context, id string) (*v1alpha1API.Operation, error)
+	waitFunc   func(ctx context.Context, id string, timeout *string) (*v1alpha1API.Operation, error)
+}
+
+func (m *mockOperationService) List(ctx context.Context, opts *service.OperationListOptions) (*service.OperationListResult, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
+	return &service.OperationListResult{}, nil
+}
+
+func (m *mockOperationService) Get(ctx context.Context, id string) (*v1alpha1API.Operation, error) {
+	if m.getFunc != nil {
+		return m.getFunc(ctx, id)
+	}
+	return &v1alpha1API.Operation{}, nil
+}
+
+func (m *mockOperationService) Cancel(ctx context.Context, id string) (*v1alpha1API.Operation, error) {
+	if m.cancelFunc != nil {
+		return m.cancelFunc(ctx, id)
+	}
+	return &v1alpha1API.Operation{}, nil
+}
+
+func (m *mockOperationService) Wait(ctx context.Context, id string, timeout *string) (*v1alpha1API.Operation, error) {
+	if m.waitFunc != nil {
+		return m.waitFunc(ctx, id, timeout)
+	}
+	return &v1alpha1API.Operation{}, nil
+}
+
+var _ = Describe("Operation Handler", func() {
+	var (
+		ctx          context.Context
+		handler      *v1alpha1.Handler
+		mockOService *mockOperationService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockOService = &mockOperationService{}
+		handler = v1alpha1.NewHandler(&mockService{operationService: mockOService})
+	})
+
+	Describe("ListOperations", func() {
+		It("should return the operations and the next page token", func() {
+			next := "token"
+			mockOService.listFunc = func(ctx context.Context, opts *service.OperationListOptions) (*service.OperationListResult, error) {
+				return &service.OperationListResult{
+					Operations:    []v1alpha1API.Operation{{Done: true}},
+					NextPageToken: &next,
+				}, nil
+			}
+
+			resp, err := handler.ListOperations(ctx, server.ListOperationsRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+			list, ok := resp.(server.ListOperations200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(list.Results).To(HaveLen(1))
+			Expect(list.NextPageToken).To(Equal("token"))
+		})
+	})
+
+	Describe("GetOperation", func() {
+		It("should return 404 for unknown operations", func() {
+			mockOService.getFunc = func(ctx context.Context, id string) (*v1alpha1API.Operation, error) {
+				return nil, service.ErrOperationNotFound
+			}
+
+			resp, err := handler.GetOperation(ctx, server.GetOperationRequestObject{OperationId: "missing"})
+			Expect(err).NotTo(HaveOccurred())
+			notFound, ok := resp.(server.GetOperation404JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(notFound.Type).To(Equal(v1alpha1API.NOTFOUND))
+		})
+	})
+
+	Describe("CancelOperation", func() {
+		It("should return the cancelled operation", func() {
+			mockOService.cancelFunc = func(ctx context.Context, id string) (*v1alpha1API.Operation, error) {
+				Expect(id).To(Equal("op-1"))
+				return &v1alpha1API.Operation{
+					Done:  true,
+					Error: &v1alpha1API.Error{Type: v1alpha1API.CANCELLED, Status: 499, Title: "Cancelled"},
+				}, nil
+			}
+
+			resp, err := handler.CancelOperation(ctx, server.CancelOperationRequestObject{OperationId: "op-1"})
+			Expect(err).NotTo(HaveOccurred())
+			op, ok := resp.(server.CancelOperation200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(op.Error.Type).To(Equal(v1alpha1API.CANCELLED))
+		})
+
+		It("should return 409 for operations that cannot be cancelled", func() {
+			mockOService.cancelFunc = func(ctx context.Context, id string) (*v1alpha1API.Operation, error) {
+				return nil, fmt.Errorf("%w: deletions cannot be cancelled", service.ErrOperationNotCancellable)
+			}
+
+			resp, err := handler.CancelOperation(ctx, server.CancelOperationRequestObject{OperationId: "op-1"})
+			Expect(err).NotTo(HaveOccurred())
+			conflict, ok := resp.(server.CancelOperation409JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(conflict.Type).To(Equal(v1alpha1API.FAILEDPRECONDITION))
+		})
+	})
+
+	Describe("WaitOperation", func() {
+		It("should pass the timeout to the service", func() {
+			timeout := "5s"
+			mockOService.waitFunc = func(ctx context.Context, id string, got *string) (*v1alpha1API.Operation, error) {
+				Expect(got).To(Equal(&timeout))
+				return &v1alpha1API.Operation{Done: true}, nil
+			}
+
+			resp, err := handler.WaitOperation(ctx, server.WaitOperationRequestObject{
+				OperationId: "op-1",
+				Params:      v1alpha1API.WaitOperationParams{Timeout: &timeout},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.WaitOperation200JSONResponse{}))
+		})
+
+		It("should return 400 for an invalid timeout", func() {
+			mockOService.waitFunc = func(ctx context.Context, id string, timeout *string) (*v1alpha1API.Operation, error) {
+				return nil, fmt.Errorf("%w: %q", service.ErrInvalidTimeout, "soon")
+			}
+
+			resp, err := handler.WaitOperation(ctx, server.WaitOperationRequestObject{OperationId: "op-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.WaitOperation400JSONResponse{}))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+func (h *Handler) ListQuotas(ctx context.Context, request server.ListQuotasRequestObject) (server.ListQuotasResponseObject, error) {
+	// Build service request from HTTP params
+	opts := &service.QuotaListOptions{
+		PageToken:   request.Params.PageToken,
+		MaxPageSize: request.Params.MaxPageSize,
+		Parent:      request.Params.Parent,
+	}
+
+	// Call service layer
+	result, err := h.service.Quota().List(ctx, opts)
+	if err != nil {
+		return mapListQuotasErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	response := server.ListQuotas200JSONResponse(v1alpha1.QuotaList{
+		Results: result.Quotas,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
+}
+
+func (h *Handler) CreateQuota(ctx context.Context, request server.CreateQuotaRequestObject) (server.CreateQuotaResponseObject, error) {
+	// Build service request from HTTP params
+	req := &service.CreateQuotaRequest{
+		ID:            request.Params.Id,
+		Parent:        request.Params.Parent,
+		ServiceType:   derefString(request.Body.ServiceType),
+		CatalogItemId: derefString(request.Body.CatalogItemId),
+		MaxInstances:  request.Body.MaxInstances,
+		MaxVcpu:       request.Body.MaxVcpu,
+		MaxMemory:     request.Body.MaxMemory,
+		MaxStorage:    request.Body.MaxStorage,
+	}
+	var err error
+	if req.RequestID, err = requestID(request.Params.RequestId, request.Params.IdempotencyKey); err != nil {
+		return mapCreateQuotaErrorToHTTP(err), nil
+	}
+
+	// Call service layer
+	result, err := h.service.Quota().Create(ctx, req)
+	if err != nil {
+		return mapCreateQuotaErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.CreateQuota201JSONResponse(*result), nil
+}
+
+func (h *Handler) GetQuota(ctx context.Context, request server.GetQuotaRequestObject) (server.GetQuotaResponseObject, error) {
+	// Call service layer
+	result, err := h.service.Quota().Get(ctx, request.QuotaId)
+	if err != nil {
+		return mapGetQuotaErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.GetQuota200JSONResponse(*result), nil
+}
+
+func (h *Handler) DeleteQuota(ctx context.Context, request server.DeleteQuotaRequestObject) (server.DeleteQuotaResponseObject, error) {
+	// Call service layer
+	if err := h.service.Quota().Delete(ctx, request.QuotaId); err != nil {
+		return mapDeleteQuotaErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.DeleteQuota204Response{}, nil
+}
+
+func (h *Handler) GetUsage(ctx context.Context, request server.GetUsageRequestObject) (server.GetUsageResponseObject, error) {
+	// Call service layer
+	result, err := h.service.Quota().Usage(ctx)
+	if err != nil {
+		return server.GetUsage500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}, nil
+	}
+
+	// Return HTTP response
+	return server.GetUsage200JSONResponse(*result), nil
+}
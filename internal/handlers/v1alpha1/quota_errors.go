@@ -0,0 +1,74 @@
+package v1alpha1
+
+import (
+	"errors"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// mapListQuotasErrorToHTTP converts service domain errors to ListQuotas HTTP responses
+func mapListQuotasErrorToHTTP(err error) server.ListQuotasResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidParent):
+		return server.ListQuotas400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.ListQuotas403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.ListQuotas500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapCreateQuotaErrorToHTTP converts service domain errors to CreateQuota HTTP responses
+func mapCreateQuotaErrorToHTTP(err error) server.CreateQuotaResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidQuota), errors.Is(err, service.ErrInvalidParent),
+		errors.Is(err, service.ErrInvalidRequestID):
+		// Validation errors -> 400 Bad Request
+		return server.CreateQuota400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch), errors.Is(err, service.ErrAdminRequired):
+		return server.CreateQuota403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrQuotaIDTaken):
+		// Conflict errors -> 409 Conflict
+		return server.CreateQuota409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(newError(v1alpha1.ALREADYEXISTS, 409, "Conflict", err)),
+		}
+	case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return server.CreateQuota409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(idempotencyConflict(err)),
+		}
+	default:
+		return server.CreateQuota500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapGetQuotaErrorToHTTP converts service domain errors to GetQuota HTTP responses
+func mapGetQuotaErrorToHTTP(err error) server.GetQuotaResponseObject {
+	switch {
+	case errors.Is(err, service.ErrQuotaNotFound):
+		return server.GetQuota404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.GetQuota500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapDeleteQuotaErrorToHTTP converts service domain errors to DeleteQuota HTTP responses
+func mapDeleteQuotaErrorToHTTP(err error) server.DeleteQuotaResponseObject {
+	switch {
+	case errors.Is(err, service.ErrQuotaNotFound):
+		return server.DeleteQuota404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	case errors.Is(err, service.ErrAdminRequired):
+		return server.DeleteQuota403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.DeleteQuota500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
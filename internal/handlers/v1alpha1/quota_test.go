@@ -0,0 +1,155 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1alpha1API "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// Mock QuotaService for testing
+type mockQuotaService struct {
+	listFunc   func(ctx context.Context, opts *service.QuotaListOptions) (*service.QuotaListResult, error)
+	createFunc func(ctx context.Context, req *service.CreateQuotaRequest) (*v1alpha1API.Quota, error)
+	getFunc    func(ctx context.Context, id string) (*v1alpha1API.Quota, error)
+	deleteFunc func(ctx context.Context, id string) error
+	usageFunc  func(ctx context.Context) (*v1alpha1API.Usage, error)
+}
+
+func (m *mockQuotaService) List(ctx context.Context, opts *service.QuotaListOptions) (*service.QuotaListResult, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
+	return &service.QuotaListResult{}, nil
+}
+
+func (m *mockQuotaService) Create(ctx context.Context, req *service.CreateQuotaRequest) (*v1alpha1API.Quota, error) {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, req)
+	}
+	return &v1alpha1API.Quota{}, nil
+}
+
+func (m *mockQuotaService) Get(ctx context.Context, id string) (*v1alpha1API.Quota, error) {
+	if m.getFunc != nil {
+		return m.getFunc(ctx, id)
+	}
+	return &v1alpha1API.Quota{}, nil
+}
+
+func (m *mockQuotaService) Delete(ctx context.Context, id string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockQuotaService) Usage(ctx context.Context) (*v1alpha1API.Usage, error) {
+	if m.usageFunc != nil {
+		return m.usageFunc(ctx)
+	}
+	return &v1alpha1API.Usage{}, nil
+}
+
+var _ = Describe("Quota Handler", func() {
+	var (
+		ctx          context.Context
+		handler      *v1alpha1.Handler
+		mockQService *mockQuotaService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockQService = &mockQuotaService{}
+		handler = v1alpha1.NewHandler(&mockService{quotaService: mockQService})
+	})
+
+	Describe("CreateQuota", func() {
+		It("should pass the limits to the service and return 201", func() {
+			maxVcpu := int64(32)
+			maxMemory := "64GB"
+			serviceType := "vm"
+			mockQService.createFunc = func(ctx context.Context, req *service.CreateQuotaRequest) (*v1alpha1API.Quota, error) {
+				Expect(req.ServiceType).To(Equal("vm"))
+				Expect(req.CatalogItemId).To(BeEmpty())
+				Expect(*req.MaxVcpu).To(Equal(maxVcpu))
+				Expect(*req.MaxMemory).To(Equal(maxMemory))
+				Expect(req.MaxInstances).To(BeNil())
+				path := "tenants/team-a/quotas/vm-limits"
+				return &v1alpha1API.Quota{Path: &path}, nil
+			}
+
+			response, err := handler.CreateQuota(ctx, server.CreateQuotaRequestObject{
+				Body: &v1alpha1API.Quota{ServiceType: &serviceType, MaxVcpu: &maxVcpu, MaxMemory: &maxMemory},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			created := response.(server.CreateQuota201JSONResponse)
+			Expect(*created.Path).To(Equal("tenants/team-a/quotas/vm-limits"))
+		})
+
+		It("should return 400 for validation errors", func() {
+			mockQService.createFunc = func(ctx context.Context, req *service.CreateQuotaRequest) (*v1alpha1API.Quota, error) {
+				return nil, fmt.Errorf("%w: at most one of service_type and catalog_item_id may be set", service.ErrInvalidQuota)
+			}
+
+			response, err := handler.CreateQuota(ctx, server.CreateQuotaRequestObject{Body: &v1alpha1API.Quota{}})
+			Expect(err).ToNot(HaveOccurred())
+			badRequest := response.(server.CreateQuota400JSONResponse)
+			Expect(badRequest.Type).To(Equal(v1alpha1API.INVALIDARGUMENT))
+		})
+
+		It("should return 409 for duplicate ID", func() {
+			mockQService.createFunc = func(ctx context.Context, req *service.CreateQuotaRequest) (*v1alpha1API.Quota, error) {
+				return nil, service.ErrQuotaIDTaken
+			}
+
+			response, err := handler.CreateQuota(ctx, server.CreateQuotaRequestObject{Body: &v1alpha1API.Quota{}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.CreateQuota409JSONResponse{}))
+		})
+	})
+
+	Describe("GetQuota", func() {
+		It("should return 404 when the quota does not exist", func() {
+			mockQService.getFunc = func(ctx context.Context, id string) (*v1alpha1API.Quota, error) {
+				return nil, service.ErrQuotaNotFound
+			}
+
+			response, err := handler.GetQuota(ctx, server.GetQuotaRequestObject{QuotaId: "missing"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.GetQuota404JSONResponse{}))
+		})
+	})
+
+	Describe("DeleteQuota", func() {
+		It("should return 403 when the caller is not an administrator", func() {
+			mockQService.deleteFunc = func(ctx context.Context, id string) error {
+				return fmt.Errorf("%w: only administrators delete quotas", service.ErrAdminRequired)
+			}
+
+			response, err := handler.DeleteQuota(ctx, server.DeleteQuotaRequestObject{QuotaId: "vm-limits"})
+			Expect(err).ToNot(HaveOccurred())
+			forbidden := response.(server.DeleteQuota403JSONResponse)
+			Expect(forbidden.Type).To(Equal(v1alpha1API.PERMISSIONDENIED))
+		})
+	})
+
+	Describe("GetUsage", func() {
+		It("should return the tenant's usage", func() {
+			mockQService.usageFunc = func(ctx context.Context) (*v1alpha1API.Usage, error) {
+				return &v1alpha1API.Usage{Total: v1alpha1API.ResourceUsage{Instances: 2, Vcpu: 8, Memory: "16GB", Storage: "0MB"}}, nil
+			}
+
+			response, err := handler.GetUsage(ctx, server.GetUsageRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			usage := response.(server.GetUsage200JSONResponse)
+			Expect(usage.Total.Vcpu).To(Equal(int64(8)))
+		})
+	})
+})
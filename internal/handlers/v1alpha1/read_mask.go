@@ -0,0 +1,81 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+)
+
+// readMask parses the read_mask query parameter of a get or list, checking
+// that it names fields of resource
+func readMask(param *string, resource any) (fieldmask.Mask, error) {
+	if param == nil {
+		return nil, nil
+	}
+	mask, err := fieldmask.Parse(*param)
+	if err != nil {
+		return nil, err
+	}
+	if err := mask.Validate(resource); err != nil {
+		return nil, err
+	}
+	return mask, nil
+}
+
+// partialResponse is the 200 response of a get or list with a read mask,
+// holding only the requested fields of its resources. The generated response
+// types cannot leave out required fields.
+type partialResponse map[string]any
+
+// maskResource returns the partial response of a get
+func maskResource(mask fieldmask.Mask, resource any) (partialResponse, error) {
+	return mask.Apply(resource)
+}
+
+// maskList returns the partial response of a list page
+func maskList[T any](mask fieldmask.Mask, results []T, nextPageToken *string, totalSize *int64) (partialResponse, error) {
+	masked := make([]map[string]any, len(results))
+	for i := range results {
+		var err error
+		if masked[i], err = mask.Apply(results[i]); err != nil {
+			return nil, err
+		}
+	}
+	response := partialResponse{"results": masked, "next_page_token": derefString(nextPageToken)}
+	if totalSize != nil {
+		response["total_size"] = *totalSize
+	}
+	return response, nil
+}
+
+func (response partialResponse) visit(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+func (response partialResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response partialResponse) VisitListServiceTypesResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response partialResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response partialResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response partialResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
+
+func (response partialResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	return response.visit(w)
+}
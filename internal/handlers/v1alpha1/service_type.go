@@ -10,27 +10,31 @@ import (
 
 func (h *Handler) ListServiceTypes(ctx context.Context, request server.ListServiceTypesRequestObject) (server.ListServiceTypesResponseObject, error) {
 	// Build service request from HTTP params
+	mask, err := readMask(request.Params.ReadMask, v1alpha1.ServiceType{})
+	if err != nil {
+		return mapListServiceErrorToHTTP(err), nil
+	}
 	opts := &service.ServiceTypeListOptions{
-		PageToken:   request.Params.PageToken,
-		MaxPageSize: request.Params.MaxPageSize,
+		PageToken:     request.Params.PageToken,
+		MaxPageSize:   request.Params.MaxPageSize,
+		ServiceType:   request.Params.ServiceType,
+		ReadMask:      mask,
+		SkipTotalSize: request.Params.SkipTotalSize != nil && *request.Params.SkipTotalSize,
 	}
 
 	// Call service layer
 	result, err := h.service.ServiceType().List(ctx, opts)
 	if err != nil {
-		return server.ListServiceTypes500JSONResponse{
-			InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
-				Type:   v1alpha1.INTERNAL,
-				Status: 500,
-				Title:  "Internal Server Error",
-				Detail: stringPtr(err.Error()),
-			},
-		}, nil
+		return mapListServiceErrorToHTTP(err), nil
 	}
 
 	// Return HTTP response
+	if mask != nil {
+		return maskList(mask, result.ServiceTypes, result.NextPageToken, result.TotalSize)
+	}
 	response := server.ListServiceTypes200JSONResponse(v1alpha1.ServiceTypeList{
-		Results: result.ServiceTypes,
+		Results:   result.ServiceTypes,
+		TotalSize: result.TotalSize,
 	})
 	if result.NextPageToken != nil {
 		response.NextPageToken = *result.NextPageToken
@@ -48,6 +52,21 @@ func (h *Handler) CreateServiceType(ctx context.Context, request server.CreateSe
 		Metadata:    request.Body.Metadata,
 		Spec:        request.Body.Spec,
 	}
+	if request.Body.Default != nil {
+		req.Default = *request.Body.Default
+	}
+	if request.Body.Deprecated != nil {
+		req.Deprecated = *request.Body.Deprecated
+		req.DeprecationMessage = request.Body.DeprecationMessage
+		req.SunsetTime = request.Body.SunsetTime
+	}
+	if request.Body.Conversions != nil {
+		req.Conversions = *request.Body.Conversions
+	}
+	var err error
+	if req.RequestID, err = requestID(request.Params.RequestId, request.Params.IdempotencyKey); err != nil {
+		return mapCreateServiceErrorToHTTP(err), nil
+	}
 
 	// Call service layer
 	result, err := h.service.ServiceType().Create(ctx, req)
@@ -60,6 +79,12 @@ func (h *Handler) CreateServiceType(ctx context.Context, request server.CreateSe
 }
 
 func (h *Handler) GetServiceType(ctx context.Context, request server.GetServiceTypeRequestObject) (server.GetServiceTypeResponseObject, error) {
+	// Build service request from HTTP params
+	mask, err := readMask(request.Params.ReadMask, v1alpha1.ServiceType{})
+	if err != nil {
+		return mapGetServiceErrorToHTTP(err), nil
+	}
+
 	// Call service layer
 	result, err := h.service.ServiceType().Get(ctx, request.ServiceTypeId)
 	if err != nil {
@@ -67,5 +92,58 @@ func (h *Handler) GetServiceType(ctx context.Context, request server.GetServiceT
 	}
 
 	// Return HTTP response
+	if mask != nil {
+		return maskResource(mask, result)
+	}
 	return server.GetServiceType200JSONResponse(*result), nil
 }
+
+func (h *Handler) UpdateServiceType(ctx context.Context, request server.UpdateServiceTypeRequestObject) (server.UpdateServiceTypeResponseObject, error) {
+	// Build service request from the merge patch body
+	req := &service.UpdateServiceTypeRequest{
+		Default:            request.Body.Default,
+		Deprecated:         request.Body.Deprecated,
+		DeprecationMessage: request.Body.DeprecationMessage,
+		SunsetTime:         request.Body.SunsetTime,
+	}
+
+	// Call service layer
+	result, err := h.service.ServiceType().Update(ctx, request.ServiceTypeId, req)
+	if err != nil {
+		return mapUpdateServiceErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.UpdateServiceType200JSONResponse(*result), nil
+}
+
+func (h *Handler) ApplyServiceType(ctx context.Context, request server.ApplyServiceTypeRequestObject) (server.ApplyServiceTypeResponseObject, error) {
+	// Build service request from HTTP params
+	req := &service.CreateServiceTypeRequest{
+		ApiVersion:  request.Body.ApiVersion,
+		ServiceType: request.Body.ServiceType,
+		Metadata:    request.Body.Metadata,
+		Spec:        request.Body.Spec,
+	}
+	if request.Body.Default != nil {
+		req.Default = *request.Body.Default
+	}
+	if request.Body.Deprecated != nil {
+		req.Deprecated = *request.Body.Deprecated
+		req.DeprecationMessage = request.Body.DeprecationMessage
+		req.SunsetTime = request.Body.SunsetTime
+	}
+	if request.Body.Conversions != nil {
+		req.Conversions = *request.Body.Conversions
+	}
+	validateOnly := request.Params.ValidateOnly != nil && *request.Params.ValidateOnly
+
+	// Call service layer
+	result, err := h.service.ServiceType().Apply(ctx, request.ServiceTypeId, req, validateOnly)
+	if err != nil {
+		return mapApplyServiceTypeErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.ApplyServiceType200JSONResponse(*result), nil
+}
@@ -5,13 +5,27 @@ import (
 
 	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
 	"github.com/dcm-project/catalog-manager/internal/service"
 )
 
+// mapListServiceErrorToHTTP converts service domain errors to ListServiceTypes HTTP responses
+func mapListServiceErrorToHTTP(err error) server.ListServiceTypesResponseObject {
+	switch {
+	case errors.Is(err, fieldmask.ErrInvalidReadMask):
+		return server.ListServiceTypes400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	default:
+		return server.ListServiceTypes500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
 // mapCreateServiceErrorToHTTP converts service domain errors to CreateServiceType HTTP responses
 func mapCreateServiceErrorToHTTP(err error) server.CreateServiceTypeResponseObject {
 	switch {
-	case errors.Is(err, service.ErrInvalidServiceType):
+	case errors.Is(err, service.ErrInvalidServiceType), errors.Is(err, service.ErrInvalidServiceTypeVersion),
+		errors.Is(err, service.ErrInvalidRequestID):
 		// Validation errors -> 400 Bad Request
 		return server.CreateServiceType400JSONResponse(v1alpha1.Error{
 			Type:   v1alpha1.INVALIDARGUMENT,
@@ -29,6 +43,10 @@ func mapCreateServiceErrorToHTTP(err error) server.CreateServiceTypeResponseObje
 				Detail: stringPtr(err.Error()),
 			},
 		}
+	case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return server.CreateServiceType409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(idempotencyConflict(err)),
+		}
 	default:
 		// Unknown errors -> 500 Internal Server Error
 		return server.CreateServiceType500JSONResponse{
@@ -45,6 +63,10 @@ func mapCreateServiceErrorToHTTP(err error) server.CreateServiceTypeResponseObje
 // mapGetServiceErrorToHTTP converts service domain errors to GetServiceType HTTP responses
 func mapGetServiceErrorToHTTP(err error) server.GetServiceTypeResponseObject {
 	switch {
+	case errors.Is(err, fieldmask.ErrInvalidReadMask):
+		return server.GetServiceType400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
 	case errors.Is(err, service.ErrServiceTypeNotFound):
 		// Not found -> 404 Not Found
 		return server.GetServiceType404JSONResponse{
@@ -67,3 +89,43 @@ func mapGetServiceErrorToHTTP(err error) server.GetServiceTypeResponseObject {
 		}
 	}
 }
+
+// mapUpdateServiceErrorToHTTP converts service domain errors to UpdateServiceType HTTP responses
+func mapUpdateServiceErrorToHTTP(err error) server.UpdateServiceTypeResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidServiceTypeUpdate):
+		// Validation errors -> 400 Bad Request
+		return server.UpdateServiceType400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrServiceTypeNotFound):
+		// Not found -> 404 Not Found
+		return server.UpdateServiceType404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		// Unknown errors -> 500 Internal Server Error
+		return server.UpdateServiceType500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapApplyServiceTypeErrorToHTTP converts service domain errors to ApplyServiceType HTTP responses
+func mapApplyServiceTypeErrorToHTTP(err error) server.ApplyServiceTypeResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidServiceType),
+		errors.Is(err, service.ErrInvalidServiceTypeVersion),
+		errors.Is(err, service.ErrInvalidServiceTypeUpdate):
+		// Validation errors -> 400 Bad Request
+		return server.ApplyServiceType400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrServiceTypeIDTaken), errors.Is(err, service.ErrServiceTypeNameTaken):
+		// Conflict errors -> 409 Conflict
+		return server.ApplyServiceType409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(newError(v1alpha1.ALREADYEXISTS, 409, "Conflict", err)),
+		}
+	default:
+		// Unknown errors -> 500 Internal Server Error
+		return server.ApplyServiceType500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
@@ -19,6 +19,8 @@ type mockServiceTypeService struct {
 	listFunc   func(ctx context.Context, opts *service.ServiceTypeListOptions) (*service.ServiceTypeListResult, error)
 	createFunc func(ctx context.Context, req *service.CreateServiceTypeRequest) (*v1alpha1API.ServiceType, error)
 	getFunc    func(ctx context.Context, id string) (*v1alpha1API.ServiceType, error)
+	updateFunc func(ctx context.Context, id string, req *service.UpdateServiceTypeRequest) (*v1alpha1API.ServiceType, error)
+	applyFunc  func(ctx context.Context, id string, req *service.CreateServiceTypeRequest, validateOnly bool) (*v1alpha1API.ServiceTypeApplyResult, error)
 }
 
 func (m *mockServiceTypeService) List(ctx context.Context, opts *service.ServiceTypeListOptions) (*service.ServiceTypeListResult, error) {
@@ -42,15 +44,59 @@ func (m *mockServiceTypeService) Get(ctx context.Context, id string) (*v1alpha1A
 	return &v1alpha1API.ServiceType{}, nil
 }
 
+func (m *mockServiceTypeService) Update(ctx context.Context, id string, req *service.UpdateServiceTypeRequest) (*v1alpha1API.ServiceType, error) {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, id, req)
+	}
+	return &v1alpha1API.ServiceType{}, nil
+}
+
+func (m *mockServiceTypeService) Apply(ctx context.Context, id string, req *service.CreateServiceTypeRequest, validateOnly bool) (*v1alpha1API.ServiceTypeApplyResult, error) {
+	if m.applyFunc != nil {
+		return m.applyFunc(ctx, id, req, validateOnly)
+	}
+	return &v1alpha1API.ServiceTypeApplyResult{}, nil
+}
+
 // Mock Service
 type mockService struct {
-	serviceTypeService service.ServiceTypeService
+	serviceTypeService         service.ServiceTypeService
+	catalogItemService         service.CatalogItemService
+	catalogItemInstanceService service.CatalogItemInstanceService
+	quotaService               service.QuotaService
+	operationService           service.OperationService
+	webhookSubscriptionService service.WebhookSubscriptionService
+	auditEventService          service.AuditEventService
 }
 
 func (m *mockService) ServiceType() service.ServiceTypeService {
 	return m.serviceTypeService
 }
 
+func (m *mockService) CatalogItem() service.CatalogItemService {
+	return m.catalogItemService
+}
+
+func (m *mockService) CatalogItemInstance() service.CatalogItemInstanceService {
+	return m.catalogItemInstanceService
+}
+
+func (m *mockService) Quota() service.QuotaService {
+	return m.quotaService
+}
+
+func (m *mockService) Operation() service.OperationService {
+	return m.operationService
+}
+
+func (m *mockService) WebhookSubscription() service.WebhookSubscriptionService {
+	return m.webhookSubscriptionService
+}
+
+func (m *mockService) AuditEvent() service.AuditEventService {
+	return m.auditEventService
+}
+
 var _ = Describe("ServiceType Handler", func() {
 	var (
 		ctx           context.Context
@@ -137,6 +183,27 @@ var _ = Describe("ServiceType Handler", func() {
 				created := response.(server.CreateServiceType201JSONResponse)
 				Expect(*created.Uid).To(Equal(userID))
 			})
+
+			It("should pass the idempotency key of the header or the query", func() {
+				key := "create-vm-1"
+				mockSTService.createFunc = func(ctx context.Context, req *service.CreateServiceTypeRequest) (*v1alpha1API.ServiceType, error) {
+					Expect(req.RequestID).To(Equal(key))
+					return &v1alpha1API.ServiceType{Uid: &testID, Path: &testPath, ApiVersion: "v1alpha1", ServiceType: "vm"}, nil
+				}
+
+				for _, params := range []v1alpha1API.CreateServiceTypeParams{
+					{IdempotencyKey: &key},
+					{RequestId: &key},
+					{IdempotencyKey: &key, RequestId: &key},
+				} {
+					response, err := handler.CreateServiceType(ctx, server.CreateServiceTypeRequestObject{
+						Params: params,
+						Body:   &v1alpha1API.ServiceType{ApiVersion: "v1alpha1", ServiceType: "vm"},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(response).To(BeAssignableToTypeOf(server.CreateServiceType201JSONResponse{}))
+				}
+			})
 		})
 
 		Context("with validation errors", func() {
@@ -162,6 +229,18 @@ var _ = Describe("ServiceType Handler", func() {
 				Expect(badRequest.Type).To(Equal(v1alpha1API.INVALIDARGUMENT))
 			})
 
+			It("should return 400 when the idempotency key and request_id differ", func() {
+				header, query := "key-1", "key-2"
+				request := server.CreateServiceTypeRequestObject{
+					Params: v1alpha1API.CreateServiceTypeParams{IdempotencyKey: &header, RequestId: &query},
+					Body:   &v1alpha1API.ServiceType{ApiVersion: "v1alpha1", ServiceType: "vm"},
+				}
+
+				response, err := handler.CreateServiceType(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(BeAssignableToTypeOf(server.CreateServiceType400JSONResponse{}))
+			})
+
 		})
 
 		Context("with conflict errors", func() {
@@ -205,6 +284,33 @@ var _ = Describe("ServiceType Handler", func() {
 				conflict := response.(server.CreateServiceType409JSONResponse)
 				Expect(conflict.Status).To(Equal(int32(409)))
 			})
+
+			It("should return 409 for an idempotency key reused by a different request", func() {
+				mockSTService.createFunc = func(ctx context.Context, req *service.CreateServiceTypeRequest) (*v1alpha1API.ServiceType, error) {
+					return nil, service.ErrIdempotencyKeyReused
+				}
+
+				response, err := handler.CreateServiceType(ctx, server.CreateServiceTypeRequestObject{
+					Body: &v1alpha1API.ServiceType{ApiVersion: "v1alpha1", ServiceType: "vm"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				conflict := response.(server.CreateServiceType409JSONResponse)
+				Expect(conflict.Status).To(Equal(int32(409)))
+				Expect(conflict.Type).To(Equal(v1alpha1API.FAILEDPRECONDITION))
+			})
+
+			It("should return 409 for an idempotency key of a request in progress", func() {
+				mockSTService.createFunc = func(ctx context.Context, req *service.CreateServiceTypeRequest) (*v1alpha1API.ServiceType, error) {
+					return nil, service.ErrIdempotencyKeyInProgress
+				}
+
+				response, err := handler.CreateServiceType(ctx, server.CreateServiceTypeRequestObject{
+					Body: &v1alpha1API.ServiceType{ApiVersion: "v1alpha1", ServiceType: "vm"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				conflict := response.(server.CreateServiceType409JSONResponse)
+				Expect(conflict.Type).To(Equal(v1alpha1API.ABORTED))
+			})
 		})
 
 		Context("with unknown errors", func() {
@@ -408,4 +514,124 @@ var _ = Describe("ServiceType Handler", func() {
 			})
 		})
 	})
+
+	Describe("UpdateServiceType", func() {
+		It("should pass the merge patch to the service and return 200", func() {
+			deprecated := true
+			message := "use v1beta1"
+			mockSTService.updateFunc = func(ctx context.Context, id string, req *service.UpdateServiceTypeRequest) (*v1alpha1API.ServiceType, error) {
+				Expect(id).To(Equal(testID))
+				Expect(req.Default).To(BeNil())
+				Expect(*req.Deprecated).To(BeTrue())
+				Expect(*req.DeprecationMessage).To(Equal(message))
+				return &v1alpha1API.ServiceType{
+					Uid:                &testID,
+					ApiVersion:         "v1alpha1",
+					ServiceType:        "vm",
+					Deprecated:         &deprecated,
+					DeprecationMessage: &message,
+				}, nil
+			}
+
+			request := server.UpdateServiceTypeRequestObject{
+				ServiceTypeId: testID,
+				Body: &v1alpha1API.UpdateServiceTypeApplicationMergePatchPlusJSONRequestBody{
+					Deprecated:         &deprecated,
+					DeprecationMessage: &message,
+				},
+			}
+
+			response, err := handler.UpdateServiceType(ctx, request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.UpdateServiceType200JSONResponse{}))
+			Expect(*response.(server.UpdateServiceType200JSONResponse).Deprecated).To(BeTrue())
+		})
+
+		It("should return 400 for invalid updates", func() {
+			mockSTService.updateFunc = func(ctx context.Context, id string, req *service.UpdateServiceTypeRequest) (*v1alpha1API.ServiceType, error) {
+				return nil, service.ErrInvalidServiceTypeUpdate
+			}
+
+			response, err := handler.UpdateServiceType(ctx, server.UpdateServiceTypeRequestObject{
+				ServiceTypeId: testID,
+				Body:          &v1alpha1API.UpdateServiceTypeApplicationMergePatchPlusJSONRequestBody{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.UpdateServiceType400JSONResponse{}))
+		})
+
+		It("should return 404 when service type does not exist", func() {
+			mockSTService.updateFunc = func(ctx context.Context, id string, req *service.UpdateServiceTypeRequest) (*v1alpha1API.ServiceType, error) {
+				return nil, service.ErrServiceTypeNotFound
+			}
+
+			response, err := handler.UpdateServiceType(ctx, server.UpdateServiceTypeRequestObject{
+				ServiceTypeId: "non-existent-id",
+				Body:          &v1alpha1API.UpdateServiceTypeApplicationMergePatchPlusJSONRequestBody{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.UpdateServiceType404JSONResponse{}))
+		})
+	})
+
+	Describe("ApplyServiceType", func() {
+		It("should pass the manifest and validate_only to the service and return 200", func() {
+			validateOnly := true
+			mockSTService.applyFunc = func(ctx context.Context, id string, req *service.CreateServiceTypeRequest, dryRun bool) (*v1alpha1API.ServiceTypeApplyResult, error) {
+				Expect(id).To(Equal(testID))
+				Expect(req.ServiceType).To(Equal("vm"))
+				Expect(req.Default).To(BeTrue())
+				Expect(dryRun).To(BeTrue())
+				return &v1alpha1API.ServiceTypeApplyResult{
+					Action:      v1alpha1API.ApplyActionUpdated,
+					Changes:     []v1alpha1API.ApplyChange{{Path: "default", Type: v1alpha1API.ApplyChangeModified, Before: false, After: true}},
+					ServiceType: v1alpha1API.ServiceType{Uid: &testID, ApiVersion: "v1alpha1", ServiceType: "vm"},
+				}, nil
+			}
+
+			isDefault := true
+			response, err := handler.ApplyServiceType(ctx, server.ApplyServiceTypeRequestObject{
+				ServiceTypeId: testID,
+				Params:        v1alpha1API.ApplyServiceTypeParams{ValidateOnly: &validateOnly},
+				Body: &v1alpha1API.ServiceType{
+					ApiVersion:  "v1alpha1",
+					ServiceType: "vm",
+					Spec:        map[string]any{"vcpu": map[string]any{"count": 2}},
+					Default:     &isDefault,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ApplyServiceType200JSONResponse{}))
+			result := response.(server.ApplyServiceType200JSONResponse)
+			Expect(result.Action).To(Equal(v1alpha1API.ApplyActionUpdated))
+			Expect(result.Changes).To(HaveLen(1))
+		})
+
+		It("should return 400 when the manifest changes an immutable field", func() {
+			mockSTService.applyFunc = func(ctx context.Context, id string, req *service.CreateServiceTypeRequest, validateOnly bool) (*v1alpha1API.ServiceTypeApplyResult, error) {
+				Expect(validateOnly).To(BeFalse())
+				return nil, service.ErrInvalidServiceTypeUpdate
+			}
+
+			response, err := handler.ApplyServiceType(ctx, server.ApplyServiceTypeRequestObject{
+				ServiceTypeId: testID,
+				Body:          &v1alpha1API.ServiceType{ApiVersion: "v1alpha1", ServiceType: "vm"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ApplyServiceType400JSONResponse{}))
+		})
+
+		It("should return 409 when the version belongs to another service type ID", func() {
+			mockSTService.applyFunc = func(ctx context.Context, id string, req *service.CreateServiceTypeRequest, validateOnly bool) (*v1alpha1API.ServiceTypeApplyResult, error) {
+				return nil, service.ErrServiceTypeNameTaken
+			}
+
+			response, err := handler.ApplyServiceType(ctx, server.ApplyServiceTypeRequestObject{
+				ServiceTypeId: testID,
+				Body:          &v1alpha1API.ServiceType{ApiVersion: "v1alpha1", ServiceType: "vm"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ApplyServiceType409JSONResponse{}))
+		})
+	})
 })
@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+func (h *Handler) ListWebhookSubscriptions(ctx context.Context, request server.ListWebhookSubscriptionsRequestObject) (server.ListWebhookSubscriptionsResponseObject, error) {
+	// Build service request from HTTP params
+	opts := &service.WebhookSubscriptionListOptions{
+		PageToken:   request.Params.PageToken,
+		MaxPageSize: request.Params.MaxPageSize,
+		Parent:      request.Params.Parent,
+	}
+
+	// Call service layer
+	result, err := h.service.WebhookSubscription().List(ctx, opts)
+	if err != nil {
+		return mapListWebhookSubscriptionsErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	response := server.ListWebhookSubscriptions200JSONResponse(v1alpha1.WebhookSubscriptionList{
+		Results: result.WebhookSubscriptions,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
+}
+
+func (h *Handler) CreateWebhookSubscription(ctx context.Context, request server.CreateWebhookSubscriptionRequestObject) (server.CreateWebhookSubscriptionResponseObject, error) {
+	// Build service request from HTTP params
+	req := &service.CreateWebhookSubscriptionRequest{
+		ID:             request.Params.Id,
+		Parent:         request.Params.Parent,
+		URL:            request.Body.Url,
+		ResourceFilter: derefString(request.Body.ResourceFilter),
+		Secret:         derefString(request.Body.Secret),
+	}
+	if request.Body.EventTypes != nil {
+		req.EventTypes = *request.Body.EventTypes
+	}
+	var err error
+	if req.RequestID, err = requestID(request.Params.RequestId, request.Params.IdempotencyKey); err != nil {
+		return mapCreateWebhookSubscriptionErrorToHTTP(err), nil
+	}
+
+	// Call service layer
+	result, err := h.service.WebhookSubscription().Create(ctx, req)
+	if err != nil {
+		return mapCreateWebhookSubscriptionErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.CreateWebhookSubscription201JSONResponse(*result), nil
+}
+
+func (h *Handler) GetWebhookSubscription(ctx context.Context, request server.GetWebhookSubscriptionRequestObject) (server.GetWebhookSubscriptionResponseObject, error) {
+	// Call service layer
+	result, err := h.service.WebhookSubscription().Get(ctx, request.WebhookSubscriptionId)
+	if err != nil {
+		return mapGetWebhookSubscriptionErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.GetWebhookSubscription200JSONResponse(*result), nil
+}
+
+func (h *Handler) DeleteWebhookSubscription(ctx context.Context, request server.DeleteWebhookSubscriptionRequestObject) (server.DeleteWebhookSubscriptionResponseObject, error) {
+	// Call service layer
+	if err := h.service.WebhookSubscription().Delete(ctx, request.WebhookSubscriptionId); err != nil {
+		return mapDeleteWebhookSubscriptionErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.DeleteWebhookSubscription204Response{}, nil
+}
+
+func (h *Handler) TestWebhookSubscription(ctx context.Context, request server.TestWebhookSubscriptionRequestObject) (server.TestWebhookSubscriptionResponseObject, error) {
+	// Call service layer
+	result, err := h.service.WebhookSubscription().Test(ctx, request.WebhookSubscriptionId)
+	if err != nil {
+		return mapTestWebhookSubscriptionErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	return server.TestWebhookSubscription200JSONResponse(*result), nil
+}
+
+func (h *Handler) ListWebhookDeliveries(ctx context.Context, request server.ListWebhookDeliveriesRequestObject) (server.ListWebhookDeliveriesResponseObject, error) {
+	// Build service request from HTTP params
+	opts := &service.WebhookDeliveryListOptions{
+		PageToken:   request.Params.PageToken,
+		MaxPageSize: request.Params.MaxPageSize,
+	}
+
+	// Call service layer
+	result, err := h.service.WebhookSubscription().ListDeliveries(ctx, request.WebhookSubscriptionId, opts)
+	if err != nil {
+		return mapListWebhookDeliveriesErrorToHTTP(err), nil
+	}
+
+	// Return HTTP response
+	response := server.ListWebhookDeliveries200JSONResponse(v1alpha1.WebhookDeliveryList{
+		Results: result.WebhookDeliveries,
+	})
+	if result.NextPageToken != nil {
+		response.NextPageToken = *result.NextPageToken
+	}
+
+	return response, nil
+}
@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"errors"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// mapListWebhookSubscriptionsErrorToHTTP converts service domain errors to ListWebhookSubscriptions HTTP responses
+func mapListWebhookSubscriptionsErrorToHTTP(err error) server.ListWebhookSubscriptionsResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidParent):
+		return server.ListWebhookSubscriptions400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.ListWebhookSubscriptions403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	default:
+		return server.ListWebhookSubscriptions500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapCreateWebhookSubscriptionErrorToHTTP converts service domain errors to CreateWebhookSubscription HTTP responses
+func mapCreateWebhookSubscriptionErrorToHTTP(err error) server.CreateWebhookSubscriptionResponseObject {
+	switch {
+	case errors.Is(err, service.ErrInvalidWebhookSubscription), errors.Is(err, service.ErrInvalidParent),
+		errors.Is(err, service.ErrInvalidRequestID):
+		// Validation errors -> 400 Bad Request
+		return server.CreateWebhookSubscription400JSONResponse{
+			BadRequestJSONResponse: server.BadRequestJSONResponse(newError(v1alpha1.INVALIDARGUMENT, 400, "Bad Request", err)),
+		}
+	case errors.Is(err, service.ErrTenantMismatch):
+		return server.CreateWebhookSubscription403JSONResponse{ForbiddenJSONResponse: forbiddenError(err)}
+	case errors.Is(err, service.ErrWebhookSubscriptionIDTaken):
+		// Conflict errors -> 409 Conflict
+		return server.CreateWebhookSubscription409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(newError(v1alpha1.ALREADYEXISTS, 409, "Conflict", err)),
+		}
+	case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrIdempotencyKeyInProgress):
+		return server.CreateWebhookSubscription409JSONResponse{
+			AlreadyExistsJSONResponse: server.AlreadyExistsJSONResponse(idempotencyConflict(err)),
+		}
+	default:
+		return server.CreateWebhookSubscription500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapGetWebhookSubscriptionErrorToHTTP converts service domain errors to GetWebhookSubscription HTTP responses
+func mapGetWebhookSubscriptionErrorToHTTP(err error) server.GetWebhookSubscriptionResponseObject {
+	switch {
+	case errors.Is(err, service.ErrWebhookSubscriptionNotFound):
+		return server.GetWebhookSubscription404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.GetWebhookSubscription500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapDeleteWebhookSubscriptionErrorToHTTP converts service domain errors to DeleteWebhookSubscription HTTP responses
+func mapDeleteWebhookSubscriptionErrorToHTTP(err error) server.DeleteWebhookSubscriptionResponseObject {
+	switch {
+	case errors.Is(err, service.ErrWebhookSubscriptionNotFound):
+		return server.DeleteWebhookSubscription404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.DeleteWebhookSubscription500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapTestWebhookSubscriptionErrorToHTTP converts service domain errors to TestWebhookSubscription HTTP responses
+func mapTestWebhookSubscriptionErrorToHTTP(err error) server.TestWebhookSubscriptionResponseObject {
+	switch {
+	case errors.Is(err, service.ErrWebhookSubscriptionNotFound):
+		return server.TestWebhookSubscription404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.TestWebhookSubscription500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
+
+// mapListWebhookDeliveriesErrorToHTTP converts service domain errors to ListWebhookDeliveries HTTP responses
+func mapListWebhookDeliveriesErrorToHTTP(err error) server.ListWebhookDeliveriesResponseObject {
+	switch {
+	case errors.Is(err, service.ErrWebhookSubscriptionNotFound):
+		return server.ListWebhookDeliveries404JSONResponse{
+			NotFoundJSONResponse: server.NotFoundJSONResponse(newError(v1alpha1.NOTFOUND, 404, "Not Found", err)),
+		}
+	default:
+		return server.ListWebhookDeliveries500JSONResponse{InternalServerErrorJSONResponse: internalError(err)}
+	}
+}
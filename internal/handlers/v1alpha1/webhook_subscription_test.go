@@ -0,0 +1,186 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1alpha1API "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+)
+
+// Mock WebhookSubscriptionService for testing
+type mockWebhookSubscriptionService struct {
+	listFunc           func(ctx context.Context, opts *service.WebhookSubscriptionListOptions) (*service.WebhookSubscriptionListResult, error)
+	createFunc         func(ctx context.Context, req *service.CreateWebhookSubscriptionRequest) (*v1alpha1API.WebhookSubscription, error)
+	getFunc            func(ctx context.Context, id string) (*v1alpha1API.WebhookSubscription, error)
+	deleteFunc         func(ctx context.Context, id string) error
+	testFunc           func(ctx context.Context, id string) (*v1alpha1API.WebhookDelivery, error)
+	listDeliveriesFunc func(ctx context.Context, id string, opts *service.WebhookDeliveryListOptions) (*service.WebhookDeliveryListResult, error)
+}
+
+func (m *mockWebhookSubscriptionService) List(ctx context.Context, opts *service.WebhookSubscriptionListOptions) (*service.WebhookSubscriptionListResult, error) {
+	if m.listFunc != nil {
+		return m.listFunc(ctx, opts)
+	}
+	return &service.WebhookSubscriptionListResult{}, nil
+}
+
+func (m *mockWebhookSubscriptionService) Create(ctx context.Context, req *service.CreateWebhookSubscriptionRequest) (*v1alpha1API.WebhookSubscription, error) {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, req)
+	}
+	return &v1alpha1API.WebhookSubscription{}, nil
+}
+
+func (m *mockWebhookSubscriptionService) Get(ctx context.Context, id string) (*v1alpha1API.WebhookSubscription, error) {
+	if m.getFunc != nil {
+		return m.getFunc(ctx, id)
+	}
+	return &v1alpha1API.WebhookSubscription{}, nil
+}
+
+func (m *mockWebhookSubscriptionService) Delete(ctx context.Context, id string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockWebhookSubscriptionService) Test(ctx context.Context, id string) (*v1alpha1API.WebhookDelivery, error) {
+	if m.testFunc != nil {
+		return m.testFunc(ctx, id)
+	}
+	return &v1alpha1API.WebhookDelivery{}, nil
+}
+
+func (m *mockWebhookSubscriptionService) ListDeliveries(ctx context.Context, id string, opts *service.WebhookDeliveryListOptions) (*service.WebhookDeliveryListResult, error) {
+	if m.listDeliveriesFunc != nil {
+		return m.listDeliveriesFunc(ctx, id, opts)
+	}
+	return &service.WebhookDeliveryListResult{}, nil
+}
+
+var _ = Describe("WebhookSubscription Handler", func() {
+	var (
+		ctx          context.Context
+		handler      *v1alpha1.Handler
+		mockWService *mockWebhookSubscriptionService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockWService = &mockWebhookSubscriptionService{}
+		handler = v1alpha1.NewHandler(&mockService{webhookSubscriptionService: mockWService})
+	})
+
+	Describe("CreateWebhookSubscription", func() {
+		It("should pass the body to the service and return 201", func() {
+			eventTypes := []string{"io.dcm.catalog.catalog_item.created"}
+			secret := "0123456789abcdef"
+			var captured *service.CreateWebhookSubscriptionRequest
+			mockWService.createFunc = func(ctx context.Context, req *service.CreateWebhookSubscriptionRequest) (*v1alpha1API.WebhookSubscription, error) {
+				captured = req
+				return &v1alpha1API.WebhookSubscription{Url: req.URL}, nil
+			}
+
+			resp, err := handler.CreateWebhookSubscription(ctx, server.CreateWebhookSubscriptionRequestObject{
+				Body: &v1alpha1API.WebhookSubscription{
+					Url:        "https://example.com/hook",
+					EventTypes: &eventTypes,
+					Secret:     &secret,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.CreateWebhookSubscription201JSONResponse{}))
+			Expect(captured.URL).To(Equal("https://example.com/hook"))
+			Expect(captured.EventTypes).To(Equal(eventTypes))
+			Expect(captured.Secret).To(Equal(secret))
+		})
+
+		It("should return 400 for invalid subscriptions", func() {
+			mockWService.createFunc = func(ctx context.Context, req *service.CreateWebhookSubscriptionRequest) (*v1alpha1API.WebhookSubscription, error) {
+				return nil, fmt.Errorf("%w: url must be an absolute http or https URL", service.ErrInvalidWebhookSubscription)
+			}
+
+			resp, err := handler.CreateWebhookSubscription(ctx, server.CreateWebhookSubscriptionRequestObject{
+				Body: &v1alpha1API.WebhookSubscription{Url: "ftp://example.com"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			badRequest, ok := resp.(server.CreateWebhookSubscription400JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(*badRequest.Detail).To(ContainSubstring("http or https"))
+		})
+
+		It("should return 409 when the ID is taken", func() {
+			mockWService.createFunc = func(ctx context.Context, req *service.CreateWebhookSubscriptionRequest) (*v1alpha1API.WebhookSubscription, error) {
+				return nil, service.ErrWebhookSubscriptionIDTaken
+			}
+
+			resp, err := handler.CreateWebhookSubscription(ctx, server.CreateWebhookSubscriptionRequestObject{
+				Body: &v1alpha1API.WebhookSubscription{Url: "https://example.com/hook"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.CreateWebhookSubscription409JSONResponse{}))
+		})
+	})
+
+	Describe("TestWebhookSubscription", func() {
+		It("should return the ping delivery", func() {
+			state := v1alpha1API.WebhookDeliverySucceeded
+			mockWService.testFunc = func(ctx context.Context, id string) (*v1alpha1API.WebhookDelivery, error) {
+				Expect(id).To(Equal("billing"))
+				return &v1alpha1API.WebhookDelivery{State: &state}, nil
+			}
+
+			resp, err := handler.TestWebhookSubscription(ctx, server.TestWebhookSubscriptionRequestObject{WebhookSubscriptionId: "billing"})
+			Expect(err).NotTo(HaveOccurred())
+			delivery, ok := resp.(server.TestWebhookSubscription200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(*delivery.State).To(Equal(v1alpha1API.WebhookDeliverySucceeded))
+		})
+
+		It("should return 404 for unknown subscriptions", func() {
+			mockWService.testFunc = func(ctx context.Context, id string) (*v1alpha1API.WebhookDelivery, error) {
+				return nil, service.ErrWebhookSubscriptionNotFound
+			}
+
+			resp, err := handler.TestWebhookSubscription(ctx, server.TestWebhookSubscriptionRequestObject{WebhookSubscriptionId: "missing"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.TestWebhookSubscription404JSONResponse{}))
+		})
+	})
+
+	Describe("ListWebhookDeliveries", func() {
+		It("should return the deliveries and the next page token", func() {
+			next := "token"
+			mockWService.listDeliveriesFunc = func(ctx context.Context, id string, opts *service.WebhookDeliveryListOptions) (*service.WebhookDeliveryListResult, error) {
+				return &service.WebhookDeliveryListResult{
+					WebhookDeliveries: []v1alpha1API.WebhookDelivery{{}},
+					NextPageToken:     &next,
+				}, nil
+			}
+
+			resp, err := handler.ListWebhookDeliveries(ctx, server.ListWebhookDeliveriesRequestObject{WebhookSubscriptionId: "billing"})
+			Expect(err).NotTo(HaveOccurred())
+			list, ok := resp.(server.ListWebhookDeliveries200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(list.Results).To(HaveLen(1))
+			Expect(list.NextPageToken).To(Equal("token"))
+		})
+
+		It("should return 404 for unknown subscriptions", func() {
+			mockWService.listDeliveriesFunc = func(ctx context.Context, id string, opts *service.WebhookDeliveryListOptions) (*service.WebhookDeliveryListResult, error) {
+				return nil, service.ErrWebhookSubscriptionNotFound
+			}
+
+			resp, err := handler.ListWebhookDeliveries(ctx, server.ListWebhookDeliveriesRequestObject{WebhookSubscriptionId: "missing"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(server.ListWebhookDeliveries404JSONResponse{}))
+		})
+	})
+})
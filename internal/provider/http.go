@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxErrorBody bounds how much of a failed provider response is quoted in errors
+const maxErrorBody = 512
+
+// createResponse is the body a provider returns from a create request
+type createResponse struct {
+	ID string `json:"id"`
+}
+
+// HTTPClient is a Client that talks to providers over HTTP.
+//
+// For each service type it POSTs the JSON payload to the configured endpoint and
+// expects a 2xx response with a JSON body of the form {"id": "<uid>"}. The
+// requestID is sent in the Idempotency-Key header so that retried creates can be
+// deduplicated. Status is read with GET {endpoint}/{uid}, which returns a body of
+// the form {"status": "PROVISIONING|READY|FAILED", "message": "..."}, and deletes
+// are sent as DELETE {endpoint}/{uid}.
+type HTTPClient struct {
+	endpoints  map[string]string
+	httpClient *http.Client
+}
+
+// NewHTTPClient creates an HTTPClient from a map of service type to provider endpoint.
+// A nil httpClient uses http.DefaultClient.
+func NewHTTPClient(endpoints map[string]string, httpClient *http.Client) *HTTPClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &HTTPClient{endpoints: endpoints, httpClient: httpClient}
+}
+
+// Compile-time verification
+var _ Client = (*HTTPClient)(nil)
+
+// Create POSTs the payload to the service type's provider and returns the UID it assigned
+func (c *HTTPClient) Create(ctx context.Context, serviceType, requestID string, payload map[string]any) (string, error) {
+	endpoint, err := c.endpoint(serviceType)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrProviderFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Idempotency-Key", requestID)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrProviderFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", statusError(http.MethodPost, endpoint, resp)
+	}
+
+	var created createResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("%w: invalid response from %s: %w", ErrProviderFailed, endpoint, err)
+	}
+	if created.ID == "" {
+		return "", fmt.Errorf("%w: response from %s has no id", ErrProviderFailed, endpoint)
+	}
+	return created.ID, nil
+}
+
+// Get reads the status of a service type instance with GET {endpoint}/{uid}
+func (c *HTTPClient) Get(ctx context.Context, serviceType, uid string) (*Status, error) {
+	target, err := c.instanceURL(serviceType, uid)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProviderFailed, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProviderFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %q", ErrInstanceNotFound, uid)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, statusError(http.MethodGet, target, resp)
+	}
+
+	var status Status
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("%w: invalid response from %s: %w", ErrProviderFailed, target, err)
+	}
+	switch status.State {
+	case StateProvisioning, StateReady, StateFailed:
+		return &status, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q from %s", ErrProviderFailed, status.State, target)
+	}
+}
+
+// Delete sends DELETE {endpoint}/{uid} to the service type's provider
+func (c *HTTPClient) Delete(ctx context.Context, serviceType, uid string) error {
+	target, err := c.instanceURL(serviceType, uid)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, target, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrProviderFailed, err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrProviderFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return statusError(http.MethodDelete, target, resp)
+	}
+	return nil
+}
+
+// endpoint returns the provider endpoint of a service type
+func (c *HTTPClient) endpoint(serviceType string) (string, error) {
+	endpoint, ok := c.endpoints[serviceType]
+	if !ok || endpoint == "" {
+		return "", fmt.Errorf("%w: %q", ErrNoProvider, serviceType)
+	}
+	return endpoint, nil
+}
+
+// instanceURL returns the URL of a service type instance at its provider
+func (c *HTTPClient) instanceURL(serviceType, uid string) (string, error) {
+	endpoint, err := c.endpoint(serviceType)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(endpoint, "/") + "/" + url.PathEscape(uid), nil
+}
+
+// statusError builds an ErrProviderFailed error from a non-2xx response
+func statusError(method, target string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	detail := strings.TrimSpace(string(body))
+	if detail == "" {
+		return fmt.Errorf("%w: %s %s returned %s", ErrProviderFailed, method, target, resp.Status)
+	}
+	return fmt.Errorf("%w: %s %s returned %s: %s", ErrProviderFailed, method, target, resp.Status, detail)
+}
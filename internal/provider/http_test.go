@@ -0,0 +1,150 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/provider"
+)
+
+var _ = Describe("HTTPClient", func() {
+	var (
+		ctx     context.Context
+		srv     *httptest.Server
+		handler http.HandlerFunc
+		client  *provider.HTTPClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		handler = nil
+		srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+		client = provider.NewHTTPClient(map[string]string{"vm": srv.URL + "/vms"}, srv.Client())
+	})
+
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	Describe("Create", func() {
+		It("should POST the payload and return the provider's UID", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.URL.Path).To(Equal("/vms"))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+				Expect(r.Header.Get("Idempotency-Key")).To(Equal("my-vm"))
+				var payload map[string]any
+				Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+				Expect(payload).To(HaveKeyWithValue("service_type", "vm"))
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id": "vm-123"}`))
+			}
+
+			uid, err := client.Create(ctx, "vm", "my-vm", map[string]any{"service_type": "vm"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uid).To(Equal("vm-123"))
+		})
+
+		It("should fail for service types without a provider", func() {
+			_, err := client.Create(ctx, "database", "my-db", map[string]any{})
+			Expect(err).To(MatchError(provider.ErrNoProvider))
+		})
+
+		It("should fail with the response detail on non-2xx responses", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "out of capacity", http.StatusBadGateway)
+			}
+
+			_, err := client.Create(ctx, "vm", "my-vm", map[string]any{})
+			Expect(err).To(MatchError(provider.ErrProviderFailed))
+			Expect(err.Error()).To(ContainSubstring("out of capacity"))
+		})
+
+		It("should fail when the response has no id", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{}`))
+			}
+
+			_, err := client.Create(ctx, "vm", "my-vm", map[string]any{})
+			Expect(err).To(MatchError(provider.ErrProviderFailed))
+		})
+
+		It("should fail when the provider is unreachable", func() {
+			srv.Close()
+			_, err := client.Create(ctx, "vm", "my-vm", map[string]any{})
+			Expect(err).To(MatchError(provider.ErrProviderFailed))
+		})
+	})
+
+	Describe("Get", func() {
+		It("should return the provider's status of the instance", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.Method).To(Equal(http.MethodGet))
+				Expect(r.URL.Path).To(Equal("/vms/vm-123"))
+				_, _ = w.Write([]byte(`{"status": "PROVISIONING", "message": "booting"}`))
+			}
+
+			status, err := client.Get(ctx, "vm", "vm-123")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*status).To(Equal(provider.Status{State: provider.StateProvisioning, Message: "booting"}))
+		})
+
+		It("should report instances unknown to the provider", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}
+
+			_, err := client.Get(ctx, "vm", "vm-123")
+			Expect(err).To(MatchError(provider.ErrInstanceNotFound))
+		})
+
+		It("should reject unknown states", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"status": "SLEEPING"}`))
+			}
+
+			_, err := client.Get(ctx, "vm", "vm-123")
+			Expect(err).To(MatchError(provider.ErrProviderFailed))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should DELETE the instance by UID", func() {
+			var path string
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					path = r.URL.Path
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}
+
+			Expect(client.Delete(ctx, "vm", "vm-123")).To(Succeed())
+			Expect(path).To(Equal("/vms/vm-123"))
+		})
+
+		It("should treat instances unknown to the provider as deleted", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}
+
+			Expect(client.Delete(ctx, "vm", "vm-123")).To(Succeed())
+		})
+
+		It("should fail on server errors", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+
+			Expect(client.Delete(ctx, "vm", "vm-123")).To(MatchError(provider.ErrProviderFailed))
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrNoProvider is returned when no provider is configured for a service type
+	ErrNoProvider = errors.New("no provider configured for service type")
+	// ErrProviderFailed is returned when the provider cannot be reached or rejects a request
+	ErrProviderFailed = errors.New("provider request failed")
+	// ErrInstanceNotFound is returned when the provider does not know a service type instance
+	ErrInstanceNotFound = errors.New("service type instance not found")
+)
+
+// States reported by providers for a service type instance
+const (
+	StateProvisioning = "PROVISIONING"
+	StateReady        = "READY"
+	StateFailed       = "FAILED"
+)
+
+// Status is the state of a service type instance as reported by its provider
+type Status struct {
+	State   string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Client dispatches service type instances to the provider responsible for them
+type Client interface {
+	// Create submits a rendered service type payload and returns the UID the
+	// provider assigned to the new service type instance. Requests with the same
+	// requestID must not create more than one service type instance.
+	Create(ctx context.Context, serviceType, requestID string, payload map[string]any) (string, error)
+	// Get returns the provider's view of a service type instance
+	Get(ctx context.Context, serviceType, uid string) (*Status, error)
+	// Delete removes a service type instance. Instances the provider no longer
+	// knows about are treated as deleted.
+	Delete(ctx context.Context, serviceType, uid string) error
+}
@@ -0,0 +1,57 @@
+package quantity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Quantity is a storage or memory size in megabytes
+type Quantity int64
+
+// Size units accepted in quantity strings
+const (
+	MB Quantity = 1
+	GB          = 1024 * MB
+	TB          = 1024 * GB
+)
+
+// ErrInvalidQuantity is returned when a string is not a quantity such as "16GB"
+var ErrInvalidQuantity = errors.New("invalid quantity: must match ^[0-9]+(MB|GB|TB)$")
+
+var (
+	quantityPattern = regexp.MustCompile(`^([0-9]+)(MB|GB|TB)$`)
+
+	units = map[string]Quantity{"MB": MB, "GB": GB, "TB": TB}
+)
+
+// Parse parses a quantity string with an MB, GB or TB suffix (e.g. "16GB").
+// Units are binary: 1GB is 1024MB.
+func Parse(s string) (Quantity, error) {
+	m := quantityPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidQuantity, s)
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidQuantity, s)
+	}
+	unit := units[m[2]]
+	if n > int64(^uint64(0)>>1)/int64(unit) {
+		return 0, fmt.Errorf("%w: %q overflows", ErrInvalidQuantity, s)
+	}
+	return Quantity(n) * unit, nil
+}
+
+// String formats the quantity using the largest unit that represents it exactly
+func (q Quantity) String() string {
+	switch {
+	case q != 0 && q%TB == 0:
+		return fmt.Sprintf("%dTB", q/TB)
+	case q != 0 && q%GB == 0:
+		return fmt.Sprintf("%dGB", q/GB)
+	default:
+		return fmt.Sprintf("%dMB", int64(q))
+	}
+}
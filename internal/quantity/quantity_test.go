@@ -0,0 +1,44 @@
+package quantity_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/quantity"
+)
+
+var _ = Describe("Quantity", func() {
+	DescribeTable("Parse",
+		func(s string, expected quantity.Quantity) {
+			q, err := quantity.Parse(s)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(q).To(Equal(expected))
+		},
+		Entry("megabytes", "512MB", 512*quantity.MB),
+		Entry("gigabytes", "16GB", 16*quantity.GB),
+		Entry("terabytes", "2TB", 2*quantity.TB),
+		Entry("zero", "0GB", quantity.Quantity(0)),
+	)
+
+	DescribeTable("Parse rejects invalid strings",
+		func(s string) {
+			_, err := quantity.Parse(s)
+			Expect(err).To(MatchError(quantity.ErrInvalidQuantity))
+		},
+		Entry("no unit", "16"),
+		Entry("lowercase unit", "16gb"),
+		Entry("unknown unit", "16KB"),
+		Entry("decimal", "1.5GB"),
+		Entry("overflow", "99999999999999999TB"),
+	)
+
+	DescribeTable("String normalizes to the largest exact unit",
+		func(q quantity.Quantity, expected string) {
+			Expect(q.String()).To(Equal(expected))
+		},
+		Entry("megabytes", 1536*quantity.MB, "1536MB"),
+		Entry("gigabytes", 2048*quantity.MB, "2GB"),
+		Entry("terabytes", 1024*quantity.GB, "1TB"),
+		Entry("zero", quantity.Quantity(0), "0MB"),
+	)
+})
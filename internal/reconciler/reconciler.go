@@ -0,0 +1,316 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/provider"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// Condition types reported in an instance's status
+const (
+	// ConditionDispatched is True once the provider has accepted the instance
+	ConditionDispatched = "Dispatched"
+	// ConditionReady is True once the provider reports the instance as ready
+	ConditionReady = "Ready"
+)
+
+// Condition statuses
+const (
+	ConditionTrue    = "True"
+	ConditionFalse   = "False"
+	ConditionUnknown = "Unknown"
+)
+
+// Config holds the tuning parameters of the reconciler. Zero values use the defaults.
+type Config struct {
+	// Workers is the number of instances reconciled concurrently
+	Workers int
+	// PollInterval is how often the database is scanned for due work, and how
+	// often provisioning instances are checked
+	PollInterval time.Duration
+	// MaxAttempts is the number of consecutive failed attempts before an instance is marked FAILED
+	MaxAttempts int
+	// BackoffBase is the delay after the first failed attempt; it doubles with every further failure
+	BackoffBase time.Duration
+	// BackoffMax caps the delay between attempts
+	BackoffMax time.Duration
+	// Lease is how long a claimed instance is withheld from other workers
+	Lease time.Duration
+}
+
+// scanBatchSize is the maximum number of due instances fetched per scan
+const scanBatchSize = 100
+
+// Reconciler advances catalog item instances through their lifecycle by talking to
+// providers. All state lives in the database, so work resumes after a restart.
+type Reconciler struct {
+	store    store.Store
+	provider provider.Client // nil when instances are not dispatched
+	cfg      Config
+	wake     chan struct{}
+	inflight sync.Map
+}
+
+// New creates a Reconciler. A nil provider marks instances READY without
+// dispatching them and deletes them without contacting a provider.
+func New(store store.Store, provider provider.Client, cfg Config) *Reconciler {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = time.Second
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = 5 * time.Minute
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 2 * time.Minute
+	}
+	return &Reconciler{
+		store:    store,
+		provider: provider,
+		cfg:      cfg,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Enqueue signals that an instance has new work, so that the next scan runs
+// immediately instead of waiting for the poll interval
+func (r *Reconciler) Enqueue(id string) {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run scans for due instances and reconciles them with a pool of workers until
+// ctx is cancelled
+func (r *Reconciler) Run(ctx context.Context) {
+	ids := make(chan string)
+	var wg sync.WaitGroup
+	for range r.cfg.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				if err := r.Reconcile(ctx, id); err != nil && ctx.Err() == nil {
+					log.Printf("Failed to reconcile catalog item instance %q: %v", id, err)
+				}
+				r.inflight.Delete(id)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		r.scan(ctx, ids)
+		select {
+		case <-ctx.Done():
+			close(ids)
+			wg.Wait()
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// scan hands the due instances that are not already being worked on to the workers
+func (r *Reconciler) scan(ctx context.Context, ids chan<- string) {
+	due, err := r.store.CatalogItemInstance().ListDue(ctx, time.Now(), scanBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("Failed to list due catalog item instances: %v", err)
+		}
+		return
+	}
+	for _, instance := range due {
+		if _, busy := r.inflight.LoadOrStore(instance.ID, struct{}{}); busy {
+			continue
+		}
+		select {
+		case ids <- instance.ID:
+		case <-ctx.Done():
+			r.inflight.Delete(instance.ID)
+			return
+		}
+	}
+}
+
+// Reconcile claims an instance and advances it by one step. Instances that are not
+// due, or are claimed by another worker, are left alone.
+func (r *Reconciler) Reconcile(ctx context.Context, id string) error {
+	now := time.Now()
+	instance, err := r.store.CatalogItemInstance().Claim(ctx, id, now, now.Add(r.cfg.Lease))
+	if err != nil {
+		if errors.Is(err, store.ErrCatalogItemInstanceNotDue) {
+			return nil
+		}
+		return err
+	}
+
+	switch instance.Status.State {
+	case model.InstanceStateDeleting:
+		return r.delete(ctx, instance)
+	case model.InstanceStatePending:
+		return r.dispatch(ctx, instance)
+	case model.InstanceStateProvisioning:
+		return r.poll(ctx, instance)
+	}
+	return nil
+}
+
+// dispatch submits a pending instance to its provider
+func (r *Reconciler) dispatch(ctx context.Context, instance *model.CatalogItemInstance) error {
+	if r.provider == nil {
+		setCondition(instance, ConditionDispatched, ConditionFalse, "NoProvider", "no provider is configured")
+		return r.settle(ctx, instance, model.InstanceStateReady, ConditionTrue, "NoProvider", "instance recorded without a provider")
+	}
+
+	// A UID is already recorded when a previous attempt was interrupted after the
+	// provider accepted the instance
+	if instance.ServiceTypeInstanceUid == "" {
+		uid, err := r.provider.Create(ctx, instance.ServiceType, instance.ID, instance.RenderedSpec)
+		if err != nil {
+			return r.retry(ctx, instance, "DispatchFailed", err)
+		}
+		instance.ServiceTypeInstanceUid = uid
+	}
+
+	setCondition(instance, ConditionDispatched, ConditionTrue, "Accepted",
+		fmt.Sprintf("service type instance %q created", instance.ServiceTypeInstanceUid))
+	instance.Status.State = model.InstanceStateProvisioning
+	instance.Status.Attempts = 0
+	instance.Status.LastError = ""
+	return r.poll(ctx, instance)
+}
+
+// poll checks a provisioning instance with its provider
+func (r *Reconciler) poll(ctx context.Context, instance *model.CatalogItemInstance) error {
+	if r.provider == nil {
+		return r.settle(ctx, instance, model.InstanceStateReady, ConditionTrue, "NoProvider", "instance recorded without a provider")
+	}
+
+	status, err := r.provider.Get(ctx, instance.ServiceType, instance.ServiceTypeInstanceUid)
+	switch {
+	case errors.Is(err, provider.ErrInstanceNotFound):
+		return r.fail(ctx, instance, "NotFound", err)
+	case err != nil:
+		return r.retry(ctx, instance, "StatusCheckFailed", err)
+	}
+
+	switch status.State {
+	case provider.StateReady:
+		return r.settle(ctx, instance, model.InstanceStateReady, ConditionTrue, "Ready", status.Message)
+	case provider.StateFailed:
+		return r.fail(ctx, instance, "ProvisioningFailed", errors.New(status.Message))
+	default:
+		setCondition(instance, ConditionReady, ConditionFalse, "Provisioning", status.Message)
+		instance.Status.Attempts = 0
+		instance.Status.LastError = ""
+		next := time.Now().Add(r.cfg.PollInterval)
+		instance.Status.NextAttemptTime = &next
+		return r.store.CatalogItemInstance().UpdateStatus(ctx, instance)
+	}
+}
+
+// delete removes an instance from its provider and then from the database
+func (r *Reconciler) delete(ctx context.Context, instance *model.CatalogItemInstance) error {
+	if r.provider != nil && instance.ServiceTypeInstanceUid != "" {
+		if err := r.provider.Delete(ctx, instance.ServiceType, instance.ServiceTypeInstanceUid); err != nil {
+			return r.retry(ctx, instance, "DeleteFailed", err)
+		}
+	}
+	err := r.store.CatalogItemInstance().Delete(ctx, instance.ID)
+	if err != nil && !errors.Is(err, store.ErrCatalogItemInstanceNotFound) {
+		return err
+	}
+	return nil
+}
+
+// retry records a failed attempt and schedules the next one with exponential
+// backoff, or marks the instance FAILED once the attempts are exhausted.
+// Missing provider configuration is not retried.
+func (r *Reconciler) retry(ctx context.Context, instance *model.CatalogItemInstance, reason string, cause error) error {
+	instance.Status.Attempts++
+	if instance.Status.Attempts >= r.cfg.MaxAttempts || errors.Is(cause, provider.ErrNoProvider) {
+		return r.fail(ctx, instance, reason, cause)
+	}
+
+	if instance.Status.State != model.InstanceStateDeleting {
+		setCondition(instance, ConditionReady, ConditionUnknown, reason, cause.Error())
+	}
+	instance.Status.LastError = cause.Error()
+	next := time.Now().Add(r.backoff(instance.Status.Attempts))
+	instance.Status.NextAttemptTime = &next
+	return r.store.CatalogItemInstance().UpdateStatus(ctx, instance)
+}
+
+// fail marks an instance FAILED; it is not retried until it is deleted
+func (r *Reconciler) fail(ctx context.Context, instance *model.CatalogItemInstance, reason string, cause error) error {
+	instance.Status.LastError = cause.Error()
+	return r.settle(ctx, instance, model.InstanceStateFailed, ConditionFalse, reason, cause.Error())
+}
+
+// settle moves an instance to a final state with no further attempts scheduled
+func (r *Reconciler) settle(ctx context.Context, instance *model.CatalogItemInstance, state, ready, reason, message string) error {
+	setCondition(instance, ConditionReady, ready, reason, message)
+	instance.Status.State = state
+	instance.Status.NextAttemptTime = nil
+	if state != model.InstanceStateFailed {
+		instance.Status.Attempts = 0
+		instance.Status.LastError = ""
+	}
+	return r.store.CatalogItemInstance().UpdateStatus(ctx, instance)
+}
+
+// backoff returns the delay before the given attempt, with up to 10% jitter
+func (r *Reconciler) backoff(attempt int) time.Duration {
+	delay := r.cfg.BackoffMax
+	if shift := attempt - 1; shift < 32 {
+		if d := r.cfg.BackoffBase << shift; d > 0 && d < delay {
+			delay = d
+		}
+	}
+	return delay + rand.N(delay/10+1)
+}
+
+// setCondition sets a condition of an instance, keeping its transition time when
+// the status does not change
+func setCondition(instance *model.CatalogItemInstance, conditionType, status, reason, message string) {
+	conditions := instance.Status.Conditions
+	for i := range conditions {
+		if conditions[i].Type != conditionType {
+			continue
+		}
+		if conditions[i].Status != status {
+			conditions[i].LastTransitionTime = time.Now().UTC()
+		}
+		conditions[i].Status = status
+		conditions[i].Reason = reason
+		conditions[i].Message = message
+		return
+	}
+	instance.Status.Conditions = append(conditions, model.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: time.Now().UTC(),
+	})
+}
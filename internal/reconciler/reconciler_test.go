@@ -0,0 +1,266 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/provider"
+	"github.com/dcm-project/catalog-manager/internal/reconciler"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+// fakeProvider is an in-memory provider.Client
+type fakeProvider struct {
+	mu        sync.Mutex
+	createErr error
+	state     string
+	creates   []string
+	deletes   []string
+	payloads  []map[string]any
+}
+
+func (f *fakeProvider) Create(ctx context.Context, serviceType, requestID string, payload map[string]any) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.createErr != nil {
+		return "", f.createErr
+	}
+	f.creates = append(f.creates, requestID)
+	f.payloads = append(f.payloads, payload)
+	return "uid-" + requestID, nil
+}
+
+func (f *fakeProvider) Get(ctx context.Context, serviceType, uid string) (*provider.Status, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &provider.Status{State: f.state, Message: "state " + f.state}, nil
+}
+
+func (f *fakeProvider) Delete(ctx context.Context, serviceType, uid string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deletes = append(f.deletes, uid)
+	return nil
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx   context.Context
+		teamA context.Context
+		str   store.Store
+		svc   service.Service
+		fake  *fakeProvider
+		rec   *reconciler.Reconciler
+	)
+
+	createInstance := func(id string) {
+		_, err := svc.CatalogItemInstance().Create(teamA, &service.CreateCatalogItemInstanceRequest{
+			ID:            &id,
+			ApiVersion:    "v1alpha1",
+			DisplayName:   "My VM",
+			CatalogItemId: "small-vm",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getInstance := func(id string) *model.CatalogItemInstance {
+		instance, err := str.CatalogItemInstance().Get(ctx, id)
+		Expect(err).ToNot(HaveOccurred())
+		return instance
+	}
+
+	// makeDue moves the next attempt of an instance to now, skipping any backoff
+	makeDue := func(id string) {
+		instance := getInstance(id)
+		now := time.Now()
+		instance.Status.NextAttemptTime = &now
+		Expect(str.CatalogItemInstance().UpdateStatus(ctx, instance)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		teamA = tenancy.NewContext(ctx, "team-a")
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		// A single connection keeps every goroutine on the same in-memory database
+		sqlDB, err := db.DB()
+		Expect(err).ToNot(HaveOccurred())
+		sqlDB.SetMaxOpenConns(1)
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+		svc = service.NewService(str)
+
+		_, err = svc.ServiceType().Create(ctx, &service.CreateServiceTypeRequest{
+			ApiVersion:  "v1alpha1",
+			ServiceType: "vm",
+			Spec:        map[string]any{"vcpu": map[string]any{"count": 1}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		id := "small-vm"
+		_, err = svc.CatalogItem().Create(ctx, &service.CreateCatalogItemRequest{
+			ID:          &id,
+			ApiVersion:  "v1alpha1",
+			DisplayName: "Small VM",
+			ServiceType: "vm",
+			Fields:      []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		fake = &fakeProvider{state: provider.StateProvisioning}
+		rec = reconciler.New(str, fake, reconciler.Config{
+			PollInterval: time.Hour,
+			MaxAttempts:  3,
+			BackoffBase:  time.Hour,
+			BackoffMax:   2 * time.Hour,
+		})
+	})
+
+	AfterEach(func() {
+		if str != nil {
+			Expect(str.Close()).To(Succeed())
+		}
+	})
+
+	Describe("Reconcile", func() {
+		It("should dispatch a pending instance and track it until ready", func() {
+			createInstance("my-vm")
+
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			instance := getInstance("my-vm")
+			Expect(instance.Status.State).To(Equal(model.InstanceStateProvisioning))
+			Expect(instance.ServiceTypeInstanceUid).To(Equal("uid-my-vm"))
+			Expect(fake.payloads).To(ConsistOf(HaveKeyWithValue("vcpu", map[string]any{"count": float64(2)})))
+
+			// Not due again until the poll interval has passed
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			Expect(getInstance("my-vm").Status.State).To(Equal(model.InstanceStateProvisioning))
+
+			fake.state = provider.StateReady
+			makeDue("my-vm")
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			instance = getInstance("my-vm")
+			Expect(instance.Status.State).To(Equal(model.InstanceStateReady))
+			Expect(instance.Status.NextAttemptTime).To(BeNil())
+			Expect(instance.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", reconciler.ConditionReady),
+				HaveField("Status", reconciler.ConditionTrue),
+			)))
+			Expect(fake.creates).To(HaveLen(1))
+		})
+
+		It("should retry with backoff and fail after the maximum attempts", func() {
+			fake.createErr = fmt.Errorf("%w: connection refused", provider.ErrProviderFailed)
+			createInstance("my-vm")
+
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			instance := getInstance("my-vm")
+			Expect(instance.Status.State).To(Equal(model.InstanceStatePending))
+			Expect(instance.Status.Attempts).To(Equal(1))
+			Expect(instance.Status.LastError).To(ContainSubstring("connection refused"))
+			Expect(*instance.Status.NextAttemptTime).To(BeTemporally(">", time.Now().Add(50*time.Minute)))
+
+			makeDue("my-vm")
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			makeDue("my-vm")
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			instance = getInstance("my-vm")
+			Expect(instance.Status.State).To(Equal(model.InstanceStateFailed))
+			Expect(instance.Status.NextAttemptTime).To(BeNil())
+		})
+
+		It("should not retry when no provider is configured for the service type", func() {
+			fake.createErr = fmt.Errorf("%w: %q", provider.ErrNoProvider, "vm")
+			createInstance("my-vm")
+
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			Expect(getInstance("my-vm").Status.State).To(Equal(model.InstanceStateFailed))
+		})
+
+		It("should mark the instance failed when the provider reports a failure", func() {
+			fake.state = provider.StateFailed
+			createInstance("my-vm")
+
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+			instance := getInstance("my-vm")
+			Expect(instance.Status.State).To(Equal(model.InstanceStateFailed))
+			Expect(instance.Status.LastError).To(Equal("state FAILED"))
+
+			result, err := svc.Operation().List(teamA, &service.OperationListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Operations).To(HaveLen(1))
+			Expect(result.Operations[0].Done).To(BeTrue())
+			Expect(*result.Operations[0].Error.Detail).To(Equal("state FAILED"))
+		})
+
+		It("should delete the instance from the provider and then remove it", func() {
+			createInstance("my-vm")
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+
+			deleteOp, err := svc.CatalogItemInstance().Delete(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rec.Reconcile(ctx, "my-vm")).To(Succeed())
+
+			Expect(fake.deletes).To(Equal([]string{"uid-my-vm"}))
+			_, err = str.CatalogItemInstance().Get(ctx, "my-vm")
+			Expect(errors.Is(err, store.ErrCatalogItemInstanceNotFound)).To(BeTrue())
+
+			op, err := svc.Operation().Get(teamA, path.Base(*deleteOp.Path))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeTrue())
+			Expect(op.Error).To(BeNil())
+		})
+
+		It("should mark instances ready without a provider", func() {
+			createInstance("my-vm")
+
+			Expect(reconciler.New(str, nil, reconciler.Config{}).Reconcile(ctx, "my-vm")).To(Succeed())
+			instance := getInstance("my-vm")
+			Expect(instance.Status.State).To(Equal(model.InstanceStateReady))
+			Expect(instance.ServiceTypeInstanceUid).To(BeEmpty())
+		})
+	})
+
+	Describe("Run", func() {
+		It("should resume pending work from the database", func() {
+			// Created before the reconciler starts, as after a restart
+			createInstance("vm-1")
+			createInstance("vm-2")
+			fake.state = provider.StateReady
+
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				rec.Run(runCtx)
+			}()
+			defer func() {
+				cancel()
+				<-done
+			}()
+
+			for _, id := range []string{"vm-1", "vm-2"} {
+				Eventually(func() string {
+					return getInstance(id).Status.State
+				}).Should(Equal(model.InstanceStateReady))
+			}
+		})
+	})
+})
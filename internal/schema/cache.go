@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/dcm-project/catalog-manager/internal/cache"
+)
+
+// Fields are the compiled validation schemas of the fields of a catalog item, by path
+type Fields map[string]*openapi3.Schema
+
+// Cache holds the compiled validation schemas of catalog items, so that they
+// are not decoded again for every instance request
+type Cache = cache.Cache[Fields]
+
+// NewCache creates an empty Cache of the schemas of at most size catalog items
+func NewCache(size int) *Cache {
+	return cache.New[Fields](size)
+}
+
+// ServiceTypeCache holds the schemas of service type versions, as returned by
+// ForServiceType, so that they are not parsed or inferred again for every
+// instance request. Entries are keyed by service type ID and update time.
+type ServiceTypeCache = cache.Cache[*openapi3.Schema]
+
+// NewServiceTypeCache creates an empty ServiceTypeCache of the schemas of at
+// most size service type versions
+func NewServiceTypeCache(size int) *ServiceTypeCache {
+	return cache.New[*openapi3.Schema](size)
+}
@@ -0,0 +1,66 @@
+package schema_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/schema"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		cache    *schema.Cache
+		compiled int
+		updated  time.Time
+	)
+
+	compile := func() (schema.Fields, error) {
+		compiled++
+		s, err := schema.Parse(map[string]any{"type": "integer", "maximum": 8})
+		return schema.Fields{"spec.vcpu.count": s}, err
+	}
+
+	BeforeEach(func() {
+		cache = schema.NewCache(10)
+		compiled = 0
+		updated = time.Now()
+	})
+
+	It("should compile the schemas of a catalog item once", func() {
+		for range 3 {
+			fields, err := cache.Get("small-vm", updated, compile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fields).To(HaveKey("spec.vcpu.count"))
+		}
+		Expect(compiled).To(Equal(1))
+	})
+
+	It("should compile the schemas again after an update", func() {
+		_, err := cache.Get("small-vm", updated, compile)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cache.Get("small-vm", updated.Add(time.Second), compile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(compiled).To(Equal(2))
+	})
+
+	It("should compile the schemas again once invalidated", func() {
+		_, err := cache.Get("small-vm", updated, compile)
+		Expect(err).ToNot(HaveOccurred())
+		cache.Invalidate("small-vm")
+		_, err = cache.Get("small-vm", updated, compile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(compiled).To(Equal(2))
+	})
+
+	It("should not cache compilation errors", func() {
+		failure := errors.New("boom")
+		_, err := cache.Get("small-vm", updated, func() (schema.Fields, error) { return nil, failure })
+		Expect(err).To(MatchError(failure))
+		_, err = cache.Get("small-vm", updated, compile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(compiled).To(Equal(1))
+	})
+})
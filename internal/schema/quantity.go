@@ -0,0 +1,188 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/dcm-project/catalog-manager/internal/quantity"
+)
+
+// Keywords of the schemas of quantities, sizes such as "16GB" that JSON
+// Schema can only describe as strings
+const (
+	// MinQuantityKeyword is the smallest quantity a schema accepts
+	MinQuantityKeyword = "x-min-quantity"
+	// MaxQuantityKeyword is the largest quantity a schema accepts
+	MaxQuantityKeyword = "x-max-quantity"
+	// QuantityFormat marks a string schema as a quantity without bounds
+	QuantityFormat = "quantity"
+)
+
+// quantityBounds returns the bounds a schema sets on quantities, if any
+func quantityBounds(s *openapi3.Schema) (minimum, maximum *quantity.Quantity, err error) {
+	parse := func(keyword string) (*quantity.Quantity, error) {
+		raw, ok := s.Extensions[keyword]
+		if !ok {
+			return nil, nil
+		}
+		str, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a string such as \"16GB\"", keyword)
+		}
+		q, err := quantity.Parse(str)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", keyword, err)
+		}
+		return &q, nil
+	}
+	if minimum, err = parse(MinQuantityKeyword); err != nil {
+		return nil, nil, err
+	}
+	if maximum, err = parse(MaxQuantityKeyword); err != nil {
+		return nil, nil, err
+	}
+	if minimum != nil && maximum != nil && *minimum > *maximum {
+		return nil, nil, fmt.Errorf("%s %s is above %s %s", MinQuantityKeyword, *minimum, MaxQuantityKeyword, *maximum)
+	}
+	return minimum, maximum, nil
+}
+
+// isQuantity reports whether a schema describes quantities
+func isQuantity(s *openapi3.Schema) bool {
+	if s == nil {
+		return false
+	}
+	_, hasMin := s.Extensions[MinQuantityKeyword]
+	_, hasMax := s.Extensions[MaxQuantityKeyword]
+	return hasMin || hasMax || s.Format == QuantityFormat
+}
+
+// validateQuantityKeywords checks the quantity keywords of a schema and of the
+// schemas nested in it
+func validateQuantityKeywords(s *openapi3.Schema) error {
+	if s == nil {
+		return nil
+	}
+	if _, _, err := quantityBounds(s); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(s.Properties) {
+		if err := validateQuantityKeywords(s.Properties[name].Value); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	if s.Items != nil {
+		return validateQuantityKeywords(s.Items.Value)
+	}
+	return nil
+}
+
+// quantityViolations checks a value against the quantity keywords of a schema
+// and of the schemas nested in it
+func quantityViolations(s *openapi3.Schema, value any, pointer string) []string {
+	if s == nil {
+		return nil
+	}
+	var messages []string
+	if isQuantity(s) {
+		if reason := quantityViolation(s, value); reason != "" {
+			if pointer != "" {
+				reason = pointer + ": " + reason
+			}
+			messages = append(messages, reason)
+		}
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			child := s.Properties[name]
+			if child == nil {
+				child = s.AdditionalProperties.Schema
+			}
+			if child != nil {
+				messages = append(messages, quantityViolations(child.Value, v[name], pointer+"/"+name)...)
+			}
+		}
+	case []any:
+		if s.Items != nil {
+			for i, item := range v {
+				messages = append(messages, quantityViolations(s.Items.Value, item, pointer+"/"+strconv.Itoa(i))...)
+			}
+		}
+	}
+	return messages
+}
+
+// quantityViolation describes why a value is not a quantity within the bounds of a schema
+func quantityViolation(s *openapi3.Schema, value any) string {
+	str, ok := value.(string)
+	if !ok {
+		return `value must be a quantity such as "16GB"`
+	}
+	q, err := quantity.Parse(str)
+	if err != nil {
+		return `value must be a quantity such as "16GB"`
+	}
+	minimum, maximum, err := quantityBounds(s)
+	switch {
+	case err != nil:
+		return err.Error()
+	case minimum != nil && q < *minimum:
+		return fmt.Sprintf("quantity must be at least %s", *minimum)
+	case maximum != nil && q > *maximum:
+		return fmt.Sprintf("quantity must be at most %s", *maximum)
+	}
+	return ""
+}
+
+// quantityLoosenings describes the quantity bounds of narrowed that are wider than those of base
+func quantityLoosenings(base, narrowed *openapi3.Schema) []string {
+	baseMin, baseMax, err := quantityBounds(base)
+	if err != nil {
+		return nil
+	}
+	narrowedMin, narrowedMax, err := quantityBounds(narrowed)
+	if err != nil {
+		return nil
+	}
+	var loosened []string
+	if baseMin != nil && narrowedMin != nil && *narrowedMin < *baseMin {
+		loosened = append(loosened, fmt.Sprintf("%s %s is below %s", MinQuantityKeyword, *narrowedMin, *baseMin))
+	}
+	if baseMax != nil && narrowedMax != nil && *narrowedMax > *baseMax {
+		loosened = append(loosened, fmt.Sprintf("%s %s is above %s", MaxQuantityKeyword, *narrowedMax, *baseMax))
+	}
+	return loosened
+}
+
+// Normalize formats a quantity described by a schema with the largest unit
+// that represents it exactly, e.g. "2048MB" as "2GB". Other values are
+// returned unchanged.
+func Normalize(s *openapi3.Schema, value any) any {
+	str, ok := value.(string)
+	if !ok || !isQuantity(s) {
+		return value
+	}
+	q, err := quantity.Parse(str)
+	if err != nil {
+		return value
+	}
+	return q.String()
+}
+
+func sortedKeys(properties openapi3.Schemas) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
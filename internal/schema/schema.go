@@ -0,0 +1,269 @@
+// Package schema resolves the JSON Schemas constraining the fields of catalog
+// items: the schema of the payloads of a service type version, and the
+// validation_schema of each field.
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ErrInvalidSchema is returned for schemas that cannot be decoded or are malformed
+var ErrInvalidSchema = errors.New("invalid schema")
+
+// Parse decodes a JSON Schema such as a field's validation_schema
+func Parse(m map[string]any) (*openapi3.Schema, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+	s := &openapi3.Schema{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+	if err := s.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+	if err := validateQuantityKeywords(s); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+	return s, nil
+}
+
+// ForServiceType returns the schema of the payloads of a service type version.
+// A spec that is itself an object schema with properties is used as is. Any
+// other spec is an example payload: its fields and their types are the only
+// ones allowed. The fields common to every service type (service_type,
+// metadata and provider_hints) are added when the spec does not define them.
+func ForServiceType(spec map[string]any) (*openapi3.Schema, error) {
+	var root *openapi3.Schema
+	if isObjectSchema(spec) {
+		s, err := Parse(spec)
+		if err != nil {
+			return nil, err
+		}
+		root = s
+	} else {
+		root = infer(spec)
+	}
+
+	for name, s := range commonFields() {
+		if _, ok := root.Properties[name]; !ok {
+			root.WithPropertyRef(name, openapi3.NewSchemaRef("", s))
+		}
+	}
+	return root, nil
+}
+
+// Lookup returns the schema of the field at a dot-separated path below root.
+// Objects that declare properties only have those fields, unless they allow
+// additional properties; objects without properties accept any field, whose
+// schema is then nil. Numeric segments index into arrays.
+func Lookup(root *openapi3.Schema, path string) (*openapi3.Schema, bool) {
+	current := root
+	for _, key := range strings.Split(path, ".") {
+		if current == nil {
+			// Below a free-form object
+			return nil, true
+		}
+		switch {
+		case current.Type.Is(openapi3.TypeArray):
+			if _, err := strconv.Atoi(key); err != nil || current.Items == nil {
+				return nil, false
+			}
+			current = current.Items.Value
+		case current.Properties[key] != nil:
+			current = current.Properties[key].Value
+		case current.AdditionalProperties.Schema != nil:
+			current = current.AdditionalProperties.Schema.Value
+		case len(current.Properties) == 0 && current.Type.Permits(openapi3.TypeObject) && !isFalse(current.AdditionalProperties.Has):
+			current = nil
+		case current.AdditionalProperties.Has != nil && *current.AdditionalProperties.Has:
+			current = nil
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// Prune removes the fields of obj that are not fields of root, as Lookup
+// defines them, and returns their dot-separated paths
+func Prune(root *openapi3.Schema, obj map[string]any) []string {
+	return prune(root, obj, "")
+}
+
+func prune(s *openapi3.Schema, obj map[string]any, prefix string) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pruned []string
+	for _, key := range keys {
+		path := prefix + key
+		child, ok := Lookup(s, key)
+		if !ok {
+			delete(obj, key)
+			pruned = append(pruned, path)
+			continue
+		}
+		if nested, isObject := obj[key].(map[string]any); isObject && child != nil {
+			pruned = append(pruned, prune(child, nested, path+".")...)
+		}
+	}
+	return pruned
+}
+
+// Validate checks a value against a schema, including its quantity keywords,
+// and reports every violation
+func Validate(s *openapi3.Schema, value any) error {
+	if messages := Violations(s, value); len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+	return nil
+}
+
+// Violations lists the violations of a schema by a value, as Validate reports
+// them
+func Violations(s *openapi3.Schema, value any) []string {
+	if s == nil {
+		return nil
+	}
+	var messages []string
+	if err := s.VisitJSON(value, openapi3.MultiErrors()); err != nil {
+		messages = violations(err)
+	}
+	return append(messages, quantityViolations(s, value, "")...)
+}
+
+// violations flattens the errors of a schema validation into short messages
+func violations(err error) []string {
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		var messages []string
+		for _, e := range multi {
+			messages = append(messages, violations(e)...)
+		}
+		return messages
+	}
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			return []string{"/" + strings.Join(pointer, "/") + ": " + schemaErr.Reason}
+		}
+		return []string{schemaErr.Reason}
+	}
+	return []string{err.Error()}
+}
+
+// Loosenings describes the constraints of narrowed that accept values base
+// rejects, such as a maximum above the maximum of base. Constraints only set
+// in one of the schemas are not compared.
+func Loosenings(base, narrowed *openapi3.Schema) []string {
+	if base == nil || narrowed == nil {
+		return nil
+	}
+	var loosened []string
+	for _, t := range narrowed.Type.Slice() {
+		if !base.Type.Permits(t) && !(t == openapi3.TypeInteger && base.Type.Includes(openapi3.TypeNumber)) {
+			loosened = append(loosened, fmt.Sprintf("type %s is not allowed by type %s", t, strings.Join(base.Type.Slice(), ", ")))
+		}
+	}
+	if base.Min != nil && narrowed.Min != nil && *narrowed.Min < *base.Min {
+		loosened = append(loosened, fmt.Sprintf("minimum %v is below %v", *narrowed.Min, *base.Min))
+	}
+	if base.Max != nil && narrowed.Max != nil && *narrowed.Max > *base.Max {
+		loosened = append(loosened, fmt.Sprintf("maximum %v is above %v", *narrowed.Max, *base.Max))
+	}
+	if base.MinLength > 0 && narrowed.MinLength > 0 && narrowed.MinLength < base.MinLength {
+		loosened = append(loosened, fmt.Sprintf("minLength %d is below %d", narrowed.MinLength, base.MinLength))
+	}
+	if base.MaxLength != nil && narrowed.MaxLength != nil && *narrowed.MaxLength > *base.MaxLength {
+		loosened = append(loosened, fmt.Sprintf("maxLength %d is above %d", *narrowed.MaxLength, *base.MaxLength))
+	}
+	if base.MinItems > 0 && narrowed.MinItems > 0 && narrowed.MinItems < base.MinItems {
+		loosened = append(loosened, fmt.Sprintf("minItems %d is below %d", narrowed.MinItems, base.MinItems))
+	}
+	if base.MaxItems != nil && narrowed.MaxItems != nil && *narrowed.MaxItems > *base.MaxItems {
+		loosened = append(loosened, fmt.Sprintf("maxItems %d is above %d", *narrowed.MaxItems, *base.MaxItems))
+	}
+	for _, v := range narrowed.Enum {
+		if err := Validate(base, v); err != nil {
+			loosened = append(loosened, fmt.Sprintf("enum value %v is not allowed", v))
+		}
+	}
+	return append(loosened, quantityLoosenings(base, narrowed)...)
+}
+
+// isObjectSchema reports whether a service type spec is an object schema
+// rather than an example payload
+func isObjectSchema(spec map[string]any) bool {
+	_, hasProperties := spec["properties"].(map[string]any)
+	return spec["type"] == openapi3.TypeObject && hasProperties
+}
+
+// infer builds the schema of the payloads shaped like an example value
+func infer(example any) *openapi3.Schema {
+	switch v := example.(type) {
+	case map[string]any:
+		s := openapi3.NewObjectSchema()
+		if len(v) == 0 {
+			// An empty example object accepts any field
+			return s
+		}
+		for name, child := range v {
+			s.WithPropertyRef(name, openapi3.NewSchemaRef("", infer(child)))
+		}
+		return s.WithoutAdditionalProperties()
+	case []any:
+		s := openapi3.NewArraySchema()
+		if len(v) > 0 {
+			s.Items = openapi3.NewSchemaRef("", infer(v[0]))
+		}
+		return s
+	case string:
+		return openapi3.NewStringSchema()
+	case bool:
+		return openapi3.NewBoolSchema()
+	case float64:
+		if v == math.Trunc(v) {
+			return openapi3.NewIntegerSchema()
+		}
+		return openapi3.NewFloat64Schema()
+	case int, int32, int64:
+		return openapi3.NewIntegerSchema()
+	default:
+		return &openapi3.Schema{}
+	}
+}
+
+// commonFields returns the schemas of the fields shared by every service type
+// payload, as defined in api/v1alpha1/servicetypes/common.yaml
+func commonFields() map[string]*openapi3.Schema {
+	labels := openapi3.NewObjectSchema().WithAdditionalProperties(openapi3.NewStringSchema())
+	name := openapi3.NewStringSchema().WithPattern(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`).WithMinLength(1).WithMaxLength(63)
+	metadata := openapi3.NewObjectSchema().
+		WithProperty("name", name).
+		WithProperty("labels", labels).
+		WithoutAdditionalProperties()
+	return map[string]*openapi3.Schema{
+		"service_type":   openapi3.NewStringSchema(),
+		"metadata":       metadata,
+		"provider_hints": openapi3.NewObjectSchema(),
+	}
+}
+
+// isFalse reports whether an optional boolean is set to false
+func isFalse(b *bool) bool {
+	return b != nil && !*b
+}
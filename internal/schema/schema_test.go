@@ -0,0 +1,173 @@
+package schema_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/schema"
+)
+
+var _ = Describe("Schema", func() {
+	Describe("ForServiceType", func() {
+		example := map[string]any{
+			"vcpu":    map[string]any{"count": float64(2)},
+			"memory":  map[string]any{"size": "4GB"},
+			"storage": map[string]any{"disks": []any{map[string]any{"name": "boot"}}},
+			"labels":  map[string]any{},
+		}
+
+		DescribeTable("Lookup in an example payload",
+			func(path string, found bool) {
+				root, err := schema.ForServiceType(example)
+				Expect(err).ToNot(HaveOccurred())
+				_, ok := schema.Lookup(root, path)
+				Expect(ok).To(Equal(found))
+			},
+			Entry("field", "vcpu.count", true),
+			Entry("object", "memory", true),
+			Entry("array item", "storage.disks.0.name", true),
+			Entry("field of a free-form object", "labels.team", true),
+			Entry("common field", "metadata.labels.team", true),
+			Entry("misspelled field", "vcpu.cuont", false),
+			Entry("field below a value", "memory.size.unit", false),
+			Entry("non-numeric array index", "storage.disks.first", false),
+		)
+
+		It("should infer the types of the example values", func() {
+			root, err := schema.ForServiceType(example)
+			Expect(err).ToNot(HaveOccurred())
+			count, ok := schema.Lookup(root, "vcpu.count")
+			Expect(ok).To(BeTrue())
+
+			Expect(schema.Validate(count, 4)).To(Succeed())
+			Expect(schema.Validate(count, 1.5)).ToNot(Succeed())
+			Expect(schema.Validate(count, "4")).ToNot(Succeed())
+		})
+
+		It("should use object schemas as is", func() {
+			root, err := schema.ForServiceType(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"replicas": map[string]any{"type": "integer", "maximum": 5},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			replicas, ok := schema.Lookup(root, "replicas")
+			Expect(ok).To(BeTrue())
+			Expect(schema.Validate(replicas, 6)).To(MatchError(ContainSubstring("at most 5")))
+			_, ok = schema.Lookup(root, "properties")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should reject malformed object schemas", func() {
+			_, err := schema.ForServiceType(map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"replicas": map[string]any{"type": "decimal"}},
+			})
+			Expect(err).To(MatchError(schema.ErrInvalidSchema))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("should report every violation", func() {
+			s, err := schema.Parse(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name":  map[string]any{"type": "string", "maxLength": 3},
+					"count": map[string]any{"type": "integer", "minimum": 1},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = schema.Validate(s, map[string]any{"name": "toolong", "count": 0})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(SatisfyAll(ContainSubstring("/name"), ContainSubstring("/count")))
+		})
+
+		It("should accept any value without a schema", func() {
+			Expect(schema.Validate(nil, "anything")).To(Succeed())
+		})
+	})
+
+	Describe("Loosenings", func() {
+		base, _ := schema.Parse(map[string]any{
+			"type":    "integer",
+			"minimum": 1,
+			"maximum": 8,
+			"enum":    []any{1, 2, 4, 8},
+		})
+
+		DescribeTable("narrowed schemas",
+			func(narrowed map[string]any, expected ...string) {
+				s, err := schema.Parse(narrowed)
+				Expect(err).ToNot(HaveOccurred())
+				if len(expected) == 0 {
+					Expect(schema.Loosenings(base, s)).To(BeEmpty())
+					return
+				}
+				Expect(schema.Loosenings(base, s)).To(ConsistOf(expected))
+			},
+			Entry("tighter bounds", map[string]any{"type": "integer", "minimum": 2, "maximum": 4}),
+			Entry("unset bounds", map[string]any{"enum": []any{2, 4}}),
+			Entry("higher maximum", map[string]any{"maximum": 16}, "maximum 16 is above 8"),
+			Entry("lower minimum", map[string]any{"minimum": 0}, "minimum 0 is below 1"),
+			Entry("other type", map[string]any{"type": "string"}, "type string is not allowed by type integer"),
+			Entry("extra enum value", map[string]any{"enum": []any{2, 3}}, "enum value 3 is not allowed"),
+		)
+	})
+
+	Describe("Quantities", func() {
+		memory, _ := schema.Parse(map[string]any{"type": "string", "x-min-quantity": "1GB", "x-max-quantity": "64GB"})
+
+		DescribeTable("Validate",
+			func(value any, reason string) {
+				err := schema.Validate(memory, value)
+				if reason == "" {
+					Expect(err).ToNot(HaveOccurred())
+					return
+				}
+				Expect(err).To(MatchError(ContainSubstring(reason)))
+			},
+			Entry("within the bounds", "16GB", ""),
+			Entry("in another unit", "65536MB", ""),
+			Entry("below the minimum", "512MB", "quantity must be at least 1GB"),
+			Entry("above the maximum", "1TB", "quantity must be at most 64GB"),
+			Entry("not a quantity", "16GiB", "must be a quantity"),
+			Entry("not a string", 16, "must be a quantity"),
+		)
+
+		It("should check the quantities nested in objects and arrays", func() {
+			disks, err := schema.Parse(map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"capacity": map[string]any{"type": "string", "x-max-quantity": "1TB"}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = schema.Validate(disks, []any{map[string]any{"capacity": "50GB"}, map[string]any{"capacity": "2TB"}})
+			Expect(err).To(MatchError("/1/capacity: quantity must be at most 1TB"))
+		})
+
+		It("should reject malformed bounds", func() {
+			_, err := schema.Parse(map[string]any{"x-max-quantity": "lots"})
+			Expect(err).To(MatchError(schema.ErrInvalidSchema))
+			_, err = schema.Parse(map[string]any{"x-min-quantity": "2GB", "x-max-quantity": "1GB"})
+			Expect(err).To(MatchError(schema.ErrInvalidSchema))
+		})
+
+		It("should detect wider bounds", func() {
+			narrowed, err := schema.Parse(map[string]any{"x-max-quantity": "128GB"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schema.Loosenings(memory, narrowed)).To(ConsistOf("x-max-quantity 128GB is above 64GB"))
+		})
+
+		It("should normalize the units of quantities", func() {
+			Expect(schema.Normalize(memory, "2048MB")).To(Equal("2GB"))
+			Expect(schema.Normalize(memory, "1536MB")).To(Equal("1536MB"))
+			Expect(schema.Normalize(nil, "2048MB")).To(Equal("2048MB"))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/audit"
+)
+
+// outputOnlyFields are the output-only fields of resources, ignored when
+// comparing a resource with the manifest applied to it
+var outputOnlyFields = []string{"uid", "path", "revision", "create_time", "update_time"}
+
+// applyChanges returns the changes of an apply from before to after,
+// ignoring output-only fields. before is nil when the resource is created.
+func applyChanges(before, after any) ([]v1alpha1.ApplyChange, error) {
+	if before == nil {
+		before = map[string]any{}
+	}
+	diff, err := audit.Diff(before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []v1alpha1.ApplyChange{}
+	for _, c := range diff {
+		if isOutputOnly(c.Path) || (isEmpty(c.Before) && isEmpty(c.After)) {
+			continue
+		}
+		change := v1alpha1.ApplyChange{Path: c.Path, Before: c.Before, After: c.After, Type: v1alpha1.ApplyChangeModified}
+		switch {
+		case c.Before == nil:
+			change.Type = v1alpha1.ApplyChangeAdded
+		case c.After == nil:
+			change.Type = v1alpha1.ApplyChangeRemoved
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// isOutputOnly reports whether path is, or is within, an output-only field
+func isOutputOnly(path string) bool {
+	for _, field := range outputOnlyFields {
+		if path == field || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmpty reports whether a JSON value is unset or empty. Omitted and empty
+// labels, for instance, are the same to an apply.
+func isEmpty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
+
+// applyAction returns the action of an apply to an existing resource
+func applyAction(changes []v1alpha1.ApplyChange) v1alpha1.ApplyAction {
+	if len(changes) == 0 {
+		return v1alpha1.ApplyActionUnchanged
+	}
+	return v1alpha1.ApplyActionUpdated
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store"
+)
+
+// AuditEventListOptions contains options for listing audit events
+type AuditEventListOptions struct {
+	PageToken   *string
+	MaxPageSize *int32
+	Resource    *string
+	Actor       *string
+	StartTime   *time.Time
+	EndTime     *time.Time
+	Parent      *string
+}
+
+// AuditEventListResult contains the result of a List operation
+type AuditEventListResult struct {
+	AuditEvents   []v1alpha1.AuditEvent
+	NextPageToken *string
+}
+
+// AuditEventService gives access to the audit log
+type AuditEventService interface {
+	List(ctx context.Context, opts *AuditEventListOptions) (*AuditEventListResult, error)
+}
+
+type auditEventService struct {
+	store store.Store
+}
+
+// newAuditEventService creates a new AuditEventService instance
+func newAuditEventService(store store.Store) AuditEventService {
+	return &auditEventService{store: store}
+}
+
+// List returns a paginated list of the changes made by the caller's tenant, newest first
+func (s *auditEventService) List(ctx context.Context, opts *AuditEventListOptions) (*AuditEventListResult, error) {
+	storeOpts := &store.AuditEventListOptions{PageSize: 100}
+	if opts != nil {
+		tenant, err := resolveParent(ctx, opts.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if tenant != "" {
+			storeOpts.Tenant = &tenant
+		}
+		if opts.StartTime != nil && opts.EndTime != nil && !opts.StartTime.Before(*opts.EndTime) {
+			return nil, fmt.Errorf("%w: start_time must be before end_time", ErrInvalidAuditEventFilter)
+		}
+		storeOpts.PageToken = opts.PageToken
+		storeOpts.Resource = opts.Resource
+		storeOpts.Actor = opts.Actor
+		storeOpts.StartTime = opts.StartTime
+		storeOpts.EndTime = opts.EndTime
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.AuditEvent().List(ctx, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEvents := make([]v1alpha1.AuditEvent, len(storeResult.AuditEvents))
+	for i, storeModel := range storeResult.AuditEvents {
+		apiEvents[i] = toAuditEventAPIType(&storeModel)
+	}
+
+	return &AuditEventListResult{
+		AuditEvents:   apiEvents,
+		NextPageToken: storeResult.NextPageToken,
+	}, nil
+}
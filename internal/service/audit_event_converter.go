@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// toAuditEventAPIType converts a store model to an API type
+func toAuditEventAPIType(m *model.AuditEvent) v1alpha1.AuditEvent {
+	apiEvent := v1alpha1.AuditEvent{
+		Path:         m.Path,
+		Uid:          m.ID,
+		Actor:        m.Actor,
+		Action:       v1alpha1.AuditEventAction(m.Action),
+		ResourceType: m.ResourceType,
+		Resource:     m.Resource,
+		Time:         m.Time,
+	}
+	if m.RequestID != "" {
+		apiEvent.RequestId = &m.RequestID
+	}
+	if m.Before != nil {
+		apiEvent.Before = &m.Before
+	}
+	if m.After != nil {
+		apiEvent.After = &m.After
+	}
+	if len(m.Changes) > 0 {
+		changes := make([]v1alpha1.AuditChange, len(m.Changes))
+		for i, c := range m.Changes {
+			changes[i] = v1alpha1.AuditChange{Path: c.Path, Before: c.Before, After: c.After}
+		}
+		apiEvent.Changes = &changes
+	}
+	return apiEvent
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/audit"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("AuditEvent Service", func() {
+	var (
+		alice context.Context
+		str   store.Store
+		svc   service.Service
+	)
+
+	BeforeEach(func() {
+		alice = audit.NewContext(tenancy.NewAdminContext(tenancy.NewContext(context.Background(), "team-a")), audit.Request{Actor: "alice", ID: "req-1"})
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{},
+			&model.Quota{}, &model.Operation{}, &model.OutboxEvent{}, &model.AuditEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+		svc = service.NewService(str)
+
+		_, err = svc.ServiceType().Create(alice, &service.CreateServiceTypeRequest{
+			ApiVersion: "v1alpha1", ServiceType: "vm", Spec: map[string]any{"vcpu": map[string]any{"count": 1}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(str.Close()).To(Succeed())
+	})
+
+	It("should list the changes of the caller's tenant, newest first", func() {
+		id := "small-vm"
+		_, err := svc.CatalogItem().Create(alice, &service.CreateCatalogItemRequest{
+			ID: &id, ApiVersion: "v1alpha1", DisplayName: "Small VM", ServiceType: "vm",
+			Fields: []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		fields := []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Default: 4}}
+		_, err = svc.CatalogItem().Update(alice, id, &service.UpdateCatalogItemRequest{Fields: &fields})
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := svc.AuditEvent().List(alice, &service.AuditEventListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.AuditEvents).To(HaveLen(3))
+
+		update := result.AuditEvents[0]
+		Expect(update.Action).To(Equal(v1alpha1.AuditActionUpdate))
+		Expect(update.Actor).To(Equal("alice"))
+		Expect(*update.RequestId).To(Equal("req-1"))
+		Expect(update.Resource).To(Equal("catalog-items/small-vm"))
+		Expect(*update.Changes).To(HaveLen(2))
+		Expect((*update.Changes)[0].Path).To(Equal("revision"))
+		Expect((*update.Changes)[1].Path).To(Equal("spec.fields[spec.vcpu.count]"))
+
+		Expect(result.AuditEvents[2].ResourceType).To(Equal("service_type"))
+		Expect(result.AuditEvents[2].Before).To(BeNil())
+	})
+
+	It("should reject empty time ranges", func() {
+		now := time.Now()
+		_, err := svc.AuditEvent().List(alice, &service.AuditEventListOptions{StartTime: &now, EndTime: &now})
+		Expect(err).To(MatchError(service.ErrInvalidAuditEventFilter))
+	})
+
+	It("should reject the parent of another tenant", func() {
+		parent := "tenants/team-b"
+		_, err := svc.AuditEvent().List(alice, &service.AuditEventListOptions{Parent: &parent})
+		Expect(err).To(MatchError(service.ErrTenantMismatch))
+	})
+})
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+)
+
+const (
+	// maxBatchGetSize is the maximum number of IDs of a batch get (AEP-231)
+	maxBatchGetSize = 1000
+	// maxBatchCreateSize is the maximum number of requests of a batch create (AEP-233)
+	maxBatchCreateSize = 100
+)
+
+// checkBatchSize checks that a batch has between 1 and max items
+func checkBatchSize(n, max int, items string) error {
+	switch {
+	case n == 0:
+		return fmt.Errorf("%w: at least one of %s is required", ErrInvalidBatchRequest, items)
+	case n > max:
+		return fmt.Errorf("%w: at most %d %s are allowed, got %d", ErrInvalidBatchRequest, max, items, n)
+	}
+	return nil
+}
+
+// inIDOrder returns the models found by a batch get in the order of ids,
+// repeating the models of repeated IDs. It fails with notFound, naming the
+// first missing ID, unless every ID was found (AEP-231).
+func inIDOrder[M any](ids []string, found []M, idOf func(*M) string, notFound error) ([]*M, error) {
+	byID := make(map[string]*M, len(found))
+	for i := range found {
+		byID[idOf(&found[i])] = &found[i]
+	}
+	ordered := make([]*M, len(ids))
+	for i, id := range ids {
+		m, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", notFound, id)
+		}
+		ordered[i] = m
+	}
+	return ordered, nil
+}
@@ -0,0 +1,571 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/expression"
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/google/uuid"
+)
+
+// CreateCatalogItemRequest contains the parameters for creating a catalog item
+type CreateCatalogItemRequest struct {
+	ID          *string // Optional user-specified ID
+	RequestID   string  // Optional idempotency key (AEP-155)
+	Parent      *string // Optional tenant parent (tenants/{tenant_id}); global, for administrators only, when omitted
+	ApiVersion  string
+	DisplayName string
+	ServiceType string
+	// ServiceTypeVersion pins a version of the service type; the default version when empty
+	ServiceTypeVersion string
+	Fields             []v1alpha1.FieldConfiguration
+}
+
+// UpdateCatalogItemRequest contains the fields of a catalog item merge patch.
+// Nil fields are left unchanged.
+type UpdateCatalogItemRequest struct {
+	ApiVersion  *string // Immutable, must match when set
+	DisplayName *string
+	ServiceType *string // Immutable, must match when set
+	// ServiceTypeVersion is immutable, must match when set
+	ServiceTypeVersion *string
+	Fields             *[]v1alpha1.FieldConfiguration
+}
+
+// CatalogItemListOptions contains options for listing catalog items
+type CatalogItemListOptions struct {
+	PageToken   *string
+	MaxPageSize *int32
+	ServiceType *string
+	Parent      *string
+	// ReadMask is the fields the caller reads (AEP-157); the spec is not
+	// loaded unless its fields are among them
+	ReadMask fieldmask.Mask
+	// SkipTotalSize leaves out the total size of the result, saving the
+	// query counting it
+	SkipTotalSize bool
+}
+
+// CatalogItemListResult contains the result of a List operation
+type CatalogItemListResult struct {
+	CatalogItems  []v1alpha1.CatalogItem
+	NextPageToken *string
+	TotalSize     *int64 // nil when skipped
+}
+
+// CatalogItemRevisionListOptions contains options for listing catalog item revisions
+type CatalogItemRevisionListOptions struct {
+	PageToken   *string
+	MaxPageSize *int32
+}
+
+// CatalogItemRevisionListResult contains the result of a ListRevisions operation
+type CatalogItemRevisionListResult struct {
+	CatalogItemRevisions []v1alpha1.CatalogItemRevision
+	NextPageToken        *string
+}
+
+// CatalogItemService defines the business logic for CatalogItem operations
+type CatalogItemService interface {
+	List(ctx context.Context, opts *CatalogItemListOptions) (*CatalogItemListResult, error)
+	Create(ctx context.Context, req *CreateCatalogItemRequest) (*v1alpha1.CatalogItem, error)
+	Get(ctx context.Context, id string) (*v1alpha1.CatalogItem, error)
+	// BatchGet retrieves the catalog items with the given IDs, in their order
+	BatchGet(ctx context.Context, ids []string) (*v1alpha1.BatchGetCatalogItemsResult, error)
+	Update(ctx context.Context, id string, req *UpdateCatalogItemRequest) (*v1alpha1.CatalogItem, error)
+	// Apply creates the catalog item with the given ID or updates it to match req
+	Apply(ctx context.Context, id string, req *CreateCatalogItemRequest, validateOnly bool) (*v1alpha1.CatalogItemApplyResult, error)
+	Delete(ctx context.Context, id string) error
+	// ListRevisions returns the revisions of a catalog item, most recent first
+	ListRevisions(ctx context.Context, id string, opts *CatalogItemRevisionListOptions) (*CatalogItemRevisionListResult, error)
+	// Rollback restores a prior revision of a catalog item as a new revision
+	Rollback(ctx context.Context, id string, revision int) (*v1alpha1.CatalogItem, error)
+	// Convert previews the spec of a catalog item converted to another version of its service type
+	Convert(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1.CatalogItemConversion, error)
+	// Form describes the form users fill in to request an instance of a catalog item
+	Form(ctx context.Context, id string) (*v1alpha1.CatalogItemForm, error)
+}
+
+type catalogItemService struct {
+	store    store.Store
+	schemas  *schema.Cache
+	programs *expression.Cache
+	bases    *schema.ServiceTypeCache
+	keys     *idempotencyKeys
+}
+
+// newCatalogItemService creates a new CatalogItemService instance
+func newCatalogItemService(store store.Store, schemas *schema.Cache, programs *expression.Cache, bases *schema.ServiceTypeCache, keys *idempotencyKeys) CatalogItemService {
+	return &catalogItemService{store: store, schemas: schemas, programs: programs, bases: bases, keys: keys}
+}
+
+// List returns a paginated list of the catalog items visible to the caller
+func (s *catalogItemService) List(ctx context.Context, opts *CatalogItemListOptions) (*CatalogItemListResult, error) {
+	storeOpts := &store.CatalogItemListOptions{PageSize: 100, CountTotal: true}
+	if opts != nil {
+		tenant, err := resolveParent(ctx, opts.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if tenant != "" {
+			storeOpts.Tenant = &tenant
+		}
+		storeOpts.PageToken = opts.PageToken
+		storeOpts.ServiceType = opts.ServiceType
+		storeOpts.SkipSpec = !opts.ReadMask.Selects("spec.fields")
+		storeOpts.CountTotal = !opts.SkipTotalSize
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.CatalogItem().List(ctx, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiItems := make([]v1alpha1.CatalogItem, len(storeResult.CatalogItems))
+	for i, storeModel := range storeResult.CatalogItems {
+		apiItems[i] = toCatalogItemAPIType(&storeModel)
+	}
+
+	return &CatalogItemListResult{
+		CatalogItems:  apiItems,
+		NextPageToken: storeResult.NextPageToken,
+		TotalSize:     storeResult.TotalSize,
+	}, nil
+}
+
+// Create creates a new catalog item, private to a tenant when a parent is given
+func (s *catalogItemService) Create(ctx context.Context, req *CreateCatalogItemRequest) (*v1alpha1.CatalogItem, error) {
+	return createIdempotent(ctx, s.keys, "CreateCatalogItem", req.RequestID, req, func(ctx context.Context) (*v1alpha1.CatalogItem, error) {
+		return s.create(ctx, req)
+	}, lookupResource(s.Get))
+}
+
+// create creates the catalog item of req
+func (s *catalogItemService) create(ctx context.Context, req *CreateCatalogItemRequest) (*v1alpha1.CatalogItem, error) {
+	storeModel, err := s.prepareCreate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	createdModel, err := s.store.CatalogItem().Create(ctx, *storeModel)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiItem := toCatalogItemAPIType(createdModel)
+	return &apiItem, nil
+}
+
+// prepareCreate validates a new catalog item and returns the store model to create
+func (s *catalogItemService) prepareCreate(ctx context.Context, req *CreateCatalogItemRequest) (*model.CatalogItem, error) {
+	tenant, err := resolveParent(ctx, req.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == "" && !tenancy.IsPrivileged(ctx) {
+		return nil, fmt.Errorf("%w: only administrators create global catalog items; give a parent to create a private one", ErrAdminRequired)
+	}
+	if err := validateCreateCatalogItem(req); err != nil {
+		return nil, err
+	}
+
+	// Pin the requested or default version of the service type
+	serviceType, err := s.store.ServiceType().Resolve(ctx, req.ServiceType, req.ServiceTypeVersion)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	if err := checkServiceTypeSunset(serviceType); err != nil {
+		return nil, err
+	}
+	warnIfDeprecated(ctx, serviceType)
+	if err := validateFieldsAgainstServiceType(serviceType, req.Fields); err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	if req.ID != nil && *req.ID != "" {
+		id = *req.ID
+	}
+
+	storeModel := toCatalogItemStoreModel(id, catalogItemPath(tenant, id), tenant, req)
+	storeModel.Spec.ServiceTypeVersion = serviceType.ApiVersion
+	return &storeModel, nil
+}
+
+// Get retrieves a catalog item visible to the caller by ID
+func (s *catalogItemService) Get(ctx context.Context, id string) (*v1alpha1.CatalogItem, error) {
+	storeModel, err := s.store.CatalogItem().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiItem := toCatalogItemAPIType(storeModel)
+	return &apiItem, nil
+}
+
+// BatchGet retrieves the catalog items visible to the caller with the given
+// IDs in a single query. It fails if any of them is not found.
+func (s *catalogItemService) BatchGet(ctx context.Context, ids []string) (*v1alpha1.BatchGetCatalogItemsResult, error) {
+	if err := checkBatchSize(len(ids), maxBatchGetSize, "ids"); err != nil {
+		return nil, err
+	}
+	storeModels, err := s.store.CatalogItem().BatchGet(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	ordered, err := inIDOrder(ids, storeModels, func(m *model.CatalogItem) string { return m.ID }, ErrCatalogItemNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1alpha1.BatchGetCatalogItemsResult{Results: make([]v1alpha1.CatalogItem, len(ordered))}
+	for i, storeModel := range ordered {
+		result.Results[i] = toCatalogItemAPIType(storeModel)
+	}
+	return result, nil
+}
+
+// Update applies a merge patch to the mutable fields of a catalog item
+func (s *catalogItemService) Update(ctx context.Context, id string, req *UpdateCatalogItemRequest) (*v1alpha1.CatalogItem, error) {
+	storeModel, err := s.store.CatalogItem().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	if err := checkCatalogItemWritable(ctx, storeModel); err != nil {
+		return nil, err
+	}
+	if err := s.patch(ctx, storeModel, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.CatalogItem().Update(ctx, storeModel); err != nil {
+		return nil, mapStoreError(err)
+	}
+	s.schemas.Invalidate(id)
+	s.programs.Invalidate(id)
+
+	// Re-read to pick up the new update time
+	return s.Get(ctx, id)
+}
+
+// checkCatalogItemWritable fails if the caller in ctx may not change
+// catalogItem: global catalog items are read-only to tenants
+func checkCatalogItemWritable(ctx context.Context, catalogItem *model.CatalogItem) error {
+	if catalogItem.Tenant == "" && !tenancy.IsPrivileged(ctx) {
+		return mapStoreError(store.ErrCatalogItemReadOnly)
+	}
+	return nil
+}
+
+// patch validates the merge patch req and applies it to storeModel
+func (s *catalogItemService) patch(ctx context.Context, storeModel *model.CatalogItem, req *UpdateCatalogItemRequest) error {
+	if req.ApiVersion != nil && *req.ApiVersion != storeModel.ApiVersion {
+		return fmt.Errorf("%w: api_version is immutable", ErrInvalidCatalogItem)
+	}
+	if req.ServiceType != nil && *req.ServiceType != storeModel.Spec.ServiceType {
+		return fmt.Errorf("%w: spec.service_type is immutable", ErrInvalidCatalogItem)
+	}
+	if req.ServiceTypeVersion != nil && *req.ServiceTypeVersion != storeModel.Spec.ServiceTypeVersion {
+		return fmt.Errorf("%w: spec.service_type_version is immutable", ErrInvalidCatalogItem)
+	}
+	if req.DisplayName != nil {
+		if *req.DisplayName == "" {
+			return fmt.Errorf("%w: display_name must not be empty", ErrInvalidCatalogItem)
+		}
+		storeModel.DisplayName = *req.DisplayName
+	}
+
+	// Existing catalog items keep working after the sunset of their version,
+	// but their authors are told about its deprecation
+	serviceType, err := s.store.ServiceType().Resolve(ctx, storeModel.Spec.ServiceType, storeModel.Spec.ServiceTypeVersion)
+	if err != nil {
+		return mapStoreError(err)
+	}
+	warnIfDeprecated(ctx, serviceType)
+
+	if req.Fields != nil {
+		if err := validateFieldConfigurations(*req.Fields); err != nil {
+			return err
+		}
+		if err := validateFieldsAgainstServiceType(serviceType, *req.Fields); err != nil {
+			return err
+		}
+		storeModel.Spec.Fields = toFieldConfigurationStoreModels(*req.Fields)
+	}
+	return nil
+}
+
+// Apply creates the catalog item with the given ID, or replaces the display
+// name and fields of an existing one with those of req. An unchanged catalog
+// item is not written and gets no new revision. Nothing is persisted when
+// validateOnly is set.
+func (s *catalogItemService) Apply(ctx context.Context, id string, req *CreateCatalogItemRequest, validateOnly bool) (*v1alpha1.CatalogItemApplyResult, error) {
+	req.ID = &id
+	existing, err := s.store.CatalogItem().Get(ctx, id)
+	if errors.Is(err, store.ErrCatalogItemNotFound) {
+		return s.applyCreate(ctx, req, validateOnly)
+	}
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	if err := checkCatalogItemWritable(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	// The parent only places new catalog items
+	if req.Parent != nil && *req.Parent != "" {
+		tenant, err := resolveParent(ctx, req.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if tenant != existing.Tenant {
+			return nil, fmt.Errorf("%w: parent is immutable", ErrInvalidCatalogItem)
+		}
+	}
+	if err := validateCreateCatalogItem(req); err != nil {
+		return nil, err
+	}
+
+	patch := &UpdateCatalogItemRequest{
+		ApiVersion:  &req.ApiVersion,
+		DisplayName: &req.DisplayName,
+		ServiceType: &req.ServiceType,
+		Fields:      &req.Fields,
+	}
+	if req.ServiceTypeVersion != "" {
+		patch.ServiceTypeVersion = &req.ServiceTypeVersion
+	}
+	updated := *existing
+	if err := s.patch(ctx, &updated, patch); err != nil {
+		return nil, err
+	}
+
+	changes, err := applyChanges(toCatalogItemAPIType(existing), toCatalogItemAPIType(&updated))
+	if err != nil {
+		return nil, err
+	}
+	result := &v1alpha1.CatalogItemApplyResult{Action: applyAction(changes), Changes: changes}
+
+	if len(changes) == 0 || validateOnly {
+		result.CatalogItem = toCatalogItemAPIType(&updated)
+		return result, nil
+	}
+	if err := s.store.CatalogItem().Update(ctx, &updated); err != nil {
+		return nil, mapStoreError(err)
+	}
+	s.schemas.Invalidate(id)
+	s.programs.Invalidate(id)
+
+	// Re-read to pick up the new revision and update time
+	apiItem, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result.CatalogItem = *apiItem
+	return result, nil
+}
+
+// applyCreate creates the catalog item of an apply
+func (s *catalogItemService) applyCreate(ctx context.Context, req *CreateCatalogItemRequest, validateOnly bool) (*v1alpha1.CatalogItemApplyResult, error) {
+	var created *v1alpha1.CatalogItem
+	if validateOnly {
+		storeModel, err := s.prepareCreate(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		storeModel.Revision = 1
+		apiItem := toCatalogItemAPIType(storeModel)
+		created = &apiItem
+	} else {
+		var err error
+		if created, err = s.Create(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := applyChanges(nil, created)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.CatalogItemApplyResult{Action: v1alpha1.ApplyActionCreated, Changes: changes, CatalogItem: *created}, nil
+}
+
+// Delete deletes a catalog item that has no instances
+func (s *catalogItemService) Delete(ctx context.Context, id string) error {
+	if err := s.store.CatalogItem().Delete(ctx, id); err != nil {
+		return mapStoreError(err)
+	}
+	s.schemas.Invalidate(id)
+	s.programs.Invalidate(id)
+	return nil
+}
+
+// ListRevisions returns the revisions of a catalog item visible to the caller
+func (s *catalogItemService) ListRevisions(ctx context.Context, id string, opts *CatalogItemRevisionListOptions) (*CatalogItemRevisionListResult, error) {
+	catalogItem, err := s.store.CatalogItem().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	storeOpts := &store.CatalogItemRevisionListOptions{PageSize: 100}
+	if opts != nil {
+		storeOpts.PageToken = opts.PageToken
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.CatalogItemRevision().List(ctx, catalogItem.ID, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRevisions := make([]v1alpha1.CatalogItemRevision, len(storeResult.CatalogItemRevisions))
+	for i := range storeResult.CatalogItemRevisions {
+		apiRevisions[i] = toCatalogItemRevisionAPIType(&storeResult.CatalogItemRevisions[i])
+	}
+
+	return &CatalogItemRevisionListResult{
+		CatalogItemRevisions: apiRevisions,
+		NextPageToken:        storeResult.NextPageToken,
+	}, nil
+}
+
+// Rollback restores the display name and spec of a prior revision of a catalog
+// item. The fields of the revision are validated again, as the service type
+// schema may have changed since it was recorded.
+func (s *catalogItemService) Rollback(ctx context.Context, id string, revision int) (*v1alpha1.CatalogItem, error) {
+	if revision < 1 {
+		return nil, fmt.Errorf("%w: revision must be at least 1", ErrInvalidCatalogItem)
+	}
+
+	existing, err := s.store.CatalogItem().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	target, err := s.store.CatalogItemRevision().Get(ctx, existing.ID, revision)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	serviceTypeVersion := target.Spec.ServiceTypeVersion
+	if serviceTypeVersion == "" {
+		// Revisions recorded before service type versions were pinned
+		serviceTypeVersion = existing.Spec.ServiceTypeVersion
+	}
+	serviceType, err := s.store.ServiceType().Resolve(ctx, target.Spec.ServiceType, serviceTypeVersion)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	if err := validateFieldsAgainstServiceType(serviceType, *toCatalogItemSpecAPIType(&target.Spec).Fields); err != nil {
+		return nil, err
+	}
+
+	storeModel, err := s.store.CatalogItem().Rollback(ctx, id, revision)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	s.schemas.Invalidate(id)
+	s.programs.Invalidate(id)
+
+	apiItem := toCatalogItemAPIType(storeModel)
+	return &apiItem, nil
+}
+
+// Convert maps the field paths of a catalog item to another version of its
+// service type, without changing the catalog item. Fields that are not fields
+// of the target version are dropped; the others are checked against its schema.
+func (s *catalogItemService) Convert(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1.CatalogItemConversion, error) {
+	storeModel, err := s.store.CatalogItem().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	spec := storeModel.Spec
+
+	mappings, target, base, err := serviceTypeConversion(ctx, s.store, s.bases, spec.ServiceType, spec.ServiceTypeVersion, serviceTypeVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]model.FieldConfiguration, 0, len(spec.Fields))
+	lossy := []string{}
+	for _, f := range spec.Fields {
+		path, ok := mappings.Path(specPath(f.Path))
+		if !ok {
+			lossy = append(lossy, f.Path)
+			continue
+		}
+		if _, ok := schema.Lookup(base, path); !ok {
+			lossy = append(lossy, f.Path)
+			continue
+		}
+		if strings.HasPrefix(f.Path, "spec.") {
+			path = "spec." + path
+		}
+		f.Path = path
+		fields = append(fields, f)
+	}
+	converted := model.CatalogItemSpec{
+		ServiceType:        spec.ServiceType,
+		ServiceTypeVersion: serviceTypeVersion,
+		Fields:             fields,
+	}
+
+	apiSpec := toCatalogItemSpecAPIType(&converted)
+	violations := fieldProblems(target, base, *apiSpec.Fields)
+	if violations == nil {
+		violations = []string{}
+	}
+
+	return &v1alpha1.CatalogItemConversion{
+		FromVersion: spec.ServiceTypeVersion,
+		ToVersion:   serviceTypeVersion,
+		Spec:        *apiSpec,
+		LossyFields: lossy,
+		Violations:  violations,
+	}, nil
+}
+
+// catalogItemPath returns the resource path of a global or tenant-private catalog item
+func catalogItemPath(tenant, id string) string {
+	if tenant == "" {
+		return fmt.Sprintf("catalog-items/%s", id)
+	}
+	return fmt.Sprintf("tenants/%s/catalog-items/%s", tenant, id)
+}
+
+// validateCreateCatalogItem checks the required fields of a new catalog item
+func validateCreateCatalogItem(req *CreateCatalogItemRequest) error {
+	switch {
+	case req.ApiVersion == "":
+		return fmt.Errorf("%w: api_version is required", ErrInvalidCatalogItem)
+	case req.DisplayName == "":
+		return fmt.Errorf("%w: display_name is required", ErrInvalidCatalogItem)
+	case req.ServiceType == "":
+		return fmt.Errorf("%w: spec.service_type is required", ErrInvalidCatalogItem)
+	}
+	return validateFieldConfigurations(req.Fields)
+}
+
+// validateFieldConfigurations checks the structural constraints of field configurations
+func validateFieldConfigurations(fields []v1alpha1.FieldConfiguration) error {
+	if len(fields) == 0 {
+		return fmt.Errorf("%w: spec.fields must contain at least one field", ErrInvalidCatalogItem)
+	}
+	for i, f := range fields {
+		if f.Path == "" {
+			return fmt.Errorf("%w: spec.fields[%d].path is required", ErrInvalidCatalogItem, i)
+		}
+	}
+	return nil
+}
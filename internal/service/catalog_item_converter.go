@@ -0,0 +1,123 @@
+package service
+
+import (
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// toCatalogItemStoreModel converts a CreateCatalogItemRequest to a store model
+func toCatalogItemStoreModel(id, path, tenant string, req *CreateCatalogItemRequest) model.CatalogItem {
+	return model.CatalogItem{
+		ID:          id,
+		ApiVersion:  req.ApiVersion,
+		DisplayName: req.DisplayName,
+		Spec: model.CatalogItemSpec{
+			ServiceType:        req.ServiceType,
+			ServiceTypeVersion: req.ServiceTypeVersion,
+			Fields:             toFieldConfigurationStoreModels(req.Fields),
+		},
+		Path:   path,
+		Tenant: tenant,
+	}
+}
+
+// toFieldConfigurationStoreModels converts API field configurations to store models
+func toFieldConfigurationStoreModels(fields []v1alpha1.FieldConfiguration) []model.FieldConfiguration {
+	storeFields := make([]model.FieldConfiguration, len(fields))
+	for i, f := range fields {
+		storeFields[i] = model.FieldConfiguration{
+			Path:    f.Path,
+			Default: f.Default,
+		}
+		if f.DisplayName != nil {
+			storeFields[i].DisplayName = *f.DisplayName
+		}
+		if f.Editable != nil {
+			storeFields[i].Editable = *f.Editable
+		}
+		if f.ValidationSchema != nil {
+			storeFields[i].ValidationSchema = *f.ValidationSchema
+		}
+		if f.DefaultExpression != nil {
+			storeFields[i].DefaultExpression = *f.DefaultExpression
+		}
+		if f.VisibleWhen != nil {
+			storeFields[i].VisibleWhen = *f.VisibleWhen
+		}
+		if f.RequiredWhen != nil {
+			storeFields[i].RequiredWhen = *f.RequiredWhen
+		}
+	}
+	return storeFields
+}
+
+// toCatalogItemAPIType converts a store model to an API type
+func toCatalogItemAPIType(m *model.CatalogItem) v1alpha1.CatalogItem {
+	revision := int32(m.Revision)
+	return v1alpha1.CatalogItem{
+		ApiVersion:  &m.ApiVersion,
+		DisplayName: &m.DisplayName,
+		Spec:        toCatalogItemSpecAPIType(&m.Spec),
+		Path:        &m.Path,
+		Uid:         &m.ID,
+		Revision:    &revision,
+		CreateTime:  &m.CreateTime,
+		UpdateTime:  &m.UpdateTime,
+	}
+}
+
+// toCatalogItemRevisionAPIType converts a store model to an API type
+func toCatalogItemRevisionAPIType(m *model.CatalogItemRevision) v1alpha1.CatalogItemRevision {
+	revision := int32(m.Revision)
+	return v1alpha1.CatalogItemRevision{
+		Revision:    &revision,
+		Path:        &m.Path,
+		DisplayName: &m.DisplayName,
+		Spec:        *toCatalogItemSpecAPIType(&m.Spec),
+		CreateTime:  &m.CreateTime,
+	}
+}
+
+// toCatalogItemSpecAPIType converts a store spec to an API type
+func toCatalogItemSpecAPIType(spec *model.CatalogItemSpec) *v1alpha1.CatalogItemSpec {
+	fields := make([]v1alpha1.FieldConfiguration, len(spec.Fields))
+	for i, f := range spec.Fields {
+		editable := f.Editable
+		fields[i] = v1alpha1.FieldConfiguration{
+			Path:     f.Path,
+			Editable: &editable,
+			Default:  f.Default,
+		}
+		if f.DisplayName != "" {
+			displayName := f.DisplayName
+			fields[i].DisplayName = &displayName
+		}
+		if f.ValidationSchema != nil {
+			schema := f.ValidationSchema
+			fields[i].ValidationSchema = &schema
+		}
+		if f.DefaultExpression != "" {
+			defaultExpression := f.DefaultExpression
+			fields[i].DefaultExpression = &defaultExpression
+		}
+		if f.VisibleWhen != "" {
+			visibleWhen := f.VisibleWhen
+			fields[i].VisibleWhen = &visibleWhen
+		}
+		if f.RequiredWhen != "" {
+			requiredWhen := f.RequiredWhen
+			fields[i].RequiredWhen = &requiredWhen
+		}
+	}
+
+	serviceType := spec.ServiceType
+	apiSpec := &v1alpha1.CatalogItemSpec{
+		ServiceType: &serviceType,
+		Fields:      &fields,
+	}
+	if spec.ServiceTypeVersion != "" {
+		serviceTypeVersion := spec.ServiceTypeVersion
+		apiSpec.ServiceTypeVersion = &serviceTypeVersion
+	}
+	return apiSpec
+}
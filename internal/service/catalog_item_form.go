@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// Form describes the form of the editable fields of a catalog item
+func (s *catalogItemService) Form(ctx context.Context, id string) (*v1alpha1.CatalogItemForm, error) {
+	catalogItem, err := s.store.CatalogItem().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	serviceType, err := s.store.ServiceType().Resolve(ctx, catalogItem.Spec.ServiceType, catalogItem.Spec.ServiceTypeVersion)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	base, err := serviceTypeSchema(s.bases, serviceType)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]any{}
+	required := []string{}
+	order := []string{}
+	uiSchema := map[string]any{}
+	for _, f := range catalogItem.Spec.Fields {
+		if !f.Editable {
+			continue
+		}
+		// Fields that are not in the schema predate its validation; they
+		// are still offered, without constraints
+		fieldSchema, _ := schema.Lookup(base, specPath(f.Path))
+		property, err := formProperty(fieldSchema, f)
+		if err != nil {
+			return nil, err
+		}
+		properties[f.Path] = property
+		if f.Default == nil && f.DefaultExpression == "" && f.RequiredWhen == "" && requiredField(base, specPath(f.Path)) {
+			required = append(required, f.Path)
+		}
+		order = append(order, f.Path)
+		if widget := formWidget(property); widget != "" {
+			uiSchema[f.Path] = map[string]any{"ui:widget": widget}
+		}
+	}
+	uiSchema["ui:order"] = order
+
+	return &v1alpha1.CatalogItemForm{
+		Schema: map[string]any{
+			"$schema":    "https://json-schema.org/draft/2020-12/schema",
+			"type":       openapi3.TypeObject,
+			"title":      catalogItem.DisplayName,
+			"properties": properties,
+			"required":   required,
+		},
+		UiSchema: uiSchema,
+	}, nil
+}
+
+// requiredField reports whether the service type schema requires the field at
+// path, that is whether each object on the path requires the next field
+func requiredField(base *openapi3.Schema, path string) bool {
+	current := base
+	for _, key := range strings.Split(path, ".") {
+		if current == nil || current.Properties[key] == nil || !slices.Contains(current.Required, key) {
+			return false
+		}
+		current = current.Properties[key].Value
+	}
+	return true
+}
+
+// formProperty merges the service type schema of a field with its
+// validation_schema, which only narrows it, and adds its title and default.
+// Both are OpenAPI 3.0 schemas, converted to the JSON Schema of the form.
+func formProperty(fieldSchema *openapi3.Schema, f model.FieldConfiguration) (map[string]any, error) {
+	property := map[string]any{}
+	if fieldSchema != nil {
+		data, err := json.Marshal(fieldSchema)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &property); err != nil {
+			return nil, err
+		}
+	}
+	for keyword, value := range f.ValidationSchema {
+		property[keyword] = value
+	}
+	toJSONSchema(property)
+
+	property["title"] = f.DisplayName
+	if f.DisplayName == "" {
+		property["title"] = fieldTitle(f.Path)
+	}
+	if f.Default != nil {
+		property["default"] = f.Default
+	}
+	if f.DefaultExpression != "" {
+		property["x-default-expression"] = f.DefaultExpression
+	}
+	if f.VisibleWhen != "" {
+		property["x-visible-when"] = f.VisibleWhen
+	}
+	if f.RequiredWhen != "" {
+		property["x-required-when"] = f.RequiredWhen
+	}
+	return property, nil
+}
+
+// toJSONSchema rewrites the OpenAPI 3.0 keywords of a schema and of the
+// schemas nested in it as their JSON Schema 2020-12 equivalents: the boolean
+// exclusiveMinimum and exclusiveMaximum become the exclusive bound, and
+// nullable adds null to the type and enum.
+func toJSONSchema(s map[string]any) {
+	for _, bound := range [][2]string{{"exclusiveMinimum", "minimum"}, {"exclusiveMaximum", "maximum"}} {
+		exclusive, ok := s[bound[0]].(bool)
+		if !ok {
+			continue
+		}
+		delete(s, bound[0])
+		if value, ok := s[bound[1]]; exclusive && ok {
+			s[bound[0]] = value
+			delete(s, bound[1])
+		}
+	}
+	if nullable, ok := s["nullable"].(bool); ok {
+		delete(s, "nullable")
+		if typ, ok := s["type"].(string); nullable && ok {
+			s["type"] = []any{typ, "null"}
+		}
+		if enum, ok := s["enum"].([]any); nullable && ok && !slices.Contains(enum, nil) {
+			s["enum"] = append(enum, nil)
+		}
+	}
+
+	for _, keyword := range []string{"items", "additionalProperties", "not"} {
+		if nested, ok := s[keyword].(map[string]any); ok {
+			toJSONSchema(nested)
+		}
+	}
+	if properties, ok := s["properties"].(map[string]any); ok {
+		for _, property := range properties {
+			if nested, ok := property.(map[string]any); ok {
+				toJSONSchema(nested)
+			}
+		}
+	}
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		if schemas, ok := s[keyword].([]any); ok {
+			for _, subschema := range schemas {
+				if nested, ok := subschema.(map[string]any); ok {
+					toJSONSchema(nested)
+				}
+			}
+		}
+	}
+}
+
+// formWidget picks the widget of a form property, or none for the default
+// widget of its type
+func formWidget(property map[string]any) string {
+	if _, ok := property["enum"]; ok {
+		return "select"
+	}
+	typ := property["type"]
+	if types, ok := typ.([]any); ok && len(types) > 0 {
+		// The type of a nullable property, listed before null
+		typ = types[0]
+	}
+	switch typ {
+	case openapi3.TypeBoolean:
+		return "checkbox"
+	case openapi3.TypeInteger, openapi3.TypeNumber:
+		return "updown"
+	case openapi3.TypeString:
+		if property["format"] == "password" {
+			return "password"
+		}
+	}
+	return ""
+}
+
+// fieldTitle derives the title of a field from its path, e.g. "Vcpu Count"
+// for "spec.vcpu.count"
+func fieldTitle(path string) string {
+	words := strings.FieldsFunc(specPath(path), func(r rune) bool {
+		return r == '.' || r == '_' || r == '-'
+	})
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
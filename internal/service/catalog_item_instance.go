@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/audit"
+	"github.com/dcm-project/catalog-manager/internal/expression"
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/dcm-project/catalog-manager/internal/watch"
+	"github.com/google/uuid"
+)
+
+// CreateCatalogItemInstanceRequest contains the parameters for creating a catalog item instance
+type CreateCatalogItemInstanceRequest struct {
+	ID            *string // Optional user-specified ID
+	RequestID     string  // Optional idempotency key (AEP-155)
+	ApiVersion    string
+	DisplayName   string
+	CatalogItemId string
+	UserValues    []v1alpha1.UserValue
+}
+
+// BatchCreateCatalogItemInstancesRequest contains the parameters for creating catalog item instances in bulk
+type BatchCreateCatalogItemInstancesRequest struct {
+	RequestID string // Optional idempotency key (AEP-155)
+	Requests  []CreateCatalogItemInstanceRequest
+}
+
+// CatalogItemInstanceListOptions contains options for listing catalog item instances
+type CatalogItemInstanceListOptions struct {
+	PageToken     *string
+	MaxPageSize   *int32
+	CatalogItemId *string
+	Parent        *string
+	// ReadMask is the fields the caller reads (AEP-157); the spec is not
+	// loaded unless its user values are among them
+	ReadMask fieldmask.Mask
+	// SkipTotalSize leaves out the total size of the result, saving the
+	// query counting it
+	SkipTotalSize bool
+}
+
+// CatalogItemInstanceCountOptions contains options for counting catalog item instances
+type CatalogItemInstanceCountOptions struct {
+	GroupBy       v1alpha1.CatalogItemInstanceCountsGroupBy
+	CatalogItemId *string
+	Parent        *string
+}
+
+// CatalogItemInstanceListResult contains the result of a List operation
+type CatalogItemInstanceListResult struct {
+	CatalogItemInstances []v1alpha1.CatalogItemInstance
+	NextPageToken        *string
+	TotalSize            *int64 // nil when skipped
+}
+
+// CatalogItemInstanceService defines the business logic for CatalogItemInstance operations
+type CatalogItemInstanceService interface {
+	List(ctx context.Context, opts *CatalogItemInstanceListOptions) (*CatalogItemInstanceListResult, error)
+	// Count returns the number of the caller's instances grouped by catalog item, service type or owner
+	Count(ctx context.Context, opts *CatalogItemInstanceCountOptions) (*v1alpha1.CatalogItemInstanceCounts, error)
+	// Create creates an instance and returns the operation tracking its provisioning
+	Create(ctx context.Context, req *CreateCatalogItemInstanceRequest) (*v1alpha1.Operation, error)
+	// BatchCreate creates instances atomically and returns the operations tracking their provisioning
+	BatchCreate(ctx context.Context, req *BatchCreateCatalogItemInstancesRequest) (*v1alpha1.BatchCreateCatalogItemInstancesResult, error)
+	Get(ctx context.Context, id string) (*v1alpha1.CatalogItemInstance, error)
+	// BatchGet retrieves the caller's instances with the given IDs, in their order
+	BatchGet(ctx context.Context, ids []string) (*v1alpha1.BatchGetCatalogItemInstancesResult, error)
+	// Convert previews the rendered spec of an instance converted to another version of its service type
+	Convert(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1.CatalogItemInstanceConversion, error)
+	// Delete requests the deletion of an instance and returns the operation tracking it
+	Delete(ctx context.Context, id string) (*v1alpha1.Operation, error)
+	// Watch streams the changes to the caller's instances
+	Watch(ctx context.Context, req *WatchCatalogItemInstancesRequest) (CatalogItemInstanceWatcher, error)
+}
+
+type catalogItemInstanceService struct {
+	store      store.Store
+	schemas    *schema.Cache
+	programs   *expression.Cache
+	bases      *schema.ServiceTypeCache
+	reconciler InstanceReconciler // nil when the reconciler only scans
+	hub        *watch.Hub         // nil when watching is unavailable
+	keys       *idempotencyKeys
+}
+
+// newCatalogItemInstanceService creates a new CatalogItemInstanceService instance
+func newCatalogItemInstanceService(store store.Store, schemas *schema.Cache, programs *expression.Cache, bases *schema.ServiceTypeCache, reconciler InstanceReconciler, hub *watch.Hub, keys *idempotencyKeys) CatalogItemInstanceService {
+	return &catalogItemInstanceService{store: store, schemas: schemas, programs: programs, bases: bases, reconciler: reconciler, hub: hub, keys: keys}
+}
+
+// List returns a paginated list of the caller's catalog item instances
+func (s *catalogItemInstanceService) List(ctx context.Context, opts *CatalogItemInstanceListOptions) (*CatalogItemInstanceListResult, error) {
+	storeOpts := &store.CatalogItemInstanceListOptions{PageSize: 100, CountTotal: true}
+	if opts != nil {
+		tenant, err := resolveParent(ctx, opts.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if tenant != "" {
+			storeOpts.Tenant = &tenant
+		}
+		storeOpts.PageToken = opts.PageToken
+		storeOpts.CatalogItemId = opts.CatalogItemId
+		storeOpts.SkipSpec = !opts.ReadMask.Selects("spec.user_values")
+		storeOpts.CountTotal = !opts.SkipTotalSize
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.CatalogItemInstance().List(ctx, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiInstances := make([]v1alpha1.CatalogItemInstance, len(storeResult.CatalogItemInstances))
+	for i, storeModel := range storeResult.CatalogItemInstances {
+		apiInstances[i] = toCatalogItemInstanceAPIType(&storeModel)
+	}
+
+	return &CatalogItemInstanceListResult{
+		CatalogItemInstances: apiInstances,
+		NextPageToken:        storeResult.NextPageToken,
+		TotalSize:            storeResult.TotalSize,
+	}, nil
+}
+
+// instanceGroupBy maps the attributes instances can be counted by to those of the store
+var instanceGroupBy = map[v1alpha1.CatalogItemInstanceCountsGroupBy]string{
+	v1alpha1.CatalogItemInstanceCountsGroupByCatalogItem: store.GroupByCatalogItem,
+	v1alpha1.CatalogItemInstanceCountsGroupByServiceType: store.GroupByServiceType,
+	v1alpha1.CatalogItemInstanceCountsGroupByOwner:       store.GroupByTenant,
+}
+
+// Count returns the number of the caller's catalog item instances grouped by
+// catalog item, service type or owning tenant, with the filters of List
+func (s *catalogItemInstanceService) Count(ctx context.Context, opts *CatalogItemInstanceCountOptions) (*v1alpha1.CatalogItemInstanceCounts, error) {
+	groupBy, ok := instanceGroupBy[opts.GroupBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot group by %q", ErrInvalidCatalogItemInstance, opts.GroupBy)
+	}
+	storeOpts := &store.CatalogItemInstanceCountOptions{GroupBy: groupBy, CatalogItemId: opts.CatalogItemId}
+	tenant, err := resolveParent(ctx, opts.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if tenant != "" {
+		storeOpts.Tenant = &tenant
+	}
+
+	counts, err := s.store.CatalogItemInstance().Count(ctx, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1alpha1.CatalogItemInstanceCounts{
+		GroupBy: opts.GroupBy,
+		Groups:  make([]v1alpha1.CatalogItemInstanceCount, len(counts)),
+	}
+	for i, count := range counts {
+		key := count.Key
+		if opts.GroupBy == v1alpha1.CatalogItemInstanceCountsGroupByOwner {
+			key = fmt.Sprintf("tenants/%s", count.Key)
+		}
+		result.Groups[i] = v1alpha1.CatalogItemInstanceCount{Key: key, Count: count.Count}
+		result.TotalSize += count.Count
+	}
+	return result, nil
+}
+
+// Create creates a new catalog item instance owned by the caller's tenant.
+// The instance starts PENDING; the reconciler dispatches its rendered spec to
+// the service type's provider. The returned operation completes once the
+// instance is READY or FAILED.
+func (s *catalogItemInstanceService) Create(ctx context.Context, req *CreateCatalogItemInstanceRequest) (*v1alpha1.Operation, error) {
+	return createIdempotent(ctx, s.keys, "CreateCatalogItemInstance", req.RequestID, req, func(ctx context.Context) (*v1alpha1.Operation, error) {
+		return s.create(ctx, req)
+	}, lookupResource(s.getOperation))
+}
+
+// create creates the catalog item instance of req
+func (s *catalogItemInstanceService) create(ctx context.Context, req *CreateCatalogItemInstanceRequest) (*v1alpha1.Operation, error) {
+	tenant, ok := tenancy.FromContext(ctx)
+	if !ok {
+		return nil, ErrTenantRequired
+	}
+	storeModel, op, err := s.prepare(ctx, tenant, req)
+	if err != nil {
+		return nil, err
+	}
+
+	createdModel, createdOp, err := s.store.CatalogItemInstance().CreateWithOperation(ctx, *storeModel, *op)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	s.enqueue(createdModel.ID)
+
+	apiOperation := toOperationAPIType(createdOp, createdModel)
+	return &apiOperation, nil
+}
+
+// BatchCreate creates catalog item instances owned by the caller's tenant,
+// either all of them or none. Every request is validated and rendered like
+// Create before the instances are created in a single transaction.
+func (s *catalogItemInstanceService) BatchCreate(ctx context.Context, req *BatchCreateCatalogItemInstancesRequest) (*v1alpha1.BatchCreateCatalogItemInstancesResult, error) {
+	return createIdempotent(ctx, s.keys, "BatchCreateCatalogItemInstances", req.RequestID, req, func(ctx context.Context) (*v1alpha1.BatchCreateCatalogItemInstancesResult, error) {
+		return s.batchCreate(ctx, req)
+	}, func(ctx context.Context, operations []string) (*v1alpha1.BatchCreateCatalogItemInstancesResult, error) {
+		result := &v1alpha1.BatchCreateCatalogItemInstancesResult{Results: make([]v1alpha1.Operation, len(operations))}
+		for i, operation := range operations {
+			apiOperation, err := s.getOperation(ctx, path.Base(operation))
+			if err != nil {
+				return nil, err
+			}
+			result.Results[i] = *apiOperation
+		}
+		return result, nil
+	})
+}
+
+// getOperation returns an operation creating an instance by ID, for the
+// retries of a create whose response was not recorded with its idempotency key
+func (s *catalogItemInstanceService) getOperation(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	op, err := s.store.Operation().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	target, err := s.store.CatalogItemInstance().Get(ctx, op.TargetID)
+	if err != nil && !errors.Is(err, store.ErrCatalogItemInstanceNotFound) {
+		return nil, err
+	}
+	apiOperation := toOperationAPIType(op, target)
+	return &apiOperation, nil
+}
+
+// batchCreate creates the catalog item instances of req
+func (s *catalogItemInstanceService) batchCreate(ctx context.Context, req *BatchCreateCatalogItemInstancesRequest) (*v1alpha1.BatchCreateCatalogItemInstancesResult, error) {
+	tenant, ok := tenancy.FromContext(ctx)
+	if !ok {
+		return nil, ErrTenantRequired
+	}
+	if err := checkBatchSize(len(req.Requests), maxBatchCreateSize, "requests"); err != nil {
+		return nil, err
+	}
+
+	storeModels := make([]model.CatalogItemInstance, len(req.Requests))
+	ops := make([]model.Operation, len(req.Requests))
+	seen := make(map[string]int, len(req.Requests))
+	for i := range req.Requests {
+		storeModel, op, err := s.prepare(ctx, tenant, &req.Requests[i])
+		if err != nil {
+			return nil, fmt.Errorf("requests[%d]: %w", i, err)
+		}
+		if j, ok := seen[storeModel.ID]; ok {
+			return nil, fmt.Errorf("requests[%d]: %w: id %q is also requested by requests[%d]", i, ErrInvalidCatalogItemInstance, storeModel.ID, j)
+		}
+		seen[storeModel.ID] = i
+		storeModels[i], ops[i] = *storeModel, *op
+	}
+
+	createdModels, createdOps, err := s.store.CatalogItemInstance().BatchCreateWithOperations(ctx, storeModels, ops)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	result := &v1alpha1.BatchCreateCatalogItemInstancesResult{Results: make([]v1alpha1.Operation, len(createdModels))}
+	for i := range createdModels {
+		s.enqueue(createdModels[i].ID)
+		result.Results[i] = toOperationAPIType(&createdOps[i], &createdModels[i])
+	}
+	return result, nil
+}
+
+// prepare validates req and renders the instance it creates for tenant,
+// together with the operation tracking its creation
+func (s *catalogItemInstanceService) prepare(ctx context.Context, tenant string, req *CreateCatalogItemInstanceRequest) (*model.CatalogItemInstance, *model.Operation, error) {
+	if req.CatalogItemId == "" {
+		return nil, nil, fmt.Errorf("%w: spec.catalog_item_id is required", ErrInvalidCatalogItemInstance)
+	}
+
+	catalogItem, err := s.store.CatalogItem().Get(ctx, req.CatalogItemId)
+	if err != nil {
+		if errors.Is(err, store.ErrCatalogItemNotFound) {
+			return nil, nil, fmt.Errorf("%w: catalog item %q does not exist", ErrInvalidCatalogItemInstance, req.CatalogItemId)
+		}
+		return nil, nil, err
+	}
+	serviceType, err := s.store.ServiceType().Resolve(ctx, catalogItem.Spec.ServiceType, catalogItem.Spec.ServiceTypeVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkServiceTypeSunset(serviceType); err != nil {
+		return nil, nil, err
+	}
+	warnIfDeprecated(ctx, serviceType)
+	schemas, err := validationSchemas(s.schemas, catalogItem)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := validateUserValues(catalogItem, schemas, req.UserValues); err != nil {
+		return nil, nil, err
+	}
+	programs, err := fieldPrograms(s.programs, catalogItem)
+	if err != nil {
+		return nil, nil, err
+	}
+	base, err := serviceTypeSchema(s.bases, serviceType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidCatalogItemInstance, err)
+	}
+
+	id := uuid.New().String()
+	if req.ID != nil && *req.ID != "" {
+		id = *req.ID
+	}
+
+	actor := audit.Anonymous
+	if r, ok := audit.FromContext(ctx); ok {
+		actor = r.Actor
+	}
+	spec, err := renderSpec(catalogItem, base, schemas, programs, req.UserValues, expression.Vars{
+		Instance:  expression.Instance{UID: id, DisplayName: req.DisplayName, CatalogItemID: catalogItem.ID},
+		Requester: expression.Requester{Tenant: tenant, Actor: actor},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	// Providers require a name; default it to the instance ID
+	if _, ok := lookupPath(spec, "metadata.name"); !ok {
+		if err := setPath(spec, "metadata.name", id); err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", ErrInvalidCatalogItemInstance, err)
+		}
+	}
+	resources, err := computeResources(catalogItem.Spec.ServiceType, spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storeModel := toCatalogItemInstanceStoreModel(id, catalogItemInstancePath(tenant, id), tenant, req)
+	storeModel.ServiceType = catalogItem.Spec.ServiceType
+	storeModel.CatalogItemRevision = catalogItem.Revision
+	storeModel.RenderedSpec = spec
+	storeModel.Resources = resources
+	now := time.Now()
+	storeModel.Status = model.InstanceStatus{State: model.InstanceStatePending, NextAttemptTime: &now}
+
+	op := newInstanceOperation(uuid.New().String(), model.OperationTypeCreateCatalogItemInstance, &storeModel)
+	return &storeModel, &op, nil
+}
+
+// Get retrieves one of the caller's catalog item instances by ID
+func (s *catalogItemInstanceService) Get(ctx context.Context, id string) (*v1alpha1.CatalogItemInstance, error) {
+	storeModel, err := s.store.CatalogItemInstance().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiInstance := toCatalogItemInstanceAPIType(storeModel)
+	return &apiInstance, nil
+}
+
+// BatchGet retrieves the caller's catalog item instances with the given IDs
+// in a single query. It fails if any of them is not found.
+func (s *catalogItemInstanceService) BatchGet(ctx context.Context, ids []string) (*v1alpha1.BatchGetCatalogItemInstancesResult, error) {
+	if err := checkBatchSize(len(ids), maxBatchGetSize, "ids"); err != nil {
+		return nil, err
+	}
+	storeModels, err := s.store.CatalogItemInstance().BatchGet(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	ordered, err := inIDOrder(ids, storeModels, func(m *model.CatalogItemInstance) string { return m.ID }, ErrCatalogItemInstanceNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1alpha1.BatchGetCatalogItemInstancesResult{Results: make([]v1alpha1.CatalogItemInstance, len(ordered))}
+	for i, storeModel := range ordered {
+		result.Results[i] = toCatalogItemInstanceAPIType(storeModel)
+	}
+	return result, nil
+}
+
+// Convert converts the rendered spec of one of the caller's instances to another
+// version of its service type, without changing the instance. The instance's
+// version is the one pinned by its catalog item. Values that are not fields of
+// the target version are dropped, and the result is validated against its schema.
+func (s *catalogItemInstanceService) Convert(ctx context.Context, id, serviceTypeVersion string) (*v1alpha1.CatalogItemInstanceConversion, error) {
+	instance, err := s.store.CatalogItemInstance().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	catalogItem, err := s.store.CatalogItem().Get(ctx, instance.SpecCatalogItemId)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	fromVersion := catalogItem.Spec.ServiceTypeVersion
+
+	mappings, _, base, err := serviceTypeConversion(ctx, s.store, s.bases, catalogItem.Spec.ServiceType, fromVersion, serviceTypeVersion)
+	if err != nil {
+		return nil, err
+	}
+	result, err := mappings.Spec(instance.RenderedSpec)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrConversionUnavailable, err)
+	}
+	lossy := append(result.Lossy, schema.Prune(base, result.Spec)...)
+	sort.Strings(lossy)
+	violations := schema.Violations(base, result.Spec)
+	if violations == nil {
+		violations = []string{}
+	}
+
+	return &v1alpha1.CatalogItemInstanceConversion{
+		FromVersion: fromVersion,
+		ToVersion:   serviceTypeVersion,
+		Spec:        result.Spec,
+		LossyFields: lossy,
+		Violations:  violations,
+	}, nil
+}
+
+// Delete requests the deletion of one of the caller's catalog item instances.
+// The instance moves to DELETING; the reconciler deletes it from its provider and
+// then removes it, which completes the returned operation.
+func (s *catalogItemInstanceService) Delete(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	instance, err := s.store.CatalogItemInstance().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	op := newInstanceOperation(uuid.New().String(), model.OperationTypeDeleteCatalogItemInstance, instance)
+
+	if err := s.store.CatalogItemInstance().MarkDeleting(ctx, id, &op); err != nil {
+		return nil, mapStoreError(err)
+	}
+	s.enqueue(id)
+
+	instance.Status.State = model.InstanceStateDeleting
+	apiOperation := toOperationAPIType(&op, instance)
+	return &apiOperation, nil
+}
+
+// enqueue notifies the reconciler, if any, that an instance has work
+func (s *catalogItemInstanceService) enqueue(id string) {
+	if s.reconciler != nil {
+		s.reconciler.Enqueue(id)
+	}
+}
+
+// catalogItemInstancePath returns the resource path of a catalog item instance
+func catalogItemInstancePath(tenant, id string) string {
+	return fmt.Sprintf("tenants/%s/catalog-item-instances/%s", tenant, id)
+}
+
+// validateUserValues checks that every user value targets a distinct editable
+// field of the catalog item and conforms to the field's validation schema
+func validateUserValues(catalogItem *model.CatalogItem, schemas schema.Fields, userValues []v1alpha1.UserValue) error {
+	fields := make(map[string]model.FieldConfiguration, len(catalogItem.Spec.Fields))
+	for _, f := range catalogItem.Spec.Fields {
+		fields[f.Path] = f
+	}
+
+	seen := make(map[string]bool, len(userValues))
+	for _, uv := range userValues {
+		field, ok := fields[uv.Path]
+		switch {
+		case !ok:
+			return fmt.Errorf("%w: %q is not a field of catalog item %q", ErrInvalidCatalogItemInstance, uv.Path, catalogItem.ID)
+		case !field.Editable:
+			return fmt.Errorf("%w: field %q is not editable", ErrInvalidCatalogItemInstance, uv.Path)
+		case seen[uv.Path]:
+			return fmt.Errorf("%w: duplicate value for field %q", ErrInvalidCatalogItemInstance, uv.Path)
+		}
+		if err := schema.Validate(schemas[uv.Path], uv.Value); err != nil {
+			return fmt.Errorf("%w: field %q: %v", ErrInvalidCatalogItemInstance, uv.Path, err)
+		}
+		seen[uv.Path] = true
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/expression"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// benchStore serves a single catalog item and service type from memory, so
+// that benchmarks measure the service rather than the database
+type benchStore struct {
+	store.Store
+	catalogItem *model.CatalogItem
+	serviceType *model.ServiceType
+}
+
+func (s *benchStore) CatalogItem() store.CatalogItemStore {
+	return benchCatalogItems{catalogItem: s.catalogItem}
+}
+
+func (s *benchStore) ServiceType() store.ServiceTypeStore {
+	return benchServiceTypes{serviceType: s.serviceType}
+}
+
+type benchCatalogItems struct {
+	store.CatalogItemStore
+	catalogItem *model.CatalogItem
+}
+
+func (s benchCatalogItems) Get(context.Context, string) (*model.CatalogItem, error) {
+	return s.catalogItem, nil
+}
+
+type benchServiceTypes struct {
+	store.ServiceTypeStore
+	serviceType *model.ServiceType
+}
+
+func (s benchServiceTypes) Resolve(context.Context, string, string) (*model.ServiceType, error) {
+	return s.serviceType, nil
+}
+
+// BenchmarkValidateInstance prepares an instance of a catalog item with 50
+// fields: it validates the user values, then renders the spec with fixed and
+// computed defaults, visible_when and required_when conditions and quantities,
+// and computes the resources of the instance. Only the store is left out. It
+// should stay well under a millisecond per operation.
+func BenchmarkValidateInstance(b *testing.B) {
+	const fieldCount = 50
+	now := time.Now()
+	catalogItem := &model.CatalogItem{
+		ID:         "small-vm",
+		UpdateTime: now,
+		Spec:       model.CatalogItemSpec{ServiceType: "vm", ServiceTypeVersion: "v1alpha1"},
+	}
+	extra := map[string]any{}
+	serviceType := &model.ServiceType{
+		ID:          "vm-v1alpha1",
+		ApiVersion:  "v1alpha1",
+		ServiceType: "vm",
+		UpdateTime:  now,
+		Spec: map[string]any{
+			"vcpu":   map[string]any{"count": 1},
+			"memory": map[string]any{"size": "2GB"},
+			"extra":  extra,
+		},
+	}
+	req := &CreateCatalogItemInstanceRequest{CatalogItemId: catalogItem.ID, DisplayName: "Web Server"}
+
+	fields := []model.FieldConfiguration{
+		{Path: "spec.vcpu.count", Editable: true, ValidationSchema: map[string]any{"type": "integer", "minimum": 1, "maximum": 64}},
+		{Path: "spec.memory.size", Editable: true, ValidationSchema: map[string]any{"x-min-quantity": "1GB", "x-max-quantity": "64GB"}},
+		{Path: "metadata.name", DefaultExpression: `instance.display_name.lowerAscii().replace(" ", "-") + "-" + instance.uid.substring(0, 8)`},
+	}
+	req.UserValues = []v1alpha1.UserValue{
+		{Path: "spec.vcpu.count", Value: float64(8)},
+		{Path: "spec.memory.size", Value: "2048MB"},
+	}
+	for i := len(fields); i < fieldCount; i++ {
+		name := fmt.Sprintf("field%d", i)
+		f := model.FieldConfiguration{Path: "spec.extra." + name}
+		switch i % 4 {
+		case 0:
+			extra[name] = 1
+			f.Editable = true
+			f.ValidationSchema = map[string]any{"type": "integer", "minimum": 1, "maximum": 64}
+			req.UserValues = append(req.UserValues, v1alpha1.UserValue{Path: f.Path, Value: float64(8)})
+		case 1:
+			extra[name] = "web"
+			f.Editable = true
+			f.ValidationSchema = map[string]any{"type": "string", "pattern": "^[a-z][a-z0-9-]*$", "maxLength": 63}
+			req.UserValues = append(req.UserValues, v1alpha1.UserValue{Path: f.Path, Value: "web-frontend"})
+		case 2:
+			extra[name] = "rhel-9"
+			f.Default = "rhel-9"
+			f.ValidationSchema = map[string]any{"type": "string", "enum": []any{"rhel-9", "ubuntu-24.04", "windows-11"}}
+		default:
+			extra[name] = true
+			f.DefaultExpression = "spec.vcpu.count > 4.0"
+			f.VisibleWhen = `spec.memory.size != "1GB"`
+			f.RequiredWhen = "spec.vcpu.count > 32.0"
+		}
+		fields = append(fields, f)
+	}
+	catalogItem.Spec.Fields = fields
+
+	s := &catalogItemInstanceService{
+		store:    &benchStore{catalogItem: catalogItem, serviceType: serviceType},
+		schemas:  schema.NewCache(defaultCacheSize),
+		programs: expression.NewCache(defaultCacheSize),
+		bases:    schema.NewServiceTypeCache(defaultCacheSize),
+	}
+	ctx := context.Background()
+	for b.Loop() {
+		instance, _, err := s.prepare(ctx, "team-a", req)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if instance.Resources.MemoryMB != 2048 {
+			b.Fatalf("unexpected resources %+v", instance.Resources)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// toCatalogItemInstanceStoreModel converts a CreateCatalogItemInstanceRequest to a store model
+func toCatalogItemInstanceStoreModel(id, path, tenant string, req *CreateCatalogItemInstanceRequest) model.CatalogItemInstance {
+	userValues := make([]model.UserValue, len(req.UserValues))
+	for i, uv := range req.UserValues {
+		userValues[i] = model.UserValue{Path: uv.Path, Value: uv.Value}
+	}
+
+	return model.CatalogItemInstance{
+		ID:          id,
+		ApiVersion:  req.ApiVersion,
+		DisplayName: req.DisplayName,
+		Spec: model.CatalogItemInstanceSpec{
+			CatalogItemId: req.CatalogItemId,
+			UserValues:    userValues,
+		},
+		Path:   path,
+		Tenant: tenant,
+	}
+}
+
+// toCatalogItemInstanceAPIType converts a store model to an API type
+func toCatalogItemInstanceAPIType(m *model.CatalogItemInstance) v1alpha1.CatalogItemInstance {
+	userValues := make([]v1alpha1.UserValue, len(m.Spec.UserValues))
+	for i, uv := range m.Spec.UserValues {
+		userValues[i] = v1alpha1.UserValue{Path: uv.Path, Value: uv.Value}
+	}
+
+	apiInstance := v1alpha1.CatalogItemInstance{
+		ApiVersion:  m.ApiVersion,
+		DisplayName: m.DisplayName,
+		Spec: v1alpha1.CatalogItemInstanceSpec{
+			CatalogItemId: m.Spec.CatalogItemId,
+			UserValues:    userValues,
+		},
+		Path:       &m.Path,
+		Uid:        &m.ID,
+		CreateTime: &m.CreateTime,
+		UpdateTime: &m.UpdateTime,
+	}
+	if m.ServiceTypeInstanceUid != "" {
+		apiInstance.ServiceTypeInstanceUid = &m.ServiceTypeInstanceUid
+	}
+	if m.CatalogItemRevision != 0 {
+		revision := int32(m.CatalogItemRevision)
+		apiInstance.CatalogItemRevision = &revision
+	}
+	status := toCatalogItemInstanceStatusAPIType(&m.Status)
+	apiInstance.Status = &status
+	return apiInstance
+}
+
+// toCatalogItemInstanceStatusAPIType converts a store status to an API type
+func toCatalogItemInstanceStatusAPIType(s *model.InstanceStatus) v1alpha1.CatalogItemInstanceStatus {
+	status := v1alpha1.CatalogItemInstanceStatus{
+		State: v1alpha1.CatalogItemInstanceStatusState(s.State),
+	}
+	if len(s.Conditions) > 0 {
+		conditions := make([]v1alpha1.Condition, len(s.Conditions))
+		for i, c := range s.Conditions {
+			conditions[i] = v1alpha1.Condition{
+				Type:               c.Type,
+				Status:             v1alpha1.ConditionStatus(c.Status),
+				LastTransitionTime: c.LastTransitionTime,
+			}
+			if c.Reason != "" {
+				conditions[i].Reason = &c.Reason
+			}
+			if c.Message != "" {
+				conditions[i].Message = &c.Message
+			}
+		}
+		status.Conditions = &conditions
+	}
+	if s.LastError != "" {
+		status.LastError = &s.LastError
+	}
+	return status
+}
@@ -0,0 +1,725 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("CatalogItemInstance Service", func() {
+	var (
+		teamA context.Context
+		teamB context.Context
+		admin context.Context
+		db    *gorm.DB
+		str   store.Store
+		svc   service.Service
+	)
+
+	newRequest := func(id string, userValues ...v1alpha1.UserValue) *service.CreateCatalogItemInstanceRequest {
+		return &service.CreateCatalogItemInstanceRequest{
+			ID:            &id,
+			ApiVersion:    "v1alpha1",
+			DisplayName:   "My VM",
+			CatalogItemId: "small-vm",
+			UserValues:    userValues,
+		}
+	}
+
+	BeforeEach(func() {
+		teamA = tenancy.NewContext(context.Background(), "team-a")
+		teamB = tenancy.NewContext(context.Background(), "team-b")
+		admin = tenancy.NewAdminContext(teamA)
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+		svc = service.NewService(str)
+
+		_, err = svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+			ApiVersion:  "v1alpha1",
+			ServiceType: "vm",
+			Spec: map[string]any{
+				"vcpu":      map[string]any{"count": 1},
+				"guest_os":  map[string]any{"type": "rhel-9"},
+				"data_disk": map[string]any{"enabled": false, "size": "10GB"},
+				"memory":    map[string]any{"size": "2GB"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		editable := true
+		id := "small-vm"
+		_, err = svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+			ID:          &id,
+			ApiVersion:  "v1alpha1",
+			DisplayName: "Small VM",
+			ServiceType: "vm",
+			Fields: []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Editable: &editable, Default: 2, ValidationSchema: &map[string]any{"maximum": 8}},
+				{Path: "spec.guest_os.type", Default: "rhel-9"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if str != nil {
+			Expect(str.Close()).To(Succeed())
+		}
+	})
+
+	Describe("Create", func() {
+		It("should create an instance owned by the caller's tenant", func() {
+			op, err := svc.CatalogItemInstance().Create(teamA, newRequest("my-vm", v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 4}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeFalse())
+			Expect(*op.Path).To(HavePrefix("tenants/team-a/operations/"))
+			Expect(op.Metadata.Type).To(Equal(v1alpha1.CreateCatalogItemInstance))
+			Expect(op.Metadata.Target).To(Equal("tenants/team-a/catalog-item-instances/my-vm"))
+
+			result, err := svc.CatalogItemInstance().Get(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status.State).To(Equal(v1alpha1.PENDING))
+			Expect(*result.CatalogItemRevision).To(Equal(int32(1)))
+		})
+
+		It("should pin the current revision of the catalog item", func() {
+			displayName := "Small VM v2"
+			_, err := svc.CatalogItem().Update(admin, "small-vm", &service.UpdateCatalogItemRequest{DisplayName: &displayName})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItemInstance().Create(teamA, newRequest("my-vm"))
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := svc.CatalogItemInstance().Get(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.CatalogItemRevision).To(Equal(int32(2)))
+		})
+
+		It("should require a tenant", func() {
+			_, err := svc.CatalogItemInstance().Create(context.Background(), newRequest("my-vm"))
+			Expect(err).To(MatchError(service.ErrTenantRequired))
+		})
+
+		It("should reject values for fields that are not editable", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, newRequest("my-vm", v1alpha1.UserValue{Path: "spec.guest_os.type", Value: "windows-11"}))
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+		})
+
+		It("should reject values for unknown fields", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, newRequest("my-vm", v1alpha1.UserValue{Path: "spec.memory.size", Value: "8GB"}))
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+		})
+
+		It("should reject values outside the validation schema of their field", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, newRequest("my-vm", v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 16}))
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(err.Error()).To(ContainSubstring("spec.vcpu.count"))
+		})
+
+		It("should validate against the schemas of the updated catalog item", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, newRequest("vm-1", v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 4}))
+			Expect(err).ToNot(HaveOccurred())
+
+			editable := true
+			fields := []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Editable: &editable, Default: 2, ValidationSchema: &map[string]any{"maximum": 16}},
+			}
+			_, err = svc.CatalogItem().Update(admin, "small-vm", &service.UpdateCatalogItemRequest{Fields: &fields})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItemInstance().Create(teamA, newRequest("vm-2", v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 16}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject a catalog item private to another tenant", func() {
+			editable := true
+			id := "private-vm"
+			parent := "tenants/team-b"
+			_, err := svc.CatalogItem().Create(teamB, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				Parent:      &parent,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "Private VM",
+				ServiceType: "vm",
+				Fields:      []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Editable: &editable}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("my-vm")
+			req.CatalogItemId = "private-vm"
+			_, err = svc.CatalogItemInstance().Create(teamA, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+		})
+	})
+
+	Describe("Conditional fields", func() {
+		BeforeEach(func() {
+			editable := true
+			enabled := "spec.data_disk.enabled"
+			id := "vm-with-disk"
+			_, err := svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "VM with a data disk",
+				ServiceType: "vm",
+				Fields: []v1alpha1.FieldConfiguration{
+					{Path: "spec.vcpu.count", Default: 2},
+					{Path: "spec.data_disk.enabled", Editable: &editable, Default: false},
+					{Path: "spec.data_disk.size", Editable: &editable, VisibleWhen: &enabled, RequiredWhen: &enabled},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		request := func(userValues ...v1alpha1.UserValue) *service.CreateCatalogItemInstanceRequest {
+			req := newRequest("my-vm", userValues...)
+			req.CatalogItemId = "vm-with-disk"
+			return req
+		}
+
+		It("should reject values for hidden fields", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, request(v1alpha1.UserValue{Path: "spec.data_disk.size", Value: "100GB"}))
+			Expect(err).To(MatchError(ContainSubstring(`field "spec.data_disk.size" is not visible`)))
+		})
+
+		It("should require fields whose condition holds", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, request(v1alpha1.UserValue{Path: "spec.data_disk.enabled", Value: true}))
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(err).To(MatchError(ContainSubstring(`field "spec.data_disk.size" is required`)))
+		})
+
+		It("should render visible fields", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, request(
+				v1alpha1.UserValue{Path: "spec.data_disk.enabled", Value: true},
+				v1alpha1.UserValue{Path: "spec.data_disk.size", Value: "100GB"},
+			))
+			Expect(err).ToNot(HaveOccurred())
+
+			instance, err := str.CatalogItemInstance().Get(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.RenderedSpec["data_disk"]).To(HaveKeyWithValue("size", "100GB"))
+		})
+
+		It("should report conditions reading fields that are not set", func() {
+			editable := true
+			unguarded := "spec.gpu.count > 0"
+			guarded := "spec.?gpu.count.orValue(0) > 0"
+			id := "gpu-vm"
+			_, err := svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "GPU VM",
+				ServiceType: "vm",
+				Fields: []v1alpha1.FieldConfiguration{
+					{Path: "spec.vcpu.count", Default: 2},
+					{Path: "spec.data_disk.size", Editable: &editable, VisibleWhen: &unguarded},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("my-vm")
+			req.CatalogItemId = "gpu-vm"
+			_, err = svc.CatalogItemInstance().Create(teamA, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(err).To(MatchError(ContainSubstring(`field "spec.data_disk.size": visible_when`)))
+
+			fields := []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Default: 2},
+				{Path: "spec.data_disk.size", Editable: &editable, VisibleWhen: &guarded},
+			}
+			_, err = svc.CatalogItem().Update(admin, "gpu-vm", &service.UpdateCatalogItemRequest{Fields: &fields})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = svc.CatalogItemInstance().Create(teamA, req)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject conditions that do not compile", func() {
+			editable := true
+			condition := "spec.data_disk.enabled =="
+			id := "broken-vm"
+			_, err := svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "Broken VM",
+				ServiceType: "vm",
+				Fields:      []v1alpha1.FieldConfiguration{{Path: "spec.data_disk.size", Editable: &editable, VisibleWhen: &condition}},
+			})
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+			Expect(err).To(MatchError(ContainSubstring("visible_when")))
+		})
+	})
+
+	Describe("Computed defaults", func() {
+		createCatalogItem := func(fields ...v1alpha1.FieldConfiguration) error {
+			id := "named-vm"
+			_, err := svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "Named VM",
+				ServiceType: "vm",
+				Fields:      fields,
+			})
+			return err
+		}
+		request := func(userValues ...v1alpha1.UserValue) *service.CreateCatalogItemInstanceRequest {
+			req := newRequest("0f8e2c1a-9b7d", userValues...)
+			req.CatalogItemId = "named-vm"
+			req.DisplayName = "Web Server"
+			return req
+		}
+
+		It("should compute defaults from the instance and the other fields", func() {
+			editable := true
+			name := `instance.display_name.lowerAscii().replace(" ", "-") + "-" + instance.uid.substring(0, 8)`
+			dataDisk := "spec.vcpu.count > 2"
+			Expect(createCatalogItem(
+				v1alpha1.FieldConfiguration{Path: "spec.vcpu.count", Editable: &editable, Default: 2},
+				v1alpha1.FieldConfiguration{Path: "metadata.name", DefaultExpression: &name},
+				v1alpha1.FieldConfiguration{Path: "spec.data_disk.enabled", DefaultExpression: &dataDisk},
+			)).To(Succeed())
+
+			_, err := svc.CatalogItemInstance().Create(teamA, request(v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 4}))
+			Expect(err).ToNot(HaveOccurred())
+
+			instance, err := str.CatalogItemInstance().Get(teamA, "0f8e2c1a-9b7d")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.RenderedSpec["metadata"]).To(HaveKeyWithValue("name", "web-server-0f8e2c1a"))
+			Expect(instance.RenderedSpec["data_disk"]).To(HaveKeyWithValue("enabled", true))
+		})
+
+		It("should prefer user values to computed defaults", func() {
+			editable := true
+			count := "1.0 + 1.0"
+			Expect(createCatalogItem(v1alpha1.FieldConfiguration{Path: "spec.vcpu.count", Editable: &editable, DefaultExpression: &count})).To(Succeed())
+
+			_, err := svc.CatalogItemInstance().Create(teamA, request(v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 4}))
+			Expect(err).ToNot(HaveOccurred())
+
+			instance, err := str.CatalogItemInstance().Get(teamA, "0f8e2c1a-9b7d")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.RenderedSpec["vcpu"]).To(HaveKeyWithValue("count", BeNumerically("==", 4)))
+		})
+
+		It("should reject computed defaults outside the validation schema", func() {
+			count := "spec.guest_os.type"
+			Expect(createCatalogItem(
+				v1alpha1.FieldConfiguration{Path: "spec.guest_os.type", Default: "rhel-9"},
+				v1alpha1.FieldConfiguration{Path: "spec.vcpu.count", DefaultExpression: &count, ValidationSchema: &map[string]any{"type": "integer"}},
+			)).To(Succeed())
+
+			_, err := svc.CatalogItemInstance().Create(teamA, request())
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(err).To(MatchError(ContainSubstring("default_expression")))
+		})
+
+		It("should reject computed defaults outside the service type schema", func() {
+			count := "spec.guest_os.type"
+			Expect(createCatalogItem(
+				v1alpha1.FieldConfiguration{Path: "spec.guest_os.type", Default: "rhel-9"},
+				v1alpha1.FieldConfiguration{Path: "spec.vcpu.count", DefaultExpression: &count},
+			)).To(Succeed())
+
+			_, err := svc.CatalogItemInstance().Create(teamA, request())
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(err).To(MatchError(ContainSubstring("does not match the service type schema")))
+		})
+
+		It("should compute quantities", func() {
+			size := `formatQuantity(quantity("2GB") * 2)`
+			Expect(createCatalogItem(v1alpha1.FieldConfiguration{Path: "spec.memory.size", DefaultExpression: &size})).To(Succeed())
+
+			_, err := svc.CatalogItemInstance().Create(teamA, request())
+			Expect(err).ToNot(HaveOccurred())
+
+			instance, err := str.CatalogItemInstance().Get(teamA, "0f8e2c1a-9b7d")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.RenderedSpec["memory"]).To(HaveKeyWithValue("size", "4GB"))
+		})
+
+		It("should reject fields with both a default and a default expression", func() {
+			count := "2.0"
+			err := createCatalogItem(v1alpha1.FieldConfiguration{Path: "spec.vcpu.count", Default: 2, DefaultExpression: &count})
+			Expect(err).To(MatchError(ContainSubstring("mutually exclusive")))
+		})
+	})
+
+	Describe("Quantities", func() {
+		BeforeEach(func() {
+			editable := true
+			id := "sized-vm"
+			_, err := svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "Sized VM",
+				ServiceType: "vm",
+				Fields: []v1alpha1.FieldConfiguration{{
+					Path:             "spec.memory.size",
+					Editable:         &editable,
+					Default:          "4GB",
+					ValidationSchema: &map[string]any{"x-min-quantity": "1GB", "x-max-quantity": "64GB"},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		request := func(size string) *service.CreateCatalogItemInstanceRequest {
+			req := newRequest("my-vm", v1alpha1.UserValue{Path: "spec.memory.size", Value: size})
+			req.CatalogItemId = "sized-vm"
+			return req
+		}
+
+		It("should reject quantities above the maximum", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, request("128GB"))
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(err).To(MatchError(ContainSubstring("quantity must be at most 64GB")))
+		})
+
+		It("should compare quantities across units", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, request("65537MB"))
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+		})
+
+		It("should render quantities in their largest exact unit", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, request("2048MB"))
+			Expect(err).ToNot(HaveOccurred())
+
+			instance, err := str.CatalogItemInstance().Get(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.RenderedSpec["memory"]).To(HaveKeyWithValue("size", "2GB"))
+		})
+
+		It("should render quantities declared by the service type schema", func() {
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1alpha1",
+				ServiceType: "database",
+				Spec: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"storage": map[string]any{"type": "string", "format": "quantity"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			editable := true
+			id := "db"
+			_, err = svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "Database",
+				ServiceType: "database",
+				Fields:      []v1alpha1.FieldConfiguration{{Path: "spec.storage", Editable: &editable, Default: "10GB"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("my-db", v1alpha1.UserValue{Path: "spec.storage", Value: "1024GB"})
+			req.CatalogItemId = "db"
+			_, err = svc.CatalogItemInstance().Create(teamA, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			instance, err := str.CatalogItemInstance().Get(teamA, "my-db")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.RenderedSpec).To(HaveKeyWithValue("storage", "1TB"))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should mark the instance for deletion", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, newRequest("my-vm"))
+			Expect(err).ToNot(HaveOccurred())
+
+			op, err := svc.CatalogItemInstance().Delete(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeFalse())
+			Expect(op.Metadata.Type).To(Equal(v1alpha1.DeleteCatalogItemInstance))
+
+			result, err := svc.CatalogItemInstance().Get(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status.State).To(Equal(v1alpha1.DELETING))
+		})
+	})
+
+	Describe("BatchGet", func() {
+		BeforeEach(func() {
+			for _, id := range []string{"vm-1", "vm-2"} {
+				_, err := svc.CatalogItemInstance().Create(teamA, newRequest(id))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			_, err := svc.CatalogItemInstance().Create(teamB, newRequest("b-vm"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return the instances in the order of the IDs", func() {
+			result, err := svc.CatalogItemInstance().BatchGet(teamA, []string{"vm-2", "vm-1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Results).To(HaveLen(2))
+			Expect(*result.Results[0].Uid).To(Equal("vm-2"))
+			Expect(*result.Results[1].Uid).To(Equal("vm-1"))
+		})
+
+		It("should fail if any instance belongs to another tenant", func() {
+			_, err := svc.CatalogItemInstance().BatchGet(teamA, []string{"vm-1", "b-vm"})
+			Expect(err).To(MatchError(service.ErrCatalogItemInstanceNotFound))
+		})
+	})
+
+	Describe("Count", func() {
+		BeforeEach(func() {
+			for _, id := range []string{"vm-1", "vm-2", "vm-3"} {
+				_, err := svc.CatalogItemInstance().Create(teamA, newRequest(id))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			_, err := svc.CatalogItemInstance().Create(teamB, newRequest("b-vm"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should count the caller's instances by catalog item", func() {
+			counts, err := svc.CatalogItemInstance().Count(teamA, &service.CatalogItemInstanceCountOptions{
+				GroupBy: v1alpha1.CatalogItemInstanceCountsGroupByCatalogItem,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts.TotalSize).To(BeEquivalentTo(3))
+			Expect(counts.Groups).To(Equal([]v1alpha1.CatalogItemInstanceCount{{Key: "small-vm", Count: 3}}))
+		})
+
+		It("should key owner groups by tenant path", func() {
+			counts, err := svc.CatalogItemInstance().Count(context.Background(), &service.CatalogItemInstanceCountOptions{
+				GroupBy: v1alpha1.CatalogItemInstanceCountsGroupByOwner,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts.TotalSize).To(BeEquivalentTo(4))
+			Expect(counts.Groups).To(Equal([]v1alpha1.CatalogItemInstanceCount{
+				{Key: "tenants/team-a", Count: 3},
+				{Key: "tenants/team-b", Count: 1},
+			}))
+		})
+
+		It("should reject unknown attributes", func() {
+			_, err := svc.CatalogItemInstance().Count(teamA, &service.CatalogItemInstanceCountOptions{GroupBy: "state"})
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+		})
+
+		It("should return the total size of lists unless skipped", func() {
+			pageSize := int32(2)
+			result, err := svc.CatalogItemInstance().List(teamA, &service.CatalogItemInstanceListOptions{MaxPageSize: &pageSize})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItemInstances).To(HaveLen(2))
+			Expect(result.TotalSize).To(HaveValue(BeEquivalentTo(3)))
+
+			result, err = svc.CatalogItemInstance().List(teamA, &service.CatalogItemInstanceListOptions{MaxPageSize: &pageSize, SkipTotalSize: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.TotalSize).To(BeNil())
+		})
+	})
+
+	Describe("BatchCreate", func() {
+		batch := func(ids ...string) *service.BatchCreateCatalogItemInstancesRequest {
+			req := &service.BatchCreateCatalogItemInstancesRequest{}
+			for _, id := range ids {
+				req.Requests = append(req.Requests, *newRequest(id, v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 4}))
+			}
+			return req
+		}
+
+		countInstances := func() int64 {
+			var count int64
+			Expect(db.Model(&model.CatalogItemInstance{}).Count(&count).Error).To(Succeed())
+			return count
+		}
+
+		It("should create every instance with its own operation", func() {
+			result, err := svc.CatalogItemInstance().BatchCreate(teamA, batch("lab-1", "lab-2", "lab-3"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Results).To(HaveLen(3))
+			Expect(result.Results[1].Metadata.Target).To(Equal("tenants/team-a/catalog-item-instances/lab-2"))
+			Expect(*result.Results[0].Path).ToNot(Equal(*result.Results[1].Path))
+
+			instance, err := svc.CatalogItemInstance().Get(teamA, "lab-3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.Status.State).To(Equal(v1alpha1.PENDING))
+		})
+
+		It("should create no instance when a request is invalid", func() {
+			req := batch("lab-1", "lab-2")
+			req.Requests[1].UserValues = []v1alpha1.UserValue{{Path: "spec.vcpu.count", Value: 16}}
+
+			_, err := svc.CatalogItemInstance().BatchCreate(teamA, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(err.Error()).To(HavePrefix("requests[1]: "))
+			Expect(countInstances()).To(BeZero())
+		})
+
+		It("should reject IDs repeated within the batch", func() {
+			_, err := svc.CatalogItemInstance().BatchCreate(teamA, batch("lab-1", "lab-1"))
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItemInstance))
+			Expect(countInstances()).To(BeZero())
+		})
+
+		It("should roll back the batch when an ID is taken", func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, newRequest("lab-2"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItemInstance().BatchCreate(teamA, batch("lab-1", "lab-2"))
+			Expect(err).To(MatchError(service.ErrCatalogItemInstanceIDTaken))
+			Expect(countInstances()).To(Equal(int64(1)))
+		})
+
+		It("should enforce quotas on the batch as a whole", func() {
+			maxInstances := int64(2)
+			_, err := svc.Quota().Create(admin, &service.CreateQuotaRequest{MaxInstances: &maxInstances})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItemInstance().BatchCreate(teamA, batch("lab-1", "lab-2", "lab-3"))
+			Expect(err).To(MatchError(service.ErrQuotaExceeded))
+			Expect(countInstances()).To(BeZero())
+
+			_, err = svc.CatalogItemInstance().BatchCreate(teamA, batch("lab-1", "lab-2"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject empty and oversized batches", func() {
+			_, err := svc.CatalogItemInstance().BatchCreate(teamA, &service.BatchCreateCatalogItemInstancesRequest{})
+			Expect(err).To(MatchError(service.ErrInvalidBatchRequest))
+
+			ids := make([]string, 101)
+			for i := range ids {
+				ids[i] = fmt.Sprintf("lab-%d", i)
+			}
+			_, err = svc.CatalogItemInstance().BatchCreate(teamA, batch(ids...))
+			Expect(err).To(MatchError(service.ErrInvalidBatchRequest))
+		})
+	})
+
+	Describe("tenant isolation", func() {
+		BeforeEach(func() {
+			_, err := svc.CatalogItemInstance().Create(teamA, newRequest("a-vm"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = svc.CatalogItemInstance().Create(teamB, newRequest("b-vm"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should only list the caller's instances", func() {
+			result, err := svc.CatalogItemInstance().List(teamA, &service.CatalogItemInstanceListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItemInstances).To(HaveLen(1))
+			Expect(*result.CatalogItemInstances[0].Uid).To(Equal("a-vm"))
+		})
+
+		It("should reject listing another tenant's parent", func() {
+			parent := "tenants/team-b"
+			_, err := svc.CatalogItemInstance().List(teamA, &service.CatalogItemInstanceListOptions{Parent: &parent})
+			Expect(err).To(MatchError(service.ErrTenantMismatch))
+		})
+
+		It("should not get or delete another tenant's instance", func() {
+			_, err := svc.CatalogItemInstance().Get(teamA, "b-vm")
+			Expect(err).To(MatchError(service.ErrCatalogItemInstanceNotFound))
+			_, err = svc.CatalogItemInstance().Delete(teamA, "b-vm")
+			Expect(err).To(MatchError(service.ErrCatalogItemInstanceNotFound))
+
+			_, err = svc.CatalogItemInstance().Get(teamB, "b-vm")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Convert", func() {
+		BeforeEach(func() {
+			cores := "cpu.cores"
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1beta1",
+				ServiceType: "vm",
+				Spec:        map[string]any{"cpu": map[string]any{"cores": 1}},
+				Conversions: []v1alpha1.ServiceTypeConversion{{
+					FromVersion: "v1alpha1",
+					FieldMappings: &[]v1alpha1.FieldMapping{
+						{From: "vcpu.count", To: &cores},
+						{From: "guest_os"},
+					},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItemInstance().Create(teamA, newRequest("my-vm", v1alpha1.UserValue{Path: "spec.vcpu.count", Value: 4}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should convert the rendered spec and list the lost values", func() {
+			result, err := svc.CatalogItemInstance().Convert(teamA, "my-vm", "v1beta1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.FromVersion).To(Equal("v1alpha1"))
+			Expect(result.Spec).To(HaveKeyWithValue("cpu", HaveKeyWithValue("cores", BeNumerically("==", 4))))
+			Expect(result.Spec).ToNot(HaveKey("vcpu"))
+			Expect(result.LossyFields).To(ConsistOf("guest_os"))
+			Expect(result.Violations).To(BeEmpty())
+
+			// The instance is left unchanged
+			instance, err := str.CatalogItemInstance().Get(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.RenderedSpec).To(HaveKey("vcpu"))
+		})
+
+		It("should drop the values the target version lacks and report violations", func() {
+			cores := "cpu.cores"
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1",
+				ServiceType: "vm",
+				Spec: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"cpu": map[string]any{
+							"type":       "object",
+							"properties": map[string]any{"cores": map[string]any{"type": "integer", "maximum": 2}},
+						},
+					},
+				},
+				Conversions: []v1alpha1.ServiceTypeConversion{{
+					FromVersion:   "v1alpha1",
+					FieldMappings: &[]v1alpha1.FieldMapping{{From: "vcpu.count", To: &cores}},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := svc.CatalogItemInstance().Convert(teamA, "my-vm", "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Spec).ToNot(HaveKey("guest_os"))
+			Expect(result.LossyFields).To(ConsistOf("guest_os"))
+			Expect(result.Violations).To(ConsistOf(ContainSubstring("/cpu/cores")))
+		})
+
+		It("should reject versions without a declared conversion", func() {
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1",
+				ServiceType: "vm",
+				Spec:        map[string]any{"cpu": map[string]any{"cores": 1}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItemInstance().Convert(teamA, "my-vm", "v1")
+			Expect(err).To(MatchError(service.ErrConversionUnavailable))
+			_, err = svc.CatalogItemInstance().Convert(teamA, "my-vm", "v2")
+			Expect(err).To(MatchError(service.ErrInvalidConversion))
+		})
+
+		It("should not convert another tenant's instance", func() {
+			_, err := svc.CatalogItemInstance().Convert(teamB, "my-vm", "v1beta1")
+			Expect(err).To(MatchError(service.ErrCatalogItemInstanceNotFound))
+		})
+	})
+})
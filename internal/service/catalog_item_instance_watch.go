@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/dcm-project/catalog-manager/internal/watch"
+)
+
+// WatchCatalogItemInstancesRequest contains the parameters for watching catalog item instances
+type WatchCatalogItemInstancesRequest struct {
+	ResumeToken   *string
+	CatalogItemId *string
+	Parent        *string
+}
+
+// CatalogItemInstanceWatcher streams changes to catalog item instances
+type CatalogItemInstanceWatcher interface {
+	// Next blocks until the next matching change or until ctx is done
+	Next(ctx context.Context) (*v1alpha1.WatchEvent, error)
+	// Bookmark returns a BOOKMARK event carrying the current resume token
+	Bookmark() v1alpha1.WatchEvent
+	Close()
+}
+
+// watchEventTypes maps the outbox event types of instances to watch event types
+var watchEventTypes = map[string]v1alpha1.WatchEventType{
+	model.EventCatalogItemInstanceCreated:     v1alpha1.WatchEventAdded,
+	model.EventCatalogItemInstanceUpdated:     v1alpha1.WatchEventModified,
+	model.EventCatalogItemInstanceStateChange: v1alpha1.WatchEventModified,
+	model.EventCatalogItemInstanceDeleted:     v1alpha1.WatchEventDeleted,
+}
+
+// Watch streams the changes to the caller's catalog item instances, starting
+// after the resume token if any and otherwise from now
+func (s *catalogItemInstanceService) Watch(ctx context.Context, req *WatchCatalogItemInstancesRequest) (CatalogItemInstanceWatcher, error) {
+	if s.hub == nil {
+		return nil, ErrWatchUnavailable
+	}
+	tenant, err := resolveParent(ctx, req.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == "" {
+		tenant, _ = tenancy.FromContext(ctx)
+	}
+
+	var from *watch.Position
+	if req.ResumeToken != nil && *req.ResumeToken != "" {
+		position, err := watch.ParseToken(*req.ResumeToken)
+		if err != nil {
+			return nil, ErrInvalidResumeToken
+		}
+		from = &position
+	}
+
+	sub, err := s.hub.Subscribe(ctx, from)
+	if err != nil {
+		if errors.Is(err, watch.ErrResumeTokenExpired) {
+			return nil, ErrResumeTokenExpired
+		}
+		return nil, err
+	}
+	w := &catalogItemInstanceWatcher{sub: sub, tenant: tenant}
+	if req.CatalogItemId != nil {
+		w.catalogItemId = *req.CatalogItemId
+	}
+	return w, nil
+}
+
+// catalogItemInstanceWatcher filters the outbox events of a subscription
+type catalogItemInstanceWatcher struct {
+	sub           *watch.Subscription
+	tenant        string // empty to watch every tenant
+	catalogItemId string // empty to watch every catalog item
+}
+
+func (w *catalogItemInstanceWatcher) Next(ctx context.Context) (*v1alpha1.WatchEvent, error) {
+	for {
+		event, err := w.sub.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		eventType, ok := watchEventTypes[event.Type]
+		if !ok || (w.tenant != "" && event.Tenant != w.tenant) {
+			continue
+		}
+		instance, err := instanceFromEventData(event.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode event %s: %w", event.ID, err)
+		}
+		if w.catalogItemId != "" && instance.Spec.CatalogItemId != w.catalogItemId {
+			continue
+		}
+
+		apiInstance := toCatalogItemInstanceAPIType(instance)
+		return &v1alpha1.WatchEvent{
+			Type:        eventType,
+			Object:      &apiInstance,
+			ResumeToken: w.sub.Position().Token(),
+		}, nil
+	}
+}
+
+func (w *catalogItemInstanceWatcher) Bookmark() v1alpha1.WatchEvent {
+	return v1alpha1.WatchEvent{
+		Type:        v1alpha1.WatchEventBookmark,
+		ResumeToken: w.sub.Position().Token(),
+	}
+}
+
+func (w *catalogItemInstanceWatcher) Close() {
+	w.sub.Close()
+}
+
+// instanceEventData is the payload of catalog item instance events, as
+// recorded in the outbox by the store
+type instanceEventData struct {
+	Uid                    string                        `json:"uid"`
+	Path                   string                        `json:"path"`
+	ApiVersion             string                        `json:"api_version"`
+	DisplayName            string                        `json:"display_name"`
+	Spec                   model.CatalogItemInstanceSpec `json:"spec"`
+	ServiceTypeInstanceUid string                        `json:"service_type_instance_uid"`
+	CatalogItemRevision    int                           `json:"catalog_item_revision"`
+	State                  string                        `json:"state"`
+	Conditions             []model.Condition             `json:"conditions"`
+	LastError              string                        `json:"last_error"`
+	CreateTime             time.Time                     `json:"create_time"`
+	UpdateTime             time.Time                     `json:"update_time"`
+}
+
+// instanceFromEventData rebuilds an instance from the payload of its event,
+// so that watchers do not read the instance once per change
+func instanceFromEventData(data map[string]any) (*model.CatalogItemInstance, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var d instanceEventData
+	if err := json.Unmarshal(raw, &d); err != nil {
+		return nil, err
+	}
+	return &model.CatalogItemInstance{
+		ID:                     d.Uid,
+		ApiVersion:             d.ApiVersion,
+		DisplayName:            d.DisplayName,
+		Spec:                   d.Spec,
+		ServiceTypeInstanceUid: d.ServiceTypeInstanceUid,
+		CatalogItemRevision:    d.CatalogItemRevision,
+		Status: model.InstanceStatus{
+			State:      d.State,
+			Conditions: d.Conditions,
+			LastError:  d.LastError,
+		},
+		Path:       d.Path,
+		CreateTime: d.CreateTime,
+		UpdateTime: d.UpdateTime,
+	}, nil
+}
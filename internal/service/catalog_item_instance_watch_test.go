@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/dcm-project/catalog-manager/internal/watch"
+)
+
+var _ = Describe("CatalogItemInstance Watch", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		teamA  context.Context
+		teamB  context.Context
+		str    store.Store
+		svc    service.Service
+		done   chan struct{}
+	)
+
+	createInstance := func(ctx context.Context, id, catalogItemId string) {
+		_, err := svc.CatalogItemInstance().Create(ctx, &service.CreateCatalogItemInstanceRequest{
+			ID: &id, ApiVersion: "v1alpha1", DisplayName: "My VM", CatalogItemId: catalogItemId,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	next := func(w service.CatalogItemInstanceWatcher) *v1alpha1.WatchEvent {
+		nextCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		event, err := w.Next(nextCtx)
+		Expect(err).ToNot(HaveOccurred())
+		return event
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		teamA = tenancy.NewContext(ctx, "team-a")
+		teamB = tenancy.NewContext(ctx, "team-b")
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		// Every connection to :memory: opens a distinct database
+		sqlDB, err := db.DB()
+		Expect(err).ToNot(HaveOccurred())
+		sqlDB.SetMaxOpenConns(1)
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+
+		hub := watch.NewHub(str, watch.Config{Retention: time.Hour})
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			hub.Run(ctx)
+		}()
+		svc = service.NewService(str, service.WithWatchHub(hub))
+
+		_, err = svc.ServiceType().Create(ctx, &service.CreateServiceTypeRequest{
+			ApiVersion: "v1alpha1", ServiceType: "vm", Spec: map[string]any{"vcpu": map[string]any{"count": 1}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		for _, id := range []string{"small-vm", "large-vm"} {
+			_, err = svc.CatalogItem().Create(ctx, &service.CreateCatalogItemRequest{
+				ID: &id, ApiVersion: "v1alpha1", DisplayName: id, ServiceType: "vm",
+				Fields: []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+		Expect(str.Close()).To(Succeed())
+	})
+
+	It("should stream the changes to the caller's instances", func() {
+		watcher, err := svc.CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		defer watcher.Close()
+		Expect(watcher.Bookmark().Type).To(Equal(v1alpha1.WatchEventBookmark))
+
+		createInstance(teamB, "other-vm", "small-vm")
+		createInstance(teamA, "my-vm", "small-vm")
+		_, err = svc.CatalogItemInstance().Delete(teamA, "my-vm")
+		Expect(err).ToNot(HaveOccurred())
+
+		event := next(watcher)
+		Expect(event.Type).To(Equal(v1alpha1.WatchEventAdded))
+		Expect(*event.Object.Path).To(Equal("tenants/team-a/catalog-item-instances/my-vm"))
+		Expect(event.Object.Spec.CatalogItemId).To(Equal("small-vm"))
+		Expect(event.Object.Status.State).To(Equal(v1alpha1.CatalogItemInstanceStatusState(model.InstanceStatePending)))
+		Expect(event.ResumeToken).To(Equal(watcher.Bookmark().ResumeToken))
+
+		event = next(watcher)
+		Expect(event.Type).To(Equal(v1alpha1.WatchEventModified))
+		Expect(event.Object.Status.State).To(Equal(v1alpha1.CatalogItemInstanceStatusState(model.InstanceStateDeleting)))
+	})
+
+	It("should filter by catalog item", func() {
+		smallVM := "small-vm"
+		watcher, err := svc.CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{CatalogItemId: &smallVM})
+		Expect(err).ToNot(HaveOccurred())
+		defer watcher.Close()
+
+		createInstance(teamA, "large", "large-vm")
+		createInstance(teamA, "small", "small-vm")
+		Expect(*next(watcher).Object.Uid).To(Equal("small"))
+	})
+
+	It("should resume after a resume token", func() {
+		watcher, err := svc.CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		createInstance(teamA, "first", "small-vm")
+		token := next(watcher).ResumeToken
+		watcher.Close()
+
+		createInstance(teamA, "second", "small-vm")
+		watcher, err = svc.CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{ResumeToken: &token})
+		Expect(err).ToNot(HaveOccurred())
+		defer watcher.Close()
+		Expect(*next(watcher).Object.Uid).To(Equal("second"))
+	})
+
+	It("should reject invalid and expired resume tokens", func() {
+		invalid := "not a token"
+		_, err := svc.CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{ResumeToken: &invalid})
+		Expect(err).To(MatchError(service.ErrInvalidResumeToken))
+
+		expired := watch.Position{Sequence: 1, Time: time.Now().Add(-2 * time.Hour)}.Token()
+		_, err = svc.CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{ResumeToken: &expired})
+		Expect(err).To(MatchError(service.ErrResumeTokenExpired))
+	})
+
+	It("should reject the parent of another tenant", func() {
+		parent := "tenants/team-b"
+		_, err := svc.CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{Parent: &parent})
+		Expect(err).To(MatchError(service.ErrTenantMismatch))
+	})
+
+	It("should fail without a hub", func() {
+		_, err := service.NewService(str).CatalogItemInstance().Watch(teamA, &service.WatchCatalogItemInstancesRequest{})
+		Expect(err).To(MatchError(service.ErrWatchUnavailable))
+	})
+})
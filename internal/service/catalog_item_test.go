@@ -0,0 +1,760 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/dcm-project/catalog-manager/internal/warning"
+)
+
+var _ = Describe("CatalogItem Service", func() {
+	var (
+		teamA context.Context
+		teamB context.Context
+		admin context.Context
+		db    *gorm.DB
+		str   store.Store
+		svc   service.Service
+	)
+
+	editable := true
+	newRequest := func(id string) *service.CreateCatalogItemRequest {
+		return &service.CreateCatalogItemRequest{
+			ID:          &id,
+			ApiVersion:  "v1alpha1",
+			DisplayName: "Small VM",
+			ServiceType: "vm",
+			Fields: []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Editable: &editable, Default: 2},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		teamA = tenancy.NewContext(context.Background(), "team-a")
+		teamB = tenancy.NewContext(context.Background(), "team-b")
+		admin = tenancy.NewAdminContext(teamA)
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+		svc = service.NewService(str)
+
+		_, err = svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+			ApiVersion:  "v1alpha1",
+			ServiceType: "vm",
+			Spec: map[string]any{
+				"vcpu":   map[string]any{"count": 1},
+				"memory": map[string]any{"size": "2GB"},
+				"access": map[string]any{"ssh_public_key": "ssh-ed25519 AAAA"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if str != nil {
+			Expect(str.Close()).To(Succeed())
+		}
+	})
+
+	Describe("Create", func() {
+		It("should create a global catalog item", func() {
+			result, err := svc.CatalogItem().Create(admin, newRequest("small-vm"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Path).To(Equal("catalog-items/small-vm"))
+			Expect(*result.Spec.Fields).To(HaveLen(1))
+		})
+
+		It("should create a tenant-private catalog item when a parent is given", func() {
+			req := newRequest("private-vm")
+			parent := "tenants/team-a"
+			req.Parent = &parent
+
+			result, err := svc.CatalogItem().Create(teamA, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Path).To(Equal("tenants/team-a/catalog-items/private-vm"))
+		})
+
+		It("should reject a global catalog item created by a tenant", func() {
+			_, err := svc.CatalogItem().Create(teamA, newRequest("small-vm"))
+			Expect(err).To(MatchError(service.ErrAdminRequired))
+		})
+
+		It("should reject a parent of another tenant", func() {
+			req := newRequest("private-vm")
+			parent := "tenants/team-b"
+			req.Parent = &parent
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrTenantMismatch))
+		})
+
+		It("should reject a catalog item without fields", func() {
+			req := newRequest("small-vm")
+			req.Fields = nil
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+		})
+
+		It("should map an unknown service type", func() {
+			req := newRequest("small-db")
+			req.ServiceType = "database"
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrServiceTypeNotFound))
+		})
+	})
+
+	Describe("Field validation", func() {
+		It("should reject paths that are not fields of the service type", func() {
+			req := newRequest("small-vm")
+			req.Fields = []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.cuont", Default: 2}}
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+			Expect(err.Error()).To(ContainSubstring("spec.vcpu.cuont"))
+		})
+
+		It("should accept the fields common to every service type", func() {
+			req := newRequest("small-vm")
+			req.Fields = append(req.Fields, v1alpha1.FieldConfiguration{Path: "spec.metadata.labels.team", Default: "a"})
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject defaults of the wrong type", func() {
+			req := newRequest("small-vm")
+			req.Fields = []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Default: "two"}}
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+			Expect(err.Error()).To(ContainSubstring("default does not match the service type schema"))
+		})
+
+		It("should reject defaults outside the validation schema", func() {
+			req := newRequest("small-vm")
+			req.Fields = []v1alpha1.FieldConfiguration{{
+				Path:             "spec.vcpu.count",
+				Default:          16,
+				ValidationSchema: &map[string]any{"type": "integer", "maximum": 8},
+			}}
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+			Expect(err.Error()).To(ContainSubstring("default does not match validation_schema"))
+		})
+
+		It("should reject validation schemas that loosen the service type schema", func() {
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1alpha1",
+				ServiceType: "database",
+				Spec: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"replicas": map[string]any{"type": "integer", "minimum": 1, "maximum": 5},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("small-db")
+			req.ServiceType = "database"
+			req.Fields = []v1alpha1.FieldConfiguration{{
+				Path:             "spec.replicas",
+				ValidationSchema: &map[string]any{"type": "integer", "maximum": 10},
+			}}
+
+			_, err = svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+			Expect(err.Error()).To(ContainSubstring("maximum 10 is above 5"))
+		})
+
+		It("should report every problem at once", func() {
+			req := newRequest("small-vm")
+			req.Fields = []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Default: 2},
+				{Path: "spec.vcpu.count", Default: 4},
+				{Path: "spec.vcpu.cuont"},
+				{Path: "spec.memory.size", Default: 4},
+			}
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+			Expect(err.Error()).To(SatisfyAll(
+				ContainSubstring("spec.fields[1] (spec.vcpu.count): duplicate path"),
+				ContainSubstring("spec.fields[2] (spec.vcpu.cuont)"),
+				ContainSubstring("spec.fields[3] (spec.memory.size)"),
+			))
+		})
+
+		It("should validate the fields of updates", func() {
+			_, err := svc.CatalogItem().Create(admin, newRequest("small-vm"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fields := []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.cuont"}}
+			_, err = svc.CatalogItem().Update(admin, "small-vm", &service.UpdateCatalogItemRequest{Fields: &fields})
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+		})
+	})
+
+	Describe("tenant visibility", func() {
+		BeforeEach(func() {
+			_, err := svc.CatalogItem().Create(admin, newRequest("global-vm"))
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("private-vm")
+			parent := "tenants/team-a"
+			req.Parent = &parent
+			_, err = svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should hide private catalog items from other tenants", func() {
+			_, err := svc.CatalogItem().Get(teamB, "private-vm")
+			Expect(err).To(MatchError(service.ErrCatalogItemNotFound))
+
+			result, err := svc.CatalogItem().List(teamB, &service.CatalogItemListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItems).To(HaveLen(1))
+			Expect(*result.CatalogItems[0].Uid).To(Equal("global-vm"))
+		})
+
+		It("should list only the tenant's own items for a parent", func() {
+			parent := "tenants/team-a"
+			result, err := svc.CatalogItem().List(teamA, &service.CatalogItemListOptions{Parent: &parent})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItems).To(HaveLen(1))
+			Expect(*result.CatalogItems[0].Uid).To(Equal("private-vm"))
+		})
+
+		It("should not load the fields when the read mask leaves them out", func() {
+			result, err := svc.CatalogItem().List(teamA, &service.CatalogItemListOptions{
+				ReadMask: fieldmask.Mask{"uid", "display_name", "spec.service_type"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItems).To(HaveLen(2))
+			for _, item := range result.CatalogItems {
+				Expect(*item.Spec.ServiceType).To(Equal("vm"))
+				Expect(item.Spec.Fields).To(Or(BeNil(), HaveValue(BeEmpty())))
+			}
+		})
+
+		It("should prevent other tenants from deleting private items", func() {
+			Expect(svc.CatalogItem().Delete(teamB, "private-vm")).To(MatchError(service.ErrCatalogItemNotFound))
+			Expect(svc.CatalogItem().Delete(admin, "private-vm")).To(Succeed())
+		})
+
+		It("should prevent tenants from changing or deleting global items", func() {
+			displayName := "Hijacked"
+			_, err := svc.CatalogItem().Update(teamB, "global-vm", &service.UpdateCatalogItemRequest{
+				DisplayName: &displayName,
+			})
+			Expect(err).To(MatchError(service.ErrAdminRequired))
+
+			req := newRequest("")
+			req.DisplayName = displayName
+			_, err = svc.CatalogItem().Apply(teamB, "global-vm", req, false)
+			Expect(err).To(MatchError(service.ErrAdminRequired))
+
+			_, err = svc.CatalogItem().Rollback(teamB, "global-vm", 1)
+			Expect(err).To(MatchError(service.ErrAdminRequired))
+
+			Expect(svc.CatalogItem().Delete(teamB, "global-vm")).To(MatchError(service.ErrAdminRequired))
+
+			item, err := svc.CatalogItem().Get(teamB, "global-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*item.DisplayName).ToNot(Equal(displayName))
+		})
+	})
+
+	Describe("BatchGet", func() {
+		BeforeEach(func() {
+			for _, id := range []string{"small-vm", "large-vm"} {
+				_, err := svc.CatalogItem().Create(admin, newRequest(id))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			req := newRequest("private-vm")
+			parent := "tenants/team-a"
+			req.Parent = &parent
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return the catalog items in the order of the IDs", func() {
+			result, err := svc.CatalogItem().BatchGet(teamA, []string{"small-vm", "private-vm", "large-vm", "small-vm"})
+			Expect(err).ToNot(HaveOccurred())
+			uids := make([]string, len(result.Results))
+			for i, item := range result.Results {
+				uids[i] = *item.Uid
+			}
+			Expect(uids).To(Equal([]string{"small-vm", "private-vm", "large-vm", "small-vm"}))
+		})
+
+		It("should fail if any catalog item is not visible", func() {
+			_, err := svc.CatalogItem().BatchGet(teamB, []string{"small-vm", "private-vm"})
+			Expect(err).To(MatchError(service.ErrCatalogItemNotFound))
+			Expect(err.Error()).To(ContainSubstring(`"private-vm"`))
+		})
+
+		It("should reject empty and oversized batches", func() {
+			_, err := svc.CatalogItem().BatchGet(teamA, nil)
+			Expect(err).To(MatchError(service.ErrInvalidBatchRequest))
+
+			_, err = svc.CatalogItem().BatchGet(teamA, make([]string, 1001))
+			Expect(err).To(MatchError(service.ErrInvalidBatchRequest))
+		})
+	})
+
+	Describe("Update", func() {
+		BeforeEach(func() {
+			_, err := svc.CatalogItem().Create(admin, newRequest("small-vm"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should update display name and fields", func() {
+			displayName := "Renamed VM"
+			fields := []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Default: 4},
+				{Path: "spec.memory.size", Default: "8GB"},
+			}
+
+			result, err := svc.CatalogItem().Update(admin, "small-vm", &service.UpdateCatalogItemRequest{
+				DisplayName: &displayName,
+				Fields:      &fields,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.DisplayName).To(Equal(displayName))
+			Expect(*result.Spec.Fields).To(HaveLen(2))
+		})
+
+		It("should reject a change of service type", func() {
+			serviceType := "container"
+			_, err := svc.CatalogItem().Update(admin, "small-vm", &service.UpdateCatalogItemRequest{
+				ServiceType: &serviceType,
+			})
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+		})
+	})
+
+	Describe("Apply", func() {
+		It("should create a missing catalog item", func() {
+			result, err := svc.CatalogItem().Apply(admin, "small-vm", newRequest(""), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionCreated))
+			Expect(*result.CatalogItem.Path).To(Equal("catalog-items/small-vm"))
+			Expect(*result.CatalogItem.Spec.ServiceTypeVersion).To(Equal("v1alpha1"))
+			Expect(result.Changes).To(ContainElement(HaveField("Path", "display_name")))
+		})
+
+		It("should not create anything with validate_only", func() {
+			result, err := svc.CatalogItem().Apply(admin, "small-vm", newRequest(""), true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionCreated))
+			Expect(*result.CatalogItem.Revision).To(Equal(int32(1)))
+
+			_, err = svc.CatalogItem().Get(teamA, "small-vm")
+			Expect(err).To(MatchError(service.ErrCatalogItemNotFound))
+		})
+
+		It("should not record a revision for an unchanged catalog item", func() {
+			_, err := svc.CatalogItem().Apply(admin, "small-vm", newRequest(""), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := svc.CatalogItem().Apply(admin, "small-vm", newRequest(""), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionUnchanged))
+			Expect(result.Changes).To(BeEmpty())
+			Expect(*result.CatalogItem.Revision).To(Equal(int32(1)))
+
+			revisions, err := svc.CatalogItem().ListRevisions(teamA, "small-vm", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revisions.CatalogItemRevisions).To(HaveLen(1))
+		})
+
+		It("should replace the display name and fields of an existing catalog item", func() {
+			_, err := svc.CatalogItem().Apply(admin, "small-vm", newRequest(""), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("")
+			req.DisplayName = "Tiny VM"
+			req.Fields[0].Default = 1
+			result, err := svc.CatalogItem().Apply(admin, "small-vm", req, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionUpdated))
+			Expect(result.Changes).To(HaveLen(2))
+			Expect(result.Changes).To(ContainElement(v1alpha1.ApplyChange{
+				Path: "display_name", Type: v1alpha1.ApplyChangeModified, Before: "Small VM", After: "Tiny VM",
+			}))
+			Expect(result.Changes).To(ContainElement(HaveField("Path", "spec.fields[spec.vcpu.count]")))
+
+			stored, err := svc.CatalogItem().Get(teamA, "small-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*stored.DisplayName).To(Equal("Small VM"))
+
+			result, err = svc.CatalogItem().Apply(admin, "small-vm", req, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionUpdated))
+			Expect(*result.CatalogItem.DisplayName).To(Equal("Tiny VM"))
+			Expect(*result.CatalogItem.Revision).To(Equal(int32(2)))
+		})
+
+		It("should reject changes to immutable fields", func() {
+			_, err := svc.CatalogItem().Apply(admin, "small-vm", newRequest(""), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("")
+			req.ServiceType = "container"
+			_, err = svc.CatalogItem().Apply(admin, "small-vm", req, false)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+
+			req = newRequest("")
+			parent := "tenants/team-a"
+			req.Parent = &parent
+			_, err = svc.CatalogItem().Apply(admin, "small-vm", req, false)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+		})
+	})
+
+	Describe("Revisions", func() {
+		BeforeEach(func() {
+			_, err := svc.CatalogItem().Create(admin, newRequest("small-vm"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fields := []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Default: 4}}
+			_, err = svc.CatalogItem().Update(admin, "small-vm", &service.UpdateCatalogItemRequest{Fields: &fields})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should list the revisions of a catalog item, newest first", func() {
+			result, err := svc.CatalogItem().ListRevisions(teamA, "small-vm", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItemRevisions).To(HaveLen(2))
+			Expect(*result.CatalogItemRevisions[0].Revision).To(Equal(int32(2)))
+			Expect(*result.CatalogItemRevisions[1].Path).To(Equal("catalog-items/small-vm/revisions/1"))
+			Expect((*result.CatalogItemRevisions[1].Spec.Fields)[0].Default).To(BeNumerically("==", 2))
+		})
+
+		It("should roll back to a prior revision", func() {
+			result, err := svc.CatalogItem().Rollback(admin, "small-vm", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Revision).To(Equal(int32(3)))
+			Expect((*result.Spec.Fields)[0].Default).To(BeNumerically("==", 2))
+		})
+
+		It("should reject revisions whose fields do not match the service type schema", func() {
+			// A revision recorded before its fields were validated
+			stale := model.CatalogItemRevision{
+				CatalogItemID: "small-vm",
+				Revision:      1,
+				Spec: model.CatalogItemSpec{
+					ServiceType:        "vm",
+					ServiceTypeVersion: "v1alpha1",
+					Fields:             []model.FieldConfiguration{{Path: "spec.vcpu.count", Default: "two"}},
+				},
+			}
+			Expect(db.Model(&model.CatalogItemRevision{}).
+				Where("catalog_item_id = ? AND revision = ?", "small-vm", 1).
+				Select("spec").Updates(&stale).Error).To(Succeed())
+
+			_, err := svc.CatalogItem().Rollback(admin, "small-vm", 1)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+
+			item, err := svc.CatalogItem().Get(teamA, "small-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*item.Revision).To(Equal(int32(2)))
+		})
+
+		It("should reject unknown revisions", func() {
+			_, err := svc.CatalogItem().Rollback(admin, "small-vm", 0)
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+
+			_, err = svc.CatalogItem().Rollback(admin, "small-vm", 5)
+			Expect(err).To(MatchError(service.ErrCatalogItemRevisionNotFound))
+		})
+
+		It("should hide the revisions of private catalog items from other tenants", func() {
+			req := newRequest("private-vm")
+			parent := "tenants/team-a"
+			req.Parent = &parent
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItem().ListRevisions(teamB, "private-vm", nil)
+			Expect(err).To(MatchError(service.ErrCatalogItemNotFound))
+			_, err = svc.CatalogItem().Rollback(teamB, "private-vm", 1)
+			Expect(err).To(MatchError(service.ErrCatalogItemNotFound))
+		})
+	})
+
+	Describe("Service type versions", func() {
+		var v1beta1 string
+
+		BeforeEach(func() {
+			created, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1beta1",
+				ServiceType: "vm",
+				Spec:        map[string]any{"vcpu": map[string]any{"count": 1}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			v1beta1 = *created.Uid
+		})
+
+		It("should pin the default version when none is given", func() {
+			result, err := svc.CatalogItem().Create(admin, newRequest("small-vm"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Spec.ServiceTypeVersion).To(Equal("v1alpha1"))
+		})
+
+		It("should pin the requested version", func() {
+			req := newRequest("small-vm")
+			req.ServiceTypeVersion = "v1beta1"
+
+			result, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Spec.ServiceTypeVersion).To(Equal("v1beta1"))
+		})
+
+		It("should reject unknown versions", func() {
+			req := newRequest("small-vm")
+			req.ServiceTypeVersion = "v2"
+
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrServiceTypeNotFound))
+		})
+
+		It("should warn about deprecated versions", func() {
+			deprecated := true
+			message := "use v1"
+			_, err := svc.ServiceType().Update(context.Background(), v1beta1, &service.UpdateServiceTypeRequest{
+				Deprecated:         &deprecated,
+				DeprecationMessage: &message,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			ctx, recorder := warning.NewContext(admin)
+			req := newRequest("small-vm")
+			req.ServiceTypeVersion = "v1beta1"
+			_, err = svc.CatalogItem().Create(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorder.Messages()).To(ConsistOf("service type vm v1beta1 is deprecated: use v1"))
+		})
+
+		It("should reject versions past their sunset time", func() {
+			deprecated := true
+			sunset := time.Now().Add(-time.Hour)
+			_, err := svc.ServiceType().Update(context.Background(), v1beta1, &service.UpdateServiceTypeRequest{
+				Deprecated: &deprecated,
+				SunsetTime: &sunset,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest("small-vm")
+			req.ServiceTypeVersion = "v1beta1"
+			_, err = svc.CatalogItem().Create(admin, req)
+			Expect(err).To(MatchError(service.ErrServiceTypeSunset))
+		})
+
+		It("should reject a change of version", func() {
+			_, err := svc.CatalogItem().Create(admin, newRequest("small-vm"))
+			Expect(err).ToNot(HaveOccurred())
+
+			version := "v1beta1"
+			_, err = svc.CatalogItem().Update(admin, "small-vm", &service.UpdateCatalogItemRequest{ServiceTypeVersion: &version})
+			Expect(err).To(MatchError(service.ErrInvalidCatalogItem))
+		})
+	})
+
+	Describe("Form", func() {
+		It("should describe the editable fields with their merged constraints", func() {
+			memory := "Memory"
+			largeVM := "spec.vcpu.count > 4"
+			req := newRequest("small-vm")
+			req.Fields = []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Editable: &editable, Default: 2, ValidationSchema: &map[string]any{"minimum": 1, "maximum": 8}},
+				{Path: "spec.memory.size", DisplayName: &memory, Editable: &editable, VisibleWhen: &largeVM, ValidationSchema: &map[string]any{"enum": []any{"2GB", "4GB"}}},
+				{Path: "spec.access.ssh_public_key", Default: "ssh-ed25519 AAAA"},
+			}
+			_, err := svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			form, err := svc.CatalogItem().Form(teamA, "small-vm")
+			Expect(err).ToNot(HaveOccurred())
+			properties := form.Schema["properties"].(map[string]any)
+			Expect(properties).To(HaveLen(2))
+			Expect(properties["spec.vcpu.count"]).To(SatisfyAll(
+				HaveKeyWithValue("type", "integer"),
+				HaveKeyWithValue("title", "Vcpu Count"),
+				HaveKeyWithValue("default", BeNumerically("==", 2)),
+				HaveKeyWithValue("maximum", BeNumerically("==", 8)),
+			))
+			Expect(properties["spec.memory.size"]).To(SatisfyAll(
+				HaveKeyWithValue("type", "string"),
+				HaveKeyWithValue("title", "Memory"),
+				HaveKeyWithValue("x-visible-when", largeVM),
+			))
+
+			Expect(form.UiSchema["ui:order"]).To(Equal([]string{"spec.vcpu.count", "spec.memory.size"}))
+			Expect(form.UiSchema["spec.vcpu.count"]).To(HaveKeyWithValue("ui:widget", "updown"))
+			Expect(form.UiSchema["spec.memory.size"]).To(HaveKeyWithValue("ui:widget", "select"))
+		})
+
+		It("should use JSON Schema keywords and list the required fields", func() {
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1alpha1",
+				ServiceType: "database",
+				Spec: map[string]any{
+					"type":     "object",
+					"required": []any{"size", "tier", "engine"},
+					"properties": map[string]any{
+						"size":   map[string]any{"type": "integer", "minimum": 0, "exclusiveMinimum": true},
+						"tier":   map[string]any{"type": "string", "enum": []any{"gold", "silver"}, "nullable": true},
+						"engine": map[string]any{"type": "string"},
+						"note":   map[string]any{"type": "string"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			id := "db"
+			replicated := "spec.size > 10"
+			_, err = svc.CatalogItem().Create(admin, &service.CreateCatalogItemRequest{
+				ID:          &id,
+				ApiVersion:  "v1alpha1",
+				DisplayName: "Database",
+				ServiceType: "database",
+				Fields: []v1alpha1.FieldConfiguration{
+					{Path: "spec.size", Editable: &editable},
+					{Path: "spec.tier", Editable: &editable},
+					{Path: "spec.engine", Editable: &editable, Default: "postgres"},
+					{Path: "spec.note", Editable: &editable, RequiredWhen: &replicated},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			form, err := svc.CatalogItem().Form(teamA, "db")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(form.Schema["required"]).To(Equal([]string{"spec.size", "spec.tier"}))
+			properties := form.Schema["properties"].(map[string]any)
+			Expect(properties["spec.size"]).To(SatisfyAll(
+				HaveKeyWithValue("exclusiveMinimum", BeNumerically("==", 0)),
+				Not(HaveKey("minimum")),
+			))
+			Expect(properties["spec.tier"]).To(SatisfyAll(
+				HaveKeyWithValue("type", []any{"string", "null"}),
+				HaveKeyWithValue("enum", []any{"gold", "silver", nil}),
+				Not(HaveKey("nullable")),
+			))
+			Expect(form.UiSchema["spec.tier"]).To(HaveKeyWithValue("ui:widget", "select"))
+		})
+
+		It("should map unknown catalog items", func() {
+			_, err := svc.CatalogItem().Form(teamA, "missing")
+			Expect(err).To(MatchError(service.ErrCatalogItemNotFound))
+		})
+	})
+
+	Describe("Convert", func() {
+		It("should map the field paths and list the dropped fields", func() {
+			cores := "cpu.cores"
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1beta1",
+				ServiceType: "vm",
+				Spec:        map[string]any{"cpu": map[string]any{"cores": 1}},
+				Conversions: []v1alpha1.ServiceTypeConversion{{
+					FromVersion:   "v1alpha1",
+					FieldMappings: &[]v1alpha1.FieldMapping{{From: "vcpu.count", To: &cores}, {From: "access"}},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			req := newRequest("small-vm")
+			req.Fields = append(req.Fields, v1alpha1.FieldConfiguration{Path: "spec.access.ssh_public_key", Editable: &editable})
+			_, err = svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := svc.CatalogItem().Convert(teamA, "small-vm", "v1beta1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Spec.ServiceTypeVersion).To(Equal("v1beta1"))
+			Expect(*result.Spec.Fields).To(HaveLen(1))
+			Expect((*result.Spec.Fields)[0].Path).To(Equal("spec.cpu.cores"))
+			Expect(result.LossyFields).To(ConsistOf("spec.access.ssh_public_key"))
+
+			// The catalog item is left unchanged
+			item, err := svc.CatalogItem().Get(teamA, "small-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*item.Spec.ServiceTypeVersion).To(Equal("v1alpha1"))
+			Expect(*item.Spec.Fields).To(HaveLen(2))
+		})
+
+		It("should check the converted fields against the target version", func() {
+			cores := "cpu.cores"
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1beta1",
+				ServiceType: "vm",
+				Spec: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"cpu": map[string]any{
+							"type":       "object",
+							"properties": map[string]any{"cores": map[string]any{"type": "integer", "maximum": 1}},
+						},
+					},
+				},
+				Conversions: []v1alpha1.ServiceTypeConversion{{
+					FromVersion:   "v1alpha1",
+					FieldMappings: &[]v1alpha1.FieldMapping{{From: "vcpu.count", To: &cores}},
+				}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			req := newRequest("small-vm")
+			req.Fields = append(req.Fields, v1alpha1.FieldConfiguration{Path: "spec.memory.size", Editable: &editable})
+			_, err = svc.CatalogItem().Create(admin, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := svc.CatalogItem().Convert(teamA, "small-vm", "v1beta1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Spec.Fields).To(HaveLen(1))
+			Expect(result.LossyFields).To(ConsistOf("spec.memory.size"))
+			Expect(result.Violations).To(ConsistOf(And(
+				ContainSubstring("spec.cpu.cores"),
+				ContainSubstring("default does not match the service type schema"),
+			)))
+		})
+
+		It("should reject conversions to the same version", func() {
+			_, err := svc.CatalogItem().Create(admin, newRequest("small-vm"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.CatalogItem().Convert(teamA, "small-vm", "v1alpha1")
+			Expect(err).To(MatchError(service.ErrInvalidConversion))
+		})
+
+		It("should reject invalid conversions on new versions", func() {
+			_, err := svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1beta1",
+				ServiceType: "vm",
+				Spec:        map[string]any{"cpu": map[string]any{"cores": 1}},
+				Conversions: []v1alpha1.ServiceTypeConversion{{FromVersion: "v1alpha1"}, {FromVersion: "v1alpha1"}},
+			})
+			Expect(err).To(MatchError(service.ErrInvalidServiceTypeVersion))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/conversion"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// validateConversions checks the conversions declared by a new version of a service type
+func validateConversions(apiVersion string, conversions []v1alpha1.ServiceTypeConversion) error {
+	seen := make(map[string]bool, len(conversions))
+	for _, c := range conversions {
+		if c.FromVersion == apiVersion {
+			return fmt.Errorf("%w: conversion from %s to itself", ErrInvalidServiceTypeVersion, apiVersion)
+		}
+		if seen[c.FromVersion] {
+			return fmt.Errorf("%w: duplicate conversion from %s", ErrInvalidServiceTypeVersion, c.FromVersion)
+		}
+		seen[c.FromVersion] = true
+		if c.FieldMappings == nil {
+			continue
+		}
+		from := make(map[string]bool, len(*c.FieldMappings))
+		for _, m := range *c.FieldMappings {
+			if m.From == "" || (m.To != nil && *m.To == "") {
+				return fmt.Errorf("%w: field mappings of the conversion from %s need non-empty paths", ErrInvalidServiceTypeVersion, c.FromVersion)
+			}
+			if from[m.From] {
+				return fmt.Errorf("%w: field %q is mapped twice in the conversion from %s", ErrInvalidServiceTypeVersion, m.From, c.FromVersion)
+			}
+			from[m.From] = true
+		}
+	}
+	return nil
+}
+
+// serviceTypeConversion returns the field mappings converting specs of one
+// version of a service type to another, as declared by the target version,
+// along with the target version and its schema
+func serviceTypeConversion(ctx context.Context, st store.Store, bases *schema.ServiceTypeCache, serviceType, fromVersion, toVersion string) (conversion.Mappings, *model.ServiceType, *openapi3.Schema, error) {
+	if toVersion == "" {
+		return nil, nil, nil, fmt.Errorf("%w: service_type_version is required", ErrInvalidConversion)
+	}
+	if toVersion == fromVersion {
+		return nil, nil, nil, fmt.Errorf("%w: already uses %s %s", ErrInvalidConversion, serviceType, toVersion)
+	}
+
+	target, err := st.ServiceType().Resolve(ctx, serviceType, toVersion)
+	if err != nil {
+		if errors.Is(err, store.ErrServiceTypeNotFound) {
+			return nil, nil, nil, fmt.Errorf("%w: service type %s %s does not exist", ErrInvalidConversion, serviceType, toVersion)
+		}
+		return nil, nil, nil, err
+	}
+	base, err := serviceTypeSchema(bases, target)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %w", ErrConversionUnavailable, err)
+	}
+
+	for _, c := range target.Conversions {
+		if c.FromVersion != fromVersion {
+			continue
+		}
+		mappings := make(conversion.Mappings, len(c.FieldMappings))
+		for i, m := range c.FieldMappings {
+			mappings[i] = conversion.Mapping{From: m.From, To: m.To}
+		}
+		return mappings, target, base, nil
+	}
+	return nil, nil, nil, fmt.Errorf("%w: %s %s declares no conversion from %s", ErrConversionUnavailable, serviceType, toVersion, fromVersion)
+}
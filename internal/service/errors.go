@@ -10,9 +10,150 @@ var (
 	// ErrServiceTypeIDTaken indicates a service type with the given ID already exists
 	ErrServiceTypeIDTaken = errors.New("service type ID already exists")
 
-	// ErrServiceTypeNameTaken indicates a service type with the given service_type value already exists
-	ErrServiceTypeNameTaken = errors.New("service type name already taken")
+	// ErrServiceTypeNameTaken indicates a version of the service type with the given api_version already exists
+	ErrServiceTypeNameTaken = errors.New("service type version already exists")
+
+	// ErrInvalidServiceTypeVersion indicates the spec or another version-specific field of a new service type failed validation
+	ErrInvalidServiceTypeVersion = errors.New("invalid service type version")
+
+	// ErrInvalidServiceTypeUpdate indicates the service type update request failed validation
+	ErrInvalidServiceTypeUpdate = errors.New("invalid service type update")
+
+	// ErrServiceTypeSunset indicates the service type version is past its sunset time and cannot be used anymore
+	ErrServiceTypeSunset = errors.New("service type version has been sunset")
 
 	// ErrServiceTypeNotFound indicates the requested service type does not exist
 	ErrServiceTypeNotFound = errors.New("service type not found")
 )
+
+// Domain errors for catalog items
+var (
+	// ErrInvalidCatalogItem indicates the catalog item request failed validation
+	ErrInvalidCatalogItem = errors.New("invalid catalog item")
+
+	// ErrCatalogItemIDTaken indicates the given catalog item ID is taken,
+	// possibly by a catalog item the caller cannot see
+	ErrCatalogItemIDTaken = errors.New("catalog item ID is not available")
+
+	// ErrCatalogItemNotFound indicates the requested catalog item does not exist
+	ErrCatalogItemNotFound = errors.New("catalog item not found")
+
+	// ErrCatalogItemHasInstances indicates the catalog item cannot be deleted while instances reference it
+	ErrCatalogItemHasInstances = errors.New("cannot delete catalog item with existing instances")
+
+	// ErrCatalogItemRevisionNotFound indicates the requested revision of a catalog item does not exist
+	ErrCatalogItemRevisionNotFound = errors.New("catalog item revision not found")
+)
+
+// Domain errors for conversions
+var (
+	// ErrInvalidConversion indicates the conversion request names no other existing version of the service type
+	ErrInvalidConversion = errors.New("invalid conversion")
+
+	// ErrConversionUnavailable indicates the target version declares no conversion from the source version
+	ErrConversionUnavailable = errors.New("conversion unavailable")
+)
+
+// Domain errors for catalog item instances
+var (
+	// ErrInvalidCatalogItemInstance indicates the catalog item instance request failed validation
+	ErrInvalidCatalogItemInstance = errors.New("invalid catalog item instance")
+
+	// ErrCatalogItemInstanceIDTaken indicates the given catalog item instance ID
+	// is taken, possibly by another tenant's instance
+	ErrCatalogItemInstanceIDTaken = errors.New("catalog item instance ID is not available")
+
+	// ErrCatalogItemInstanceNotFound indicates the requested catalog item instance does not exist
+	ErrCatalogItemInstanceNotFound = errors.New("catalog item instance not found")
+)
+
+// Domain errors for tenancy
+var (
+	// ErrInvalidParent indicates the parent is not in the format tenants/{tenant_id}
+	ErrInvalidParent = errors.New("invalid parent: must be in the format tenants/{tenant_id}")
+
+	// ErrTenantMismatch indicates the parent names a tenant other than the caller's
+	ErrTenantMismatch = errors.New("parent does not match the caller's tenant")
+
+	// ErrTenantRequired indicates the operation needs a tenant-scoped context
+	ErrTenantRequired = errors.New("operation requires a tenant")
+
+	// ErrAdminRequired indicates the operation changes resources shared by every
+	// tenant, which only administrators may do
+	ErrAdminRequired = errors.New("operation requires an administrator")
+)
+
+// Domain errors for quotas
+var (
+	// ErrInvalidQuota indicates the quota request failed validation
+	ErrInvalidQuota = errors.New("invalid quota")
+
+	// ErrQuotaIDTaken indicates a quota with the given ID already exists
+	ErrQuotaIDTaken = errors.New("quota ID already exists")
+
+	// ErrQuotaNotFound indicates the requested quota does not exist
+	ErrQuotaNotFound = errors.New("quota not found")
+
+	// ErrQuotaExceeded indicates creating the instance would exceed one of the tenant's quotas
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// Domain errors for operations
+var (
+	// ErrOperationNotFound indicates the requested operation does not exist
+	ErrOperationNotFound = errors.New("operation not found")
+
+	// ErrOperationNotCancellable indicates the operation cannot be cancelled
+	ErrOperationNotCancellable = errors.New("operation cannot be cancelled")
+
+	// ErrInvalidTimeout indicates the wait timeout is not a valid duration
+	ErrInvalidTimeout = errors.New("invalid timeout: must be a duration such as 30s")
+)
+
+// Domain errors for webhook subscriptions
+var (
+	// ErrInvalidWebhookSubscription indicates the webhook subscription request failed validation
+	ErrInvalidWebhookSubscription = errors.New("invalid webhook subscription")
+
+	// ErrWebhookSubscriptionIDTaken indicates a webhook subscription with the given ID already exists
+	ErrWebhookSubscriptionIDTaken = errors.New("webhook subscription ID already exists")
+
+	// ErrWebhookSubscriptionNotFound indicates the requested webhook subscription does not exist
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+)
+
+// Domain errors for watches
+var (
+	// ErrInvalidResumeToken indicates the resume token of a watch could not be parsed
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+
+	// ErrResumeTokenExpired indicates the events following the resume token are no longer available
+	ErrResumeTokenExpired = errors.New("resume token expired: list the resources again and watch without a resume token")
+
+	// ErrWatchUnavailable indicates the server does not serve watches
+	ErrWatchUnavailable = errors.New("watch is unavailable")
+)
+
+// Domain errors for audit events
+var (
+	// ErrInvalidAuditEventFilter indicates the filters of an audit event list are invalid
+	ErrInvalidAuditEventFilter = errors.New("invalid audit event filter")
+)
+
+// Domain errors for idempotency keys
+var (
+	// ErrInvalidRequestID indicates the request_id and the Idempotency-Key header of a request differ
+	ErrInvalidRequestID = errors.New("invalid request ID")
+
+	// ErrIdempotencyKeyReused indicates the idempotency key of a request was used by a different request
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused")
+
+	// ErrIdempotencyKeyInProgress indicates the request of the idempotency key is still in progress
+	ErrIdempotencyKeyInProgress = errors.New("a request with the same idempotency key is in progress")
+)
+
+// Domain errors for batch methods
+var (
+	// ErrInvalidBatchRequest indicates a batch request has no items or too many
+	ErrInvalidBatchRequest = errors.New("invalid batch request")
+)
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/expression"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// validateFieldsAgainstServiceType checks field configurations against the
+// schema of the service type version they configure. Every problem is
+// reported at once, so that admins can fix a catalog item in one go.
+func validateFieldsAgainstServiceType(serviceType *model.ServiceType, fields []v1alpha1.FieldConfiguration) error {
+	base, err := schema.ForServiceType(serviceType.Spec)
+	if err != nil {
+		return fmt.Errorf("%w: service type %s %s: %w", ErrInvalidCatalogItem, serviceType.ServiceType, serviceType.ApiVersion, err)
+	}
+	if problems := fieldProblems(serviceType, base, fields); len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidCatalogItem, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// fieldProblems lists the problems of field configurations against base, the
+// schema of the service type version they configure
+func fieldProblems(serviceType *model.ServiceType, base *openapi3.Schema, fields []v1alpha1.FieldConfiguration) []string {
+	var problems []string
+	seen := make(map[string]bool, len(fields))
+	for i, f := range fields {
+		field := fmt.Sprintf("spec.fields[%d] (%s)", i, f.Path)
+		if seen[f.Path] {
+			problems = append(problems, field+": duplicate path")
+			continue
+		}
+		seen[f.Path] = true
+
+		if f.DefaultExpression != nil {
+			if f.Default != nil {
+				problems = append(problems, field+": default and default_expression are mutually exclusive")
+			}
+			if _, err := expression.CompileValue(*f.DefaultExpression); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: default_expression: %v", field, err))
+			}
+		}
+		if f.VisibleWhen != nil {
+			if _, err := expression.CompileCondition(*f.VisibleWhen); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: visible_when: %v", field, err))
+			}
+		}
+		if f.RequiredWhen != nil {
+			if _, err := expression.CompileCondition(*f.RequiredWhen); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: required_when: %v", field, err))
+			}
+		}
+
+		fieldSchema, ok := schema.Lookup(base, specPath(f.Path))
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: not a field of service type %s %s", field, serviceType.ServiceType, serviceType.ApiVersion))
+			continue
+		}
+
+		var (
+			narrowed *openapi3.Schema
+			err      error
+		)
+		if f.ValidationSchema != nil {
+			narrowed, err = schema.Parse(*f.ValidationSchema)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s: validation_schema: %v", field, err))
+			}
+			for _, loosened := range schema.Loosenings(fieldSchema, narrowed) {
+				problems = append(problems, fmt.Sprintf("%s: validation_schema loosens the service type schema: %s", field, loosened))
+			}
+		}
+
+		if f.Default == nil {
+			continue
+		}
+		if err := schema.Validate(fieldSchema, f.Default); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: default does not match the service type schema: %v", field, err))
+		}
+		if err := schema.Validate(narrowed, f.Default); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: default does not match validation_schema: %v", field, err))
+		}
+	}
+	return problems
+}
+
+// serviceTypeSchema returns the schema of the payloads of a service type
+// version, parsing or inferring it on first use after each update
+func serviceTypeSchema(cache *schema.ServiceTypeCache, serviceType *model.ServiceType) (*openapi3.Schema, error) {
+	return cache.Get(serviceType.ID, serviceType.UpdateTime, func() (*openapi3.Schema, error) {
+		base, err := schema.ForServiceType(serviceType.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("service type %s %s: %w", serviceType.ServiceType, serviceType.ApiVersion, err)
+		}
+		return base, nil
+	})
+}
+
+// validationSchemas returns the compiled validation schemas of the fields of a
+// catalog item, compiling them on first use after each update
+func validationSchemas(cache *schema.Cache, catalogItem *model.CatalogItem) (schema.Fields, error) {
+	return cache.Get(catalogItem.ID, catalogItem.UpdateTime, func() (schema.Fields, error) {
+		fields := schema.Fields{}
+		for _, f := range catalogItem.Spec.Fields {
+			if f.ValidationSchema == nil {
+				continue
+			}
+			s, err := schema.Parse(f.ValidationSchema)
+			if err != nil {
+				return nil, fmt.Errorf("%w: catalog item %q: field %q: %w", ErrInvalidCatalogItemInstance, catalogItem.ID, f.Path, err)
+			}
+			fields[f.Path] = s
+		}
+		return fields, nil
+	})
+}
+
+// fieldPrograms returns the compiled expressions of the fields of a catalog
+// item, compiling them on first use after each update
+func fieldPrograms(cache *expression.Cache, catalogItem *model.CatalogItem) (expression.Programs, error) {
+	return cache.Get(catalogItem.ID, catalogItem.UpdateTime, func() (expression.Programs, error) {
+		programs := expression.Programs{}
+		for _, f := range catalogItem.Spec.Fields {
+			var (
+				compiled expression.FieldPrograms
+				err      error
+			)
+			if f.DefaultExpression != "" {
+				if compiled.DefaultExpression, err = expression.CompileValue(f.DefaultExpression); err != nil {
+					return nil, fmt.Errorf("%w: field %q: default_expression: %w", ErrInvalidCatalogItemInstance, f.Path, err)
+				}
+			}
+			if f.VisibleWhen != "" {
+				if compiled.VisibleWhen, err = expression.CompileCondition(f.VisibleWhen); err != nil {
+					return nil, fmt.Errorf("%w: field %q: visible_when: %w", ErrInvalidCatalogItemInstance, f.Path, err)
+				}
+			}
+			if f.RequiredWhen != "" {
+				if compiled.RequiredWhen, err = expression.CompileCondition(f.RequiredWhen); err != nil {
+					return nil, fmt.Errorf("%w: field %q: required_when: %w", ErrInvalidCatalogItemInstance, f.Path, err)
+				}
+			}
+			programs[f.Path] = compiled
+		}
+		return programs, nil
+	})
+}
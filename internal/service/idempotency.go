@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+// defaultIdempotencyTTL is how long the idempotency keys of create requests are kept by default
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// defaultIdempotencyPendingTimeout is how long a key stays reserved by default
+// by a request that created nothing, e.g. because the server stopped while
+// serving it
+const defaultIdempotencyPendingTimeout = time.Minute
+
+// idempotencyKeys makes create requests with an idempotency key (AEP-155)
+// return the response of their first attempt when they are retried
+type idempotencyKeys struct {
+	store          store.Store
+	ttl            time.Duration
+	pendingTimeout time.Duration
+}
+
+// createIdempotent calls create, unless a request was made with the same key
+// before, in which case it returns the response of that request. It fails
+// when the key was used by a different request, or while its request is in
+// progress. Requests without a key are always created.
+//
+// The resources created are recorded on the key by the create transaction
+// itself, see store.WithIdempotencyKey. When the response of a request that
+// created them was not recorded, e.g. because the server stopped right after
+// the commit, lookup returns it from their paths.
+func createIdempotent[T any](ctx context.Context, keys *idempotencyKeys, operation, key string, req any,
+	create func(ctx context.Context) (*T, error), lookup func(ctx context.Context, resources []string) (*T, error)) (*T, error) {
+	if key == "" {
+		return create(ctx)
+	}
+	hash, err := requestHash(operation, req)
+	if err != nil {
+		return nil, err
+	}
+	tenant, _ := tenancy.FromContext(ctx)
+
+	reserved := model.IdempotencyKey{
+		Tenant:      tenant,
+		Key:         key,
+		Operation:   operation,
+		RequestHash: hash,
+		ExpireTime:  time.Now().Add(keys.ttl),
+	}
+	recorded, err := keys.store.IdempotencyKey().Reserve(ctx, reserved, keys.pendingTimeout)
+	if errors.Is(err, store.ErrIdempotencyKeyExists) {
+		return replay(ctx, recorded, hash, lookup)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := create(store.WithIdempotencyKey(ctx, reserved))
+	if errors.Is(err, store.ErrIdempotencyKeyExists) {
+		// Another attempt created the resources after the reservation of this
+		// one was deleted as pending for too long
+		recorded, err := keys.store.IdempotencyKey().Get(ctx, tenant, key)
+		if err != nil {
+			return nil, err
+		}
+		return replay(ctx, recorded, hash, lookup)
+	}
+	if err != nil {
+		// Failed requests can be retried with the same key
+		if releaseErr := keys.store.IdempotencyKey().Release(ctx, tenant, key); releaseErr != nil {
+			log.Printf("Failed to release idempotency key %q: %v", key, releaseErr)
+		}
+		return nil, err
+	}
+	response, err := toResponseMap(result)
+	if err != nil {
+		return nil, err
+	}
+	if err := keys.store.IdempotencyKey().Complete(ctx, tenant, key, response); err != nil {
+		// Retries look up the resources recorded on the key instead
+		log.Printf("Failed to record the response of idempotency key %q: %v", key, err)
+	}
+	return result, nil
+}
+
+// replay returns the response of a request retried with the same key
+func replay[T any](ctx context.Context, recorded *model.IdempotencyKey, hash string, lookup func(ctx context.Context, resources []string) (*T, error)) (*T, error) {
+	if recorded.RequestHash != hash {
+		return nil, fmt.Errorf("%w: it was used by a different %s request", ErrIdempotencyKeyReused, recorded.Operation)
+	}
+	if recorded.Response == nil {
+		if recorded.Resources == nil {
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		return lookup(ctx, recorded.Resources)
+	}
+	data, err := json.Marshal(recorded.Response)
+	if err != nil {
+		return nil, err
+	}
+	var result T
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// lookupResource returns a lookup of the single resource created by a request,
+// getting it by ID with get
+func lookupResource[T any](get func(ctx context.Context, id string) (*T, error)) func(context.Context, []string) (*T, error) {
+	return func(ctx context.Context, resources []string) (*T, error) {
+		if len(resources) != 1 {
+			return nil, fmt.Errorf("expected a single resource, got %d", len(resources))
+		}
+		return get(ctx, path.Base(resources[0]))
+	}
+}
+
+// requestHash identifies the parameters of a request, so that a key reused by
+// a different request can be told from a retry
+func requestHash(operation string, req any) (string, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(operation+"\n"), data...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// toResponseMap converts a response to the generic JSON representation it is recorded as
+func toResponseMap(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
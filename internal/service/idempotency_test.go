@@ -0,0 +1,222 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("Idempotent creates", func() {
+	var (
+		teamA context.Context
+		teamB context.Context
+		db    *gorm.DB
+		str   store.Store
+		svc   service.Service
+	)
+
+	newRequest := func(key string) *service.CreateServiceTypeRequest {
+		return &service.CreateServiceTypeRequest{
+			RequestID:   key,
+			ApiVersion:  "v1alpha1",
+			ServiceType: "vm",
+			Spec:        map[string]any{"vcpu": map[string]any{"count": 2}},
+		}
+	}
+
+	BeforeEach(func() {
+		teamA = tenancy.NewContext(context.Background(), "team-a")
+		teamB = tenancy.NewContext(context.Background(), "team-b")
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.OutboxEvent{}, &model.IdempotencyKey{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+		svc = service.NewService(str)
+	})
+
+	AfterEach(func() {
+		if str != nil {
+			Expect(str.Close()).To(Succeed())
+		}
+	})
+
+	It("should return the first response when a request is retried with the same key", func() {
+		first, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		retried, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retried.Uid).To(Equal(first.Uid))
+		Expect(retried.Path).To(Equal(first.Path))
+
+		var count int64
+		Expect(db.Model(&model.ServiceType{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(Equal(int64(1)))
+	})
+
+	It("should record the created resource with the key", func() {
+		created, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var recorded model.IdempotencyKey
+		Expect(db.First(&recorded, "tenant = ? AND idempotency_key = ?", "team-a", "key-1").Error).To(Succeed())
+		Expect(recorded.Operation).To(Equal("CreateServiceType"))
+		Expect(recorded.Resources).To(Equal([]string{*created.Path}))
+		Expect(recorded.Response).ToNot(BeNil())
+	})
+
+	It("should reject a key reused by a different request", func() {
+		_, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		req := newRequest("key-1")
+		req.Spec = map[string]any{"vcpu": map[string]any{"count": 4}}
+		_, err = svc.ServiceType().Create(teamA, req)
+		Expect(err).To(MatchError(service.ErrIdempotencyKeyReused))
+	})
+
+	It("should reject a retry while the first request is in progress", func() {
+		_, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+		// Forget the resources and response, as if the first request had not created them yet
+		Expect(db.Model(&model.IdempotencyKey{}).Where("idempotency_key = ?", "key-1").
+			Updates(map[string]any{"resources": nil, "response": nil}).Error).To(Succeed())
+
+		_, err = svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).To(MatchError(service.ErrIdempotencyKeyInProgress))
+	})
+
+	It("should look up the created resource when the response was not recorded", func() {
+		first, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+		// Forget the response, as if the server had stopped right after the create committed
+		Expect(db.Model(&model.IdempotencyKey{}).Where("idempotency_key = ?", "key-1").
+			Update("response", nil).Error).To(Succeed())
+
+		retried, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retried.Uid).To(Equal(first.Uid))
+
+		var count int64
+		Expect(db.Model(&model.ServiceType{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(Equal(int64(1)))
+	})
+
+	Context("with a pending timeout", func() {
+		BeforeEach(func() {
+			svc = service.NewService(str, service.WithIdempotencyPendingTimeout(time.Millisecond))
+		})
+
+		It("should look up the resource of a stale key instead of creating it again", func() {
+			first, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.Model(&model.IdempotencyKey{}).Where("idempotency_key = ?", "key-1").
+				Update("response", nil).Error).To(Succeed())
+			time.Sleep(5 * time.Millisecond)
+
+			retried, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retried.Uid).To(Equal(first.Uid))
+		})
+
+		It("should create again when a stale key created nothing", func() {
+			Expect(db.Create(&model.IdempotencyKey{
+				Tenant:      "team-a",
+				Key:         "key-1",
+				Operation:   "CreateServiceType",
+				RequestHash: "hash",
+				ExpireTime:  time.Now().Add(time.Hour),
+			}).Error).To(Succeed())
+			time.Sleep(5 * time.Millisecond)
+
+			_, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	It("should not create the resources of a key twice", func() {
+		first, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// A slow attempt whose reservation was deleted, committing after the retry
+		ctx := store.WithIdempotencyKey(teamA, model.IdempotencyKey{Tenant: "team-a", Key: "key-1", RequestHash: "hash"})
+		_, err = str.ServiceType().Create(ctx, model.ServiceType{
+			ID:          "container-v1alpha1",
+			ApiVersion:  "v1alpha1",
+			ServiceType: "container",
+			Path:        "service-types/container-v1alpha1",
+		})
+		Expect(err).To(MatchError(store.ErrIdempotencyKeyExists))
+
+		var count int64
+		Expect(db.Model(&model.ServiceType{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(Equal(int64(1)))
+		var recorded model.IdempotencyKey
+		Expect(db.First(&recorded, "idempotency_key = ?", "key-1").Error).To(Succeed())
+		Expect(recorded.Resources).To(Equal([]string{*first.Path}))
+	})
+
+	It("should record the key again when its reservation was deleted during the create", func() {
+		ctx := store.WithIdempotencyKey(teamA, model.IdempotencyKey{
+			Tenant:      "team-a",
+			Key:         "key-1",
+			Operation:   "CreateServiceType",
+			RequestHash: "hash",
+			ExpireTime:  time.Now().Add(time.Hour),
+		})
+		created, err := str.ServiceType().Create(ctx, model.ServiceType{
+			ID:          "vm-v1alpha1",
+			ApiVersion:  "v1alpha1",
+			ServiceType: "vm",
+			Path:        "service-types/vm-v1alpha1",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		var recorded model.IdempotencyKey
+		Expect(db.First(&recorded, "idempotency_key = ?", "key-1").Error).To(Succeed())
+		Expect(recorded.Resources).To(Equal([]string{created.Path}))
+	})
+
+	It("should release the key of a failed request", func() {
+		req := newRequest("key-1")
+		req.ServiceType = ""
+		_, err := svc.ServiceType().Create(teamA, req)
+		Expect(err).To(MatchError(service.ErrInvalidServiceType))
+
+		var count int64
+		Expect(db.Model(&model.IdempotencyKey{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(BeZero())
+	})
+
+	It("should scope keys to the tenant", func() {
+		_, err := svc.ServiceType().Create(teamA, newRequest("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		req := newRequest("key-1")
+		req.ServiceType = "container"
+		_, err = svc.ServiceType().Create(teamB, req)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should create every request without a key", func() {
+		_, err := svc.ServiceType().Create(teamA, newRequest(""))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = svc.ServiceType().Create(teamA, newRequest(""))
+		Expect(err).To(MatchError(service.ErrServiceTypeNameTaken))
+	})
+})
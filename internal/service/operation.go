@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+const (
+	// defaultWaitTimeout is how long Wait waits when no timeout is given
+	defaultWaitTimeout = 30 * time.Second
+	// maxWaitTimeout caps the timeout of Wait
+	maxWaitTimeout = 60 * time.Second
+	// waitPollInterval is how often Wait checks whether the operation is done
+	waitPollInterval = 200 * time.Millisecond
+)
+
+// OperationListOptions contains options for listing operations
+type OperationListOptions struct {
+	PageToken   *string
+	MaxPageSize *int32
+	Parent      *string
+}
+
+// OperationListResult contains the result of a List operation
+type OperationListResult struct {
+	Operations    []v1alpha1.Operation
+	NextPageToken *string
+}
+
+// OperationService defines the business logic for long-running operations
+type OperationService interface {
+	List(ctx context.Context, opts *OperationListOptions) (*OperationListResult, error)
+	Get(ctx context.Context, id string) (*v1alpha1.Operation, error)
+	// Cancel cancels a pending instance creation and deletes the instance
+	Cancel(ctx context.Context, id string) (*v1alpha1.Operation, error)
+	// Wait returns the operation once it is done or the timeout elapsed
+	Wait(ctx context.Context, id string, timeout *string) (*v1alpha1.Operation, error)
+}
+
+type operationService struct {
+	store      store.Store
+	reconciler InstanceReconciler // nil when the reconciler only scans
+}
+
+// newOperationService creates a new OperationService instance
+func newOperationService(store store.Store, reconciler InstanceReconciler) OperationService {
+	return &operationService{store: store, reconciler: reconciler}
+}
+
+// List returns a paginated list of the caller's operations
+func (s *operationService) List(ctx context.Context, opts *OperationListOptions) (*OperationListResult, error) {
+	storeOpts := &store.OperationListOptions{PageSize: 100}
+	if opts != nil {
+		tenant, err := resolveParent(ctx, opts.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if tenant != "" {
+			storeOpts.Tenant = &tenant
+		}
+		storeOpts.PageToken = opts.PageToken
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.Operation().List(ctx, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiOperations := make([]v1alpha1.Operation, len(storeResult.Operations))
+	for i := range storeResult.Operations {
+		apiOperation, err := s.toAPIType(ctx, &storeResult.Operations[i])
+		if err != nil {
+			return nil, err
+		}
+		apiOperations[i] = *apiOperation
+	}
+
+	return &OperationListResult{
+		Operations:    apiOperations,
+		NextPageToken: storeResult.NextPageToken,
+	}, nil
+}
+
+// Get retrieves one of the caller's operations by ID
+func (s *operationService) Get(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	storeModel, err := s.store.Operation().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	return s.toAPIType(ctx, storeModel)
+}
+
+// Cancel cancels a pending instance creation. The operation completes with a
+// CANCELLED error and the instance is deleted by the reconciler.
+func (s *operationService) Cancel(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	storeModel, err := s.store.Operation().Cancel(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+	if storeModel.CancelRequested && s.reconciler != nil {
+		s.reconciler.Enqueue(storeModel.TargetID)
+	}
+	return s.toAPIType(ctx, storeModel)
+}
+
+// Wait polls an operation until it is done or the timeout elapsed, and returns
+// its latest state
+func (s *operationService) Wait(ctx context.Context, id string, timeout *string) (*v1alpha1.Operation, error) {
+	wait := defaultWaitTimeout
+	if timeout != nil && *timeout != "" {
+		parsed, err := time.ParseDuration(*timeout)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTimeout, *timeout)
+		}
+		wait = min(parsed, maxWaitTimeout)
+	}
+	deadline := time.Now().Add(wait)
+
+	for {
+		storeModel, err := s.store.Operation().Get(ctx, id)
+		if err != nil {
+			return nil, mapStoreError(err)
+		}
+		remaining := time.Until(deadline)
+		if storeModel.Done || remaining <= 0 {
+			return s.toAPIType(ctx, storeModel)
+		}
+
+		timer := time.NewTimer(min(waitPollInterval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// toAPIType converts an operation to an API type, resolving the current state
+// of its target and, for successful creations, the created instance
+func (s *operationService) toAPIType(ctx context.Context, m *model.Operation) (*v1alpha1.Operation, error) {
+	target, err := s.store.CatalogItemInstance().Get(ctx, m.TargetID)
+	if err != nil && !errors.Is(err, store.ErrCatalogItemInstanceNotFound) {
+		return nil, err
+	}
+	apiOperation := toOperationAPIType(m, target)
+	return &apiOperation, nil
+}
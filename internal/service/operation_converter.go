@@ -0,0 +1,64 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// operationPath returns the resource path of an operation
+func operationPath(tenant, id string) string {
+	return fmt.Sprintf("tenants/%s/operations/%s", tenant, id)
+}
+
+// newInstanceOperation returns a pending operation of the given type on an instance
+func newInstanceOperation(id, opType string, instance *model.CatalogItemInstance) model.Operation {
+	return model.Operation{
+		ID:         id,
+		Tenant:     instance.Tenant,
+		Type:       opType,
+		TargetID:   instance.ID,
+		TargetPath: instance.Path,
+		Path:       operationPath(instance.Tenant, id),
+	}
+}
+
+// toOperationAPIType converts a store model to an API type.
+// target is the instance the operation acts on, or nil if it no longer exists;
+// it is the response of a successful creation.
+func toOperationAPIType(m *model.Operation, target *model.CatalogItemInstance) v1alpha1.Operation {
+	apiOperation := v1alpha1.Operation{
+		Path: &m.Path,
+		Done: m.Done,
+		Metadata: v1alpha1.OperationMetadata{
+			Type:       v1alpha1.OperationMetadataType(m.Type),
+			Target:     m.TargetPath,
+			CreateTime: m.CreateTime,
+			EndTime:    m.EndTime,
+		},
+	}
+	if m.CancelRequested {
+		apiOperation.Metadata.CancelRequested = &m.CancelRequested
+	}
+	if target != nil {
+		state := target.Status.State
+		apiOperation.Metadata.State = &state
+	}
+
+	switch {
+	case m.Error != nil:
+		apiOperation.Error = &v1alpha1.Error{
+			Type:   v1alpha1.ErrorType(m.Error.Type),
+			Status: m.Error.Status,
+			Title:  m.Error.Title,
+		}
+		if m.Error.Detail != "" {
+			apiOperation.Error.Detail = &m.Error.Detail
+		}
+	case m.Done && m.Type == model.OperationTypeCreateCatalogItemInstance && target != nil:
+		response := toCatalogItemInstanceAPIType(target)
+		apiOperation.Response = &response
+	}
+	return apiOperation
+}
@@ -0,0 +1,188 @@
+package service_test
+
+import (
+	"context"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/reconciler"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("Operation Service", func() {
+	var (
+		teamA context.Context
+		teamB context.Context
+		str   store.Store
+		svc   service.Service
+		rec   *reconciler.Reconciler
+	)
+
+	stringPtr := func(s string) *string { return &s }
+
+	// createVM creates an instance and returns the ID of its operation
+	createVM := func(id string) string {
+		op, err := svc.CatalogItemInstance().Create(teamA, &service.CreateCatalogItemInstanceRequest{
+			ID:            &id,
+			ApiVersion:    "v1alpha1",
+			DisplayName:   "My VM",
+			CatalogItemId: "vm-item",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return path.Base(*op.Path)
+	}
+
+	BeforeEach(func() {
+		teamA = tenancy.NewContext(context.Background(), "team-a")
+		teamB = tenancy.NewContext(context.Background(), "team-b")
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+		svc = service.NewService(str)
+		rec = reconciler.New(str, nil, reconciler.Config{})
+
+		_, err = svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+			ApiVersion:  "v1alpha1",
+			ServiceType: "vm",
+			Spec:        map[string]any{"vcpu": map[string]any{"count": 1}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		id := "vm-item"
+		_, err = svc.CatalogItem().Create(context.Background(), &service.CreateCatalogItemRequest{
+			ID:          &id,
+			ApiVersion:  "v1alpha1",
+			DisplayName: "VM",
+			ServiceType: "vm",
+			Fields:      []v1alpha1.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if str != nil {
+			Expect(str.Close()).To(Succeed())
+		}
+	})
+
+	Describe("Get", func() {
+		It("should report the instance as the response once it is ready", func() {
+			opID := createVM("my-vm")
+
+			op, err := svc.Operation().Get(teamA, opID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeFalse())
+			Expect(*op.Metadata.State).To(Equal(model.InstanceStatePending))
+			Expect(op.Response).To(BeNil())
+
+			Expect(rec.Reconcile(context.Background(), "my-vm")).To(Succeed())
+
+			op, err = svc.Operation().Get(teamA, opID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeTrue())
+			Expect(op.Error).To(BeNil())
+			Expect(op.Metadata.EndTime).ToNot(BeNil())
+			Expect(*op.Response.Uid).To(Equal("my-vm"))
+			Expect(op.Response.Status.State).To(Equal(v1alpha1.READY))
+		})
+
+		It("should not return operations of another tenant", func() {
+			opID := createVM("my-vm")
+
+			_, err := svc.Operation().Get(teamB, opID)
+			Expect(err).To(MatchError(service.ErrOperationNotFound))
+		})
+	})
+
+	Describe("List", func() {
+		It("should list the caller's operations", func() {
+			createVM("vm-1")
+			createVM("vm-2")
+			_, err := svc.CatalogItemInstance().Delete(teamA, "vm-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := svc.Operation().List(teamA, &service.OperationListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Operations).To(HaveLen(3))
+
+			result, err = svc.Operation().List(teamB, &service.OperationListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Operations).To(BeEmpty())
+		})
+	})
+
+	Describe("Cancel", func() {
+		It("should cancel a pending creation and delete the instance", func() {
+			opID := createVM("my-vm")
+
+			op, err := svc.Operation().Cancel(teamA, opID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeTrue())
+			Expect(op.Error.Type).To(Equal(v1alpha1.CANCELLED))
+			Expect(*op.Metadata.CancelRequested).To(BeTrue())
+
+			instance, err := svc.CatalogItemInstance().Get(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.Status.State).To(Equal(v1alpha1.DELETING))
+		})
+
+		It("should leave completed operations unchanged", func() {
+			opID := createVM("my-vm")
+			Expect(rec.Reconcile(context.Background(), "my-vm")).To(Succeed())
+
+			op, err := svc.Operation().Cancel(teamA, opID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Error).To(BeNil())
+			Expect(op.Metadata.CancelRequested).To(BeNil())
+		})
+
+		It("should not cancel deletions", func() {
+			createVM("my-vm")
+			deleteOp, err := svc.CatalogItemInstance().Delete(teamA, "my-vm")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.Operation().Cancel(teamA, path.Base(*deleteOp.Path))
+			Expect(err).To(MatchError(service.ErrOperationNotCancellable))
+		})
+	})
+
+	Describe("Wait", func() {
+		It("should return once the timeout elapsed", func() {
+			opID := createVM("my-vm")
+
+			op, err := svc.Operation().Wait(teamA, opID, stringPtr("10ms"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeFalse())
+		})
+
+		It("should return a completed operation immediately", func() {
+			opID := createVM("my-vm")
+			Expect(rec.Reconcile(context.Background(), "my-vm")).To(Succeed())
+
+			op, err := svc.Operation().Wait(teamA, opID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeTrue())
+		})
+
+		It("should reject invalid timeouts", func() {
+			opID := createVM("my-vm")
+
+			_, err := svc.Operation().Wait(teamA, opID, stringPtr("soon"))
+			Expect(err).To(MatchError(service.ErrInvalidTimeout))
+		})
+	})
+})
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/quantity"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/google/uuid"
+)
+
+// CreateQuotaRequest contains the parameters for creating a quota
+type CreateQuotaRequest struct {
+	ID            *string // Optional user-specified ID
+	RequestID     string  // Optional idempotency key (AEP-155)
+	Parent        *string // Optional tenant parent (tenants/{tenant_id}); the caller's tenant when omitted. Administrators may name any tenant.
+	ServiceType   string
+	CatalogItemId string
+	MaxInstances  *int64
+	MaxVcpu       *int64
+	MaxMemory     *string
+	MaxStorage    *string
+}
+
+// QuotaListOptions contains options for listing quotas
+type QuotaListOptions struct {
+	PageToken   *string
+	MaxPageSize *int32
+	Parent      *string
+}
+
+// QuotaListResult contains the result of a List operation
+type QuotaListResult struct {
+	Quotas        []v1alpha1.Quota
+	NextPageToken *string
+}
+
+// QuotaService defines the business logic for Quota operations
+type QuotaService interface {
+	List(ctx context.Context, opts *QuotaListOptions) (*QuotaListResult, error)
+	Create(ctx context.Context, req *CreateQuotaRequest) (*v1alpha1.Quota, error)
+	Get(ctx context.Context, id string) (*v1alpha1.Quota, error)
+	Delete(ctx context.Context, id string) error
+	// Usage returns the resources consumed by the caller's tenant, in total and per quota
+	Usage(ctx context.Context) (*v1alpha1.Usage, error)
+}
+
+type quotaService struct {
+	store store.Store
+	keys  *idempotencyKeys
+}
+
+// newQuotaService creates a new QuotaService instance
+func newQuotaService(store store.Store, keys *idempotencyKeys) QuotaService {
+	return &quotaService{store: store, keys: keys}
+}
+
+// List returns a paginated list of the caller's quotas. Administrators list
+// the quotas of every tenant, or of the tenant named by the parent.
+func (s *quotaService) List(ctx context.Context, opts *QuotaListOptions) (*QuotaListResult, error) {
+	storeOpts := &store.QuotaListOptions{PageSize: 100}
+	if opts != nil {
+		tenant, err := resolveParent(ctx, opts.Parent)
+		if tenancy.IsPrivileged(ctx) {
+			tenant, err = resolveAdministeredParent(opts.Parent)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if tenant != "" {
+			storeOpts.Tenant = &tenant
+		}
+		storeOpts.PageToken = opts.PageToken
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.Quota().List(ctx, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiQuotas := make([]v1alpha1.Quota, len(storeResult.Quotas))
+	for i, storeModel := range storeResult.Quotas {
+		apiQuotas[i] = toQuotaAPIType(&storeModel)
+	}
+
+	return &QuotaListResult{
+		Quotas:        apiQuotas,
+		NextPageToken: storeResult.NextPageToken,
+	}, nil
+}
+
+// Create creates a new quota. Only administrators create quotas.
+func (s *quotaService) Create(ctx context.Context, req *CreateQuotaRequest) (*v1alpha1.Quota, error) {
+	return createIdempotent(ctx, s.keys, "CreateQuota", req.RequestID, req, func(ctx context.Context) (*v1alpha1.Quota, error) {
+		return s.create(ctx, req)
+	}, lookupResource(s.Get))
+}
+
+// create creates the quota of req
+func (s *quotaService) create(ctx context.Context, req *CreateQuotaRequest) (*v1alpha1.Quota, error) {
+	if !tenancy.IsPrivileged(ctx) {
+		return nil, fmt.Errorf("%w: only administrators create quotas", ErrAdminRequired)
+	}
+	tenant, err := resolveAdministeredParent(req.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == "" {
+		var ok bool
+		if tenant, ok = tenancy.FromContext(ctx); !ok {
+			return nil, ErrTenantRequired
+		}
+	}
+
+	id := uuid.New().String()
+	if req.ID != nil && *req.ID != "" {
+		id = *req.ID
+	}
+
+	storeModel, err := toQuotaStoreModel(id, quotaPath(tenant, id), tenant, req)
+	if err != nil {
+		return nil, err
+	}
+
+	createdModel, err := s.store.Quota().Create(ctx, storeModel)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiQuota := toQuotaAPIType(createdModel)
+	return &apiQuota, nil
+}
+
+// Get retrieves one of the caller's quotas by ID, or any quota for administrators
+func (s *quotaService) Get(ctx context.Context, id string) (*v1alpha1.Quota, error) {
+	storeModel, err := s.store.Quota().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiQuota := toQuotaAPIType(storeModel)
+	return &apiQuota, nil
+}
+
+// Delete deletes a quota by ID. Only administrators delete quotas.
+func (s *quotaService) Delete(ctx context.Context, id string) error {
+	if !tenancy.IsPrivileged(ctx) {
+		return fmt.Errorf("%w: only administrators delete quotas", ErrAdminRequired)
+	}
+	return mapStoreError(s.store.Quota().Delete(ctx, id))
+}
+
+// Usage returns the resources consumed by the caller's tenant, in total and per quota
+func (s *quotaService) Usage(ctx context.Context) (*v1alpha1.Usage, error) {
+	tenant, ok := tenancy.FromContext(ctx)
+	if !ok {
+		return nil, ErrTenantRequired
+	}
+
+	total, err := s.store.Quota().Usage(ctx, model.Quota{Tenant: tenant})
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("tenants/%s/usage", tenant)
+	usage := &v1alpha1.Usage{
+		Path:   &path,
+		Total:  toResourceUsageAPIType(total),
+		Quotas: []v1alpha1.QuotaUsage{},
+	}
+
+	opts := &store.QuotaListOptions{PageSize: 1000}
+	for {
+		page, err := s.store.Quota().List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, quota := range page.Quotas {
+			used, err := s.store.Quota().Usage(ctx, quota)
+			if err != nil {
+				return nil, err
+			}
+			usage.Quotas = append(usage.Quotas, v1alpha1.QuotaUsage{
+				Quota: quota.Path,
+				Used:  toResourceUsageAPIType(used),
+			})
+		}
+		if page.NextPageToken == nil {
+			return usage, nil
+		}
+		opts.PageToken = page.NextPageToken
+	}
+}
+
+// quotaPath returns the resource path of a quota
+func quotaPath(tenant, id string) string {
+	return fmt.Sprintf("tenants/%s/quotas/%s", tenant, id)
+}
+
+// parseQuotaLimit parses an optional quantity limit into megabytes
+func parseQuotaLimit(field string, limit *string) (*int64, error) {
+	if limit == nil {
+		return nil, nil
+	}
+	q, err := quantity.Parse(*limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidQuota, field, err)
+	}
+	mb := int64(q)
+	return &mb, nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/quantity"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// toQuotaStoreModel validates a CreateQuotaRequest and converts it to a store model
+func toQuotaStoreModel(id, path, tenant string, req *CreateQuotaRequest) (model.Quota, error) {
+	if req.ServiceType != "" && req.CatalogItemId != "" {
+		return model.Quota{}, fmt.Errorf("%w: at most one of service_type and catalog_item_id may be set", ErrInvalidQuota)
+	}
+	for field, limit := range map[string]*int64{"max_instances": req.MaxInstances, "max_vcpu": req.MaxVcpu} {
+		if limit != nil && *limit < 0 {
+			return model.Quota{}, fmt.Errorf("%w: %s must not be negative", ErrInvalidQuota, field)
+		}
+	}
+	maxMemory, err := parseQuotaLimit("max_memory", req.MaxMemory)
+	if err != nil {
+		return model.Quota{}, err
+	}
+	maxStorage, err := parseQuotaLimit("max_storage", req.MaxStorage)
+	if err != nil {
+		return model.Quota{}, err
+	}
+
+	return model.Quota{
+		ID:            id,
+		Tenant:        tenant,
+		ServiceType:   req.ServiceType,
+		CatalogItemId: req.CatalogItemId,
+		MaxInstances:  req.MaxInstances,
+		MaxVcpu:       req.MaxVcpu,
+		MaxMemoryMB:   maxMemory,
+		MaxStorageMB:  maxStorage,
+		Path:          path,
+	}, nil
+}
+
+// toQuotaAPIType converts a store model to an API type
+func toQuotaAPIType(m *model.Quota) v1alpha1.Quota {
+	apiQuota := v1alpha1.Quota{
+		MaxInstances: m.MaxInstances,
+		MaxVcpu:      m.MaxVcpu,
+		Path:         &m.Path,
+		Uid:          &m.ID,
+		CreateTime:   &m.CreateTime,
+		UpdateTime:   &m.UpdateTime,
+	}
+	if m.ServiceType != "" {
+		apiQuota.ServiceType = &m.ServiceType
+	}
+	if m.CatalogItemId != "" {
+		apiQuota.CatalogItemId = &m.CatalogItemId
+	}
+	if m.MaxMemoryMB != nil {
+		maxMemory := quantity.Quantity(*m.MaxMemoryMB).String()
+		apiQuota.MaxMemory = &maxMemory
+	}
+	if m.MaxStorageMB != nil {
+		maxStorage := quantity.Quantity(*m.MaxStorageMB).String()
+		apiQuota.MaxStorage = &maxStorage
+	}
+	return apiQuota
+}
+
+// toResourceUsageAPIType converts aggregate store usage to an API type
+func toResourceUsageAPIType(u *model.ResourceUsage) v1alpha1.ResourceUsage {
+	return v1alpha1.ResourceUsage{
+		Instances: u.Instances,
+		Vcpu:      u.Vcpu,
+		Memory:    quantity.Quantity(u.MemoryMB).String(),
+		Storage:   quantity.Quantity(u.StorageMB).String(),
+	}
+}
@@ -0,0 +1,243 @@
+package service_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/reconciler"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("Quota Service", func() {
+	var (
+		teamA context.Context
+		teamB context.Context
+		admin context.Context
+		db    *gorm.DB
+		str   store.Store
+		svc   service.Service
+	)
+
+	int64Ptr := func(n int64) *int64 { return &n }
+	stringPtr := func(s string) *string { return &s }
+
+	createQuota := func(ctx context.Context, id string, req service.CreateQuotaRequest) {
+		req.ID = &id
+		req.Parent = stringPtr("tenants/team-a")
+		_, err := svc.Quota().Create(ctx, &req)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createVM := func(ctx context.Context, id string, vcpu int, memory string) error {
+		_, err := svc.CatalogItemInstance().Create(ctx, &service.CreateCatalogItemInstanceRequest{
+			ID:            &id,
+			ApiVersion:    "v1alpha1",
+			DisplayName:   "My VM",
+			CatalogItemId: "vm-item",
+			UserValues: []v1alpha1.UserValue{
+				{Path: "spec.vcpu.count", Value: vcpu},
+				{Path: "spec.memory.size", Value: memory},
+			},
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		teamA = tenancy.NewContext(context.Background(), "team-a")
+		teamB = tenancy.NewContext(context.Background(), "team-b")
+		admin = tenancy.NewAdminContext(tenancy.NewContext(context.Background(), "ops"))
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+		svc = service.NewService(str)
+
+		_, err = svc.ServiceType().Create(context.Background(), &service.CreateServiceTypeRequest{
+			ApiVersion:  "v1alpha1",
+			ServiceType: "vm",
+			Spec: map[string]any{
+				"vcpu":    map[string]any{"count": 1},
+				"memory":  map[string]any{"size": "2GB"},
+				"storage": map[string]any{"disks": []any{map[string]any{"name": "boot", "capacity": "20GB"}}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		editable := true
+		id := "vm-item"
+		_, err = svc.CatalogItem().Create(context.Background(), &service.CreateCatalogItemRequest{
+			ID:          &id,
+			ApiVersion:  "v1alpha1",
+			DisplayName: "VM",
+			ServiceType: "vm",
+			Fields: []v1alpha1.FieldConfiguration{
+				{Path: "spec.vcpu.count", Editable: &editable, Default: 2},
+				{Path: "spec.memory.size", Editable: &editable, Default: "4GB"},
+				{Path: "spec.storage.disks", Default: []any{
+					map[string]any{"name": "boot", "capacity": "50GB"},
+					map[string]any{"name": "data", "capacity": "1TB"},
+				}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if str != nil {
+			Expect(str.Close()).To(Succeed())
+		}
+	})
+
+	Describe("Create", func() {
+		It("should normalize quantity limits", func() {
+			result, err := svc.Quota().Create(admin, &service.CreateQuotaRequest{MaxMemory: stringPtr("2048MB")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.MaxMemory).To(Equal("2GB"))
+			Expect(*result.Path).To(HavePrefix("tenants/ops/quotas/"))
+		})
+
+		It("should reject invalid quantities", func() {
+			_, err := svc.Quota().Create(admin, &service.CreateQuotaRequest{MaxStorage: stringPtr("lots")})
+			Expect(err).To(MatchError(service.ErrInvalidQuota))
+		})
+
+		It("should reject quotas scoped to both a service type and a catalog item", func() {
+			_, err := svc.Quota().Create(admin, &service.CreateQuotaRequest{ServiceType: "vm", CatalogItemId: "vm-item"})
+			Expect(err).To(MatchError(service.ErrInvalidQuota))
+		})
+
+		It("should let administrators create quotas of other tenants", func() {
+			result, err := svc.Quota().Create(admin, &service.CreateQuotaRequest{Parent: stringPtr("tenants/team-b")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Path).To(HavePrefix("tenants/team-b/quotas/"))
+
+			_, err = svc.Quota().Get(teamB, *result.Uid)
+			Expect(err).ToNot(HaveOccurred())
+
+			listed, err := svc.Quota().List(admin, &service.QuotaListOptions{Parent: stringPtr("tenants/team-b")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listed.Quotas).To(HaveLen(1))
+			Expect(*listed.Quotas[0].Uid).To(Equal(*result.Uid))
+
+			_, err = svc.Quota().List(teamA, &service.QuotaListOptions{Parent: stringPtr("tenants/team-b")})
+			Expect(err).To(MatchError(service.ErrTenantMismatch))
+		})
+
+		It("should reject quotas created by tenants", func() {
+			_, err := svc.Quota().Create(teamA, &service.CreateQuotaRequest{MaxInstances: int64Ptr(100)})
+			Expect(err).To(MatchError(service.ErrAdminRequired))
+		})
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			createQuota(admin, "vm-count", service.CreateQuotaRequest{MaxInstances: int64Ptr(1)})
+		})
+
+		It("should reject a tenant's delete of its own quota", func() {
+			Expect(svc.Quota().Delete(teamA, "vm-count")).To(MatchError(service.ErrAdminRequired))
+
+			_, err := svc.Quota().Get(teamA, "vm-count")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should let administrators delete quotas of any tenant", func() {
+			Expect(svc.Quota().Delete(admin, "vm-count")).To(Succeed())
+
+			_, err := svc.Quota().Get(teamA, "vm-count")
+			Expect(err).To(MatchError(service.ErrQuotaNotFound))
+		})
+	})
+
+	Describe("enforcement", func() {
+		It("should cap the instance count of a catalog item", func() {
+			createQuota(admin, "vm-count", service.CreateQuotaRequest{CatalogItemId: "vm-item", MaxInstances: int64Ptr(1)})
+
+			Expect(createVM(teamA, "vm-1", 2, "4GB")).To(Succeed())
+			Expect(createVM(teamA, "vm-2", 2, "4GB")).To(MatchError(service.ErrQuotaExceeded))
+
+			// Other tenants are not affected
+			Expect(createVM(teamB, "vm-2", 2, "4GB")).To(Succeed())
+		})
+
+		It("should cap the total vCPU of a service type", func() {
+			createQuota(admin, "vm-cpu", service.CreateQuotaRequest{ServiceType: "vm", MaxVcpu: int64Ptr(8)})
+
+			Expect(createVM(teamA, "vm-1", 6, "4GB")).To(Succeed())
+			err := createVM(teamA, "vm-2", 4, "4GB")
+			Expect(err).To(MatchError(service.ErrQuotaExceeded))
+			Expect(err.Error()).To(ContainSubstring("vcpu would be 10, limit is 8"))
+			Expect(createVM(teamA, "vm-2", 2, "4GB")).To(Succeed())
+		})
+
+		It("should cap memory parsed from size strings", func() {
+			createQuota(admin, "memory", service.CreateQuotaRequest{MaxMemory: stringPtr("16GB")})
+
+			Expect(createVM(teamA, "vm-1", 2, "8GB")).To(Succeed())
+			Expect(createVM(teamA, "vm-2", 2, "8193MB")).To(MatchError(service.ErrQuotaExceeded))
+			Expect(createVM(teamA, "vm-2", 2, "8192MB")).To(Succeed())
+		})
+
+		It("should count the storage of every disk", func() {
+			createQuota(admin, "storage", service.CreateQuotaRequest{MaxStorage: stringPtr("2TB")})
+
+			Expect(createVM(teamA, "vm-1", 2, "4GB")).To(Succeed())
+			Expect(createVM(teamA, "vm-2", 2, "4GB")).To(MatchError(service.ErrQuotaExceeded))
+		})
+
+		It("should free capacity when an instance is deleted", func() {
+			createQuota(admin, "vm-count", service.CreateQuotaRequest{MaxInstances: int64Ptr(1)})
+
+			Expect(createVM(teamA, "vm-1", 2, "4GB")).To(Succeed())
+			_, err := svc.CatalogItemInstance().Delete(teamA, "vm-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			// Deleting instances count until the reconciler has removed them
+			Expect(createVM(teamA, "vm-2", 2, "4GB")).To(MatchError(service.ErrQuotaExceeded))
+			Expect(reconciler.New(str, nil, reconciler.Config{}).Reconcile(context.Background(), "vm-1")).To(Succeed())
+			Expect(createVM(teamA, "vm-2", 2, "4GB")).To(Succeed())
+		})
+
+		It("should reject user values that are not quantities", func() {
+			Expect(createVM(teamA, "vm-1", 2, "lots")).To(MatchError(service.ErrInvalidCatalogItemInstance))
+		})
+	})
+
+	Describe("Usage", func() {
+		It("should report the tenant's total and per-quota usage", func() {
+			createQuota(admin, "vm-cpu", service.CreateQuotaRequest{ServiceType: "vm", MaxVcpu: int64Ptr(32)})
+			createQuota(admin, "other", service.CreateQuotaRequest{ServiceType: "container", MaxInstances: int64Ptr(5)})
+			Expect(createVM(teamA, "vm-1", 2, "4GB")).To(Succeed())
+			Expect(createVM(teamA, "vm-2", 4, "8GB")).To(Succeed())
+			Expect(createVM(teamB, "vm-3", 8, "8GB")).To(Succeed())
+
+			usage, err := svc.Quota().Usage(teamA)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*usage.Path).To(Equal("tenants/team-a/usage"))
+			Expect(usage.Total).To(Equal(v1alpha1.ResourceUsage{Instances: 2, Vcpu: 6, Memory: "12GB", Storage: "2148GB"}))
+			Expect(usage.Quotas).To(ConsistOf(
+				v1alpha1.QuotaUsage{Quota: "tenants/team-a/quotas/vm-cpu", Used: usage.Total},
+				v1alpha1.QuotaUsage{Quota: "tenants/team-a/quotas/other", Used: v1alpha1.ResourceUsage{Memory: "0MB", Storage: "0MB"}},
+			))
+		})
+
+		It("should require a tenant", func() {
+			_, err := svc.Quota().Usage(context.Background())
+			Expect(err).To(MatchError(service.ErrTenantRequired))
+		})
+	})
+})
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/expression"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// renderSpec builds the service type payload of an instance from the catalog item's
+// field defaults and the user's values.
+// Field paths are dot-separated; a leading "spec." is stripped so that
+// "spec.vcpu.count" sets vcpu.count, while paths such as "metadata.name" are kept.
+// Computed defaults read the payload rendered from the user values and the
+// fixed defaults, and must conform both to the service type schema base and
+// to the validation schema of their field.
+// The visible_when and required_when conditions of the fields are then
+// evaluated against the payload rendered from every field; hidden fields are
+// left out. Finally the units of quantities are normalized.
+func renderSpec(catalogItem *model.CatalogItem, base *openapi3.Schema, schemas schema.Fields, programs expression.Programs, userValues []v1alpha1.UserValue, vars expression.Vars) (map[string]any, error) {
+	values := make(map[string]any, len(userValues))
+	for _, uv := range userValues {
+		values[uv.Path] = uv.Value
+	}
+
+	spec, err := renderFields(catalogItem, values, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	computed := map[string]any{}
+	vars.Spec = spec
+	for _, f := range catalogItem.Spec.Fields {
+		program := programs[f.Path].DefaultExpression
+		if _, ok := values[f.Path]; program == nil || (ok && f.Editable) {
+			continue
+		}
+		value, err := computeDefault(f.Path, program, vars)
+		if err != nil {
+			return nil, err
+		}
+		fieldSchema, ok := schema.Lookup(base, specPath(f.Path))
+		if !ok {
+			return nil, fmt.Errorf("%w: field %q is not a field of the service type", ErrInvalidCatalogItemInstance, f.Path)
+		}
+		if err := schema.Validate(fieldSchema, value); err != nil {
+			return nil, fmt.Errorf("%w: field %q: default_expression does not match the service type schema: %v", ErrInvalidCatalogItemInstance, f.Path, err)
+		}
+		if err := schema.Validate(schemas[f.Path], value); err != nil {
+			return nil, fmt.Errorf("%w: field %q: default_expression: %v", ErrInvalidCatalogItemInstance, f.Path, err)
+		}
+		computed[f.Path] = value
+	}
+	if len(computed) > 0 {
+		if spec, err = renderFields(catalogItem, values, computed, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	hidden := map[string]bool{}
+	for _, f := range catalogItem.Spec.Fields {
+		visible, err := evalFieldCondition(f.Path, "visible_when", programs[f.Path].VisibleWhen, true, spec)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			if _, ok := values[f.Path]; ok {
+				return nil, fmt.Errorf("%w: field %q is not visible", ErrInvalidCatalogItemInstance, f.Path)
+			}
+			hidden[f.Path] = true
+			continue
+		}
+		required, err := evalFieldCondition(f.Path, "required_when", programs[f.Path].RequiredWhen, false, spec)
+		if err != nil {
+			return nil, err
+		}
+		if required && fieldValue(f, values, computed) == nil {
+			return nil, fmt.Errorf("%w: field %q is required", ErrInvalidCatalogItemInstance, f.Path)
+		}
+	}
+	if len(hidden) > 0 {
+		if spec, err = renderFields(catalogItem, values, computed, hidden); err != nil {
+			return nil, err
+		}
+	}
+
+	// Quantities are rendered in their largest exact unit, e.g. "2048MB" as
+	// "2GB", whether the service type schema or validation_schema declares them
+	for _, f := range catalogItem.Spec.Fields {
+		if value, ok := lookupPath(spec, specPath(f.Path)); ok {
+			baseSchema, _ := schema.Lookup(base, specPath(f.Path))
+			value = schema.Normalize(schemas[f.Path], schema.Normalize(baseSchema, value))
+			if err := setPath(spec, specPath(f.Path), value); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidCatalogItemInstance, err)
+			}
+		}
+	}
+	return spec, nil
+}
+
+// renderFields sets the value of every field of the catalog item that is not hidden
+func renderFields(catalogItem *model.CatalogItem, values, computed map[string]any, hidden map[string]bool) (map[string]any, error) {
+	spec := map[string]any{"service_type": catalogItem.Spec.ServiceType}
+	for _, f := range catalogItem.Spec.Fields {
+		value := fieldValue(f, values, computed)
+		if value == nil || hidden[f.Path] {
+			continue
+		}
+		if err := setPath(spec, specPath(f.Path), value); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCatalogItemInstance, err)
+		}
+	}
+	return spec, nil
+}
+
+// fieldValue returns the user value of an editable field, or else its
+// computed or fixed default
+func fieldValue(f model.FieldConfiguration, values, computed map[string]any) any {
+	if value, ok := values[f.Path]; ok && f.Editable {
+		return value
+	}
+	if value, ok := computed[f.Path]; ok {
+		return value
+	}
+	return f.Default
+}
+
+// computeDefault evaluates the default_expression of a field
+func computeDefault(path string, program *expression.Program, vars expression.Vars) (any, error) {
+	value, err := program.Value(vars)
+	if err != nil {
+		return nil, fmt.Errorf("%w: field %q: default_expression: %w", ErrInvalidCatalogItemInstance, path, err)
+	}
+	return value, nil
+}
+
+// evalFieldCondition evaluates a condition of a field, or returns otherwise
+// when the field has none
+func evalFieldCondition(path, keyword string, program *expression.Program, otherwise bool, spec map[string]any) (bool, error) {
+	if program == nil {
+		return otherwise, nil
+	}
+	result, err := program.Condition(spec)
+	if err != nil {
+		return false, fmt.Errorf("%w: field %q: %s: %w", ErrInvalidCatalogItemInstance, path, keyword, err)
+	}
+	return result, nil
+}
+
+// specPath returns the path of a field within the service type payload
+func specPath(path string) string {
+	return strings.TrimPrefix(path, "spec.")
+}
+
+// setPath sets a dot-separated path in a nested map, creating intermediate objects
+func setPath(obj map[string]any, path string, value any) error {
+	keys := strings.Split(path, ".")
+	for i, key := range keys[:len(keys)-1] {
+		next, ok := obj[key]
+		if !ok {
+			child := map[string]any{}
+			obj[key] = child
+			obj = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("field %q conflicts with the value of %q", path, strings.Join(keys[:i+1], "."))
+		}
+		obj = child
+	}
+	obj[keys[len(keys)-1]] = value
+	return nil
+}
+
+// lookupPath returns the value at a dot-separated path in a nested map
+func lookupPath(obj map[string]any, path string) (any, bool) {
+	var current any = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dcm-project/catalog-manager/internal/quantity"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// computeResources returns the vCPU, memory and storage requested by a rendered spec.
+// Resources that are absent from the spec count as zero; service types without
+// sizing fields request nothing.
+func computeResources(serviceType string, spec map[string]any) (model.InstanceResources, error) {
+	var r resourceCounter
+	switch serviceType {
+	case "vm":
+		r.vcpu(spec, "vcpu.count", 1)
+		r.memory(spec, "memory.size", 1)
+		if disks, ok := lookupPath(spec, "storage.disks"); ok {
+			list, _ := disks.([]any)
+			for i := range list {
+				disk, _ := list[i].(map[string]any)
+				r.storageValue(disk["capacity"], fmt.Sprintf("storage.disks[%d].capacity", i), 1)
+			}
+		}
+	case "container":
+		r.vcpu(spec, "resources.cpu.max", 1)
+		r.memory(spec, "resources.memory.max", 1)
+	case "database":
+		r.vcpu(spec, "resources.cpu", 1)
+		r.memory(spec, "resources.memory", 1)
+		r.storage(spec, "resources.storage", 1)
+	case "cluster":
+		for _, pool := range []string{"nodes.control_plane", "nodes.workers"} {
+			count := r.integer(spec, pool+".count")
+			r.vcpu(spec, pool+".cpu", count)
+			r.memory(spec, pool+".memory", count)
+			r.storage(spec, pool+".storage", count)
+		}
+	}
+	if r.err != nil {
+		return model.InstanceResources{}, fmt.Errorf("%w: %w", ErrInvalidCatalogItemInstance, r.err)
+	}
+	return r.res, nil
+}
+
+// resourceCounter accumulates resources from spec values, keeping the first error
+type resourceCounter struct {
+	res model.InstanceResources
+	err error
+}
+
+// integer returns the whole number at path, or zero when it is absent
+func (r *resourceCounter) integer(spec map[string]any, path string) int64 {
+	v, ok := lookupPath(spec, path)
+	if !ok || r.err != nil {
+		return 0
+	}
+	var n float64
+	switch num := v.(type) {
+	case int:
+		return int64(num)
+	case int64:
+		return num
+	case float64:
+		n = num
+	default:
+		r.err = fmt.Errorf("%s must be an integer", path)
+		return 0
+	}
+	if n != math.Trunc(n) || n < 0 || n > math.MaxInt32 {
+		r.err = fmt.Errorf("%s must be a non-negative integer", path)
+		return 0
+	}
+	return int64(n)
+}
+
+// quantity returns the size in MB of the quantity string v, or zero when it is absent
+func (r *resourceCounter) quantity(v any, path string) int64 {
+	if v == nil || r.err != nil {
+		return 0
+	}
+	s, ok := v.(string)
+	if !ok {
+		r.err = fmt.Errorf("%s must be a quantity string such as \"16GB\"", path)
+		return 0
+	}
+	q, err := quantity.Parse(s)
+	if err != nil {
+		r.err = fmt.Errorf("%s: %w", path, err)
+		return 0
+	}
+	return int64(q)
+}
+
+func (r *resourceCounter) vcpu(spec map[string]any, path string, times int64) {
+	r.res.Vcpu += times * r.integer(spec, path)
+}
+
+func (r *resourceCounter) memory(spec map[string]any, path string, times int64) {
+	v, _ := lookupPath(spec, path)
+	r.res.MemoryMB += times * r.quantity(v, path)
+}
+
+func (r *resourceCounter) storage(spec map[string]any, path string, times int64) {
+	v, _ := lookupPath(spec, path)
+	r.storageValue(v, path, times)
+}
+
+func (r *resourceCounter) storageValue(v any, path string, times int64) {
+	r.res.StorageMB += times * r.quantity(v, path)
+}
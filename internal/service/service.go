@@ -1,23 +1,147 @@
 package service
 
-import "github.com/dcm-project/catalog-manager/internal/store"
+import (
+	"context"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/expression"
+	"github.com/dcm-project/catalog-manager/internal/schema"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/watch"
+	"github.com/dcm-project/catalog-manager/internal/webhooks"
+)
 
 // Service is the main interface that aggregates all service interfaces
 type Service interface {
 	ServiceType() ServiceTypeService
+	CatalogItem() CatalogItemService
+	CatalogItemInstance() CatalogItemInstanceService
+	Quota() QuotaService
+	Operation() OperationService
+	WebhookSubscription() WebhookSubscriptionService
+	AuditEvent() AuditEventService
 }
 
 // service is the implementation of the Service interface
 type service struct {
-	store              store.Store
-	serviceTypeService ServiceTypeService
+	store                      store.Store
+	serviceTypeService         ServiceTypeService
+	catalogItemService         CatalogItemService
+	catalogItemInstanceService CatalogItemInstanceService
+	quotaService               QuotaService
+	operationService           OperationService
+	webhookSubscriptionService WebhookSubscriptionService
+	auditEventService          AuditEventService
+}
+
+// Option configures optional dependencies of the service layer
+type Option func(*options)
+
+type options struct {
+	reconciler     InstanceReconciler
+	webhookTester  WebhookTester
+	watchHub       *watch.Hub
+	idempotencyTTL time.Duration
+	pendingTimeout time.Duration
+	cacheSize      int
+}
+
+// InstanceReconciler is notified when an instance has work for the reconciler
+type InstanceReconciler interface {
+	Enqueue(id string)
+}
+
+// WithReconciler notifies the reconciler when instances are created, deleted or
+// their creation is cancelled, so that it does not wait for its next scan
+func WithReconciler(reconciler InstanceReconciler) Option {
+	return func(o *options) {
+		o.reconciler = reconciler
+	}
+}
+
+// WebhookTester sends ping events to webhook subscriptions
+type WebhookTester interface {
+	// Test sends a ping event to the subscription once and records the delivery
+	Test(ctx context.Context, subscription *model.WebhookSubscription) (*model.WebhookDelivery, error)
+	// CheckTarget reports whether webhooks may be sent to the URL
+	CheckTarget(ctx context.Context, rawURL string) error
+}
+
+// WithWebhookTester sets how webhook subscriptions are tested. By default a
+// webhooks.Dispatcher with the default configuration is used.
+func WithWebhookTester(tester WebhookTester) Option {
+	return func(o *options) {
+		o.webhookTester = tester
+	}
+}
+
+// WithWatchHub serves the watch of catalog item instances from hub. Without
+// it, watching fails with ErrWatchUnavailable.
+func WithWatchHub(hub *watch.Hub) Option {
+	return func(o *options) {
+		o.watchHub = hub
+	}
+}
+
+// WithIdempotencyTTL keeps the idempotency keys of create requests for ttl,
+// instead of 24 hours
+func WithIdempotencyTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.idempotencyTTL = ttl
+	}
+}
+
+// WithIdempotencyPendingTimeout lets a request retried with the same key
+// create its resource again once the first attempt created nothing for
+// timeout, instead of a minute
+func WithIdempotencyPendingTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.pendingTimeout = timeout
+	}
+}
+
+// defaultCacheSize is the number of catalog items, and of service type
+// versions, whose compiled schemas and expressions are kept by default
+const defaultCacheSize = 1000
+
+// WithCacheSize keeps the compiled schemas and expressions of the size most
+// recently used catalog items and service type versions, instead of 1000
+func WithCacheSize(size int) Option {
+	return func(o *options) {
+		o.cacheSize = size
+	}
 }
 
 // NewService creates a new Service instance
-func NewService(store store.Store) Service {
+func NewService(store store.Store, opts ...Option) Service {
+	o := options{
+		idempotencyTTL: defaultIdempotencyTTL,
+		pendingTimeout: defaultIdempotencyPendingTimeout,
+		cacheSize:      defaultCacheSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.webhookTester == nil {
+		o.webhookTester = webhooks.NewDispatcher(store, webhooks.Config{})
+	}
+	// Shared so that catalog item updates invalidate the schemas and expressions
+	// used by instance requests. Service type schemas are immutable: their
+	// entries are only refreshed by update time.
+	schemas := schema.NewCache(o.cacheSize)
+	programs := expression.NewCache(o.cacheSize)
+	bases := schema.NewServiceTypeCache(o.cacheSize)
+	keys := &idempotencyKeys{store: store, ttl: o.idempotencyTTL, pendingTimeout: o.pendingTimeout}
 	return &service{
-		store:              store,
-		serviceTypeService: newServiceTypeService(store),
+		store:                      store,
+		serviceTypeService:         newServiceTypeService(store, keys),
+		catalogItemService:         newCatalogItemService(store, schemas, programs, bases, keys),
+		catalogItemInstanceService: newCatalogItemInstanceService(store, schemas, programs, bases, o.reconciler, o.watchHub, keys),
+		quotaService:               newQuotaService(store, keys),
+		operationService:           newOperationService(store, o.reconciler),
+		webhookSubscriptionService: newWebhookSubscriptionService(store, o.webhookTester, keys),
+		auditEventService:          newAuditEventService(store),
 	}
 }
 
@@ -25,3 +149,33 @@ func NewService(store store.Store) Service {
 func (s *service) ServiceType() ServiceTypeService {
 	return s.serviceTypeService
 }
+
+// CatalogItem returns the CatalogItemService
+func (s *service) CatalogItem() CatalogItemService {
+	return s.catalogItemService
+}
+
+// CatalogItemInstance returns the CatalogItemInstanceService
+func (s *service) CatalogItemInstance() CatalogItemInstanceService {
+	return s.catalogItemInstanceService
+}
+
+// Quota returns the QuotaService
+func (s *service) Quota() QuotaService {
+	return s.quotaService
+}
+
+// Operation returns the OperationService
+func (s *service) Operation() OperationService {
+	return s.operationService
+}
+
+// WebhookSubscription returns the WebhookSubscriptionService
+func (s *service) WebhookSubscription() WebhookSubscriptionService {
+	return s.webhookSubscriptionService
+}
+
+// AuditEvent returns the AuditEventService
+func (s *service) AuditEvent() AuditEventService {
+	return s.auditEventService
+}
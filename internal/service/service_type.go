@@ -2,10 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/fieldmask"
+	"github.com/dcm-project/catalog-manager/internal/schema"
 	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/warning"
 	"github.com/google/uuid"
 )
 
@@ -20,24 +26,51 @@ var allowedServiceTypes = map[string]bool{
 // CreateServiceTypeRequest contains the parameters for creating a service type
 type CreateServiceTypeRequest struct {
 	ID          *string   // Optional user-specified ID
+	RequestID   string    // Optional idempotency key (AEP-155)
 	ApiVersion  string    // e.g., "v1alpha1"
 	ServiceType string    // Must be: vm, container, cluster, or database
 	Metadata    *struct { // Optional labels
 		Labels *map[string]string `json:"labels,omitempty"`
 	}
 	Spec map[string]any // Required, cannot be empty
+
+	// Default makes this version the default of the service type.
+	// The first version of a service type is always the default.
+	Default            bool
+	Deprecated         bool
+	DeprecationMessage *string
+	SunsetTime         *time.Time // Only allowed on deprecated versions
+	// Conversions from other versions to this one, immutable after creation
+	Conversions []v1alpha1.ServiceTypeConversion
+}
+
+// UpdateServiceTypeRequest contains the fields of a service type version that can be changed.
+// Nil fields are left unchanged.
+type UpdateServiceTypeRequest struct {
+	Default            *bool // Only true is accepted: make another version the default instead
+	Deprecated         *bool // Setting it to false also clears the deprecation message and sunset time
+	DeprecationMessage *string
+	SunsetTime         *time.Time
 }
 
 // ServiceTypeListOptions contains options for listing service types
 type ServiceTypeListOptions struct {
 	PageToken   *string
 	MaxPageSize *int32
+	ServiceType *string // Optional filter returning the versions of a single service type
+	// ReadMask is the fields the caller reads (AEP-157); the spec is not
+	// loaded unless it is among them
+	ReadMask fieldmask.Mask
+	// SkipTotalSize leaves out the total size of the result, saving the
+	// query counting it
+	SkipTotalSize bool
 }
 
 // ServiceTypeListResult contains the result of a List operation
 type ServiceTypeListResult struct {
 	ServiceTypes  []v1alpha1.ServiceType
 	NextPageToken *string
+	TotalSize     *int64 // nil when skipped
 }
 
 // ServiceTypeService defines the business logic for ServiceType operations
@@ -45,32 +78,44 @@ type ServiceTypeService interface {
 	List(ctx context.Context, opts *ServiceTypeListOptions) (*ServiceTypeListResult, error)
 	Create(ctx context.Context, req *CreateServiceTypeRequest) (*v1alpha1.ServiceType, error)
 	Get(ctx context.Context, id string) (*v1alpha1.ServiceType, error)
+	Update(ctx context.Context, id string, req *UpdateServiceTypeRequest) (*v1alpha1.ServiceType, error)
+	// Apply creates the service type version with the given ID or updates it to match req
+	Apply(ctx context.Context, id string, req *CreateServiceTypeRequest, validateOnly bool) (*v1alpha1.ServiceTypeApplyResult, error)
 }
 
 type serviceTypeService struct {
 	store store.Store
+	keys  *idempotencyKeys
 }
 
 // newServiceTypeService creates a new ServiceTypeService instance
-func newServiceTypeService(store store.Store) ServiceTypeService {
-	return &serviceTypeService{store: store}
+func newServiceTypeService(store store.Store, keys *idempotencyKeys) ServiceTypeService {
+	return &serviceTypeService{store: store, keys: keys}
 }
 
 // List returns a paginated list of service types
 func (s *serviceTypeService) List(ctx context.Context, opts *ServiceTypeListOptions) (*ServiceTypeListResult, error) {
 	// Convert service options to store options
-	var pageToken *string
+	var pageToken, serviceType *string
+	var readMask fieldmask.Mask
+	var skipTotalSize bool
 	maxPageSize := 100
 	if opts != nil {
 		pageToken = opts.PageToken
+		serviceType = opts.ServiceType
+		readMask = opts.ReadMask
+		skipTotalSize = opts.SkipTotalSize
 		if opts.MaxPageSize != nil {
 			maxPageSize = int(*opts.MaxPageSize)
 		}
 	}
 
 	storeOpts := &store.ServiceTypeListOptions{
-		PageToken: pageToken,
-		PageSize:  maxPageSize,
+		PageToken:   pageToken,
+		PageSize:    maxPageSize,
+		ServiceType: serviceType,
+		SkipSpec:    !readMask.Selects("spec"),
+		CountTotal:  !skipTotalSize,
 	}
 
 	// Call store layer
@@ -88,14 +133,21 @@ func (s *serviceTypeService) List(ctx context.Context, opts *ServiceTypeListOpti
 	return &ServiceTypeListResult{
 		ServiceTypes:  apiTypes,
 		NextPageToken: storeResult.NextPageToken,
+		TotalSize:     storeResult.TotalSize,
 	}, nil
 }
 
 // Create creates a new service type with business validation
 func (s *serviceTypeService) Create(ctx context.Context, req *CreateServiceTypeRequest) (*v1alpha1.ServiceType, error) {
-	// Validate service type (must be one of the allowed values)
-	if !allowedServiceTypes[req.ServiceType] {
-		return nil, ErrInvalidServiceType
+	return createIdempotent(ctx, s.keys, "CreateServiceType", req.RequestID, req, func(ctx context.Context) (*v1alpha1.ServiceType, error) {
+		return s.create(ctx, req)
+	}, lookupResource(s.Get))
+}
+
+// create creates the service type of req
+func (s *serviceTypeService) create(ctx context.Context, req *CreateServiceTypeRequest) (*v1alpha1.ServiceType, error) {
+	if err := validateCreateServiceType(req); err != nil {
+		return nil, err
 	}
 
 	// Generate or use provided ID
@@ -107,11 +159,8 @@ func (s *serviceTypeService) Create(ctx context.Context, req *CreateServiceTypeR
 		id = uuid.New().String()
 	}
 
-	// Generate path
-	path := fmt.Sprintf("service-types/%s", id)
-
 	// Convert to store model
-	storeModel := toStoreModel(id, path, req)
+	storeModel := toStoreModel(id, serviceTypePath(id), req)
 
 	// Call store layer
 	createdModel, err := s.store.ServiceType().Create(ctx, storeModel)
@@ -124,6 +173,26 @@ func (s *serviceTypeService) Create(ctx context.Context, req *CreateServiceTypeR
 	return &apiType, nil
 }
 
+// validateCreateServiceType validates a new service type version
+func validateCreateServiceType(req *CreateServiceTypeRequest) error {
+	// Validate service type (must be one of the allowed values)
+	if !allowedServiceTypes[req.ServiceType] {
+		return ErrInvalidServiceType
+	}
+	if !req.Deprecated && (req.DeprecationMessage != nil || req.SunsetTime != nil) {
+		return fmt.Errorf("%w: deprecation_message and sunset_time require deprecated", ErrInvalidServiceTypeVersion)
+	}
+	if _, err := schema.ForServiceType(req.Spec); err != nil {
+		return fmt.Errorf("%w: spec: %w", ErrInvalidServiceTypeVersion, err)
+	}
+	return validateConversions(req.ApiVersion, req.Conversions)
+}
+
+// serviceTypePath returns the resource path of the service type with the given ID
+func serviceTypePath(id string) string {
+	return fmt.Sprintf("service-types/%s", id)
+}
+
 // Get retrieves a service type by ID
 func (s *serviceTypeService) Get(ctx context.Context, id string) (*v1alpha1.ServiceType, error) {
 	// Call store layer
@@ -136,3 +205,161 @@ func (s *serviceTypeService) Get(ctx context.Context, id string) (*v1alpha1.Serv
 	apiType := toAPIType(storeModel)
 	return &apiType, nil
 }
+
+// Update changes the default and deprecation status of a service type version
+func (s *serviceTypeService) Update(ctx context.Context, id string, req *UpdateServiceTypeRequest) (*v1alpha1.ServiceType, error) {
+	if req.Default != nil && !*req.Default {
+		return nil, fmt.Errorf("%w: default cannot be unset, make another version the default instead", ErrInvalidServiceTypeUpdate)
+	}
+
+	existing, err := s.store.ServiceType().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	// Apply the changes
+	if req.Default != nil {
+		existing.Default = true
+	}
+	if req.Deprecated != nil {
+		if *req.Deprecated {
+			existing.Deprecation.Deprecated = true
+		} else {
+			existing.Deprecation = model.Deprecation{}
+		}
+	}
+	if req.DeprecationMessage != nil || req.SunsetTime != nil {
+		if !existing.Deprecation.Deprecated {
+			return nil, fmt.Errorf("%w: deprecation_message and sunset_time require deprecated", ErrInvalidServiceTypeUpdate)
+		}
+		if req.DeprecationMessage != nil {
+			existing.Deprecation.Message = *req.DeprecationMessage
+		}
+		if req.SunsetTime != nil {
+			sunset := req.SunsetTime.UTC()
+			existing.Deprecation.SunsetTime = &sunset
+		}
+	}
+
+	// Call store layer
+	updated, err := s.store.ServiceType().Update(ctx, existing)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiType := toAPIType(updated)
+	return &apiType, nil
+}
+
+// Apply creates the service type version with the given ID, or updates an
+// existing one to match req. Only the default and deprecation status of an
+// existing version can change. Nothing is persisted when validateOnly is set.
+func (s *serviceTypeService) Apply(ctx context.Context, id string, req *CreateServiceTypeRequest, validateOnly bool) (*v1alpha1.ServiceTypeApplyResult, error) {
+	existing, err := s.store.ServiceType().Get(ctx, id)
+	if errors.Is(err, store.ErrServiceTypeNotFound) {
+		return s.applyCreate(ctx, id, req, validateOnly)
+	}
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	if err := validateCreateServiceType(req); err != nil {
+		return nil, err
+	}
+	desired := toStoreModel(id, existing.Path, req)
+	// A manifest leaving default unset does not take it away from the default version
+	desired.Default = existing.Default || req.Default
+
+	// Everything but the default and deprecation status is immutable
+	immutable := desired
+	immutable.Default = existing.Default
+	immutable.Deprecation = existing.Deprecation
+	changes, err := applyChanges(toAPIType(existing), toAPIType(&immutable))
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		return nil, fmt.Errorf("%w: %s is immutable, publish a new version of the service type instead", ErrInvalidServiceTypeUpdate, changes[0].Path)
+	}
+
+	updated := *existing
+	updated.Default = desired.Default
+	updated.Deprecation = desired.Deprecation
+	changes, err = applyChanges(toAPIType(existing), toAPIType(&updated))
+	if err != nil {
+		return nil, err
+	}
+	result := &v1alpha1.ServiceTypeApplyResult{Action: applyAction(changes), Changes: changes}
+
+	if len(changes) > 0 && !validateOnly {
+		stored, err := s.store.ServiceType().Update(ctx, &updated)
+		if err != nil {
+			return nil, mapStoreError(err)
+		}
+		updated = *stored
+	}
+	result.ServiceType = toAPIType(&updated)
+	return result, nil
+}
+
+// applyCreate creates the service type version of an apply
+func (s *serviceTypeService) applyCreate(ctx context.Context, id string, req *CreateServiceTypeRequest, validateOnly bool) (*v1alpha1.ServiceTypeApplyResult, error) {
+	var created *v1alpha1.ServiceType
+	if validateOnly {
+		if err := validateCreateServiceType(req); err != nil {
+			return nil, err
+		}
+		storeModel := toStoreModel(id, serviceTypePath(id), req)
+		// Mirror the checks of the store: versions are unique per service
+		// type, and the first version of a service type is its default
+		_, err := s.store.ServiceType().Resolve(ctx, req.ServiceType, req.ApiVersion)
+		if err == nil {
+			return nil, ErrServiceTypeNameTaken
+		}
+		if !errors.Is(err, store.ErrServiceTypeNotFound) {
+			return nil, mapStoreError(err)
+		}
+		if _, err := s.store.ServiceType().Resolve(ctx, req.ServiceType, ""); errors.Is(err, store.ErrServiceTypeNotFound) {
+			storeModel.Default = true
+		}
+		apiType := toAPIType(&storeModel)
+		created = &apiType
+	} else {
+		req.ID = &id
+		var err error
+		if created, err = s.Create(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := applyChanges(nil, created)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.ServiceTypeApplyResult{Action: v1alpha1.ApplyActionCreated, Changes: changes, ServiceType: *created}, nil
+}
+
+// checkServiceTypeSunset rejects service type versions past their sunset time
+func checkServiceTypeSunset(st *model.ServiceType) error {
+	sunset := st.Deprecation.SunsetTime
+	if !st.Deprecation.Deprecated || sunset == nil || time.Now().Before(*sunset) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s was sunset on %s", ErrServiceTypeSunset, st.ServiceType, st.ApiVersion, sunset.Format(time.RFC3339))
+}
+
+// warnIfDeprecated records a warning for the request of ctx when st is deprecated
+func warnIfDeprecated(ctx context.Context, st *model.ServiceType) {
+	d := st.Deprecation
+	if !d.Deprecated {
+		return
+	}
+	message := fmt.Sprintf("service type %s %s is deprecated", st.ServiceType, st.ApiVersion)
+	if d.SunsetTime != nil {
+		message += fmt.Sprintf(" and will be sunset on %s", d.SunsetTime.Format(time.RFC3339))
+	}
+	if d.Message != "" {
+		message += ": " + d.Message
+	}
+	warning.Add(ctx, message)
+}
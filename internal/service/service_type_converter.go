@@ -2,6 +2,8 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/store"
@@ -16,6 +18,28 @@ func toStoreModel(id, path string, req *CreateServiceTypeRequest) model.ServiceT
 		ServiceType: req.ServiceType,
 		Spec:        req.Spec,
 		Path:        path,
+		Default:     req.Default,
+		Deprecation: model.Deprecation{Deprecated: req.Deprecated},
+	}
+	if req.DeprecationMessage != nil {
+		storeModel.Deprecation.Message = *req.DeprecationMessage
+	}
+	if req.SunsetTime != nil {
+		sunset := req.SunsetTime.UTC()
+		storeModel.Deprecation.SunsetTime = &sunset
+	}
+	for _, c := range req.Conversions {
+		conversion := model.Conversion{FromVersion: c.FromVersion}
+		if c.FieldMappings != nil {
+			for _, m := range *c.FieldMappings {
+				mapping := model.FieldMapping{From: m.From}
+				if m.To != nil {
+					mapping.To = *m.To
+				}
+				conversion.FieldMappings = append(conversion.FieldMappings, mapping)
+			}
+		}
+		storeModel.Conversions = append(storeModel.Conversions, conversion)
 	}
 
 	// Convert metadata if present
@@ -38,6 +62,29 @@ func toAPIType(m *model.ServiceType) v1alpha1.ServiceType {
 		Uid:         &m.ID,
 		CreateTime:  &m.CreateTime,
 		UpdateTime:  &m.UpdateTime,
+		Default:     &m.Default,
+		Deprecated:  &m.Deprecation.Deprecated,
+	}
+	if m.Deprecation.Deprecated {
+		if m.Deprecation.Message != "" {
+			apiType.DeprecationMessage = &m.Deprecation.Message
+		}
+		apiType.SunsetTime = m.Deprecation.SunsetTime
+	}
+	if len(m.Conversions) > 0 {
+		conversions := make([]v1alpha1.ServiceTypeConversion, len(m.Conversions))
+		for i, c := range m.Conversions {
+			mappings := make([]v1alpha1.FieldMapping, len(c.FieldMappings))
+			for j, fm := range c.FieldMappings {
+				mappings[j] = v1alpha1.FieldMapping{From: fm.From}
+				if fm.To != "" {
+					to := fm.To
+					mappings[j].To = &to
+				}
+			}
+			conversions[i] = v1alpha1.ServiceTypeConversion{FromVersion: c.FromVersion, FieldMappings: &mappings}
+		}
+		apiType.Conversions = &conversions
 	}
 
 	// Convert metadata if present
@@ -66,6 +113,39 @@ func mapStoreError(err error) error {
 		return ErrServiceTypeIDTaken
 	case errors.Is(err, store.ErrServiceTypeServiceTypeTaken):
 		return ErrServiceTypeNameTaken
+	case errors.Is(err, store.ErrCatalogItemNotFound):
+		return ErrCatalogItemNotFound
+	case errors.Is(err, store.ErrCatalogItemIDTaken):
+		return ErrCatalogItemIDTaken
+	case errors.Is(err, store.ErrCatalogItemHasInstances):
+		return ErrCatalogItemHasInstances
+	case errors.Is(err, store.ErrCatalogItemRevisionNotFound):
+		return ErrCatalogItemRevisionNotFound
+	case errors.Is(err, store.ErrCatalogItemReadOnly):
+		return fmt.Errorf("%w: %w", ErrAdminRequired, err)
+	case errors.Is(err, store.ErrCatalogItemInstanceNotFound):
+		return ErrCatalogItemInstanceNotFound
+	case errors.Is(err, store.ErrCatalogItemInstanceIDTaken):
+		return ErrCatalogItemInstanceIDTaken
+	case errors.Is(err, store.ErrCatalogItemNotFoundRef):
+		return fmt.Errorf("%w: %w", ErrInvalidCatalogItemInstance, err)
+	case errors.Is(err, store.ErrQuotaExceeded):
+		// Keep the store's detail about which limit was hit
+		return fmt.Errorf("%w%s", ErrQuotaExceeded, strings.TrimPrefix(err.Error(), store.ErrQuotaExceeded.Error()))
+	case errors.Is(err, store.ErrQuotaNotFound):
+		return ErrQuotaNotFound
+	case errors.Is(err, store.ErrQuotaIDTaken):
+		return ErrQuotaIDTaken
+	case errors.Is(err, store.ErrQuotaReadOnly):
+		return fmt.Errorf("%w: %w", ErrAdminRequired, err)
+	case errors.Is(err, store.ErrOperationNotFound):
+		return ErrOperationNotFound
+	case errors.Is(err, store.ErrOperationNotCancellable):
+		return fmt.Errorf("%w%s", ErrOperationNotCancellable, strings.TrimPrefix(err.Error(), store.ErrOperationNotCancellable.Error()))
+	case errors.Is(err, store.ErrWebhookSubscriptionNotFound):
+		return ErrWebhookSubscriptionNotFound
+	case errors.Is(err, store.ErrWebhookSubscriptionIDTaken):
+		return ErrWebhookSubscriptionIDTaken
 	default:
 		return err
 	}
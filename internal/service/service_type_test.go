@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/service"
 	"github.com/dcm-project/catalog-manager/internal/store"
 	"github.com/dcm-project/catalog-manager/internal/store/model"
@@ -29,7 +30,7 @@ var _ = Describe("ServiceType Service", func() {
 			Logger: logger.Discard,
 		})
 		Expect(err).ToNot(HaveOccurred())
-		err = db.AutoMigrate(&model.ServiceType{})
+		err = db.AutoMigrate(&model.ServiceType{}, &model.OutboxEvent{})
 		Expect(err).ToNot(HaveOccurred())
 		str = store.NewStore(db)
 		svc = service.NewService(str)
@@ -250,6 +251,20 @@ var _ = Describe("ServiceType Service", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(retrieved.Metadata).To(BeNil())
 			})
+
+			It("should reject malformed JSON Schema specs", func() {
+				req := &service.CreateServiceTypeRequest{
+					ApiVersion:  "v1alpha1",
+					ServiceType: "vm",
+					Spec: map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"vcpu": map[string]any{"type": "decimal"}},
+					},
+				}
+
+				_, err := svc.ServiceType().Create(ctx, req)
+				Expect(err).To(MatchError(service.ErrInvalidServiceTypeVersion))
+			})
 		})
 	})
 
@@ -329,4 +344,140 @@ var _ = Describe("ServiceType Service", func() {
 			Expect(result3.NextPageToken).To(BeNil())
 		})
 	})
+
+	Describe("Update", func() {
+		var id string
+
+		BeforeEach(func() {
+			created, err := svc.ServiceType().Create(ctx, &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1alpha1",
+				ServiceType: "vm",
+				Spec:        map[string]any{"vcpu": 2},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*created.Default).To(BeTrue())
+			id = *created.Uid
+		})
+
+		It("should deprecate and undeprecate a version", func() {
+			deprecated := true
+			message := "use v1"
+			result, err := svc.ServiceType().Update(ctx, id, &service.UpdateServiceTypeRequest{
+				Deprecated:         &deprecated,
+				DeprecationMessage: &message,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Deprecated).To(BeTrue())
+			Expect(*result.DeprecationMessage).To(Equal(message))
+
+			deprecated = false
+			result, err = svc.ServiceType().Update(ctx, id, &service.UpdateServiceTypeRequest{Deprecated: &deprecated})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Deprecated).To(BeFalse())
+			Expect(result.DeprecationMessage).To(BeNil())
+		})
+
+		It("should reject unsetting the default", func() {
+			isDefault := false
+			_, err := svc.ServiceType().Update(ctx, id, &service.UpdateServiceTypeRequest{Default: &isDefault})
+			Expect(err).To(MatchError(service.ErrInvalidServiceTypeUpdate))
+		})
+
+		It("should reject a deprecation message on a version that is not deprecated", func() {
+			message := "use v1"
+			_, err := svc.ServiceType().Update(ctx, id, &service.UpdateServiceTypeRequest{DeprecationMessage: &message})
+			Expect(err).To(MatchError(service.ErrInvalidServiceTypeUpdate))
+		})
+
+		It("should map ErrServiceTypeNotFound", func() {
+			_, err := svc.ServiceType().Update(ctx, "non-existent", &service.UpdateServiceTypeRequest{})
+			Expect(err).To(Equal(service.ErrServiceTypeNotFound))
+		})
+	})
+
+	Describe("Apply", func() {
+		newRequest := func() *service.CreateServiceTypeRequest {
+			return &service.CreateServiceTypeRequest{
+				ApiVersion:  "v1alpha1",
+				ServiceType: "vm",
+				Spec:        map[string]any{"vcpu": map[string]any{"count": 2}},
+			}
+		}
+
+		It("should create a missing service type version", func() {
+			result, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", newRequest(), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionCreated))
+			Expect(*result.ServiceType.Path).To(Equal("service-types/vm-v1alpha1"))
+			Expect(*result.ServiceType.Default).To(BeTrue())
+			Expect(result.Changes).To(ContainElement(HaveField("Path", "spec")))
+			Expect(result.Changes).ToNot(ContainElement(HaveField("Path", "uid")))
+
+			_, err = svc.ServiceType().Get(ctx, "vm-v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not persist anything with validate_only", func() {
+			result, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", newRequest(), true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionCreated))
+			Expect(*result.ServiceType.Default).To(BeTrue())
+
+			_, err = svc.ServiceType().Get(ctx, "vm-v1alpha1")
+			Expect(err).To(MatchError(service.ErrServiceTypeNotFound))
+		})
+
+		It("should leave an unchanged version alone", func() {
+			_, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", newRequest(), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", newRequest(), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionUnchanged))
+			Expect(result.Changes).To(BeEmpty())
+			Expect(*result.ServiceType.Default).To(BeTrue())
+		})
+
+		It("should update the deprecation status of an existing version", func() {
+			_, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", newRequest(), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest()
+			req.Deprecated = true
+			message := "use v1"
+			req.DeprecationMessage = &message
+			result, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", req, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Action).To(Equal(v1alpha1.ApplyActionUpdated))
+			Expect(result.Changes).To(ConsistOf(
+				v1alpha1.ApplyChange{Path: "deprecated", Type: v1alpha1.ApplyChangeModified, Before: false, After: true},
+				v1alpha1.ApplyChange{Path: "deprecation_message", Type: v1alpha1.ApplyChangeAdded, After: message},
+			))
+
+			stored, err := svc.ServiceType().Get(ctx, "vm-v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*stored.Deprecated).To(BeTrue())
+		})
+
+		It("should reject changes to the spec of an existing version", func() {
+			_, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", newRequest(), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			req := newRequest()
+			req.Spec = map[string]any{"vcpu": map[string]any{"count": 4}}
+			_, err = svc.ServiceType().Apply(ctx, "vm-v1alpha1", req, false)
+			Expect(err).To(MatchError(service.ErrInvalidServiceTypeUpdate))
+			Expect(err.Error()).To(ContainSubstring("spec.vcpu.count"))
+		})
+
+		It("should reject a version already published under another ID", func() {
+			_, err := svc.ServiceType().Apply(ctx, "vm-v1alpha1", newRequest(), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.ServiceType().Apply(ctx, "other", newRequest(), true)
+			Expect(err).To(MatchError(service.ErrServiceTypeNameTaken))
+			_, err = svc.ServiceType().Apply(ctx, "other", newRequest(), false)
+			Expect(err).To(MatchError(service.ErrServiceTypeNameTaken))
+		})
+	})
 })
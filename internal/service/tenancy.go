@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+// resolveParent validates an optional parent against the caller's tenant and
+// returns the tenant it names. An empty result means no parent was given.
+func resolveParent(ctx context.Context, parent *string) (string, error) {
+	if parent == nil || *parent == "" {
+		return "", nil
+	}
+	tenant, err := tenancy.ParseParent(*parent)
+	if err != nil {
+		return "", ErrInvalidParent
+	}
+	if caller, ok := tenancy.FromContext(ctx); ok && caller != tenant {
+		return "", ErrTenantMismatch
+	}
+	return tenant, nil
+}
+
+// resolveAdministeredParent validates an optional parent given by an
+// administrator, who may name any tenant, and returns the tenant it names.
+// An empty result means no parent was given.
+func resolveAdministeredParent(parent *string) (string, error) {
+	if parent == nil || *parent == "" {
+		return "", nil
+	}
+	tenant, err := tenancy.ParseParent(*parent)
+	if err != nil {
+		return "", ErrInvalidParent
+	}
+	return tenant, nil
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/google/uuid"
+)
+
+// CreateWebhookSubscriptionRequest contains the parameters for creating a webhook subscription
+type CreateWebhookSubscriptionRequest struct {
+	ID             *string // Optional user-specified ID
+	RequestID      string  // Optional idempotency key (AEP-155)
+	Parent         *string // Optional tenant parent (tenants/{tenant_id}); the caller's tenant when omitted
+	URL            string
+	EventTypes     []string
+	ResourceFilter string
+	Secret         string
+}
+
+// WebhookSubscriptionListOptions contains options for listing webhook subscriptions
+type WebhookSubscriptionListOptions struct {
+	PageToken   *string
+	MaxPageSize *int32
+	Parent      *string
+}
+
+// WebhookSubscriptionListResult contains the result of a List operation
+type WebhookSubscriptionListResult struct {
+	WebhookSubscriptions []v1alpha1.WebhookSubscription
+	NextPageToken        *string
+}
+
+// WebhookDeliveryListOptions contains options for listing webhook deliveries
+type WebhookDeliveryListOptions struct {
+	PageToken   *string
+	MaxPageSize *int32
+}
+
+// WebhookDeliveryListResult contains the result of a ListDeliveries operation
+type WebhookDeliveryListResult struct {
+	WebhookDeliveries []v1alpha1.WebhookDelivery
+	NextPageToken     *string
+}
+
+// WebhookSubscriptionService defines the business logic for WebhookSubscription operations
+type WebhookSubscriptionService interface {
+	List(ctx context.Context, opts *WebhookSubscriptionListOptions) (*WebhookSubscriptionListResult, error)
+	Create(ctx context.Context, req *CreateWebhookSubscriptionRequest) (*v1alpha1.WebhookSubscription, error)
+	Get(ctx context.Context, id string) (*v1alpha1.WebhookSubscription, error)
+	Delete(ctx context.Context, id string) error
+	// Test sends a ping event to the subscription once and returns the delivery
+	Test(ctx context.Context, id string) (*v1alpha1.WebhookDelivery, error)
+	// ListDeliveries returns the delivery log of a subscription, most recent first
+	ListDeliveries(ctx context.Context, id string, opts *WebhookDeliveryListOptions) (*WebhookDeliveryListResult, error)
+}
+
+type webhookSubscriptionService struct {
+	store  store.Store
+	tester WebhookTester
+	keys   *idempotencyKeys
+}
+
+// newWebhookSubscriptionService creates a new WebhookSubscriptionService instance
+func newWebhookSubscriptionService(store store.Store, tester WebhookTester, keys *idempotencyKeys) WebhookSubscriptionService {
+	return &webhookSubscriptionService{store: store, tester: tester, keys: keys}
+}
+
+// List returns a paginated list of the caller's webhook subscriptions
+func (s *webhookSubscriptionService) List(ctx context.Context, opts *WebhookSubscriptionListOptions) (*WebhookSubscriptionListResult, error) {
+	storeOpts := &store.WebhookSubscriptionListOptions{PageSize: 100}
+	if opts != nil {
+		tenant, err := resolveParent(ctx, opts.Parent)
+		if err != nil {
+			return nil, err
+		}
+		if tenant != "" {
+			storeOpts.Tenant = &tenant
+		}
+		storeOpts.PageToken = opts.PageToken
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.WebhookSubscription().List(ctx, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiSubscriptions := make([]v1alpha1.WebhookSubscription, len(storeResult.WebhookSubscriptions))
+	for i, storeModel := range storeResult.WebhookSubscriptions {
+		apiSubscriptions[i] = toWebhookSubscriptionAPIType(&storeModel)
+	}
+
+	return &WebhookSubscriptionListResult{
+		WebhookSubscriptions: apiSubscriptions,
+		NextPageToken:        storeResult.NextPageToken,
+	}, nil
+}
+
+// Create creates a new webhook subscription for the caller's tenant
+func (s *webhookSubscriptionService) Create(ctx context.Context, req *CreateWebhookSubscriptionRequest) (*v1alpha1.WebhookSubscription, error) {
+	return createIdempotent(ctx, s.keys, "CreateWebhookSubscription", req.RequestID, req, func(ctx context.Context) (*v1alpha1.WebhookSubscription, error) {
+		return s.create(ctx, req)
+	}, lookupResource(s.Get))
+}
+
+// create creates the webhook subscription of req
+func (s *webhookSubscriptionService) create(ctx context.Context, req *CreateWebhookSubscriptionRequest) (*v1alpha1.WebhookSubscription, error) {
+	tenant, err := resolveParent(ctx, req.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == "" {
+		var ok bool
+		if tenant, ok = tenancy.FromContext(ctx); !ok {
+			return nil, ErrTenantRequired
+		}
+	}
+
+	id := uuid.New().String()
+	if req.ID != nil && *req.ID != "" {
+		id = *req.ID
+	}
+
+	storeModel, err := toWebhookSubscriptionStoreModel(id, webhookSubscriptionPath(tenant, id), tenant, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tester.CheckTarget(ctx, storeModel.URL); err != nil {
+		return nil, fmt.Errorf("%w: url: %w", ErrInvalidWebhookSubscription, err)
+	}
+
+	createdModel, err := s.store.WebhookSubscription().Create(ctx, storeModel)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiSubscription := toWebhookSubscriptionAPIType(createdModel)
+	return &apiSubscription, nil
+}
+
+// Get retrieves one of the caller's webhook subscriptions by ID
+func (s *webhookSubscriptionService) Get(ctx context.Context, id string) (*v1alpha1.WebhookSubscription, error) {
+	storeModel, err := s.store.WebhookSubscription().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	apiSubscription := toWebhookSubscriptionAPIType(storeModel)
+	return &apiSubscription, nil
+}
+
+// Delete deletes one of the caller's webhook subscriptions by ID
+func (s *webhookSubscriptionService) Delete(ctx context.Context, id string) error {
+	return mapStoreError(s.store.WebhookSubscription().Delete(ctx, id))
+}
+
+// Test sends a ping event to one of the caller's webhook subscriptions
+func (s *webhookSubscriptionService) Test(ctx context.Context, id string) (*v1alpha1.WebhookDelivery, error) {
+	subscription, err := s.store.WebhookSubscription().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	delivery, err := s.tester.Test(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	apiDelivery := toWebhookDeliveryAPIType(subscription.Path, delivery)
+	return &apiDelivery, nil
+}
+
+// ListDeliveries returns the delivery log of one of the caller's webhook subscriptions
+func (s *webhookSubscriptionService) ListDeliveries(ctx context.Context, id string, opts *WebhookDeliveryListOptions) (*WebhookDeliveryListResult, error) {
+	subscription, err := s.store.WebhookSubscription().Get(ctx, id)
+	if err != nil {
+		return nil, mapStoreError(err)
+	}
+
+	storeOpts := &store.WebhookDeliveryListOptions{PageSize: 100}
+	if opts != nil {
+		storeOpts.PageToken = opts.PageToken
+		if opts.MaxPageSize != nil {
+			storeOpts.PageSize = int(*opts.MaxPageSize)
+		}
+	}
+
+	storeResult, err := s.store.WebhookDelivery().List(ctx, subscription.ID, storeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	apiDeliveries := make([]v1alpha1.WebhookDelivery, len(storeResult.WebhookDeliveries))
+	for i := range storeResult.WebhookDeliveries {
+		apiDeliveries[i] = toWebhookDeliveryAPIType(subscription.Path, &storeResult.WebhookDeliveries[i])
+	}
+
+	return &WebhookDeliveryListResult{
+		WebhookDeliveries: apiDeliveries,
+		NextPageToken:     storeResult.NextPageToken,
+	}, nil
+}
+
+// webhookSubscriptionPath returns the resource path of a webhook subscription
+func webhookSubscriptionPath(tenant, id string) string {
+	return fmt.Sprintf("tenants/%s/webhook-subscriptions/%s", tenant, id)
+}
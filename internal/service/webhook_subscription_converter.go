@@ -0,0 +1,85 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// minWebhookSecretLength is the minimum length of a webhook subscription secret
+const minWebhookSecretLength = 16
+
+// toWebhookSubscriptionStoreModel validates a CreateWebhookSubscriptionRequest and converts it to a store model
+func toWebhookSubscriptionStoreModel(id, path, tenant string, req *CreateWebhookSubscriptionRequest) (model.WebhookSubscription, error) {
+	target, err := url.Parse(req.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return model.WebhookSubscription{}, fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhookSubscription)
+	}
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(model.EventTypes, eventType) {
+			return model.WebhookSubscription{}, fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhookSubscription, eventType)
+		}
+	}
+	if len(req.Secret) < minWebhookSecretLength {
+		return model.WebhookSubscription{}, fmt.Errorf("%w: secret must be at least %d characters", ErrInvalidWebhookSubscription, minWebhookSecretLength)
+	}
+
+	return model.WebhookSubscription{
+		ID:             id,
+		Tenant:         tenant,
+		URL:            req.URL,
+		EventTypes:     req.EventTypes,
+		ResourceFilter: req.ResourceFilter,
+		Secret:         req.Secret,
+		Path:           path,
+	}, nil
+}
+
+// toWebhookSubscriptionAPIType converts a store model to an API type. The secret is never returned.
+func toWebhookSubscriptionAPIType(m *model.WebhookSubscription) v1alpha1.WebhookSubscription {
+	apiSubscription := v1alpha1.WebhookSubscription{
+		Url:        m.URL,
+		Path:       &m.Path,
+		Uid:        &m.ID,
+		CreateTime: &m.CreateTime,
+		UpdateTime: &m.UpdateTime,
+	}
+	if len(m.EventTypes) > 0 {
+		apiSubscription.EventTypes = &m.EventTypes
+	}
+	if m.ResourceFilter != "" {
+		apiSubscription.ResourceFilter = &m.ResourceFilter
+	}
+	return apiSubscription
+}
+
+// toWebhookDeliveryAPIType converts a store model to an API type
+func toWebhookDeliveryAPIType(subscriptionPath string, m *model.WebhookDelivery) v1alpha1.WebhookDelivery {
+	path := fmt.Sprintf("%s/deliveries/%s", subscriptionPath, m.ID)
+	state := v1alpha1.WebhookDeliveryState(m.State)
+	attempts := int32(m.Attempts)
+	apiDelivery := v1alpha1.WebhookDelivery{
+		Uid:        &m.ID,
+		Path:       &path,
+		EventId:    &m.EventID,
+		EventType:  &m.EventType,
+		State:      &state,
+		Attempts:   &attempts,
+		CreateTime: &m.CreateTime,
+		UpdateTime: &m.UpdateTime,
+	}
+	if m.ResponseCode != 0 {
+		code := int32(m.ResponseCode)
+		apiDelivery.ResponseCode = &code
+	}
+	if m.LastError != "" {
+		apiDelivery.LastError = &m.LastError
+	}
+	if m.State == model.WebhookDeliveryStatePending {
+		apiDelivery.NextAttemptTime = &m.NextAttemptTime
+	}
+	return apiDelivery
+}
@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/service"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/dcm-project/catalog-manager/internal/webhooks"
+)
+
+var _ = Describe("WebhookSubscription Service", func() {
+	var (
+		teamA context.Context
+		teamB context.Context
+		str   store.Store
+		svc   service.Service
+		srv   *httptest.Server
+	)
+
+	stringPtr := func(s string) *string { return &s }
+
+	createRequest := func(id string) *service.CreateWebhookSubscriptionRequest {
+		return &service.CreateWebhookSubscriptionRequest{
+			ID:     &id,
+			URL:    srv.URL,
+			Secret: "0123456789abcdef",
+		}
+	}
+
+	BeforeEach(func() {
+		teamA = tenancy.NewContext(context.Background(), "team-a")
+		teamB = tenancy.NewContext(context.Background(), "team-b")
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		Expect(db.AutoMigrate(&model.WebhookSubscription{}, &model.WebhookDelivery{})).To(Succeed())
+		str = store.NewStore(db)
+		// The test endpoint listens on the loopback address
+		svc = service.NewService(str, service.WithWebhookTester(webhooks.NewDispatcher(str, webhooks.Config{AllowPrivateTargets: true})))
+
+		srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		}))
+	})
+
+	AfterEach(func() {
+		srv.Close()
+		if str != nil {
+			Expect(str.Close()).To(Succeed())
+		}
+	})
+
+	Describe("Create", func() {
+		It("should create a subscription without returning its secret", func() {
+			req := createRequest("billing")
+			req.EventTypes = []string{model.EventCatalogItemInstanceStateChange}
+			req.ResourceFilter = "tenants/team-a/"
+
+			result, err := svc.WebhookSubscription().Create(teamA, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Path).To(Equal("tenants/team-a/webhook-subscriptions/billing"))
+			Expect(*result.EventTypes).To(Equal(req.EventTypes))
+			Expect(*result.ResourceFilter).To(Equal("tenants/team-a/"))
+			Expect(result.Secret).To(BeNil())
+		})
+
+		It("should reject URLs other than http and https", func() {
+			req := createRequest("billing")
+			req.URL = "ftp://example.com/hook"
+			_, err := svc.WebhookSubscription().Create(teamA, req)
+			Expect(err).To(MatchError(service.ErrInvalidWebhookSubscription))
+		})
+
+		It("should reject private and link-local targets by default", func() {
+			svc = service.NewService(str)
+			req := createRequest("billing")
+			req.URL = "http://169.254.169.254/latest/meta-data"
+			_, err := svc.WebhookSubscription().Create(teamA, req)
+			Expect(err).To(MatchError(service.ErrInvalidWebhookSubscription))
+			Expect(err).To(MatchError(webhooks.ErrTargetNotAllowed))
+
+			_, err = svc.WebhookSubscription().Create(teamA, createRequest("local"))
+			Expect(err).To(MatchError(webhooks.ErrTargetNotAllowed))
+		})
+
+		It("should reject unknown event types", func() {
+			req := createRequest("billing")
+			req.EventTypes = []string{"io.dcm.catalog.catalog_item.exploded"}
+			_, err := svc.WebhookSubscription().Create(teamA, req)
+			Expect(err).To(MatchError(service.ErrInvalidWebhookSubscription))
+		})
+
+		It("should require a secret", func() {
+			req := createRequest("billing")
+			req.Secret = ""
+			_, err := svc.WebhookSubscription().Create(teamA, req)
+			Expect(err).To(MatchError(service.ErrInvalidWebhookSubscription))
+		})
+
+		It("should reject a parent of another tenant", func() {
+			req := createRequest("billing")
+			req.Parent = stringPtr("tenants/team-b")
+			_, err := svc.WebhookSubscription().Create(teamA, req)
+			Expect(err).To(MatchError(service.ErrTenantMismatch))
+		})
+
+		It("should require a tenant", func() {
+			_, err := svc.WebhookSubscription().Create(context.Background(), createRequest("billing"))
+			Expect(err).To(MatchError(service.ErrTenantRequired))
+		})
+	})
+
+	Describe("Test", func() {
+		It("should send a ping and list it in the delivery log", func() {
+			_, err := svc.WebhookSubscription().Create(teamA, createRequest("billing"))
+			Expect(err).ToNot(HaveOccurred())
+
+			delivery, err := svc.WebhookSubscription().Test(teamA, "billing")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*delivery.State).To(Equal(v1alpha1.WebhookDeliverySucceeded))
+			Expect(*delivery.ResponseCode).To(Equal(int32(http.StatusAccepted)))
+			Expect(*delivery.EventType).To(Equal(model.EventWebhookPing))
+			Expect(*delivery.Path).To(Equal("tenants/team-a/webhook-subscriptions/billing/deliveries/" + *delivery.Uid))
+			Expect(delivery.NextAttemptTime).To(BeNil())
+
+			result, err := svc.WebhookSubscription().ListDeliveries(teamA, "billing", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.WebhookDeliveries).To(ConsistOf(HaveField("Uid", delivery.Uid)))
+		})
+
+		It("should not test the subscriptions of another tenant", func() {
+			_, err := svc.WebhookSubscription().Create(teamA, createRequest("billing"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = svc.WebhookSubscription().Test(teamB, "billing")
+			Expect(err).To(MatchError(service.ErrWebhookSubscriptionNotFound))
+			_, err = svc.WebhookSubscription().ListDeliveries(teamB, "billing", nil)
+			Expect(err).To(MatchError(service.ErrWebhookSubscriptionNotFound))
+		})
+	})
+})
@@ -0,0 +1,209 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/audit"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Audited resource types
+const (
+	auditServiceType         = "service_type"
+	auditCatalogItem         = "catalog_item"
+	auditCatalogItemInstance = "catalog_item_instance"
+	auditQuota               = "quota"
+	auditOperation           = "operation"
+	auditWebhookSubscription = "webhook_subscription"
+)
+
+// AuditEventListOptions contains options for listing audit events
+type AuditEventListOptions struct {
+	PageToken *string
+	PageSize  int
+	// Tenant restricts the results to the changes made by a tenant
+	Tenant *string
+	// Resource restricts the results to the changes to a resource path
+	Resource *string
+	Actor    *string
+	// StartTime and EndTime restrict the results to the changes made in [StartTime, EndTime)
+	StartTime *time.Time
+	EndTime   *time.Time
+}
+
+// AuditEventListResult contains the result of a List operation
+type AuditEventListResult struct {
+	AuditEvents   model.AuditEventList
+	NextPageToken *string
+}
+
+// AuditEventStore gives read access to the audit log. Audit events are
+// recorded by the other stores, in the transaction of each audited change,
+// and are never modified.
+type AuditEventStore interface {
+	// List returns audit events, newest first
+	List(ctx context.Context, opts *AuditEventListOptions) (*AuditEventListResult, error)
+}
+
+type auditEventStore struct {
+	db *gorm.DB
+}
+
+// NewAuditEventStore creates a new AuditEvent store
+func NewAuditEventStore(db *gorm.DB) AuditEventStore {
+	return &auditEventStore{db: db}
+}
+
+// List returns a paginated list of audit events, newest first
+func (s *auditEventStore) List(ctx context.Context, opts *AuditEventListOptions) (*AuditEventListResult, error) {
+	var events model.AuditEventList
+	query := scopeTenantOwned(ctx, s.db.WithContext(ctx))
+
+	// Default max page size
+	pageSize := 100
+	if opts != nil && opts.PageSize > 0 {
+		pageSize = opts.PageSize
+	}
+
+	// Decode page token to get offset
+	offset := 0
+	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*opts.PageToken)
+		if err == nil {
+			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
+				offset = parsedOffset
+			}
+		}
+	}
+
+	query = query.Order("time DESC").Order("id ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil {
+		if opts.Tenant != nil {
+			query = query.Where("tenant = ?", *opts.Tenant)
+		}
+		if opts.Resource != nil {
+			query = query.Where("resource = ?", *opts.Resource)
+		}
+		if opts.Actor != nil {
+			query = query.Where("actor = ?", *opts.Actor)
+		}
+		if opts.StartTime != nil {
+			query = query.Where("time >= ?", opts.StartTime.UTC())
+		}
+		if opts.EndTime != nil {
+			query = query.Where("time < ?", opts.EndTime.UTC())
+		}
+	}
+
+	if err := query.Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	result := &AuditEventListResult{
+		AuditEvents: events,
+	}
+	if len(events) > pageSize {
+		result.AuditEvents = events[:pageSize]
+		nextOffset := offset + pageSize
+		nextPageToken := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		result.NextPageToken = &nextPageToken
+	}
+	return result, nil
+}
+
+// recordAudit writes an audit event within tx for a change made by the
+// request carried by ctx. Changes made without a request, by internal
+// callers, are not audited. before and after are nil when the resource did
+// not exist before or after the change.
+func recordAudit(ctx context.Context, tx *gorm.DB, action, resourceType, resource string, before, after map[string]any) error {
+	req, ok := audit.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tenant, _ := tenancy.FromContext(ctx)
+	id := uuid.New().String()
+
+	event := model.AuditEvent{
+		ID:           id,
+		Tenant:       tenant,
+		Actor:        req.Actor,
+		Action:       action,
+		ResourceType: resourceType,
+		Resource:     resource,
+		RequestID:    req.ID,
+		Before:       before,
+		After:        after,
+		Path:         auditEventPath(tenant, id),
+		Time:         time.Now().UTC(),
+	}
+	if before != nil && after != nil {
+		changes, err := audit.Diff(before, after)
+		if err != nil {
+			return fmt.Errorf("failed to compute the changes to %s: %w", resource, err)
+		}
+		for _, c := range changes {
+			event.Changes = append(event.Changes, model.AuditChange(c))
+		}
+	}
+	if err := tx.Create(&event).Error; err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
+
+// auditEventPath returns the resource path of an audit event
+func auditEventPath(tenant, id string) string {
+	if tenant == "" {
+		return "audit-events/" + id
+	}
+	return tenancy.ParentPath(tenant) + "/audit-events/" + id
+}
+
+// quotaAuditData is the audited representation of a quota
+func quotaAuditData(m *model.Quota) map[string]any {
+	return map[string]any{
+		"uid":             m.ID,
+		"path":            m.Path,
+		"service_type":    m.ServiceType,
+		"catalog_item_id": m.CatalogItemId,
+		"max_instances":   m.MaxInstances,
+		"max_vcpu":        m.MaxVcpu,
+		"max_memory_mb":   m.MaxMemoryMB,
+		"max_storage_mb":  m.MaxStorageMB,
+	}
+}
+
+// operationAuditData is the audited representation of an operation
+func operationAuditData(m *model.Operation) map[string]any {
+	data := map[string]any{
+		"uid":              m.ID,
+		"path":             m.Path,
+		"type":             m.Type,
+		"target":           m.TargetPath,
+		"done":             m.Done,
+		"cancel_requested": m.CancelRequested,
+	}
+	if m.Error != nil {
+		data["error"] = m.Error
+	}
+	return data
+}
+
+// webhookSubscriptionAuditData is the audited representation of a webhook
+// subscription. The secret is never recorded.
+func webhookSubscriptionAuditData(m *model.WebhookSubscription) map[string]any {
+	return map[string]any{
+		"uid":             m.ID,
+		"path":            m.Path,
+		"url":             m.URL,
+		"event_types":     m.EventTypes,
+		"resource_filter": m.ResourceFilter,
+	}
+}
@@ -0,0 +1,158 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/internal/audit"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("AuditEvent Store", func() {
+	var (
+		alice context.Context
+		bob   context.Context
+		str   store.Store
+	)
+
+	list := func(ctx context.Context, opts *store.AuditEventListOptions) model.AuditEventList {
+		result, err := str.AuditEvent().List(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		return result.AuditEvents
+	}
+
+	BeforeEach(func() {
+		teamA := tenancy.NewContext(context.Background(), "team-a")
+		alice = audit.NewContext(tenancy.NewAdminContext(teamA), audit.Request{Actor: "alice", ID: "req-1"})
+		bob = audit.NewContext(tenancy.NewAdminContext(tenancy.NewContext(context.Background(), "team-b")), audit.Request{Actor: "bob", ID: "req-2"})
+
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{},
+			&model.Quota{}, &model.Operation{}, &model.OutboxEvent{}, &model.WebhookSubscription{}, &model.AuditEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+
+		_, err = str.ServiceType().Create(context.Background(), model.ServiceType{
+			ID: "vm", ApiVersion: "v1alpha1", ServiceType: "vm", Spec: map[string]any{}, Path: "service-types/vm",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(str.Close()).To(Succeed())
+	})
+
+	It("should record the fields changed by a catalog item update", func() {
+		_, err := str.CatalogItem().Create(alice, model.CatalogItem{
+			ID: "small-vm", ApiVersion: "v1alpha1", DisplayName: "Small VM", Path: "catalog-items/small-vm",
+			Spec: model.CatalogItemSpec{ServiceType: "vm", Fields: []model.FieldConfiguration{
+				{Path: "spec.vcpu.count", Editable: true, Default: 2},
+				{Path: "spec.guest_os.type", Default: "rhel-9"},
+			}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(str.CatalogItem().Update(alice, &model.CatalogItem{
+			ID: "small-vm", DisplayName: "Small VM",
+			Spec: model.CatalogItemSpec{ServiceType: "vm", Fields: []model.FieldConfiguration{
+				{Path: "spec.vcpu.count", Editable: true, Default: 4},
+				{Path: "spec.guest_os.type", Default: "rhel-9"},
+			}},
+		})).To(Succeed())
+
+		events := list(alice, nil)
+		Expect(events).To(HaveLen(2))
+		update := events[0]
+		Expect(update.Action).To(Equal(model.AuditActionUpdate))
+		Expect(update.Actor).To(Equal("alice"))
+		Expect(update.RequestID).To(Equal("req-1"))
+		Expect(update.Tenant).To(Equal("team-a"))
+		Expect(update.ResourceType).To(Equal("catalog_item"))
+		Expect(update.Resource).To(Equal("catalog-items/small-vm"))
+		Expect(update.Path).To(HavePrefix("tenants/team-a/audit-events/"))
+		Expect(update.Before).ToNot(BeNil())
+		Expect(update.After).ToNot(BeNil())
+		Expect(update.Changes).To(HaveLen(2))
+		Expect(update.Changes[0]).To(Equal(model.AuditChange{Path: "revision", Before: float64(1), After: float64(2)}))
+		Expect(update.Changes[1].Path).To(Equal("spec.fields[spec.vcpu.count]"))
+		Expect(update.Changes[1].Before).To(HaveKeyWithValue("default", float64(2)))
+		Expect(update.Changes[1].After).To(HaveKeyWithValue("default", float64(4)))
+
+		create := events[1]
+		Expect(create.Action).To(Equal(model.AuditActionCreate))
+		Expect(create.Before).To(BeNil())
+		Expect(create.Changes).To(BeEmpty())
+	})
+
+	It("should not record changes made without a request", func() {
+		Expect(list(context.Background(), nil)).To(BeEmpty())
+	})
+
+	It("should not record changes that are rolled back", func() {
+		Expect(str.CatalogItem().Delete(alice, "missing")).To(MatchError(store.ErrCatalogItemNotFound))
+		Expect(list(alice, nil)).To(BeEmpty())
+	})
+
+	It("should never record webhook secrets", func() {
+		_, err := str.WebhookSubscription().Create(alice, model.WebhookSubscription{
+			ID: "hook", Tenant: "team-a", URL: "https://example.com/hook", Secret: "0123456789abcdef",
+			Path: "tenants/team-a/webhook-subscriptions/hook",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(str.WebhookSubscription().Delete(alice, "hook")).To(Succeed())
+
+		events := list(alice, nil)
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Action).To(Equal(model.AuditActionDelete))
+		Expect(events[0].After).To(BeNil())
+		for _, e := range events {
+			Expect(e.Before).ToNot(HaveKey("secret"))
+			Expect(e.After).ToNot(HaveKey("secret"))
+		}
+	})
+
+	It("should filter by tenant, resource, actor and time", func() {
+		start := time.Now()
+		for _, c := range []struct {
+			ctx context.Context
+			id  string
+		}{{alice, "a1"}, {alice, "a2"}, {bob, "b1"}} {
+			tenant, _ := tenancy.FromContext(c.ctx)
+			_, err := str.Quota().Create(c.ctx, model.Quota{ID: c.id, Tenant: tenant, Path: "tenants/" + tenant + "/quotas/" + c.id})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(list(alice, nil)).To(HaveLen(2))
+		Expect(list(bob, nil)).To(HaveLen(1))
+		Expect(list(context.Background(), nil)).To(HaveLen(3))
+
+		resource := "tenants/team-a/quotas/a2"
+		events := list(alice, &store.AuditEventListOptions{Resource: &resource})
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].After).To(HaveKeyWithValue("uid", "a2"))
+
+		actor := "bob"
+		Expect(list(context.Background(), &store.AuditEventListOptions{Actor: &actor})).To(HaveLen(1))
+
+		end := time.Now()
+		Expect(list(alice, &store.AuditEventListOptions{StartTime: &start, EndTime: &end})).To(HaveLen(2))
+		Expect(list(alice, &store.AuditEventListOptions{StartTime: &end})).To(BeEmpty())
+		Expect(list(alice, &store.AuditEventListOptions{EndTime: &start})).To(BeEmpty())
+
+		result, err := str.AuditEvent().List(alice, &store.AuditEventListOptions{PageSize: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.AuditEvents).To(HaveLen(1))
+		Expect(result.NextPageToken).ToNot(BeNil())
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -16,10 +17,15 @@ import (
 var (
 	// ErrCatalogItemNotFound is returned when a catalog item is not found
 	ErrCatalogItemNotFound = errors.New("catalog item not found")
-	// ErrCatalogItemIDTaken is returned when a catalog item ID is already taken
-	ErrCatalogItemIDTaken = errors.New("catalog item ID already exists")
+	// ErrCatalogItemIDTaken is returned when a catalog item ID is already taken,
+	// whether by a catalog item visible to the caller or not
+	ErrCatalogItemIDTaken = errors.New("catalog item ID is not available")
 	// ErrCatalogItemHasInstances is returned when attempting to delete a catalog item with existing instances
 	ErrCatalogItemHasInstances = errors.New("cannot delete catalog item with existing instances")
+	// ErrCatalogItemRevisionNotFound is returned when a revision of a catalog item is not found
+	ErrCatalogItemRevisionNotFound = errors.New("catalog item revision not found")
+	// ErrCatalogItemReadOnly is returned when a tenant changes a global catalog item
+	ErrCatalogItemReadOnly = errors.New("global catalog items are read-only to tenants")
 )
 
 // CatalogItemListOptions contains options for listing catalog items
@@ -27,12 +33,22 @@ type CatalogItemListOptions struct {
 	PageToken   *string
 	PageSize    int
 	ServiceType *string
+	// Tenant restricts the results to the private items of a tenant
+	Tenant *string
+	// SkipSpec leaves the fields of the spec of the results empty, without
+	// loading the spec; its service type and version are still set
+	SkipSpec bool
+	// CountTotal sets the total size of the result
+	CountTotal bool
 }
 
 // CatalogItemListResult contains the result of a List operation
 type CatalogItemListResult struct {
 	CatalogItems  model.CatalogItemList
 	NextPageToken *string
+	// TotalSize is the number of catalog items matching the options across
+	// all pages, if counted
+	TotalSize *int64
 }
 
 // CatalogItemStore defines operations for CatalogItem resources
@@ -40,12 +56,19 @@ type CatalogItemStore interface {
 	List(ctx context.Context, opts *CatalogItemListOptions) (*CatalogItemListResult, error)
 	Create(ctx context.Context, catalogItem model.CatalogItem) (*model.CatalogItem, error)
 	Get(ctx context.Context, id string) (*model.CatalogItem, error)
+	// BatchGet retrieves the catalog items with the given IDs in a single
+	// query, in no particular order. Missing IDs are left out.
+	BatchGet(ctx context.Context, ids []string) (model.CatalogItemList, error)
 	Update(ctx context.Context, catalogItem *model.CatalogItem) error
 	Delete(ctx context.Context, id string) error
+	// Rollback restores the display name and spec of a prior revision,
+	// recording them as a new revision
+	Rollback(ctx context.Context, id string, revision int) (*model.CatalogItem, error)
 }
 
 type catalogItemStore struct {
-	db *gorm.DB
+	db      *gorm.DB
+	changes *changeNotifier
 }
 
 // NewCatalogItemStore creates a new CatalogItem store
@@ -56,7 +79,7 @@ func NewCatalogItemStore(db *gorm.DB) CatalogItemStore {
 // List returns a paginated list of catalog items
 func (s *catalogItemStore) List(ctx context.Context, opts *CatalogItemListOptions) (*CatalogItemListResult, error) {
 	var catalogItems model.CatalogItemList
-	query := s.db.WithContext(ctx)
+	query := scopeCatalogItems(ctx, s.db.WithContext(ctx))
 
 	// Default max page size
 	pageSize := 100
@@ -75,18 +98,32 @@ func (s *catalogItemStore) List(ctx context.Context, opts *CatalogItemListOption
 		}
 	}
 
-	query = query.Order("id ASC").Limit(pageSize + 1).Offset(offset)
 	if opts != nil && opts.ServiceType != nil && *opts.ServiceType != "" {
 		query = query.Where("spec_service_type = ?", *opts.ServiceType)
 	}
+	if opts != nil && opts.Tenant != nil {
+		query = query.Where("tenant = ?", *opts.Tenant)
+	}
+
+	result := &CatalogItemListResult{}
+	if opts != nil && opts.CountTotal {
+		total, err := countTotal(query, &model.CatalogItem{})
+		if err != nil {
+			return nil, err
+		}
+		result.TotalSize = &total
+	}
+
+	query = query.Order("id ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil && opts.SkipSpec {
+		query = query.Omit("spec")
+	}
 
 	if err := query.Find(&catalogItems).Error; err != nil {
 		return nil, err
 	}
 
-	result := &CatalogItemListResult{
-		CatalogItems: catalogItems,
-	}
+	result.CatalogItems = catalogItems
 	if len(catalogItems) > pageSize {
 		result.CatalogItems = catalogItems[:pageSize]
 		nextOffset := offset + pageSize
@@ -96,10 +133,46 @@ func (s *catalogItemStore) List(ctx context.Context, opts *CatalogItemListOption
 	return result, nil
 }
 
-// Create creates a new catalog item
+// Create creates a new catalog item. Only privileged callers create global items.
 func (s *catalogItemStore) Create(ctx context.Context, catalogItem model.CatalogItem) (*model.CatalogItem, error) {
+	if catalogItem.Tenant == "" && !tenancy.IsPrivileged(ctx) {
+		return nil, ErrCatalogItemReadOnly
+	}
 	catalogItem.SpecServiceType = catalogItem.Spec.ServiceType
-	if err := s.db.WithContext(ctx).Clauses(clause.Returning{}).Create(&catalogItem).Error; err != nil {
+	catalogItem.Revision = 1
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		// Pin the default version of the service type unless a version is given
+		if catalogItem.Spec.ServiceTypeVersion == "" {
+			var st model.ServiceType
+			if err := tx.Where("service_type = ? AND default_version", catalogItem.Spec.ServiceType).First(&st).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrServiceTypeNotFound
+				}
+				return fmt.Errorf("failed to get default service type version: %w", err)
+			}
+			catalogItem.Spec.ServiceTypeVersion = st.ApiVersion
+		}
+		catalogItem.SpecServiceTypeVersion = catalogItem.Spec.ServiceTypeVersion
+
+		if err := tx.Clauses(clause.Returning{}).Create(&catalogItem).Error; err != nil {
+			return err
+		}
+		if err := recordRevision(tx, &catalogItem); err != nil {
+			return err
+		}
+		data := catalogItemEventData(&catalogItem)
+		if err := recordEvent(tx, model.EventCatalogItemCreated, catalogItem.Path, catalogItem.Tenant, data); err != nil {
+			return err
+		}
+		if err := recordIdempotencyKey(ctx, tx, catalogItem.Path); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, model.AuditActionCreate, auditCatalogItem, catalogItem.Path, nil, data)
+	})
+	if errors.Is(err, ErrServiceTypeNotFound) || errors.Is(err, ErrIdempotencyKeyExists) {
+		return nil, err
+	}
+	if err != nil {
 		return nil, s.mapConstraintError(ctx, err, catalogItem)
 	}
 	return &catalogItem, nil
@@ -115,9 +188,11 @@ func (s *catalogItemStore) mapConstraintError(ctx context.Context, err error, at
 
 	// Check for foreign key violation first (before checking for generic constraint failed)
 	if strings.Contains(errStr, "foreign key") {
-		// Verify which constraint failed by checking if service type exists
+		// Verify which constraint failed by checking if the service type version exists
 		var st model.ServiceType
-		if err := s.db.WithContext(ctx).Where("service_type = ?", attempted.SpecServiceType).First(&st).Error; err != nil {
+		if err := s.db.WithContext(ctx).
+			Where("service_type = ? AND api_version = ?", attempted.SpecServiceType, attempted.SpecServiceTypeVersion).
+			First(&st).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return ErrServiceTypeNotFound
 			}
@@ -125,18 +200,13 @@ func (s *catalogItemStore) mapConstraintError(ctx context.Context, err error, at
 		return err
 	}
 
-	// Handle unique constraint violations
+	// Handle unique constraint violations. The ID is the only unique key. It
+	// is not looked up: whether it is taken by the caller or by another
+	// tenant's private item is not disclosed.
 	if errors.Is(err, gorm.ErrDuplicatedKey) ||
 		strings.Contains(errStr, "unique") ||
 		strings.Contains(errStr, "duplicate key") {
-		var row model.CatalogItem
-		dberr := s.db.WithContext(ctx).Where("id = ?", attempted.ID).Limit(1).First(&row).Error
-		if dberr == nil {
-			return ErrCatalogItemIDTaken
-		}
-		if !errors.Is(dberr, gorm.ErrRecordNotFound) {
-			return err
-		}
+		return ErrCatalogItemIDTaken
 	}
 
 	return err
@@ -145,7 +215,7 @@ func (s *catalogItemStore) mapConstraintError(ctx context.Context, err error, at
 // Get retrieves a catalog item by ID
 func (s *catalogItemStore) Get(ctx context.Context, id string) (*model.CatalogItem, error) {
 	var catalogItem model.CatalogItem
-	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&catalogItem).Error; err != nil {
+	if err := scopeCatalogItems(ctx, s.db.WithContext(ctx)).Where("id = ?", id).First(&catalogItem).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCatalogItemNotFound
 		}
@@ -154,38 +224,174 @@ func (s *catalogItemStore) Get(ctx context.Context, id string) (*model.CatalogIt
 	return &catalogItem, nil
 }
 
+// BatchGet retrieves the catalog items with the given IDs
+func (s *catalogItemStore) BatchGet(ctx context.Context, ids []string) (model.CatalogItemList, error) {
+	var catalogItems model.CatalogItemList
+	if err := scopeCatalogItems(ctx, s.db.WithContext(ctx)).Where("id IN ?", ids).Find(&catalogItems).Error; err != nil {
+		return nil, fmt.Errorf("failed to get catalog items: %w", err)
+	}
+	return catalogItems, nil
+}
+
 // Update updates a catalog item (only mutable fields)
 func (s *catalogItemStore) Update(ctx context.Context, catalogItem *model.CatalogItem) error {
-	// Extract service type from spec for denormalized field
+	// Extract service type from spec for denormalized fields
 	catalogItem.SpecServiceType = catalogItem.Spec.ServiceType
 
-	result := s.db.WithContext(ctx).Model(&model.CatalogItem{}).
-		Where("id = ?", catalogItem.ID).
-		Select("display_name", "spec", "spec_service_type").
-		Updates(catalogItem)
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var existing model.CatalogItem
+		if err := getWritableCatalogItem(ctx, tx, catalogItem.ID, &existing); err != nil {
+			return err
+		}
+		// The pinned service type version is immutable
+		catalogItem.Spec.ServiceTypeVersion = existing.Spec.ServiceTypeVersion
 
-	if result.Error != nil {
-		return s.mapConstraintError(ctx, result.Error, *catalogItem)
-	}
-	if result.RowsAffected == 0 {
-		return ErrCatalogItemNotFound
+		result := scopeCatalogItemWrites(ctx, tx.Model(&model.CatalogItem{})).
+			Where("id = ?", catalogItem.ID).
+			Select("display_name", "spec", "spec_service_type").
+			Updates(catalogItem)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrCatalogItemNotFound
+		}
+		return recordUpdatedCatalogItem(ctx, tx, &existing)
+	})
+	if errors.Is(err, ErrCatalogItemNotFound) || errors.Is(err, ErrCatalogItemReadOnly) {
+		return err
 	}
-	return nil
+	return s.mapConstraintError(ctx, err, *catalogItem)
 }
 
 // Delete deletes a catalog item by ID
 func (s *catalogItemStore) Delete(ctx context.Context, id string) error {
-	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&model.CatalogItem{})
-	if result.Error != nil {
-		// Check for foreign key violation (instances exist)
-		errStr := strings.ToLower(result.Error.Error())
-		if strings.Contains(errStr, "foreign key") {
-			return ErrCatalogItemHasInstances
+	return s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var catalogItem model.CatalogItem
+		if err := getWritableCatalogItem(ctx, tx, id, &catalogItem); err != nil {
+			return err
+		}
+
+		if err := scopeCatalogItemWrites(ctx, tx).Delete(&catalogItem).Error; err != nil {
+			// Check for foreign key violation (instances exist)
+			errStr := strings.ToLower(err.Error())
+			if strings.Contains(errStr, "foreign key") {
+				return ErrCatalogItemHasInstances
+			}
+			return fmt.Errorf("failed to delete catalog item: %w", err)
 		}
-		return fmt.Errorf("failed to delete catalog item: %w", result.Error)
+		if err := tx.Where("catalog_item_id = ?", id).Delete(&model.CatalogItemRevision{}).Error; err != nil {
+			return fmt.Errorf("failed to delete catalog item revisions: %w", err)
+		}
+		data := catalogItemEventData(&catalogItem)
+		if err := recordEvent(tx, model.EventCatalogItemDeleted, catalogItem.Path, catalogItem.Tenant, data); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, model.AuditActionDelete, auditCatalogItem, catalogItem.Path, data, nil)
+	})
+}
+
+// getWritableCatalogItem loads the catalog item with the given ID for a change
+// by the caller in ctx. Global items visible to a tenant are read-only to it.
+func getWritableCatalogItem(ctx context.Context, tx *gorm.DB, id string, catalogItem *model.CatalogItem) error {
+	if err := scopeCatalogItems(ctx, tx).Where("id = ?", id).First(catalogItem).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCatalogItemNotFound
+		}
+		return fmt.Errorf("failed to get catalog item: %w", err)
+	}
+	if catalogItem.Tenant == "" && !tenancy.IsPrivileged(ctx) {
+		return ErrCatalogItemReadOnly
+	}
+	return nil
+}
+
+// Rollback restores the display name and spec of a prior revision of a catalog item
+func (s *catalogItemStore) Rollback(ctx context.Context, id string, revision int) (*model.CatalogItem, error) {
+	var updated *model.CatalogItem
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var existing model.CatalogItem
+		if err := getWritableCatalogItem(ctx, tx, id, &existing); err != nil {
+			return err
+		}
+
+		var target model.CatalogItemRevision
+		if err := tx.Where("catalog_item_id = ? AND revision = ?", id, revision).First(&target).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCatalogItemRevisionNotFound
+			}
+			return fmt.Errorf("failed to get catalog item revision: %w", err)
+		}
+
+		restored := existing
+		restored.DisplayName = target.DisplayName
+		restored.Spec = target.Spec
+		restored.SpecServiceType = target.Spec.ServiceType
+		if restored.Spec.ServiceTypeVersion == "" {
+			// Revisions recorded before service type versions were pinned
+			restored.Spec.ServiceTypeVersion = existing.Spec.ServiceTypeVersion
+		}
+		restored.SpecServiceTypeVersion = restored.Spec.ServiceTypeVersion
+		if err := scopeCatalogItemWrites(ctx, tx.Model(&model.CatalogItem{})).
+			Where("id = ?", id).
+			Select("display_name", "spec", "spec_service_type", "spec_service_type_version").
+			Updates(&restored).Error; err != nil {
+			return err
+		}
+		if err := recordUpdatedCatalogItem(ctx, tx, &existing); err != nil {
+			return err
+		}
+
+		updated = &model.CatalogItem{}
+		return tx.Where("id = ?", id).First(updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// recordUpdatedCatalogItem bumps the revision of a catalog item whose mutable
+// fields were just updated, then records the new revision, the change event and
+// the audit entry. The increment is done by the database so that concurrent
+// updates, serialized by the row lock of the update, get distinct revisions.
+func recordUpdatedCatalogItem(ctx context.Context, tx *gorm.DB, existing *model.CatalogItem) error {
+	if err := tx.Model(&model.CatalogItem{}).
+		Where("id = ?", existing.ID).
+		UpdateColumn("revision", gorm.Expr("revision + 1")).Error; err != nil {
+		return fmt.Errorf("failed to bump catalog item revision: %w", err)
+	}
+
+	var updated model.CatalogItem
+	if err := tx.Where("id = ?", existing.ID).First(&updated).Error; err != nil {
+		return fmt.Errorf("failed to get updated catalog item: %w", err)
+	}
+	if err := recordRevision(tx, &updated); err != nil {
+		return err
+	}
+	data := catalogItemEventData(&updated)
+	if err := recordEvent(tx, model.EventCatalogItemUpdated, updated.Path, updated.Tenant, data); err != nil {
+		return err
 	}
-	if result.RowsAffected == 0 {
-		return ErrCatalogItemNotFound
+	return recordAudit(ctx, tx, model.AuditActionUpdate, auditCatalogItem, updated.Path, catalogItemEventData(existing), data)
+}
+
+// recordRevision stores the current revision of a catalog item
+func recordRevision(tx *gorm.DB, catalogItem *model.CatalogItem) error {
+	revision := model.CatalogItemRevision{
+		CatalogItemID: catalogItem.ID,
+		Revision:      catalogItem.Revision,
+		DisplayName:   catalogItem.DisplayName,
+		Spec:          catalogItem.Spec,
+		Path:          catalogItemRevisionPath(catalogItem.Path, catalogItem.Revision),
+	}
+	if err := tx.Create(&revision).Error; err != nil {
+		return fmt.Errorf("failed to record catalog item revision: %w", err)
 	}
 	return nil
 }
+
+// catalogItemRevisionPath returns the resource path of a revision of a catalog item
+func catalogItemRevisionPath(catalogItemPath string, revision int) string {
+	return fmt.Sprintf("%s/revisions/%d", catalogItemPath, revision)
+}
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -16,35 +18,102 @@ import (
 var (
 	// ErrCatalogItemInstanceNotFound is returned when a catalog item instance is not found
 	ErrCatalogItemInstanceNotFound = errors.New("catalog item instance not found")
-	// ErrCatalogItemInstanceIDTaken is returned when a catalog item instance ID is already taken
-	ErrCatalogItemInstanceIDTaken = errors.New("catalog item instance ID already exists")
+	// ErrCatalogItemInstanceIDTaken is returned when a catalog item instance ID
+	// is already taken, whether by one of the caller's instances or not
+	ErrCatalogItemInstanceIDTaken = errors.New("catalog item instance ID is not available")
 	// ErrCatalogItemNotFoundRef is returned when the referenced catalog item does not exist
 	ErrCatalogItemNotFoundRef = errors.New("referenced catalog item does not exist")
+	// ErrCatalogItemInstanceNotDue is returned when claiming an instance that has no
+	// pending work or is already claimed
+	ErrCatalogItemInstanceNotDue = errors.New("catalog item instance is not due for reconciliation")
 )
 
+// activeStates are the instance states the reconciler still has work for
+var activeStates = []string{
+	model.InstanceStatePending,
+	model.InstanceStateProvisioning,
+	model.InstanceStateDeleting,
+}
+
 // CatalogItemInstanceListOptions contains options for listing catalog item instances
 type CatalogItemInstanceListOptions struct {
 	PageToken     *string
 	PageSize      int
 	CatalogItemId *string
+	// Tenant restricts the results to the instances of a tenant
+	Tenant *string
+	// SkipSpec leaves the user values and rendered spec of the results empty,
+	// without loading them; the catalog item of the spec is still set
+	SkipSpec bool
+	// CountTotal sets the total size of the result
+	CountTotal bool
 }
 
 // CatalogItemInstanceListResult contains the result of a List operation
 type CatalogItemInstanceListResult struct {
 	CatalogItemInstances model.CatalogItemInstanceList
 	NextPageToken        *string
+	// TotalSize is the number of instances matching the options across all
+	// pages, if counted
+	TotalSize *int64
+}
+
+// Attributes catalog item instances can be counted by
+const (
+	GroupByCatalogItem = "catalog_item"
+	GroupByServiceType = "service_type"
+	GroupByTenant      = "tenant"
+)
+
+// groupByColumns are the columns holding the attributes instances can be counted by
+var groupByColumns = map[string]string{
+	GroupByCatalogItem: "spec_catalog_item_id",
+	GroupByServiceType: "service_type",
+	GroupByTenant:      "tenant",
+}
+
+// CatalogItemInstanceCountOptions contains options for counting catalog item instances
+type CatalogItemInstanceCountOptions struct {
+	// GroupBy is the attribute to group the instances by, one of the GroupBy constants
+	GroupBy       string
+	CatalogItemId *string
+	// Tenant restricts the count to the instances of a tenant
+	Tenant *string
 }
 
 // CatalogItemInstanceStore defines operations for CatalogItemInstance resources
 type CatalogItemInstanceStore interface {
 	List(ctx context.Context, opts *CatalogItemInstanceListOptions) (*CatalogItemInstanceListResult, error)
 	Create(ctx context.Context, catalogItemInstance model.CatalogItemInstance) (*model.CatalogItemInstance, error)
+	// CreateWithOperation creates an instance together with the operation tracking its creation
+	CreateWithOperation(ctx context.Context, catalogItemInstance model.CatalogItemInstance, op model.Operation) (*model.CatalogItemInstance, *model.Operation, error)
+	// BatchCreateWithOperations creates instances together with the operations
+	// tracking their creation, ops[i] tracking instances[i], all or none
+	BatchCreateWithOperations(ctx context.Context, instances []model.CatalogItemInstance, ops []model.Operation) (model.CatalogItemInstanceList, []model.Operation, error)
+	// Count returns the number of instances per value of an attribute, by
+	// descending count, then by value
+	Count(ctx context.Context, opts *CatalogItemInstanceCountOptions) ([]model.InstanceCount, error)
 	Get(ctx context.Context, id string) (*model.CatalogItemInstance, error)
+	// BatchGet retrieves the catalog item instances with the given IDs in a
+	// single query, in no particular order. Missing IDs are left out.
+	BatchGet(ctx context.Context, ids []string) (model.CatalogItemInstanceList, error)
 	Update(ctx context.Context, catalogItemInstance *model.CatalogItemInstance) (*model.CatalogItemInstance, error)
 	Delete(ctx context.Context, id string) error
+	// MarkDeleting requests the asynchronous deletion of an instance and records
+	// op, if not nil, as the operation tracking it
+	MarkDeleting(ctx context.Context, id string, op *model.Operation) error
+	// ListDue returns instances in an active state whose next attempt is due, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) (model.CatalogItemInstanceList, error)
+	// Claim atomically takes a due instance for reconciliation by moving its next
+	// attempt to leaseUntil, so that no other worker picks it up meanwhile
+	Claim(ctx context.Context, id string, now, leaseUntil time.Time) (*model.CatalogItemInstance, error)
+	// UpdateStatus saves the status and service type instance UID of an instance
+	UpdateStatus(ctx context.Context, catalogItemInstance *model.CatalogItemInstance) error
 }
+
 type catalogItemInstanceStore struct {
-	db *gorm.DB
+	db      *gorm.DB
+	changes *changeNotifier
 }
 
 // NewCatalogItemInstanceStore creates a new CatalogItemInstance store
@@ -55,7 +124,7 @@ func NewCatalogItemInstanceStore(db *gorm.DB) CatalogItemInstanceStore {
 // List returns a paginated list of catalog item instances
 func (s *catalogItemInstanceStore) List(ctx context.Context, opts *CatalogItemInstanceListOptions) (*CatalogItemInstanceListResult, error) {
 	var catalogItemInstances model.CatalogItemInstanceList
-	query := s.db.WithContext(ctx)
+	query := scopeTenantOwned(ctx, s.db.WithContext(ctx))
 
 	// Default max page size
 	pageSize := 100
@@ -74,18 +143,32 @@ func (s *catalogItemInstanceStore) List(ctx context.Context, opts *CatalogItemIn
 		}
 	}
 
-	query = query.Order("id ASC").Limit(pageSize + 1).Offset(offset)
 	if opts != nil && opts.CatalogItemId != nil && *opts.CatalogItemId != "" {
 		query = query.Where("spec_catalog_item_id = ?", *opts.CatalogItemId)
 	}
+	if opts != nil && opts.Tenant != nil {
+		query = query.Where("tenant = ?", *opts.Tenant)
+	}
+
+	result := &CatalogItemInstanceListResult{}
+	if opts != nil && opts.CountTotal {
+		total, err := countTotal(query, &model.CatalogItemInstance{})
+		if err != nil {
+			return nil, err
+		}
+		result.TotalSize = &total
+	}
+
+	query = query.Order("id ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil && opts.SkipSpec {
+		query = query.Omit("spec", "rendered_spec")
+	}
 
 	if err := query.Find(&catalogItemInstances).Error; err != nil {
 		return nil, err
 	}
 
-	result := &CatalogItemInstanceListResult{
-		CatalogItemInstances: catalogItemInstances,
-	}
+	result.CatalogItemInstances = catalogItemInstances
 	if len(catalogItemInstances) > pageSize {
 		result.CatalogItemInstances = catalogItemInstances[:pageSize]
 		nextOffset := offset + pageSize
@@ -95,47 +178,157 @@ func (s *catalogItemInstanceStore) List(ctx context.Context, opts *CatalogItemIn
 	return result, nil
 }
 
-// Create creates a new catalog item
+// Count returns the number of instances per value of an attribute, by
+// descending count, then by value
+func (s *catalogItemInstanceStore) Count(ctx context.Context, opts *CatalogItemInstanceCountOptions) ([]model.InstanceCount, error) {
+	column, ok := groupByColumns[opts.GroupBy]
+	if !ok {
+		return nil, fmt.Errorf("cannot group catalog item instances by %q", opts.GroupBy)
+	}
+
+	query := scopeTenantOwned(ctx, s.db.WithContext(ctx).Model(&model.CatalogItemInstance{}))
+	if opts.CatalogItemId != nil && *opts.CatalogItemId != "" {
+		query = query.Where("spec_catalog_item_id = ?", *opts.CatalogItemId)
+	}
+	if opts.Tenant != nil {
+		query = query.Where("tenant = ?", *opts.Tenant)
+	}
+
+	counts := []model.InstanceCount{}
+	if err := query.Select(column + " AS group_key, COUNT(*) AS instances").
+		Group(column).
+		Order("instances DESC").Order("group_key ASC").
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count catalog item instances: %w", err)
+	}
+	return counts, nil
+}
+
+// Create creates a new catalog item instance.
+// In a tenant-scoped context the instance is owned by that tenant and may only
+// reference catalog items visible to it. The tenant's quotas are enforced in the
+// same transaction as the insert.
 func (s *catalogItemInstanceStore) Create(ctx context.Context, catalogItemInstance model.CatalogItemInstance) (*model.CatalogItemInstance, error) {
-	catalogItemInstance.SpecCatalogItemId = catalogItemInstance.Spec.CatalogItemId
-	if err := s.db.WithContext(ctx).Clauses(clause.Returning{}).Create(&catalogItemInstance).Error; err != nil {
-		return nil, s.mapConstraintError(ctx, err, catalogItemInstance)
+	return s.create(ctx, catalogItemInstance, nil)
+}
+
+// CreateWithOperation creates a new catalog item instance like Create and
+// records op in the same transaction
+func (s *catalogItemInstanceStore) CreateWithOperation(ctx context.Context, catalogItemInstance model.CatalogItemInstance, op model.Operation) (*model.CatalogItemInstance, *model.Operation, error) {
+	created, err := s.create(ctx, catalogItemInstance, &op)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, &op, nil
+}
+
+// BatchCreateWithOperations creates new catalog item instances like Create
+// and records their operations, in a single transaction. Quotas are enforced
+// on the instances created so far, so that the batch as a whole fits.
+func (s *catalogItemInstanceStore) BatchCreateWithOperations(ctx context.Context, instances []model.CatalogItemInstance, ops []model.Operation) (model.CatalogItemInstanceList, []model.Operation, error) {
+	if len(instances) != len(ops) {
+		return nil, nil, fmt.Errorf("got %d operations for %d instances", len(ops), len(instances))
+	}
+	created := make(model.CatalogItemInstanceList, len(instances))
+	copy(created, instances)
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		paths := make([]string, len(created))
+		for i := range created {
+			if err := s.insert(ctx, tx, &created[i], &ops[i]); err != nil {
+				return err
+			}
+			paths[i] = ops[i].Path
+		}
+		return recordIdempotencyKey(ctx, tx, paths...)
+	})
+	if err != nil {
+		if errors.Is(err, ErrCatalogItemNotFoundRef) || errors.Is(err, ErrQuotaExceeded) || errors.Is(err, ErrIdempotencyKeyExists) {
+			return nil, nil, err
+		}
+		return nil, nil, mapInstanceConstraintError(err)
+	}
+	return created, ops, nil
+}
+
+func (s *catalogItemInstanceStore) create(ctx context.Context, catalogItemInstance model.CatalogItemInstance, op *model.Operation) (*model.CatalogItemInstance, error) {
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		if err := s.insert(ctx, tx, &catalogItemInstance, op); err != nil {
+			return err
+		}
+		// The operation is what creating an instance with one returns
+		resource := catalogItemInstance.Path
+		if op != nil {
+			resource = op.Path
+		}
+		return recordIdempotencyKey(ctx, tx, resource)
+	})
+	if err != nil {
+		if errors.Is(err, ErrCatalogItemNotFoundRef) || errors.Is(err, ErrQuotaExceeded) || errors.Is(err, ErrIdempotencyKeyExists) {
+			return nil, err
+		}
+		return nil, mapInstanceConstraintError(err)
 	}
 	return &catalogItemInstance, nil
 }
 
-// mapConstraintError maps a DB constraint violation to a store sentinel error
-func (s *catalogItemInstanceStore) mapConstraintError(ctx context.Context, err error, attempted model.CatalogItemInstance) error {
+// insert creates an instance, and op if not nil, within tx
+func (s *catalogItemInstanceStore) insert(ctx context.Context, tx *gorm.DB, catalogItemInstance *model.CatalogItemInstance, op *model.Operation) error {
+	catalogItemInstance.SpecCatalogItemId = catalogItemInstance.Spec.CatalogItemId
+	if tenant, ok := tenancy.FromContext(ctx); ok {
+		catalogItemInstance.Tenant = tenant
+		var count int64
+		if err := scopeCatalogItems(ctx, tx.Model(&model.CatalogItem{})).
+			Where("id = ?", catalogItemInstance.SpecCatalogItemId).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check catalog item: %w", err)
+		}
+		if count == 0 {
+			return ErrCatalogItemNotFoundRef
+		}
+	}
+	if err := enforceQuotas(tx, *catalogItemInstance); err != nil {
+		return err
+	}
+	if err := tx.Clauses(clause.Returning{}).Create(catalogItemInstance).Error; err != nil {
+		return err
+	}
+	data := catalogItemInstanceEventData(catalogItemInstance)
+	if err := recordEvent(tx, model.EventCatalogItemInstanceCreated, catalogItemInstance.Path,
+		catalogItemInstance.Tenant, data); err != nil {
+		return err
+	}
+	if err := recordAudit(ctx, tx, model.AuditActionCreate, auditCatalogItemInstance, catalogItemInstance.Path, nil, data); err != nil {
+		return err
+	}
+	if op != nil {
+		if err := tx.Clauses(clause.Returning{}).Create(op).Error; err != nil {
+			return fmt.Errorf("failed to create operation: %w", err)
+		}
+	}
+	return nil
+}
+
+// mapInstanceConstraintError maps a DB constraint violation to a store sentinel error
+func mapInstanceConstraintError(err error) error {
 	if err == nil {
 		return nil
 	}
 
 	errStr := strings.ToLower(err.Error())
 
-	// Check for foreign key violation first (before checking for generic constraint failed)
+	// Check for foreign key violation first (before checking for generic
+	// constraint failed). The catalog item is the only reference; it is not
+	// looked up, as it may be another tenant's private item.
 	if strings.Contains(errStr, "foreign key") {
-		// Verify which constraint failed by checking if catalog item exists
-		var ci model.CatalogItem
-		if err := s.db.WithContext(ctx).Where("id = ?", attempted.SpecCatalogItemId).First(&ci).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return ErrCatalogItemNotFoundRef
-			}
-		}
-		return err
+		return ErrCatalogItemNotFoundRef
 	}
 
-	// Handle unique constraint violations
+	// Handle unique constraint violations. The ID is the only unique key. It
+	// is not looked up: whether it is taken by the caller or by another
+	// tenant is not disclosed.
 	if errors.Is(err, gorm.ErrDuplicatedKey) ||
 		strings.Contains(errStr, "unique") ||
 		strings.Contains(errStr, "duplicate key") {
-		var row model.CatalogItemInstance
-		dberr := s.db.WithContext(ctx).Where("id = ?", attempted.ID).Limit(1).First(&row).Error
-		if dberr == nil {
-			return ErrCatalogItemInstanceIDTaken
-		}
-		if !errors.Is(dberr, gorm.ErrRecordNotFound) {
-			return err
-		}
+		return ErrCatalogItemInstanceIDTaken
 	}
 
 	return err
@@ -144,7 +337,7 @@ func (s *catalogItemInstanceStore) mapConstraintError(ctx context.Context, err e
 // Get retrieves a catalog item by ID
 func (s *catalogItemInstanceStore) Get(ctx context.Context, id string) (*model.CatalogItemInstance, error) {
 	var catalogItemInstance model.CatalogItemInstance
-	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&catalogItemInstance).Error; err != nil {
+	if err := scopeTenantOwned(ctx, s.db.WithContext(ctx)).Where("id = ?", id).First(&catalogItemInstance).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCatalogItemInstanceNotFound
 		}
@@ -153,33 +346,228 @@ func (s *catalogItemInstanceStore) Get(ctx context.Context, id string) (*model.C
 	return &catalogItemInstance, nil
 }
 
+// BatchGet retrieves the catalog item instances with the given IDs
+func (s *catalogItemInstanceStore) BatchGet(ctx context.Context, ids []string) (model.CatalogItemInstanceList, error) {
+	var catalogItemInstances model.CatalogItemInstanceList
+	if err := scopeTenantOwned(ctx, s.db.WithContext(ctx)).Where("id IN ?", ids).Find(&catalogItemInstances).Error; err != nil {
+		return nil, fmt.Errorf("failed to get catalog item instances: %w", err)
+	}
+	return catalogItemInstances, nil
+}
+
 // Update updates a catalog item (only mutable fields)
 func (s *catalogItemInstanceStore) Update(ctx context.Context, catalogItemInstance *model.CatalogItemInstance) (*model.CatalogItemInstance, error) {
 	// Extract catalog item ID from spec for denormalized field
 	catalogItemInstance.SpecCatalogItemId = catalogItemInstance.Spec.CatalogItemId
 
-	result := s.db.WithContext(ctx).Model(&model.CatalogItemInstance{}).
-		Where("id = ?", catalogItemInstance.ID).
-		Select("display_name", "spec", "spec_catalog_item_id").
-		Updates(catalogItemInstance)
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		result := scopeTenantOwned(ctx, tx.Model(&model.CatalogItemInstance{})).
+			Where("id = ?", catalogItemInstance.ID).
+			Select("display_name", "spec", "spec_catalog_item_id", "service_type_instance_uid").
+			Updates(catalogItemInstance)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrCatalogItemInstanceNotFound
+		}
+		return recordInstanceEvent(tx, model.EventCatalogItemInstanceUpdated, catalogItemInstance.ID)
+	})
+	if err != nil {
+		if errors.Is(err, ErrCatalogItemInstanceNotFound) {
+			return nil, err
+		}
+		return nil, mapInstanceConstraintError(err)
+	}
+	return catalogItemInstance, nil
+}
+
+// Delete deletes a catalog item by ID.
+// Pending deletion operations of the instance complete successfully; pending
+// creation operations are aborted.
+func (s *catalogItemInstanceStore) Delete(ctx context.Context, id string) error {
+	return s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var instance model.CatalogItemInstance
+		if err := scopeTenantOwned(ctx, tx).Where("id = ?", id).First(&instance).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCatalogItemInstanceNotFound
+			}
+			return fmt.Errorf("failed to get catalog item instance: %w", err)
+		}
+		if err := tx.Delete(&instance).Error; err != nil {
+			return fmt.Errorf("failed to delete catalog item instance: %w", err)
+		}
+		data := catalogItemInstanceEventData(&instance)
+		if err := recordEvent(tx, model.EventCatalogItemInstanceDeleted, instance.Path, instance.Tenant, data); err != nil {
+			return err
+		}
+		if err := recordAudit(ctx, tx, model.AuditActionDelete, auditCatalogItemInstance, instance.Path, data, nil); err != nil {
+			return err
+		}
+		if err := completeOperations(tx, id, model.OperationTypeDeleteCatalogItemInstance, nil); err != nil {
+			return err
+		}
+		return completeOperations(tx, id, model.OperationTypeCreateCatalogItemInstance, abortedError("the instance was deleted"))
+	})
+}
+
+// MarkDeleting requests the asynchronous deletion of an instance.
+// The reconciler deletes it from its provider and then removes the record.
+func (s *catalogItemInstanceStore) MarkDeleting(ctx context.Context, id string, op *model.Operation) error {
+	return s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var before model.CatalogItemInstance
+		if err := scopeTenantOwned(ctx, tx).Where("id = ?", id).First(&before).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCatalogItemInstanceNotFound
+			}
+			return fmt.Errorf("failed to get catalog item instance: %w", err)
+		}
+		if err := markDeleting(ctx, tx, id, op); err != nil {
+			return err
+		}
+		var after model.CatalogItemInstance
+		if err := tx.Where("id = ?", id).First(&after).Error; err != nil {
+			return fmt.Errorf("failed to get catalog item instance: %w", err)
+		}
+		return recordAudit(ctx, tx, model.AuditActionDelete, auditCatalogItemInstance, after.Path,
+			catalogItemInstanceEventData(&before), catalogItemInstanceEventData(&after))
+	})
+}
 
+// markDeleting moves an instance to DELETING within tx and records op, if not nil
+func markDeleting(ctx context.Context, tx *gorm.DB, id string, op *model.Operation) error {
+	now := time.Now()
+	result := scopeTenantOwned(ctx, tx.Model(&model.CatalogItemInstance{})).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"state":             model.InstanceStateDeleting,
+			"delete_time":       gorm.Expr("COALESCE(delete_time, ?)", now),
+			"attempts":          0,
+			"next_attempt_time": now,
+		})
 	if result.Error != nil {
-		return nil, s.mapConstraintError(ctx, result.Error, *catalogItemInstance)
+		return fmt.Errorf("failed to mark catalog item instance for deletion: %w", result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return nil, ErrCatalogItemInstanceNotFound
+		return ErrCatalogItemInstanceNotFound
 	}
-	return catalogItemInstance, nil
+	if err := recordInstanceEvent(tx, model.EventCatalogItemInstanceStateChange, id); err != nil {
+		return err
+	}
+	if op != nil {
+		if err := tx.Clauses(clause.Returning{}).Create(op).Error; err != nil {
+			return fmt.Errorf("failed to create operation: %w", err)
+		}
+	}
+	return nil
 }
 
-// Delete deletes a catalog item by ID
-func (s *catalogItemInstanceStore) Delete(ctx context.Context, id string) error {
-	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&model.CatalogItemInstance{})
+// dueForReconcile restricts a query to active instances whose next attempt is due
+func dueForReconcile(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("state IN ?", activeStates).
+		Where("next_attempt_time IS NULL OR next_attempt_time <= ?", now)
+}
+
+// ListDue returns instances in an active state whose next attempt is due, oldest first.
+// It is not tenant-scoped.
+func (s *catalogItemInstanceStore) ListDue(ctx context.Context, now time.Time, limit int) (model.CatalogItemInstanceList, error) {
+	var instances model.CatalogItemInstanceList
+	if err := dueForReconcile(s.db.WithContext(ctx), now).
+		Order("next_attempt_time ASC").Order("id ASC").
+		Limit(limit).
+		Find(&instances).Error; err != nil {
+		return nil, fmt.Errorf("failed to list due catalog item instances: %w", err)
+	}
+	return instances, nil
+}
+
+// Claim atomically takes a due instance for reconciliation by moving its next
+// attempt to leaseUntil. It is not tenant-scoped.
+func (s *catalogItemInstanceStore) Claim(ctx context.Context, id string, now, leaseUntil time.Time) (*model.CatalogItemInstance, error) {
+	result := dueForReconcile(s.db.WithContext(ctx).Model(&model.CatalogItemInstance{}), now).
+		Where("id = ?", id).
+		Update("next_attempt_time", leaseUntil)
 	if result.Error != nil {
-		return fmt.Errorf("failed to delete catalog item instance: %w", result.Error)
+		return nil, fmt.Errorf("failed to claim catalog item instance: %w", result.Error)
 	}
 	if result.RowsAffected == 0 {
-		return ErrCatalogItemInstanceNotFound
+		return nil, ErrCatalogItemInstanceNotDue
+	}
+
+	var instance model.CatalogItemInstance
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&instance).Error; err != nil {
+		return nil, fmt.Errorf("failed to get claimed catalog item instance: %w", err)
+	}
+	return &instance, nil
+}
+
+// UpdateStatus saves the status and service type instance UID of an instance.
+// It is not tenant-scoped. Unless the instance is being deleted, the status is
+// left alone when a deletion was requested concurrently so that the request is
+// not lost; the UID is always saved. Operations waiting for the state the
+// instance reached are completed, and state changes recorded in the outbox, in
+// the same transaction.
+func (s *catalogItemInstanceStore) UpdateStatus(ctx context.Context, catalogItemInstance *model.CatalogItemInstance) error {
+	return s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		result := tx.Model(&model.CatalogItemInstance{}).
+			Where("id = ?", catalogItemInstance.ID).
+			Update("service_type_instance_uid", catalogItemInstance.ServiceTypeInstanceUid)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update catalog item instance: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrCatalogItemInstanceNotFound
+		}
+
+		var previous model.CatalogItemInstance
+		if err := tx.Select("state").Where("id = ?", catalogItemInstance.ID).First(&previous).Error; err != nil {
+			return fmt.Errorf("failed to get catalog item instance state: %w", err)
+		}
+
+		query := tx.Model(&model.CatalogItemInstance{}).Where("id = ?", catalogItemInstance.ID)
+		if catalogItemInstance.DeleteTime == nil {
+			query = query.Where("delete_time IS NULL")
+		}
+		result = query.
+			Select("state", "conditions", "last_error", "attempts", "next_attempt_time").
+			Updates(&model.CatalogItemInstance{Status: catalogItemInstance.Status})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update catalog item instance status: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if catalogItemInstance.Status.State != previous.Status.State {
+			if err := recordInstanceEvent(tx, model.EventCatalogItemInstanceStateChange, catalogItemInstance.ID); err != nil {
+				return err
+			}
+		}
+		return completeOperationsForStatus(tx, catalogItemInstance)
+	})
+}
+
+// recordInstanceEvent records an event carrying the current state of an instance within tx
+func recordInstanceEvent(tx *gorm.DB, eventType, id string) error {
+	var instance model.CatalogItemInstance
+	if err := tx.Where("id = ?", id).First(&instance).Error; err != nil {
+		return fmt.Errorf("failed to get catalog item instance: %w", err)
+	}
+	return recordEvent(tx, eventType, instance.Path, instance.Tenant, catalogItemInstanceEventData(&instance))
+}
+
+// completeOperationsForStatus completes the pending operations of an instance
+// that reached a terminal state: READY completes its creation, FAILED fails
+// both its creation and its deletion.
+func completeOperationsForStatus(tx *gorm.DB, catalogItemInstance *model.CatalogItemInstance) error {
+	switch catalogItemInstance.Status.State {
+	case model.InstanceStateReady:
+		return completeOperations(tx, catalogItemInstance.ID, model.OperationTypeCreateCatalogItemInstance, nil)
+	case model.InstanceStateFailed:
+		opErr := abortedError(catalogItemInstance.Status.LastError)
+		if err := completeOperations(tx, catalogItemInstance.ID, model.OperationTypeCreateCatalogItemInstance, opErr); err != nil {
+			return err
+		}
+		return completeOperations(tx, catalogItemInstance.ID, model.OperationTypeDeleteCatalogItemInstance, opErr)
 	}
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/dcm-project/catalog-manager/internal/store"
 	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
 )
 
 var _ = Describe("CatalogItemInstance Store", func() {
@@ -38,7 +39,7 @@ var _ = Describe("CatalogItemInstance Store", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// Auto-migrate all related models to create foreign key constraints
-		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemInstance{})
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
 		Expect(err).ToNot(HaveOccurred())
 
 		catalogItemInstanceStore = store.NewCatalogItemInstanceStore(db)
@@ -404,5 +405,97 @@ var _ = Describe("CatalogItemInstance Store", func() {
 			Expect(lastPageResults.CatalogItemInstances).To(HaveLen(1))
 			Expect(lastPageResults.NextPageToken).To(BeNil())
 		})
+
+		It("should count the matching instances across all pages", func() {
+			createTestServiceType("vm-st-total", "vm")
+			createTestCatalogItem("small-vm-total", "vm")
+			createTestCatalogItem("large-vm-total", "vm")
+			for i := 1; i <= 5; i++ {
+				catalogItemId := "small-vm-total"
+				if i > 3 {
+					catalogItemId = "large-vm-total"
+				}
+				_, err := catalogItemInstanceStore.Create(context.Background(), model.CatalogItemInstance{
+					ID:          fmt.Sprintf("total-cii-%d", i),
+					ApiVersion:  "v1alpha1",
+					DisplayName: fmt.Sprintf("Instance %d", i),
+					Spec:        model.CatalogItemInstanceSpec{CatalogItemId: catalogItemId},
+					Path:        fmt.Sprintf("catalog-item-instances/total-cii-%d", i),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			smallVM := "small-vm-total"
+			results, err := catalogItemInstanceStore.List(context.Background(), &store.CatalogItemInstanceListOptions{
+				PageSize:      2,
+				CatalogItemId: &smallVM,
+				CountTotal:    true,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.CatalogItemInstances).To(HaveLen(2))
+			Expect(results.TotalSize).To(HaveValue(BeEquivalentTo(3)))
+
+			results, err = catalogItemInstanceStore.List(context.Background(), &store.CatalogItemInstanceListOptions{PageSize: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.TotalSize).To(BeNil())
+		})
+	})
+
+	Describe("Count", func() {
+		BeforeEach(func() {
+			createTestServiceType("vm-st-count", "vm")
+			createTestServiceType("db-st-count", "db")
+			createTestCatalogItem("small-vm-count", "vm")
+			createTestCatalogItem("large-vm-count", "vm")
+			createTestCatalogItem("small-db-count", "db")
+			instances := []struct{ catalogItemId, serviceType, tenant string }{
+				{"small-vm-count", "vm", "team-a"},
+				{"small-vm-count", "vm", "team-a"},
+				{"small-vm-count", "vm", "team-b"},
+				{"large-vm-count", "vm", "team-b"},
+				{"small-db-count", "db", "team-a"},
+			}
+			for i, instance := range instances {
+				_, err := catalogItemInstanceStore.Create(context.Background(), model.CatalogItemInstance{
+					ID:          fmt.Sprintf("count-cii-%d", i),
+					ApiVersion:  "v1alpha1",
+					DisplayName: fmt.Sprintf("Instance %d", i),
+					Spec:        model.CatalogItemInstanceSpec{CatalogItemId: instance.catalogItemId},
+					ServiceType: instance.serviceType,
+					Tenant:      instance.tenant,
+					Path:        fmt.Sprintf("tenants/%s/catalog-item-instances/count-cii-%d", instance.tenant, i),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should group instances by descending count, then by value", func() {
+			counts, err := catalogItemInstanceStore.Count(context.Background(), &store.CatalogItemInstanceCountOptions{GroupBy: store.GroupByCatalogItem})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts).To(Equal([]model.InstanceCount{
+				{Key: "small-vm-count", Count: 3},
+				{Key: "large-vm-count", Count: 1},
+				{Key: "small-db-count", Count: 1},
+			}))
+
+			counts, err = catalogItemInstanceStore.Count(context.Background(), &store.CatalogItemInstanceCountOptions{GroupBy: store.GroupByServiceType})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts).To(Equal([]model.InstanceCount{{Key: "vm", Count: 4}, {Key: "db", Count: 1}}))
+		})
+
+		It("should apply the filters and the tenant of the context", func() {
+			smallVM := "small-vm-count"
+			counts, err := catalogItemInstanceStore.Count(context.Background(), &store.CatalogItemInstanceCountOptions{
+				GroupBy:       store.GroupByTenant,
+				CatalogItemId: &smallVM,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts).To(Equal([]model.InstanceCount{{Key: "team-a", Count: 2}, {Key: "team-b", Count: 1}}))
+
+			teamB := tenancy.NewContext(context.Background(), "team-b")
+			counts, err = catalogItemInstanceStore.Count(teamB, &store.CatalogItemInstanceCountOptions{GroupBy: store.GroupByServiceType})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counts).To(Equal([]model.InstanceCount{{Key: "vm", Count: 2}}))
+		})
 	})
 })
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"gorm.io/gorm"
+)
+
+// CatalogItemRevisionListOptions contains options for listing catalog item revisions
+type CatalogItemRevisionListOptions struct {
+	PageToken *string
+	PageSize  int
+}
+
+// CatalogItemRevisionListResult contains the result of a List operation
+type CatalogItemRevisionListResult struct {
+	CatalogItemRevisions model.CatalogItemRevisionList
+	NextPageToken        *string
+}
+
+// CatalogItemRevisionStore defines read operations for the revisions of catalog items.
+// Revisions are recorded by the CatalogItem store and are never modified.
+type CatalogItemRevisionStore interface {
+	// List returns the revisions of a catalog item, most recent first
+	List(ctx context.Context, catalogItemID string, opts *CatalogItemRevisionListOptions) (*CatalogItemRevisionListResult, error)
+	Get(ctx context.Context, catalogItemID string, revision int) (*model.CatalogItemRevision, error)
+}
+
+type catalogItemRevisionStore struct {
+	db *gorm.DB
+}
+
+// NewCatalogItemRevisionStore creates a new CatalogItemRevision store
+func NewCatalogItemRevisionStore(db *gorm.DB) CatalogItemRevisionStore {
+	return &catalogItemRevisionStore{db: db}
+}
+
+// List returns a paginated list of the revisions of a catalog item, most recent first
+func (s *catalogItemRevisionStore) List(ctx context.Context, catalogItemID string, opts *CatalogItemRevisionListOptions) (*CatalogItemRevisionListResult, error) {
+	var revisions model.CatalogItemRevisionList
+	query := s.db.WithContext(ctx).Where("catalog_item_id = ?", catalogItemID)
+
+	// Default max page size
+	pageSize := 100
+	if opts != nil && opts.PageSize > 0 {
+		pageSize = opts.PageSize
+	}
+
+	// Decode page token to get offset
+	offset := 0
+	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*opts.PageToken)
+		if err == nil {
+			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
+				offset = parsedOffset
+			}
+		}
+	}
+
+	query = query.Order("revision DESC").Limit(pageSize + 1).Offset(offset)
+	if err := query.Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+
+	result := &CatalogItemRevisionListResult{
+		CatalogItemRevisions: revisions,
+	}
+	if len(revisions) > pageSize {
+		result.CatalogItemRevisions = revisions[:pageSize]
+		nextOffset := offset + pageSize
+		nextPageToken := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		result.NextPageToken = &nextPageToken
+	}
+	return result, nil
+}
+
+// Get retrieves a revision of a catalog item
+func (s *catalogItemRevisionStore) Get(ctx context.Context, catalogItemID string, revision int) (*model.CatalogItemRevision, error) {
+	var target model.CatalogItemRevision
+	if err := s.db.WithContext(ctx).Where("catalog_item_id = ? AND revision = ?", catalogItemID, revision).First(&target).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCatalogItemRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to get catalog item revision: %w", err)
+	}
+	return &target, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/dcm-project/catalog-manager/internal/store"
 	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
 )
 
 var _ = Describe("CatalogItem Store", func() {
@@ -36,7 +37,7 @@ var _ = Describe("CatalogItem Store", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// Auto-migrate parent models first to create foreign key constraints
-		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{})
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.OutboxEvent{})
 		Expect(err).ToNot(HaveOccurred())
 
 		catalogItemStore = store.NewCatalogItemStore(db)
@@ -354,6 +355,195 @@ var _ = Describe("CatalogItem Store", func() {
 		// because it requires creating CatalogItemInstance records
 	})
 
+	Describe("Revisions", func() {
+		var revisionStore store.CatalogItemRevisionStore
+
+		BeforeEach(func() {
+			revisionStore = store.NewCatalogItemRevisionStore(db)
+			createTestServiceType("vm-st-rev", "vm")
+
+			_, err := catalogItemStore.Create(context.Background(), model.CatalogItem{
+				ID:          "rev-test",
+				ApiVersion:  "v1alpha1",
+				DisplayName: "First",
+				Spec: model.CatalogItemSpec{
+					ServiceType: "vm",
+					Fields:      []model.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+				},
+				Path: "catalog-items/rev-test",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should record a revision on create and on every update, newest first", func() {
+			ci, err := catalogItemStore.Get(context.Background(), "rev-test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ci.Revision).To(Equal(1))
+
+			ci.DisplayName = "Second"
+			ci.Spec.Fields[0].Default = 4
+			Expect(catalogItemStore.Update(context.Background(), ci)).To(Succeed())
+
+			updated, err := catalogItemStore.Get(context.Background(), "rev-test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Revision).To(Equal(2))
+
+			result, err := revisionStore.List(context.Background(), "rev-test", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItemRevisions).To(HaveLen(2))
+			Expect(result.CatalogItemRevisions[0].Revision).To(Equal(2))
+			Expect(result.CatalogItemRevisions[0].DisplayName).To(Equal("Second"))
+			Expect(result.CatalogItemRevisions[0].Path).To(Equal("catalog-items/rev-test/revisions/2"))
+			Expect(result.CatalogItemRevisions[1].Revision).To(Equal(1))
+			Expect(result.CatalogItemRevisions[1].Spec.Fields[0].Default).To(BeNumerically("==", 2))
+		})
+
+		It("should restore a prior revision as a new revision", func() {
+			ci, err := catalogItemStore.Get(context.Background(), "rev-test")
+			Expect(err).ToNot(HaveOccurred())
+			ci.DisplayName = "Second"
+			ci.Spec.Fields[0].Default = 4
+			Expect(catalogItemStore.Update(context.Background(), ci)).To(Succeed())
+
+			restored, err := catalogItemStore.Rollback(context.Background(), "rev-test", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored.Revision).To(Equal(3))
+			Expect(restored.DisplayName).To(Equal("First"))
+			Expect(restored.Spec.Fields[0].Default).To(BeNumerically("==", 2))
+
+			result, err := revisionStore.List(context.Background(), "rev-test", &store.CatalogItemRevisionListOptions{PageSize: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItemRevisions).To(HaveLen(1))
+			Expect(result.CatalogItemRevisions[0].Revision).To(Equal(3))
+			Expect(result.CatalogItemRevisions[0].DisplayName).To(Equal("First"))
+			Expect(result.NextPageToken).ToNot(BeNil())
+		})
+
+		It("should get a revision", func() {
+			revision, err := revisionStore.Get(context.Background(), "rev-test", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revision.DisplayName).To(Equal("First"))
+
+			_, err = revisionStore.Get(context.Background(), "rev-test", 2)
+			Expect(err).To(Equal(store.ErrCatalogItemRevisionNotFound))
+		})
+
+		It("should return error when rolling back to an unknown revision", func() {
+			_, err := catalogItemStore.Rollback(context.Background(), "rev-test", 7)
+			Expect(err).To(Equal(store.ErrCatalogItemRevisionNotFound))
+
+			_, err = catalogItemStore.Rollback(context.Background(), "non-existent", 1)
+			Expect(err).To(Equal(store.ErrCatalogItemNotFound))
+		})
+
+		It("should delete the revisions with the catalog item", func() {
+			Expect(catalogItemStore.Delete(context.Background(), "rev-test")).To(Succeed())
+
+			result, err := revisionStore.List(context.Background(), "rev-test", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItemRevisions).To(BeEmpty())
+		})
+	})
+
+	Describe("Tenancy", func() {
+		var (
+			teamB context.Context
+			admin context.Context
+		)
+
+		BeforeEach(func() {
+			teamB = tenancy.NewContext(context.Background(), "team-b")
+			admin = tenancy.NewAdminContext(tenancy.NewContext(context.Background(), "ops"))
+			createTestServiceType("vm-st-tenancy", "vm")
+
+			_, err := catalogItemStore.Create(context.Background(), model.CatalogItem{
+				ID:          "global-item",
+				ApiVersion:  "v1alpha1",
+				DisplayName: "Global",
+				Spec: model.CatalogItemSpec{
+					ServiceType: "vm",
+					Fields:      []model.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+				},
+				Path: "catalog-items/global-item",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should let tenants read but not change a global catalog item", func() {
+			ci, err := catalogItemStore.Get(teamB, "global-item")
+			Expect(err).ToNot(HaveOccurred())
+
+			ci.DisplayName = "Hijacked"
+			Expect(catalogItemStore.Update(teamB, ci)).To(MatchError(store.ErrCatalogItemReadOnly))
+
+			_, err = catalogItemStore.Rollback(teamB, "global-item", 1)
+			Expect(err).To(MatchError(store.ErrCatalogItemReadOnly))
+
+			Expect(catalogItemStore.Delete(teamB, "global-item")).To(MatchError(store.ErrCatalogItemReadOnly))
+
+			unchanged, err := catalogItemStore.Get(context.Background(), "global-item")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unchanged.DisplayName).To(Equal("Global"))
+			Expect(unchanged.Revision).To(Equal(1))
+		})
+
+		It("should report the ID of another tenant's private item as taken, like any other", func() {
+			teamA := tenancy.NewContext(context.Background(), "team-a")
+			item := func(tenant string) model.CatalogItem {
+				return model.CatalogItem{
+					ID:          "private-item",
+					ApiVersion:  "v1alpha1",
+					DisplayName: "Private",
+					Tenant:      tenant,
+					Spec: model.CatalogItemSpec{
+						ServiceType: "vm",
+						Fields:      []model.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+					},
+					Path: "tenants/" + tenant + "/catalog-items/private-item",
+				}
+			}
+			_, err := catalogItemStore.Create(teamA, item("team-a"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = catalogItemStore.Create(teamB, item("team-b"))
+			Expect(err).To(Equal(store.ErrCatalogItemIDTaken))
+			_, err = catalogItemStore.Create(teamA, item("team-a"))
+			Expect(err).To(Equal(store.ErrCatalogItemIDTaken))
+		})
+
+		It("should reject a global catalog item created by a tenant", func() {
+			_, err := catalogItemStore.Create(teamB, model.CatalogItem{
+				ID:         "tenant-global",
+				ApiVersion: "v1alpha1",
+				Spec:       model.CatalogItemSpec{ServiceType: "vm"},
+				Path:       "catalog-items/tenant-global",
+			})
+			Expect(err).To(MatchError(store.ErrCatalogItemReadOnly))
+
+			_, err = catalogItemStore.Create(teamB, model.CatalogItem{
+				ID:         "tenant-private",
+				ApiVersion: "v1alpha1",
+				Tenant:     "team-b",
+				Spec:       model.CatalogItemSpec{ServiceType: "vm"},
+				Path:       "tenants/team-b/catalog-items/tenant-private",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should let administrators change a global catalog item", func() {
+			ci, err := catalogItemStore.Get(admin, "global-item")
+			Expect(err).ToNot(HaveOccurred())
+
+			ci.DisplayName = "Renamed"
+			Expect(catalogItemStore.Update(admin, ci)).To(Succeed())
+
+			_, err = catalogItemStore.Rollback(admin, "global-item", 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(catalogItemStore.Delete(admin, "global-item")).To(Succeed())
+		})
+	})
+
 	Describe("List", func() {
 		It("should return empty list when no catalog items exist", func() {
 			result, err := catalogItemStore.List(context.Background(), &store.CatalogItemListOptions{PageSize: 100})
@@ -389,6 +579,30 @@ var _ = Describe("CatalogItem Store", func() {
 			Expect(result.NextPageToken).To(BeNil())
 		})
 
+		It("should not load the spec when skipped", func() {
+			createTestServiceType("vm-st-skip", "vm")
+			_, err := catalogItemStore.Create(context.Background(), model.CatalogItem{
+				ID:          "vm-item",
+				ApiVersion:  "v1alpha1",
+				DisplayName: "VM Item",
+				Spec: model.CatalogItemSpec{
+					ServiceType: "vm",
+					Fields:      []model.FieldConfiguration{{Path: "spec.vcpu.count", Default: 2}},
+				},
+				Path: "catalog-items/vm-item",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := catalogItemStore.List(context.Background(), &store.CatalogItemListOptions{SkipSpec: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.CatalogItems).To(HaveLen(1))
+			item := result.CatalogItems[0]
+			Expect(item.DisplayName).To(Equal("VM Item"))
+			Expect(item.Spec.Fields).To(BeEmpty())
+			Expect(item.Spec.ServiceType).To(Equal("vm"))
+			Expect(item.Spec.ServiceTypeVersion).To(Equal(item.SpecServiceTypeVersion))
+		})
+
 		It("should filter by service type", func() {
 			// Create prerequisite service types
 			createTestServiceType("vm-st-filter", "vm")
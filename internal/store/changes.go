@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// changesChannel is the Postgres notification channel signalled when events are
+// committed to the outbox
+const changesChannel = "catalog_manager_outbox"
+
+// changeNotifier signals the in-process subscribers of Outbox().Subscribe after
+// events were committed to the outbox. A nil changeNotifier signals nobody.
+type changeNotifier struct {
+	mu   sync.Mutex
+	subs map[chan struct{}]struct{}
+}
+
+func newChangeNotifier() *changeNotifier {
+	return &changeNotifier{subs: map[chan struct{}]struct{}{}}
+}
+
+// subscribe returns a channel signalled after every commit of events, coalescing
+// signals the subscriber has not received yet, and a function unsubscribing it
+func (n *changeNotifier) subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	if n == nil {
+		return ch, func() {}
+	}
+	n.mu.Lock()
+	n.subs[ch] = struct{}{}
+	n.mu.Unlock()
+	return ch, func() {
+		n.mu.Lock()
+		delete(n.subs, ch)
+		n.mu.Unlock()
+	}
+}
+
+// notify signals every subscriber without blocking
+func (n *changeNotifier) notify() {
+	if n == nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// transaction runs fn in a transaction and signals the subscribers once it committed
+func (n *changeNotifier) transaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if err := db.WithContext(ctx).Transaction(fn); err != nil {
+		return err
+	}
+	n.notify()
+	return nil
+}
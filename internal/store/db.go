@@ -19,14 +19,7 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 
 	// Select database dialect based on configuration
 	if cfg.Database.Type == "pgsql" {
-		dsn := fmt.Sprintf("host=%s user=%s password=%s port=%s dbname=%s",
-			cfg.Database.Hostname,
-			cfg.Database.User,
-			cfg.Database.Password,
-			cfg.Database.Port,
-			cfg.Database.Name,
-		)
-		dialector = postgres.Open(dsn)
+		dialector = postgres.Open(postgresDSN(cfg))
 	} else {
 		dialector = sqlite.Open(cfg.Database.Name)
 	}
@@ -50,6 +43,12 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	// Migrate databases created before service type versions, before SQLite
+	// enforces the foreign keys of the tables it rebuilds
+	if err := migrateServiceTypeVersions(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate service type versions: %w", err)
+	}
+
 	// Enable foreign key constraints for SQLite
 	if cfg.Database.Type != "pgsql" {
 		if err := db.Exec("PRAGMA foreign_keys = ON").Error; err != nil {
@@ -69,10 +68,68 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 	if err := db.AutoMigrate(
 		&model.ServiceType{},
 		&model.CatalogItem{},
+		&model.CatalogItemRevision{},
 		&model.CatalogItemInstance{},
+		&model.Quota{},
+		&model.Operation{},
+		&model.OutboxEvent{},
+		&model.WebhookSubscription{},
+		&model.WebhookDelivery{},
+		&model.AuditEvent{},
+		&model.IdempotencyKey{},
 	); err != nil {
 		return nil, fmt.Errorf("failed to auto-migrate database schema: %w", err)
 	}
+	if err := backfillDefaultServiceTypeVersions(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate service type versions: %w", err)
+	}
 
 	return db, nil
 }
+
+// postgresDSN returns the connection string of the Postgres database
+func postgresDSN(cfg *config.Config) string {
+	return fmt.Sprintf("host=%s user=%s password=%s port=%s dbname=%s",
+		cfg.Database.Hostname,
+		cfg.Database.User,
+		cfg.Database.Password,
+		cfg.Database.Port,
+		cfg.Database.Name,
+	)
+}
+
+// migrateServiceTypeVersions prepares databases created before several versions
+// of a service type could coexist. The unique index on service_type, and the
+// catalog item foreign key relying on it, are dropped so that AutoMigrate
+// replaces them with ones on (service_type, api_version), and catalog items are
+// pinned to the only version of their service type.
+func migrateServiceTypeVersions(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&model.CatalogItem{}) || m.HasColumn(&model.CatalogItem{}, "SpecServiceTypeVersion") {
+		return nil
+	}
+
+	const legacyConstraint, legacyIndex = "fk_catalog_items_service_type_ref", "idx_service_types_service_type"
+	if m.HasConstraint(&model.CatalogItem{}, legacyConstraint) {
+		if err := m.DropConstraint(&model.CatalogItem{}, legacyConstraint); err != nil {
+			return err
+		}
+	}
+	if m.HasIndex(&model.ServiceType{}, legacyIndex) {
+		if err := m.DropIndex(&model.ServiceType{}, legacyIndex); err != nil {
+			return err
+		}
+	}
+	if err := m.AddColumn(&model.CatalogItem{}, "SpecServiceTypeVersion"); err != nil {
+		return err
+	}
+	return db.Exec(`UPDATE catalog_items SET spec_service_type_version = COALESCE(
+		(SELECT api_version FROM service_types WHERE service_types.service_type = catalog_items.spec_service_type), '')`).Error
+}
+
+// backfillDefaultServiceTypeVersions makes the service types created before
+// versioning, which have a single version, the default of their service type
+func backfillDefaultServiceTypeVersions(db *gorm.DB) error {
+	return db.Exec(`UPDATE service_types SET default_version = ? WHERE
+		(SELECT COUNT(*) FROM service_types versions WHERE versions.service_type = service_types.service_type) = 1`, true).Error
+}
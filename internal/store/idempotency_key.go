@@ -0,0 +1,159 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrIdempotencyKeyExists is returned when reserving a key that is already recorded
+	ErrIdempotencyKeyExists = errors.New("idempotency key already exists")
+)
+
+// IdempotencyKeyStore records the idempotency keys of create requests
+type IdempotencyKeyStore interface {
+	// Reserve records a key for a request in progress. When the key is
+	// already recorded, it returns the recorded key and ErrIdempotencyKeyExists.
+	// Expired keys, and keys reserved for pendingTimeout by requests that
+	// created nothing, are deleted first.
+	Reserve(ctx context.Context, key model.IdempotencyKey, pendingTimeout time.Duration) (*model.IdempotencyKey, error)
+	// Get returns a recorded key
+	Get(ctx context.Context, tenant, key string) (*model.IdempotencyKey, error)
+	// Complete records the response of the request of a key. The resources
+	// it created are recorded by the create itself, see WithIdempotencyKey.
+	Complete(ctx context.Context, tenant, key string, response map[string]any) error
+	// Release deletes a reserved key that created nothing, so that the request can be retried
+	Release(ctx context.Context, tenant, key string) error
+}
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context whose create transactions record the
+// resources they create on the reserved key, so that a retry of the request
+// finds them even if its response was never recorded
+func WithIdempotencyKey(ctx context.Context, key model.IdempotencyKey) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// recordIdempotencyKey records resources on the idempotency key of ctx, if
+// any, within the transaction creating them. The key is recorded again if its
+// reservation was deleted while the request was in progress. It fails with
+// ErrIdempotencyKeyExists when another attempt of the request already created
+// its resources, so that they are not created twice.
+func recordIdempotencyKey(ctx context.Context, tx *gorm.DB, resources ...string) error {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(model.IdempotencyKey)
+	if !ok {
+		return nil
+	}
+	key.Resources = resources
+	result := tx.Model(&model.IdempotencyKey{}).
+		Where("tenant = ? AND idempotency_key = ? AND request_hash = ? AND resources IS NULL", key.Tenant, key.Key, key.RequestHash).
+		Select("resources").
+		Updates(&model.IdempotencyKey{Resources: resources})
+	if result.Error != nil {
+		return fmt.Errorf("failed to record idempotency key: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&model.IdempotencyKey{}).
+		Where("tenant = ? AND idempotency_key = ?", key.Tenant, key.Key).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to record idempotency key: %w", err)
+	}
+	if count > 0 {
+		return ErrIdempotencyKeyExists
+	}
+	if err := tx.Create(&key).Error; err != nil {
+		if isUniqueViolation(err) {
+			return ErrIdempotencyKeyExists
+		}
+		return fmt.Errorf("failed to record idempotency key: %w", err)
+	}
+	return nil
+}
+
+type idempotencyKeyStore struct {
+	db *gorm.DB
+}
+
+// NewIdempotencyKeyStore creates a new IdempotencyKey store
+func NewIdempotencyKeyStore(db *gorm.DB) IdempotencyKeyStore {
+	return &idempotencyKeyStore{db: db}
+}
+
+// Reserve records a key for a request in progress
+func (s *idempotencyKeyStore) Reserve(ctx context.Context, key model.IdempotencyKey, pendingTimeout time.Duration) (*model.IdempotencyKey, error) {
+	var existing model.IdempotencyKey
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Where("expire_time <= ?", now).
+			Or("resources IS NULL AND create_time <= ?", now.Add(-pendingTimeout)).
+			Delete(&model.IdempotencyKey{}).Error; err != nil {
+			return err
+		}
+
+		err := tx.Where("tenant = ? AND idempotency_key = ?", key.Tenant, key.Key).First(&existing).Error
+		if err == nil {
+			return ErrIdempotencyKeyExists
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return tx.Create(&key).Error
+	})
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyExists):
+		return &existing, err
+	case err != nil:
+		// A concurrent request reserved the key first
+		if isUniqueViolation(err) {
+			recorded, err := s.Get(ctx, key.Tenant, key.Key)
+			if err != nil {
+				return nil, err
+			}
+			return recorded, ErrIdempotencyKeyExists
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// Get returns a recorded key
+func (s *idempotencyKeyStore) Get(ctx context.Context, tenant, key string) (*model.IdempotencyKey, error) {
+	var recorded model.IdempotencyKey
+	if err := s.db.WithContext(ctx).Where("tenant = ? AND idempotency_key = ?", tenant, key).First(&recorded).Error; err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+	return &recorded, nil
+}
+
+// Complete records the response of the request of a key
+func (s *idempotencyKeyStore) Complete(ctx context.Context, tenant, key string, response map[string]any) error {
+	return s.db.WithContext(ctx).Model(&model.IdempotencyKey{}).
+		Where("tenant = ? AND idempotency_key = ?", tenant, key).
+		Select("response").
+		Updates(&model.IdempotencyKey{Response: response}).Error
+}
+
+// Release deletes a reserved key that created nothing
+func (s *idempotencyKeyStore) Release(ctx context.Context, tenant, key string) error {
+	return s.db.WithContext(ctx).
+		Where("tenant = ? AND idempotency_key = ? AND resources IS NULL", tenant, key).
+		Delete(&model.IdempotencyKey{}).Error
+}
+
+// isUniqueViolation reports whether err is the violation of a unique constraint
+func isUniqueViolation(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	return errors.Is(err, gorm.ErrDuplicatedKey) ||
+		strings.Contains(errStr, "unique") ||
+		strings.Contains(errStr, "duplicate key")
+}
@@ -34,7 +34,7 @@ var _ = Describe("Foreign Key Constraint Integration Tests", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// Auto-migrate all models to create foreign key constraints
-		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemInstance{})
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
 		Expect(err).ToNot(HaveOccurred())
 
 		serviceTypeStore = store.NewServiceTypeStore(db)
@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/jackc/pgx/v5"
+)
+
+// listenRetryInterval is how long ListenChanges waits before reconnecting
+const listenRetryInterval = 5 * time.Second
+
+// ListenChanges calls notify whenever events are committed to the outbox by any
+// replica, using Postgres LISTEN/NOTIFY on a dedicated connection. It
+// reconnects after failures, calling notify on every reconnection since
+// notifications may have been missed, and returns when ctx is cancelled.
+// It must only be used with the pgsql database type.
+func ListenChanges(ctx context.Context, cfg *config.Config, notify func()) {
+	for {
+		err := listen(ctx, postgresDSN(cfg), notify)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Lost the outbox change notifications, reconnecting in %s: %v", listenRetryInterval, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryInterval):
+		}
+	}
+}
+
+// listen forwards the notifications of changesChannel to notify until the connection fails
+func listen(ctx context.Context, dsn string, notify func()) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{changesChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	notify()
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		notify()
+	}
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+)
+
+// Audited actions
+const (
+	AuditActionCreate = "CREATE"
+	AuditActionUpdate = "UPDATE"
+	AuditActionDelete = "DELETE"
+	AuditActionCancel = "CANCEL"
+)
+
+// AuditEvent is an append-only record of a change made through the API,
+// written in the same transaction as the change. Tenant is the tenant of the
+// caller; Before and After are the resource before and after the change, nil
+// when it did not exist.
+type AuditEvent struct {
+	ID           string         `gorm:"column:id;primaryKey"`
+	Tenant       string         `gorm:"column:tenant;not null;index"`
+	Actor        string         `gorm:"column:actor;not null;index"`
+	Action       string         `gorm:"column:action;not null"`
+	ResourceType string         `gorm:"column:resource_type;not null"`
+	Resource     string         `gorm:"column:resource;not null;index"`
+	RequestID    string         `gorm:"column:request_id;not null;default:''"`
+	Before       map[string]any `gorm:"column:before_state;type:jsonb;serializer:json"`
+	After        map[string]any `gorm:"column:after_state;type:jsonb;serializer:json"`
+	Changes      []AuditChange  `gorm:"column:changes;type:jsonb;serializer:json"`
+	Path         string         `gorm:"column:path;not null"`
+	Time         time.Time      `gorm:"column:time;not null;index"`
+}
+
+// AuditEventList is a slice of AuditEvent for list results
+type AuditEventList []AuditEvent
+
+// AuditChange is a difference between the resource before and after an audited change
+type AuditChange struct {
+	Path   string `json:"path"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
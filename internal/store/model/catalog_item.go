@@ -2,37 +2,77 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
-// CatalogItem represents a catalog item in the database
+// CatalogItem represents a catalog item in the database.
+// Tenant is empty for global catalog items visible to every tenant.
 type CatalogItem struct {
 	ID          string          `gorm:"column:id;primaryKey"`
 	ApiVersion  string          `gorm:"column:api_version;not null"`
 	DisplayName string          `gorm:"column:display_name;not null"`
 	Spec        CatalogItemSpec `gorm:"column:spec;type:jsonb;not null;serializer:json"`
 	Path        string          `gorm:"column:path;not null"`
+	Tenant      string          `gorm:"column:tenant;not null;default:'';index"`
+	Revision    int             `gorm:"column:revision;not null;default:1"`
 	CreateTime  time.Time       `gorm:"column:create_time;autoCreateTime"`
 	UpdateTime  time.Time       `gorm:"column:update_time;autoUpdateTime"`
 
-	// Indexed field for filtering
-	SpecServiceType string       `gorm:"column:spec_service_type;not null;index"`
-	ServiceTypeRef  *ServiceType `gorm:"foreignKey:SpecServiceType;references:ServiceType;constraint:OnDelete:RESTRICT"`
+	// Indexed fields for filtering; together they reference the pinned service type version
+	SpecServiceType        string       `gorm:"column:spec_service_type;not null;index"`
+	SpecServiceTypeVersion string       `gorm:"column:spec_service_type_version;not null;default:''"`
+	ServiceTypeVersionRef  *ServiceType `gorm:"foreignKey:SpecServiceType,SpecServiceTypeVersion;references:ServiceType,ApiVersion;constraint:OnDelete:RESTRICT"`
+}
+
+// AfterFind fills in the pinned service type version of the spec of catalog
+// items created before service type versions were pinned, from the column the
+// database migration backfilled. The service type is filled in from its
+// column when the spec was not loaded.
+func (c *CatalogItem) AfterFind(tx *gorm.DB) error {
+	if c.Spec.ServiceType == "" {
+		c.Spec.ServiceType = c.SpecServiceType
+	}
+	if c.Spec.ServiceTypeVersion == "" {
+		c.Spec.ServiceTypeVersion = c.SpecServiceTypeVersion
+	}
+	return nil
 }
 
 // CatalogItemList is a slice of CatalogItem for list results
 type CatalogItemList []CatalogItem
 
+// CatalogItemRevision is an immutable snapshot of the mutable fields of a
+// catalog item, recorded on every create, update and rollback
+type CatalogItemRevision struct {
+	CatalogItemID string          `gorm:"column:catalog_item_id;primaryKey"`
+	Revision      int             `gorm:"column:revision;primaryKey;autoIncrement:false"`
+	DisplayName   string          `gorm:"column:display_name;not null"`
+	Spec          CatalogItemSpec `gorm:"column:spec;type:jsonb;not null;serializer:json"`
+	Path          string          `gorm:"column:path;not null"`
+	CreateTime    time.Time       `gorm:"column:create_time;autoCreateTime"`
+}
+
+// CatalogItemRevisionList is a slice of CatalogItemRevision for list results
+type CatalogItemRevisionList []CatalogItemRevision
+
 // CatalogItemSpec represents the spec field of a catalog item
 type CatalogItemSpec struct {
-	ServiceType string               `json:"service_type"`
-	Fields      []FieldConfiguration `json:"fields"`
+	ServiceType        string               `json:"service_type"`
+	ServiceTypeVersion string               `json:"service_type_version,omitempty"`
+	Fields             []FieldConfiguration `json:"fields"`
 }
 
-// FieldConfiguration represents a field configuration within a catalog item
+// FieldConfiguration represents a field configuration within a catalog item.
+// DefaultExpression, VisibleWhen and RequiredWhen are CEL expressions
+// evaluated when instances are rendered.
 type FieldConfiguration struct {
-	Path             string         `json:"path"`
-	DisplayName      string         `json:"display_name,omitempty"`
-	Editable         bool           `json:"editable"`
-	Default          any            `json:"default,omitempty"`
-	ValidationSchema map[string]any `json:"validation_schema,omitempty"`
+	Path              string         `json:"path"`
+	DisplayName       string         `json:"display_name,omitempty"`
+	Editable          bool           `json:"editable"`
+	Default           any            `json:"default,omitempty"`
+	DefaultExpression string         `json:"default_expression,omitempty"`
+	ValidationSchema  map[string]any `json:"validation_schema,omitempty"`
+	VisibleWhen       string         `json:"visible_when,omitempty"`
+	RequiredWhen      string         `json:"required_when,omitempty"`
 }
@@ -2,6 +2,8 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // CatalogItemInstance represents a catalog item instance in the database
@@ -10,8 +12,15 @@ type CatalogItemInstance struct {
 	ApiVersion             string                  `gorm:"column:api_version;not null"`
 	DisplayName            string                  `gorm:"column:display_name;not null"`
 	Spec                   CatalogItemInstanceSpec `gorm:"column:spec;type:jsonb;not null;serializer:json"`
+	RenderedSpec           map[string]any          `gorm:"column:rendered_spec;type:jsonb;serializer:json"`
 	ServiceTypeInstanceUid string                  `gorm:"column:service_type_instance_uid"`
+	CatalogItemRevision    int                     `gorm:"column:catalog_item_revision;not null;default:0"`
+	Status                 InstanceStatus          `gorm:"embedded"`
+	DeleteTime             *time.Time              `gorm:"column:delete_time"`
 	Path                   string                  `gorm:"column:path;not null"`
+	Tenant                 string                  `gorm:"column:tenant;not null;index"`
+	ServiceType            string                  `gorm:"column:service_type;not null;default:'';index"`
+	Resources              InstanceResources       `gorm:"embedded"`
 	CreateTime             time.Time               `gorm:"column:create_time;autoCreateTime"`
 	UpdateTime             time.Time               `gorm:"column:update_time;autoUpdateTime"`
 
@@ -20,9 +29,24 @@ type CatalogItemInstance struct {
 	CatalogItemRef    *CatalogItem `gorm:"foreignKey:SpecCatalogItemId;references:ID;constraint:OnDelete:RESTRICT"`
 }
 
+// AfterFind fills in the catalog item of the spec from its column when the
+// spec was not loaded
+func (c *CatalogItemInstance) AfterFind(tx *gorm.DB) error {
+	if c.Spec.CatalogItemId == "" {
+		c.Spec.CatalogItemId = c.SpecCatalogItemId
+	}
+	return nil
+}
+
 // CatalogItemInstanceList is a slice of CatalogItemInstance for list results
 type CatalogItemInstanceList []CatalogItemInstance
 
+// InstanceCount is the number of instances sharing the value Key of an attribute
+type InstanceCount struct {
+	Key   string `gorm:"column:group_key"`
+	Count int64  `gorm:"column:instances"`
+}
+
 // CatalogItemInstanceSpec represents the spec field of a catalog item instance
 type CatalogItemInstanceSpec struct {
 	CatalogItemId string      `json:"catalog_item_id"`
@@ -34,3 +58,40 @@ type UserValue struct {
 	Path  string `json:"path"`
 	Value any    `json:"value"`
 }
+
+// Lifecycle states of a catalog item instance
+const (
+	InstanceStatePending      = "PENDING"
+	InstanceStateProvisioning = "PROVISIONING"
+	InstanceStateReady        = "READY"
+	InstanceStateFailed       = "FAILED"
+	InstanceStateDeleting     = "DELETING"
+)
+
+// InstanceStatus is the lifecycle status of an instance, advanced by the reconciler.
+// NextAttemptTime is when the reconciler should next look at the instance; it is
+// nil once the instance has settled in READY or FAILED.
+type InstanceStatus struct {
+	State           string      `gorm:"column:state;not null;default:'PENDING';index"`
+	Conditions      []Condition `gorm:"column:conditions;type:jsonb;serializer:json"`
+	LastError       string      `gorm:"column:last_error"`
+	Attempts        int         `gorm:"column:attempts;not null;default:0"`
+	NextAttemptTime *time.Time  `gorm:"column:next_attempt_time;index"`
+}
+
+// Condition is an observation about one aspect of an instance's status
+type Condition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"last_transition_time"`
+}
+
+// InstanceResources are the resources requested by an instance's rendered spec,
+// stored for quota accounting
+type InstanceResources struct {
+	Vcpu      int64 `gorm:"column:vcpu;not null;default:0"`
+	MemoryMB  int64 `gorm:"column:memory_mb;not null;default:0"`
+	StorageMB int64 `gorm:"column:storage_mb;not null;default:0"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+// IdempotencyKey records a create request made with an idempotency key
+// (AEP-155), so that retries of the request get its response instead of
+// creating another resource. Keys are scoped to the tenant of the caller.
+// Resources are the paths of the resources returned by the request, recorded
+// in the transaction creating them; they are nil until it committed. Response
+// is nil until it was recorded after the commit.
+type IdempotencyKey struct {
+	Tenant      string         `gorm:"column:tenant;primaryKey"`
+	Key         string         `gorm:"column:idempotency_key;primaryKey"`
+	Operation   string         `gorm:"column:operation;not null"`
+	RequestHash string         `gorm:"column:request_hash;not null"`
+	Resources   []string       `gorm:"column:resources;type:jsonb;serializer:json"`
+	Response    map[string]any `gorm:"column:response;type:jsonb;serializer:json"`
+	CreateTime  time.Time      `gorm:"column:create_time;autoCreateTime"`
+	ExpireTime  time.Time      `gorm:"column:expire_time;not null;index"`
+}
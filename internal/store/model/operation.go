@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+)
+
+// Operation types
+const (
+	OperationTypeCreateCatalogItemInstance = "CreateCatalogItemInstance"
+	OperationTypeDeleteCatalogItemInstance = "DeleteCatalogItemInstance"
+)
+
+// Operation tracks a long-running operation (AEP-151) on a catalog item instance.
+// The response of a successful creation is the instance itself, so it is not stored.
+type Operation struct {
+	ID              string          `gorm:"column:id;primaryKey"`
+	Tenant          string          `gorm:"column:tenant;not null;index"`
+	Type            string          `gorm:"column:type;not null"`
+	TargetID        string          `gorm:"column:target_id;not null;index"`
+	TargetPath      string          `gorm:"column:target_path;not null"`
+	Done            bool            `gorm:"column:done;not null;default:false;index"`
+	Error           *OperationError `gorm:"column:error;type:jsonb;serializer:json"`
+	CancelRequested bool            `gorm:"column:cancel_requested;not null;default:false"`
+	Path            string          `gorm:"column:path;not null"`
+	CreateTime      time.Time       `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime      time.Time       `gorm:"column:update_time;autoUpdateTime"`
+	EndTime         *time.Time      `gorm:"column:end_time"`
+}
+
+// Error types of failed operations, following the AEP error codes
+const (
+	OperationErrorAborted   = "ABORTED"
+	OperationErrorCancelled = "CANCELLED"
+)
+
+// OperationList is a slice of Operation for list results
+type OperationList []Operation
+
+// OperationError is the error a failed operation completed with
+type OperationError struct {
+	Type   string `json:"type"`
+	Status int32  `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail,omitempty"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+)
+
+// Event types recorded in the outbox, following the CloudEvents reverse-DNS convention
+const (
+	EventServiceTypeCreated             = "io.dcm.catalog.service_type.created"
+	EventServiceTypeUpdated             = "io.dcm.catalog.service_type.updated"
+	EventCatalogItemCreated             = "io.dcm.catalog.catalog_item.created"
+	EventCatalogItemUpdated             = "io.dcm.catalog.catalog_item.updated"
+	EventCatalogItemDeleted             = "io.dcm.catalog.catalog_item.deleted"
+	EventCatalogItemInstanceCreated     = "io.dcm.catalog.catalog_item_instance.created"
+	EventCatalogItemInstanceUpdated     = "io.dcm.catalog.catalog_item_instance.updated"
+	EventCatalogItemInstanceStateChange = "io.dcm.catalog.catalog_item_instance.state_changed"
+	EventCatalogItemInstanceDeleted     = "io.dcm.catalog.catalog_item_instance.deleted"
+)
+
+// Outbox event delivery states
+const (
+	OutboxEventStatePending   = "PENDING"
+	OutboxEventStateDelivered = "DELIVERED"
+	// OutboxEventStateDead marks events that exhausted their delivery attempts
+	OutboxEventStateDead = "DEAD"
+)
+
+// OutboxEvent is a resource change recorded in the same transaction as the change
+// itself and delivered asynchronously as a CloudEvent. Sequence orders the events.
+type OutboxEvent struct {
+	Sequence        int64          `gorm:"column:sequence;primaryKey;autoIncrement"`
+	ID              string         `gorm:"column:id;not null;uniqueIndex"`
+	Type            string         `gorm:"column:type;not null"`
+	Subject         string         `gorm:"column:subject;not null"`
+	Tenant          string         `gorm:"column:tenant;not null;default:''"`
+	Time            time.Time      `gorm:"column:time;not null"`
+	Data            map[string]any `gorm:"column:data;type:jsonb;serializer:json"`
+	State           string         `gorm:"column:state;not null;default:'PENDING';index"`
+	DeliveredSinks  []string       `gorm:"column:delivered_sinks;type:jsonb;serializer:json"`
+	Attempts        int            `gorm:"column:attempts;not null;default:0"`
+	LastError       string         `gorm:"column:last_error"`
+	NextAttemptTime time.Time      `gorm:"column:next_attempt_time;not null;index"`
+	DeliveredTime   *time.Time     `gorm:"column:delivered_time"`
+}
+
+// OutboxEventList is a slice of OutboxEvent for list results
+type OutboxEventList []OutboxEvent
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+)
+
+// Quota caps the catalog item instances of a tenant.
+// An empty ServiceType and CatalogItemId apply the quota to every instance of
+// the tenant; otherwise it only counts the matching instances.
+// Nil limits are unlimited.
+type Quota struct {
+	ID            string    `gorm:"column:id;primaryKey"`
+	Tenant        string    `gorm:"column:tenant;not null;index"`
+	ServiceType   string    `gorm:"column:service_type;not null;default:''"`
+	CatalogItemId string    `gorm:"column:catalog_item_id;not null;default:''"`
+	MaxInstances  *int64    `gorm:"column:max_instances"`
+	MaxVcpu       *int64    `gorm:"column:max_vcpu"`
+	MaxMemoryMB   *int64    `gorm:"column:max_memory_mb"`
+	MaxStorageMB  *int64    `gorm:"column:max_storage_mb"`
+	Path          string    `gorm:"column:path;not null"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime    time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// QuotaList is a slice of Quota for list results
+type QuotaList []Quota
+
+// ResourceUsage is the aggregate resource consumption of a set of instances
+type ResourceUsage struct {
+	Instances int64
+	Vcpu      int64
+	MemoryMB  int64
+	StorageMB int64
+}
@@ -4,11 +4,16 @@ import (
 	"time"
 )
 
-// ServiceType represents a service type definition in the database
+// ServiceType represents a version of a service type definition in the database.
+// Several versions (api_version) of a service type may coexist; exactly one of
+// them is the default, used by catalog items that do not pin a version.
 type ServiceType struct {
 	ID          string         `gorm:"column:id;primaryKey"`
-	ApiVersion  string         `gorm:"column:api_version;not null"`
-	ServiceType string         `gorm:"column:service_type;not null;uniqueIndex"`
+	ApiVersion  string         `gorm:"column:api_version;not null;uniqueIndex:idx_service_type_version"`
+	ServiceType string         `gorm:"column:service_type;not null;uniqueIndex:idx_service_type_version;uniqueIndex:idx_service_type_default,where:default_version"`
+	Default     bool           `gorm:"column:default_version;not null;default:false"`
+	Deprecation Deprecation    `gorm:"embedded"`
+	Conversions []Conversion   `gorm:"column:conversions;type:jsonb;serializer:json"`
 	Metadata    Metadata       `gorm:"column:metadata;type:jsonb;serializer:json"`
 	Spec        map[string]any `gorm:"column:spec;type:jsonb;not null;serializer:json"`
 	Path        string         `gorm:"column:path;not null"`
@@ -18,6 +23,29 @@ type ServiceType struct {
 
 type ServiceTypeList []ServiceType
 
+// Deprecation marks a service type version as deprecated. Using a deprecated
+// version raises a warning; once SunsetTime has passed, no new catalog items or
+// instances may use it.
+type Deprecation struct {
+	Deprecated bool       `gorm:"column:deprecated;not null;default:false"`
+	Message    string     `gorm:"column:deprecation_message;not null;default:''"`
+	SunsetTime *time.Time `gorm:"column:sunset_time"`
+}
+
+// Conversion converts specs of another version of the service type to the
+// version declaring it
+type Conversion struct {
+	FromVersion   string         `json:"from_version"`
+	FieldMappings []FieldMapping `json:"field_mappings,omitempty"`
+}
+
+// FieldMapping moves a field of the source version to another path, or drops
+// it when To is empty
+type FieldMapping struct {
+	From string `json:"from"`
+	To   string `json:"to,omitempty"`
+}
+
 // Metadata represents the metadata field with labels
 type Metadata struct {
 	Labels map[string]string `json:"labels,omitempty"`
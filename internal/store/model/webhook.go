@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+)
+
+// EventWebhookPing is the type of the event sent when a webhook subscription is tested
+const EventWebhookPing = "io.dcm.catalog.webhook_subscription.ping"
+
+// EventTypes lists the event types recorded in the outbox
+var EventTypes = []string{
+	EventServiceTypeCreated,
+	EventServiceTypeUpdated,
+	EventCatalogItemCreated,
+	EventCatalogItemUpdated,
+	EventCatalogItemDeleted,
+	EventCatalogItemInstanceCreated,
+	EventCatalogItemInstanceUpdated,
+	EventCatalogItemInstanceStateChange,
+	EventCatalogItemInstanceDeleted,
+}
+
+// Webhook delivery states
+const (
+	WebhookDeliveryStatePending   = "PENDING"
+	WebhookDeliveryStateSucceeded = "SUCCEEDED"
+	WebhookDeliveryStateFailed    = "FAILED"
+)
+
+// WebhookSubscription is a URL of a tenant notified of resource changes.
+// Empty EventTypes and ResourceFilter match every event.
+type WebhookSubscription struct {
+	ID             string    `gorm:"column:id;primaryKey"`
+	Tenant         string    `gorm:"column:tenant;not null;index"`
+	URL            string    `gorm:"column:url;not null"`
+	EventTypes     []string  `gorm:"column:event_types;type:jsonb;serializer:json"`
+	ResourceFilter string    `gorm:"column:resource_filter;not null;default:''"`
+	Secret         string    `gorm:"column:secret;not null"`
+	Path           string    `gorm:"column:path;not null"`
+	CreateTime     time.Time `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime     time.Time `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// WebhookSubscriptionList is a slice of WebhookSubscription for list results
+type WebhookSubscriptionList []WebhookSubscription
+
+// WebhookDelivery is the delivery of one event to a webhook subscription.
+// Payload is the exact request body, so that retries are signed over the same bytes.
+type WebhookDelivery struct {
+	ID              string               `gorm:"column:id;primaryKey"`
+	SubscriptionID  string               `gorm:"column:subscription_id;not null;uniqueIndex:idx_webhook_delivery_event"`
+	SubscriptionRef *WebhookSubscription `gorm:"foreignKey:SubscriptionID;references:ID;constraint:OnDelete:CASCADE"`
+	Tenant          string               `gorm:"column:tenant;not null;index"`
+	EventID         string               `gorm:"column:event_id;not null;uniqueIndex:idx_webhook_delivery_event"`
+	EventType       string               `gorm:"column:event_type;not null"`
+	Payload         string               `gorm:"column:payload;type:text;not null"`
+	State           string               `gorm:"column:state;not null;default:'PENDING';index"`
+	Attempts        int                  `gorm:"column:attempts;not null;default:0"`
+	ResponseCode    int                  `gorm:"column:response_code;not null;default:0"`
+	LastError       string               `gorm:"column:last_error"`
+	NextAttemptTime time.Time            `gorm:"column:next_attempt_time;not null;index"`
+	CreateTime      time.Time            `gorm:"column:create_time;autoCreateTime"`
+	UpdateTime      time.Time            `gorm:"column:update_time;autoUpdateTime"`
+}
+
+// WebhookDeliveryList is a slice of WebhookDelivery for list results
+type WebhookDeliveryList []WebhookDelivery
@@ -0,0 +1,191 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrOperationNotFound is returned when an operation is not found
+	ErrOperationNotFound = errors.New("operation not found")
+	// ErrOperationNotCancellable is returned when cancelling an operation that cannot be cancelled
+	ErrOperationNotCancellable = errors.New("operation cannot be cancelled")
+)
+
+// OperationListOptions contains options for listing operations
+type OperationListOptions struct {
+	PageToken *string
+	PageSize  int
+	// Tenant restricts the results to the operations of a tenant
+	Tenant *string
+}
+
+// OperationListResult contains the result of a List operation
+type OperationListResult struct {
+	Operations    model.OperationList
+	NextPageToken *string
+}
+
+// OperationStore defines operations for Operation resources.
+// Operations are created and completed by the CatalogItemInstance store, in the
+// same transactions as the changes they track.
+type OperationStore interface {
+	List(ctx context.Context, opts *OperationListOptions) (*OperationListResult, error)
+	Get(ctx context.Context, id string) (*model.Operation, error)
+	// Cancel completes a pending creation with a CANCELLED error and requests
+	// the deletion of the instance being created
+	Cancel(ctx context.Context, id string) (*model.Operation, error)
+}
+
+type operationStore struct {
+	db      *gorm.DB
+	changes *changeNotifier
+}
+
+// NewOperationStore creates a new Operation store
+func NewOperationStore(db *gorm.DB) OperationStore {
+	return &operationStore{db: db}
+}
+
+// List returns a paginated list of operations, most recent first
+func (s *operationStore) List(ctx context.Context, opts *OperationListOptions) (*OperationListResult, error) {
+	var operations model.OperationList
+	query := scopeTenantOwned(ctx, s.db.WithContext(ctx))
+
+	// Default max page size
+	pageSize := 100
+	if opts != nil && opts.PageSize > 0 {
+		pageSize = opts.PageSize
+	}
+
+	// Decode page token to get offset
+	offset := 0
+	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*opts.PageToken)
+		if err == nil {
+			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
+				offset = parsedOffset
+			}
+		}
+	}
+
+	query = query.Order("create_time DESC").Order("id ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil && opts.Tenant != nil {
+		query = query.Where("tenant = ?", *opts.Tenant)
+	}
+
+	if err := query.Find(&operations).Error; err != nil {
+		return nil, err
+	}
+
+	result := &OperationListResult{
+		Operations: operations,
+	}
+	if len(operations) > pageSize {
+		result.Operations = operations[:pageSize]
+		nextOffset := offset + pageSize
+		nextPageToken := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		result.NextPageToken = &nextPageToken
+	}
+	return result, nil
+}
+
+// Get retrieves an operation by ID
+func (s *operationStore) Get(ctx context.Context, id string) (*model.Operation, error) {
+	return getOperation(ctx, s.db.WithContext(ctx), id)
+}
+
+func getOperation(ctx context.Context, db *gorm.DB, id string) (*model.Operation, error) {
+	var op model.Operation
+	if err := scopeTenantOwned(ctx, db).Where("id = ?", id).First(&op).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOperationNotFound
+		}
+		return nil, fmt.Errorf("failed to get operation: %w", err)
+	}
+	return &op, nil
+}
+
+// Cancel completes a pending creation with a CANCELLED error and requests the
+// deletion of the instance being created. Operations that are done are
+// returned unchanged; deletions cannot be cancelled.
+func (s *operationStore) Cancel(ctx context.Context, id string) (*model.Operation, error) {
+	var op *model.Operation
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var err error
+		op, err = getOperation(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if op.Done {
+			return nil
+		}
+		if op.Type != model.OperationTypeCreateCatalogItemInstance {
+			return fmt.Errorf("%w: %s operations cannot be cancelled", ErrOperationNotCancellable, op.Type)
+		}
+		before := operationAuditData(op)
+
+		now := time.Now()
+		op.Done = true
+		op.CancelRequested = true
+		op.EndTime = &now
+		op.Error = &model.OperationError{
+			Type:   model.OperationErrorCancelled,
+			Status: 499,
+			Title:  "Cancelled",
+			Detail: "the operation was cancelled",
+		}
+		result := tx.Model(&model.Operation{}).
+			Where("id = ? AND done = ?", op.ID, false).
+			Select("done", "cancel_requested", "end_time", "error").
+			Updates(op)
+		if result.Error != nil {
+			return fmt.Errorf("failed to cancel operation: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			// Completed concurrently
+			op, err = getOperation(ctx, tx, id)
+			return err
+		}
+
+		err = markDeleting(ctx, tx, op.TargetID, nil)
+		if err != nil && !errors.Is(err, ErrCatalogItemInstanceNotFound) {
+			return err
+		}
+		return recordAudit(ctx, tx, model.AuditActionCancel, auditOperation, op.Path, before, operationAuditData(op))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// completeOperations marks the pending operations of a type on a target as done,
+// failed with opErr if it is not nil
+func completeOperations(tx *gorm.DB, targetID, opType string, opErr *model.OperationError) error {
+	now := time.Now()
+	if err := tx.Model(&model.Operation{}).
+		Where("target_id = ? AND type = ? AND done = ?", targetID, opType, false).
+		Select("done", "end_time", "error").
+		Updates(&model.Operation{Done: true, EndTime: &now, Error: opErr}).Error; err != nil {
+		return fmt.Errorf("failed to complete operations: %w", err)
+	}
+	return nil
+}
+
+// abortedError is the error of operations whose target failed or went away
+func abortedError(detail string) *model.OperationError {
+	return &model.OperationError{
+		Type:   model.OperationErrorAborted,
+		Status: 409,
+		Title:  "Aborted",
+		Detail: detail,
+	}
+}
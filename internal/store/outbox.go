@@ -0,0 +1,231 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrOutboxEventNotDue is returned when claiming an event that is not pending,
+// not due, or already claimed by another relay
+var ErrOutboxEventNotDue = errors.New("outbox event is not due for delivery")
+
+// OutboxStore gives the event relay access to the outbox. Events are recorded by
+// the other stores, in the same transactions as the changes they describe.
+type OutboxStore interface {
+	// ListDue returns pending events whose next delivery attempt is due, in sequence order
+	ListDue(ctx context.Context, now time.Time, limit int) (model.OutboxEventList, error)
+	// Claim atomically takes a due event for delivery by moving its next attempt
+	// to leaseUntil, so that relays of other replicas skip it
+	Claim(ctx context.Context, sequence int64, now, leaseUntil time.Time) (*model.OutboxEvent, error)
+	// UpdateDelivery saves the delivery state of an event
+	UpdateDelivery(ctx context.Context, event *model.OutboxEvent) error
+	// Prune deletes the events delivered before the given time and returns how many were deleted
+	Prune(ctx context.Context, deliveredBefore time.Time) (int64, error)
+	// ListAfter returns the events following a sequence number, in sequence order,
+	// whatever their delivery state
+	ListAfter(ctx context.Context, sequence int64, limit int) (model.OutboxEventList, error)
+	// LastSequence returns the sequence number of the most recent event, or 0
+	LastSequence(ctx context.Context) (int64, error)
+	// Subscribe returns a channel signalled after events are committed by this
+	// process, and a function unsubscribing it. Signals are coalesced.
+	Subscribe() (<-chan struct{}, func())
+}
+
+type outboxStore struct {
+	db      *gorm.DB
+	changes *changeNotifier
+}
+
+// NewOutboxStore creates a new Outbox store
+func NewOutboxStore(db *gorm.DB) OutboxStore {
+	return &outboxStore{db: db}
+}
+
+// ListDue returns pending events whose next delivery attempt is due, in sequence order
+func (s *outboxStore) ListDue(ctx context.Context, now time.Time, limit int) (model.OutboxEventList, error) {
+	var events model.OutboxEventList
+	if err := dueForDelivery(s.db.WithContext(ctx), now).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list due outbox events: %w", err)
+	}
+	return events, nil
+}
+
+// Claim atomically takes a due event for delivery by moving its next attempt to leaseUntil
+func (s *outboxStore) Claim(ctx context.Context, sequence int64, now, leaseUntil time.Time) (*model.OutboxEvent, error) {
+	result := dueForDelivery(s.db.WithContext(ctx).Model(&model.OutboxEvent{}), now).
+		Where("sequence = ?", sequence).
+		Update("next_attempt_time", leaseUntil)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim outbox event: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrOutboxEventNotDue
+	}
+
+	var event model.OutboxEvent
+	if err := s.db.WithContext(ctx).Where("sequence = ?", sequence).First(&event).Error; err != nil {
+		return nil, fmt.Errorf("failed to get claimed outbox event: %w", err)
+	}
+	return &event, nil
+}
+
+// dueForDelivery restricts a query to the pending events whose next delivery attempt is due
+func dueForDelivery(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("state = ? AND next_attempt_time <= ?", model.OutboxEventStatePending, now)
+}
+
+// UpdateDelivery saves the delivery state of an event
+func (s *outboxStore) UpdateDelivery(ctx context.Context, event *model.OutboxEvent) error {
+	if err := s.db.WithContext(ctx).Model(&model.OutboxEvent{}).
+		Where("sequence = ?", event.Sequence).
+		Select("state", "delivered_sinks", "attempts", "last_error", "next_attempt_time", "delivered_time").
+		Updates(event).Error; err != nil {
+		return fmt.Errorf("failed to update outbox event: %w", err)
+	}
+	return nil
+}
+
+// Prune deletes the events delivered before the given time. Dead events are kept.
+func (s *outboxStore) Prune(ctx context.Context, deliveredBefore time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).
+		Where("state = ? AND delivered_time < ?", model.OutboxEventStateDelivered, deliveredBefore).
+		Delete(&model.OutboxEvent{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to prune outbox events: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// ListAfter returns the events following a sequence number, in sequence order
+func (s *outboxStore) ListAfter(ctx context.Context, sequence int64, limit int) (model.OutboxEventList, error) {
+	var events model.OutboxEventList
+	if err := s.db.WithContext(ctx).
+		Where("sequence > ?", sequence).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+	return events, nil
+}
+
+// LastSequence returns the sequence number of the most recent event, or 0
+func (s *outboxStore) LastSequence(ctx context.Context) (int64, error) {
+	var last *int64
+	if err := s.db.WithContext(ctx).Model(&model.OutboxEvent{}).
+		Select("MAX(sequence)").
+		Scan(&last).Error; err != nil {
+		return 0, fmt.Errorf("failed to get the last outbox event: %w", err)
+	}
+	if last == nil {
+		return 0, nil
+	}
+	return *last, nil
+}
+
+// Subscribe returns a channel signalled after events are committed by this process
+func (s *outboxStore) Subscribe() (<-chan struct{}, func()) {
+	return s.changes.subscribe()
+}
+
+// recordEvent writes an event to the outbox within tx. On Postgres it also
+// notifies the listeners of changesChannel, which Postgres delivers on commit.
+func recordEvent(tx *gorm.DB, eventType, subject, tenant string, data map[string]any) error {
+	now := time.Now().UTC()
+	event := model.OutboxEvent{
+		ID:              uuid.New().String(),
+		Type:            eventType,
+		Subject:         subject,
+		Tenant:          tenant,
+		Time:            now,
+		Data:            data,
+		State:           model.OutboxEventStatePending,
+		NextAttemptTime: now,
+	}
+	if err := tx.Create(&event).Error; err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
+	if tx.Dialector.Name() == "postgres" {
+		if err := tx.Exec("SELECT pg_notify(?, '')", changesChannel).Error; err != nil {
+			return fmt.Errorf("failed to notify %s event: %w", eventType, err)
+		}
+	}
+	return nil
+}
+
+// serviceTypeEventData is the payload of service type events
+func serviceTypeEventData(m *model.ServiceType) map[string]any {
+	data := map[string]any{
+		"uid":          m.ID,
+		"path":         m.Path,
+		"api_version":  m.ApiVersion,
+		"service_type": m.ServiceType,
+		"default":      m.Default,
+		"metadata":     m.Metadata,
+		"spec":         m.Spec,
+	}
+	if m.Deprecation.Deprecated {
+		data["deprecated"] = true
+		if m.Deprecation.Message != "" {
+			data["deprecation_message"] = m.Deprecation.Message
+		}
+		if m.Deprecation.SunsetTime != nil {
+			data["sunset_time"] = m.Deprecation.SunsetTime
+		}
+	}
+	return data
+}
+
+// catalogItemEventData is the payload of catalog item events
+func catalogItemEventData(m *model.CatalogItem) map[string]any {
+	return map[string]any{
+		"uid":          m.ID,
+		"path":         m.Path,
+		"api_version":  m.ApiVersion,
+		"display_name": m.DisplayName,
+		"spec":         m.Spec,
+		"revision":     m.Revision,
+	}
+}
+
+// catalogItemInstanceEventData is the payload of catalog item instance events
+func catalogItemInstanceEventData(m *model.CatalogItemInstance) map[string]any {
+	data := map[string]any{
+		"uid":          m.ID,
+		"path":         m.Path,
+		"api_version":  m.ApiVersion,
+		"display_name": m.DisplayName,
+		"spec":         m.Spec,
+		"service_type": m.ServiceType,
+		"state":        m.Status.State,
+		"resources": map[string]any{
+			"vcpu":       m.Resources.Vcpu,
+			"memory_mb":  m.Resources.MemoryMB,
+			"storage_mb": m.Resources.StorageMB,
+		},
+		"create_time": m.CreateTime,
+		"update_time": m.UpdateTime,
+	}
+	if len(m.Status.Conditions) > 0 {
+		data["conditions"] = m.Status.Conditions
+	}
+	if m.ServiceTypeInstanceUid != "" {
+		data["service_type_instance_uid"] = m.ServiceTypeInstanceUid
+	}
+	if m.CatalogItemRevision != 0 {
+		data["catalog_item_revision"] = m.CatalogItemRevision
+	}
+	if m.Status.LastError != "" {
+		data["last_error"] = m.Status.LastError
+	}
+	return data
+}
@@ -0,0 +1,194 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+var _ = Describe("Outbox Store", func() {
+	var (
+		ctx context.Context
+		db  *gorm.DB
+		str store.Store
+	)
+
+	eventTypes := func() []string {
+		events, err := str.Outbox().ListDue(ctx, time.Now(), 100)
+		Expect(err).ToNot(HaveOccurred())
+		types := make([]string, len(events))
+		for i, e := range events {
+			types[i] = e.Type
+		}
+		return types
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		err = db.AutoMigrate(&model.ServiceType{}, &model.CatalogItem{}, &model.CatalogItemRevision{}, &model.CatalogItemInstance{}, &model.Quota{}, &model.Operation{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+
+		_, err = str.ServiceType().Create(ctx, model.ServiceType{
+			ID: "vm", ApiVersion: "v1alpha1", ServiceType: "vm", Spec: map[string]any{}, Path: "service-types/vm",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = str.CatalogItem().Create(ctx, model.CatalogItem{
+			ID: "small-vm", ApiVersion: "v1alpha1", DisplayName: "Small VM", Path: "catalog-items/small-vm",
+			Spec: model.CatalogItemSpec{ServiceType: "vm"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(str.Close()).To(Succeed())
+	})
+
+	It("should record an event for every change, in order", func() {
+		_, err := str.CatalogItemInstance().Create(ctx, model.CatalogItemInstance{
+			ID: "my-vm", ApiVersion: "v1alpha1", DisplayName: "My VM", Path: "tenants/team-a/catalog-item-instances/my-vm",
+			Tenant: "team-a", Spec: model.CatalogItemInstanceSpec{CatalogItemId: "small-vm"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(str.CatalogItemInstance().MarkDeleting(ctx, "my-vm", nil)).To(Succeed())
+		Expect(str.CatalogItemInstance().Delete(ctx, "my-vm")).To(Succeed())
+		Expect(str.CatalogItem().Delete(ctx, "small-vm")).To(Succeed())
+
+		Expect(eventTypes()).To(Equal([]string{
+			model.EventServiceTypeCreated,
+			model.EventCatalogItemCreated,
+			model.EventCatalogItemInstanceCreated,
+			model.EventCatalogItemInstanceStateChange,
+			model.EventCatalogItemInstanceDeleted,
+			model.EventCatalogItemDeleted,
+		}))
+
+		events, err := str.Outbox().ListDue(ctx, time.Now(), 100)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events[2].Subject).To(Equal("tenants/team-a/catalog-item-instances/my-vm"))
+		Expect(events[2].Tenant).To(Equal("team-a"))
+		Expect(events[3].Data).To(HaveKeyWithValue("state", model.InstanceStateDeleting))
+	})
+
+	It("should not record events for changes that are rolled back", func() {
+		_, err := str.ServiceType().Create(ctx, model.ServiceType{
+			ID: "vm", ApiVersion: "v1alpha1", ServiceType: "vm", Spec: map[string]any{}, Path: "service-types/vm",
+		})
+		Expect(err).To(MatchError(store.ErrServiceTypeIDTaken))
+
+		_, err = str.CatalogItemInstance().Create(ctx, model.CatalogItemInstance{
+			ID: "my-vm", ApiVersion: "v1alpha1", DisplayName: "My VM", Path: "catalog-item-instances/my-vm",
+			Spec: model.CatalogItemInstanceSpec{CatalogItemId: "missing"},
+		})
+		Expect(err).To(HaveOccurred())
+
+		Expect(eventTypes()).To(Equal([]string{model.EventServiceTypeCreated, model.EventCatalogItemCreated}))
+	})
+
+	It("should only record state changes of the status", func() {
+		instance, err := str.CatalogItemInstance().Create(ctx, model.CatalogItemInstance{
+			ID: "my-vm", ApiVersion: "v1alpha1", DisplayName: "My VM", Path: "catalog-item-instances/my-vm",
+			Spec:   model.CatalogItemInstanceSpec{CatalogItemId: "small-vm"},
+			Status: model.InstanceStatus{State: model.InstanceStatePending},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		instance.Status.Attempts = 1
+		Expect(str.CatalogItemInstance().UpdateStatus(ctx, instance)).To(Succeed())
+		instance.Status.State = model.InstanceStateReady
+		Expect(str.CatalogItemInstance().UpdateStatus(ctx, instance)).To(Succeed())
+
+		Expect(eventTypes()).To(Equal([]string{
+			model.EventServiceTypeCreated,
+			model.EventCatalogItemCreated,
+			model.EventCatalogItemInstanceCreated,
+			model.EventCatalogItemInstanceStateChange,
+		}))
+	})
+
+	It("should prune delivered events only", func() {
+		events, err := str.Outbox().ListDue(ctx, time.Now(), 100)
+		Expect(err).ToNot(HaveOccurred())
+		delivered := time.Now().Add(-time.Hour)
+		events[0].State = model.OutboxEventStateDelivered
+		events[0].DeliveredTime = &delivered
+		Expect(str.Outbox().UpdateDelivery(ctx, &events[0])).To(Succeed())
+		events[1].State = model.OutboxEventStateDead
+		Expect(str.Outbox().UpdateDelivery(ctx, &events[1])).To(Succeed())
+
+		pruned, err := str.Outbox().Prune(ctx, time.Now())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pruned).To(Equal(int64(1)))
+
+		var count int64
+		Expect(db.Model(&model.OutboxEvent{}).Count(&count).Error).To(Succeed())
+		Expect(count).To(Equal(int64(1)))
+	})
+
+	It("should let only one relay claim a due event", func() {
+		due, err := str.Outbox().ListDue(ctx, time.Now(), 100)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(due).To(HaveLen(2))
+
+		now := time.Now()
+		claimed, err := str.Outbox().Claim(ctx, due[0].Sequence, now, now.Add(time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(claimed.ID).To(Equal(due[0].ID))
+
+		_, err = str.Outbox().Claim(ctx, due[0].Sequence, now, now.Add(time.Minute))
+		Expect(err).To(MatchError(store.ErrOutboxEventNotDue))
+
+		due, err = str.Outbox().ListDue(ctx, time.Now(), 100)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(due).To(HaveLen(1))
+
+		// The event is due again once the lease expires
+		_, err = str.Outbox().Claim(ctx, claimed.Sequence, now.Add(2*time.Minute), now.Add(3*time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should list the events after a sequence number", func() {
+		last, err := str.Outbox().LastSequence(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(last).To(Equal(int64(2)))
+
+		events, err := str.Outbox().ListAfter(ctx, 1, 100)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Sequence).To(Equal(int64(2)))
+		Expect(events[0].Type).To(Equal(model.EventCatalogItemCreated))
+
+		events, err = str.Outbox().ListAfter(ctx, last, 100)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(BeEmpty())
+	})
+
+	It("should notify subscribers of committed changes", func() {
+		changes, unsubscribe := str.Outbox().Subscribe()
+		defer unsubscribe()
+		Expect(changes).ToNot(Receive())
+
+		Expect(str.CatalogItem().Delete(ctx, "small-vm")).To(Succeed())
+		Expect(changes).To(Receive())
+
+		_, err := str.ServiceType().Create(ctx, model.ServiceType{
+			ID: "vm", ApiVersion: "v1alpha1", ServiceType: "vm", Spec: map[string]any{}, Path: "service-types/vm",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(changes).ToNot(Receive())
+	})
+})
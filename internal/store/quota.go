@@ -0,0 +1,232 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrQuotaNotFound is returned when a quota is not found
+	ErrQuotaNotFound = errors.New("quota not found")
+	// ErrQuotaIDTaken is returned when a quota ID is already taken
+	ErrQuotaIDTaken = errors.New("quota ID already exists")
+	// ErrQuotaExceeded is returned when creating an instance would exceed a quota
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrQuotaReadOnly is returned when a tenant creates or deletes a quota
+	ErrQuotaReadOnly = errors.New("quotas are read-only to tenants")
+)
+
+// QuotaListOptions contains options for listing quotas
+type QuotaListOptions struct {
+	PageToken *string
+	PageSize  int
+	// Tenant restricts the results to the quotas of a tenant
+	Tenant *string
+}
+
+// QuotaListResult contains the result of a List operation
+type QuotaListResult struct {
+	Quotas        model.QuotaList
+	NextPageToken *string
+}
+
+// QuotaStore defines operations for Quota resources
+type QuotaStore interface {
+	List(ctx context.Context, opts *QuotaListOptions) (*QuotaListResult, error)
+	Create(ctx context.Context, quota model.Quota) (*model.Quota, error)
+	Get(ctx context.Context, id string) (*model.Quota, error)
+	Delete(ctx context.Context, id string) error
+	// Usage returns the resources consumed by the instances the quota applies to
+	Usage(ctx context.Context, quota model.Quota) (*model.ResourceUsage, error)
+}
+
+type quotaStore struct {
+	db *gorm.DB
+}
+
+// NewQuotaStore creates a new Quota store
+func NewQuotaStore(db *gorm.DB) QuotaStore {
+	return &quotaStore{db: db}
+}
+
+// List returns a paginated list of quotas. Tenants list their own quotas,
+// administrators those of every tenant.
+func (s *quotaStore) List(ctx context.Context, opts *QuotaListOptions) (*QuotaListResult, error) {
+	var quotas model.QuotaList
+	query := scopeTenantAdministered(ctx, s.db.WithContext(ctx))
+
+	// Default max page size
+	pageSize := 100
+	if opts != nil && opts.PageSize > 0 {
+		pageSize = opts.PageSize
+	}
+
+	// Decode page token to get offset
+	offset := 0
+	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*opts.PageToken)
+		if err == nil {
+			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
+				offset = parsedOffset
+			}
+		}
+	}
+
+	query = query.Order("id ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil && opts.Tenant != nil {
+		query = query.Where("tenant = ?", *opts.Tenant)
+	}
+
+	if err := query.Find(&quotas).Error; err != nil {
+		return nil, err
+	}
+
+	result := &QuotaListResult{
+		Quotas: quotas,
+	}
+	if len(quotas) > pageSize {
+		result.Quotas = quotas[:pageSize]
+		nextOffset := offset + pageSize
+		nextPageToken := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		result.NextPageToken = &nextPageToken
+	}
+	return result, nil
+}
+
+// Create creates a new quota. Only privileged callers create quotas.
+func (s *quotaStore) Create(ctx context.Context, quota model.Quota) (*model.Quota, error) {
+	if !tenancy.IsPrivileged(ctx) {
+		return nil, ErrQuotaReadOnly
+	}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Returning{}).Create(&quota).Error; err != nil {
+			return err
+		}
+		if err := recordIdempotencyKey(ctx, tx, quota.Path); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, model.AuditActionCreate, auditQuota, quota.Path, nil, quotaAuditData(&quota))
+	})
+	if errors.Is(err, ErrIdempotencyKeyExists) {
+		return nil, err
+	}
+	if err != nil {
+		errStr := strings.ToLower(err.Error())
+		if errors.Is(err, gorm.ErrDuplicatedKey) ||
+			strings.Contains(errStr, "unique") ||
+			strings.Contains(errStr, "duplicate key") {
+			return nil, ErrQuotaIDTaken
+		}
+		return nil, err
+	}
+	return &quota, nil
+}
+
+// Get retrieves a quota by ID
+func (s *quotaStore) Get(ctx context.Context, id string) (*model.Quota, error) {
+	var quota model.Quota
+	if err := scopeTenantAdministered(ctx, s.db.WithContext(ctx)).Where("id = ?", id).First(&quota).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrQuotaNotFound
+		}
+		return nil, fmt.Errorf("failed to get quota: %w", err)
+	}
+	return &quota, nil
+}
+
+// Delete deletes a quota by ID. Only privileged callers delete quotas.
+func (s *quotaStore) Delete(ctx context.Context, id string) error {
+	if !tenancy.IsPrivileged(ctx) {
+		return ErrQuotaReadOnly
+	}
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var quota model.Quota
+		if err := scopeTenantAdministered(ctx, tx).Where("id = ?", id).First(&quota).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrQuotaNotFound
+			}
+			return fmt.Errorf("failed to get quota: %w", err)
+		}
+		if err := tx.Delete(&quota).Error; err != nil {
+			return fmt.Errorf("failed to delete quota: %w", err)
+		}
+		return recordAudit(ctx, tx, model.AuditActionDelete, auditQuota, quota.Path, quotaAuditData(&quota), nil)
+	})
+}
+
+// Usage returns the resources consumed by the instances the quota applies to
+func (s *quotaStore) Usage(ctx context.Context, quota model.Quota) (*model.ResourceUsage, error) {
+	return quotaUsage(s.db.WithContext(ctx), quota)
+}
+
+// quotaUsage sums the resources of the instances matching the scope of a quota
+func quotaUsage(db *gorm.DB, quota model.Quota) (*model.ResourceUsage, error) {
+	query := db.Model(&model.CatalogItemInstance{}).Where("tenant = ?", quota.Tenant)
+	if quota.ServiceType != "" {
+		query = query.Where("service_type = ?", quota.ServiceType)
+	}
+	if quota.CatalogItemId != "" {
+		query = query.Where("spec_catalog_item_id = ?", quota.CatalogItemId)
+	}
+
+	var usage model.ResourceUsage
+	if err := query.Select(
+		"COUNT(*) AS instances, " +
+			"COALESCE(SUM(vcpu), 0) AS vcpu, " +
+			"COALESCE(SUM(memory_mb), 0) AS memory_mb, " +
+			"COALESCE(SUM(storage_mb), 0) AS storage_mb",
+	).Scan(&usage).Error; err != nil {
+		return nil, fmt.Errorf("failed to compute quota usage: %w", err)
+	}
+	return &usage, nil
+}
+
+// enforceQuotas checks that adding the instance keeps every quota that applies to it
+// within its limits. It must run in the transaction that creates the instance; on
+// PostgreSQL the quota rows are locked so concurrent creates are serialized.
+func enforceQuotas(tx *gorm.DB, instance model.CatalogItemInstance) error {
+	query := tx.Where("tenant = ?", instance.Tenant).
+		Where("service_type = '' OR service_type = ?", instance.ServiceType).
+		Where("catalog_item_id = '' OR catalog_item_id = ?", instance.SpecCatalogItemId).
+		Order("id ASC")
+	if tx.Dialector.Name() == "postgres" {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	var quotas model.QuotaList
+	if err := query.Find(&quotas).Error; err != nil {
+		return fmt.Errorf("failed to load quotas: %w", err)
+	}
+
+	for _, quota := range quotas {
+		usage, err := quotaUsage(tx, quota)
+		if err != nil {
+			return err
+		}
+		checks := []struct {
+			resource string
+			used     int64
+			limit    *int64
+		}{
+			{"instances", usage.Instances + 1, quota.MaxInstances},
+			{"vcpu", usage.Vcpu + instance.Resources.Vcpu, quota.MaxVcpu},
+			{"memory_mb", usage.MemoryMB + instance.Resources.MemoryMB, quota.MaxMemoryMB},
+			{"storage_mb", usage.StorageMB + instance.Resources.StorageMB, quota.MaxStorageMB},
+		}
+		for _, c := range checks {
+			if c.limit != nil && c.used > *c.limit {
+				return fmt.Errorf("%w: %s %s would be %d, limit is %d", ErrQuotaExceeded, quota.Path, c.resource, c.used, *c.limit)
+			}
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -17,20 +18,29 @@ var (
 	ErrServiceTypeNotFound = errors.New("service type not found")
 	// ErrServiceTypeIDTaken is returned when a service type ID is already taken
 	ErrServiceTypeIDTaken = errors.New("service type ID already exists")
-	// ErrServiceTypeServiceTypeTaken is returned when a service type service type is already taken
-	ErrServiceTypeServiceTypeTaken = errors.New("service type service type already exists")
+	// ErrServiceTypeServiceTypeTaken is returned when a version (api_version) of a service type already exists
+	ErrServiceTypeServiceTypeTaken = errors.New("service type version already exists")
 )
 
 // ServiceTypeListOptions contains options for listing service types.
 type ServiceTypeListOptions struct {
 	PageToken *string
 	PageSize  int
+	// ServiceType restricts the results to the versions of a service type
+	ServiceType *string
+	// SkipSpec leaves the spec of the results empty, without loading it
+	SkipSpec bool
+	// CountTotal sets the total size of the result
+	CountTotal bool
 }
 
 // ServiceTypeListResult contains the result of a List operation.
 type ServiceTypeListResult struct {
 	ServiceTypes  model.ServiceTypeList
 	NextPageToken *string
+	// TotalSize is the number of service types matching the options across
+	// all pages, if counted
+	TotalSize *int64
 }
 
 // ServiceTypeStore defines operations for ServiceType resources
@@ -38,10 +48,17 @@ type ServiceTypeStore interface {
 	List(ctx context.Context, opts *ServiceTypeListOptions) (*ServiceTypeListResult, error)
 	Create(ctx context.Context, serviceType model.ServiceType) (*model.ServiceType, error)
 	Get(ctx context.Context, id string) (*model.ServiceType, error)
+	// Resolve returns a version of a service type, or its default version when
+	// apiVersion is empty
+	Resolve(ctx context.Context, serviceType, apiVersion string) (*model.ServiceType, error)
+	// Update updates the default flag and the deprecation of a service type
+	// version. Making a version the default unsets the previous default.
+	Update(ctx context.Context, serviceType *model.ServiceType) (*model.ServiceType, error)
 }
 
 type serviceTypeStore struct {
-	db *gorm.DB
+	db      *gorm.DB
+	changes *changeNotifier
 }
 
 // NewServiceTypeStore creates a new ServiceType store
@@ -71,16 +88,30 @@ func (s *serviceTypeStore) List(ctx context.Context, opts *ServiceTypeListOption
 		}
 	}
 
-	query = query.Order("service_type ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil && opts.ServiceType != nil && *opts.ServiceType != "" {
+		query = query.Where("service_type = ?", *opts.ServiceType)
+	}
+
+	result := &ServiceTypeListResult{}
+	if opts != nil && opts.CountTotal {
+		total, err := countTotal(query, &model.ServiceType{})
+		if err != nil {
+			return nil, err
+		}
+		result.TotalSize = &total
+	}
+
+	query = query.Order("service_type ASC").Order("api_version ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil && opts.SkipSpec {
+		query = query.Omit("spec")
+	}
 
 	if err := query.Find(&serviceTypes).Error; err != nil {
 		return nil, err
 	}
 
 	// Generate next page token if there are more results
-	result := &ServiceTypeListResult{
-		ServiceTypes: serviceTypes,
-	}
+	result.ServiceTypes = serviceTypes
 
 	if len(serviceTypes) > pageSize {
 		// Trim to requested page size
@@ -94,15 +125,46 @@ func (s *serviceTypeStore) List(ctx context.Context, opts *ServiceTypeListOption
 	return result, nil
 }
 
+// Create creates a version of a service type. The first version of a service
+// type becomes its default.
 func (s *serviceTypeStore) Create(ctx context.Context, serviceType model.ServiceType) (*model.ServiceType, error) {
-	if err := s.db.WithContext(ctx).Clauses(clause.Returning{}).Select("*").Create(&serviceType).Error; err != nil {
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var versions int64
+		if err := tx.Model(&model.ServiceType{}).Where("service_type = ?", serviceType.ServiceType).Count(&versions).Error; err != nil {
+			return err
+		}
+		if versions == 0 {
+			serviceType.Default = true
+		}
+		if serviceType.Default {
+			if err := unsetDefaultVersion(ctx, tx, serviceType.ServiceType); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Clauses(clause.Returning{}).Select("*").Create(&serviceType).Error; err != nil {
+			return err
+		}
+		data := serviceTypeEventData(&serviceType)
+		if err := recordEvent(tx, model.EventServiceTypeCreated, serviceType.Path, "", data); err != nil {
+			return err
+		}
+		if err := recordIdempotencyKey(ctx, tx, serviceType.Path); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, model.AuditActionCreate, auditServiceType, serviceType.Path, nil, data)
+	})
+	if errors.Is(err, ErrIdempotencyKeyExists) {
+		return nil, err
+	}
+	if err != nil {
 		return nil, s.mapUniqueConstraintError(ctx, err, serviceType)
 	}
 	return &serviceType, nil
 }
 
 // mapUniqueConstraintError maps a DB unique constraint violation to a store sentinel error.
-// by querying the DB to see which constraint would be violated (ID, service_type).
+// by querying the DB to see which constraint would be violated (ID, service_type and api_version).
 func (s *serviceTypeStore) mapUniqueConstraintError(ctx context.Context, err error, attempted model.ServiceType) error {
 	if err == nil {
 		return nil
@@ -120,7 +182,8 @@ func (s *serviceTypeStore) mapUniqueConstraintError(ctx context.Context, err err
 		query    *gorm.DB
 	}{
 		{ErrServiceTypeIDTaken, s.db.WithContext(ctx).Where("id = ?", attempted.ID).Limit(1)},
-		{ErrServiceTypeServiceTypeTaken, s.db.WithContext(ctx).Where("service_type = ?", attempted.ServiceType).Limit(1)},
+		{ErrServiceTypeServiceTypeTaken, s.db.WithContext(ctx).
+			Where("service_type = ? AND api_version = ?", attempted.ServiceType, attempted.ApiVersion).Limit(1)},
 	}
 
 	for _, c := range checks {
@@ -148,3 +211,87 @@ func (s *serviceTypeStore) Get(ctx context.Context, id string) (*model.ServiceTy
 	}
 	return &serviceType, nil
 }
+
+// Resolve returns a version of a service type, or its default version when apiVersion is empty
+func (s *serviceTypeStore) Resolve(ctx context.Context, serviceType, apiVersion string) (*model.ServiceType, error) {
+	query := s.db.WithContext(ctx).Where("service_type = ?", serviceType)
+	if apiVersion == "" {
+		query = query.Where("default_version")
+	} else {
+		query = query.Where("api_version = ?", apiVersion)
+	}
+
+	var st model.ServiceType
+	if err := query.First(&st).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrServiceTypeNotFound
+		}
+		return nil, err
+	}
+	return &st, nil
+}
+
+// Update updates the default flag and the deprecation of a service type version
+func (s *serviceTypeStore) Update(ctx context.Context, serviceType *model.ServiceType) (*model.ServiceType, error) {
+	var updated model.ServiceType
+	err := s.changes.transaction(ctx, s.db, func(tx *gorm.DB) error {
+		var existing model.ServiceType
+		if err := tx.Where("id = ?", serviceType.ID).First(&existing).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrServiceTypeNotFound
+			}
+			return fmt.Errorf("failed to get service type: %w", err)
+		}
+
+		if serviceType.Default && !existing.Default {
+			if err := unsetDefaultVersion(ctx, tx, existing.ServiceType); err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&model.ServiceType{}).
+			Where("id = ?", existing.ID).
+			Select("default_version", "deprecated", "deprecation_message", "sunset_time").
+			Updates(serviceType).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("id = ?", existing.ID).First(&updated).Error; err != nil {
+			return fmt.Errorf("failed to get updated service type: %w", err)
+		}
+		return recordServiceTypeUpdate(ctx, tx, &existing, &updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// unsetDefaultVersion clears the default flag of the default version of a service type
+func unsetDefaultVersion(ctx context.Context, tx *gorm.DB, serviceType string) error {
+	var previous model.ServiceType
+	err := tx.Where("service_type = ? AND default_version", serviceType).First(&previous).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get default service type version: %w", err)
+	}
+
+	updated := previous
+	updated.Default = false
+	if err := tx.Model(&model.ServiceType{}).
+		Where("id = ?", previous.ID).
+		Update("default_version", false).Error; err != nil {
+		return fmt.Errorf("failed to unset default service type version: %w", err)
+	}
+	return recordServiceTypeUpdate(ctx, tx, &previous, &updated)
+}
+
+// recordServiceTypeUpdate records the change event and the audit entry of a service type update
+func recordServiceTypeUpdate(ctx context.Context, tx *gorm.DB, before, after *model.ServiceType) error {
+	data := serviceTypeEventData(after)
+	if err := recordEvent(tx, model.EventServiceTypeUpdated, after.Path, "", data); err != nil {
+		return err
+	}
+	return recordAudit(ctx, tx, model.AuditActionUpdate, auditServiceType, after.Path, serviceTypeEventData(before), data)
+}
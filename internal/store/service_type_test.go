@@ -30,7 +30,7 @@ var _ = Describe("ServiceType Store", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// Auto-migrate
-		err = db.AutoMigrate(&model.ServiceType{})
+		err = db.AutoMigrate(&model.ServiceType{}, &model.OutboxEvent{})
 		Expect(err).ToNot(HaveOccurred())
 
 		serviceTypeStore = store.NewServiceTypeStore(db)
@@ -215,4 +215,96 @@ var _ = Describe("ServiceType Store", func() {
 			Expect(lastPageResults.NextPageToken).To(BeNil())
 		})
 	})
+
+	Describe("Versions", func() {
+		newVersion := func(id, apiVersion string) model.ServiceType {
+			return model.ServiceType{
+				ID:          id,
+				ApiVersion:  apiVersion,
+				ServiceType: "vm",
+				Spec:        map[string]any{},
+				Path:        "service-types/" + id,
+			}
+		}
+
+		BeforeEach(func() {
+			_, err := serviceTypeStore.Create(context.Background(), newVersion("vm-v1alpha1", "v1alpha1"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = serviceTypeStore.Create(context.Background(), newVersion("vm-v1beta1", "v1beta1"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should make the first version of a service type the default", func() {
+			first, err := serviceTypeStore.Get(context.Background(), "vm-v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.Default).To(BeTrue())
+
+			second, err := serviceTypeStore.Get(context.Background(), "vm-v1beta1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.Default).To(BeFalse())
+		})
+
+		It("should resolve a version or the default version", func() {
+			resolved, err := serviceTypeStore.Resolve(context.Background(), "vm", "v1beta1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved.ID).To(Equal("vm-v1beta1"))
+
+			resolved, err = serviceTypeStore.Resolve(context.Background(), "vm", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved.ID).To(Equal("vm-v1alpha1"))
+
+			_, err = serviceTypeStore.Resolve(context.Background(), "vm", "v2")
+			Expect(err).To(Equal(store.ErrServiceTypeNotFound))
+		})
+
+		It("should move the default to the updated version", func() {
+			second, err := serviceTypeStore.Get(context.Background(), "vm-v1beta1")
+			Expect(err).ToNot(HaveOccurred())
+			second.Default = true
+
+			updated, err := serviceTypeStore.Update(context.Background(), second)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Default).To(BeTrue())
+
+			first, err := serviceTypeStore.Get(context.Background(), "vm-v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.Default).To(BeFalse())
+
+			resolved, err := serviceTypeStore.Resolve(context.Background(), "vm", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved.ID).To(Equal("vm-v1beta1"))
+		})
+
+		It("should store the deprecation of a version", func() {
+			first, err := serviceTypeStore.Get(context.Background(), "vm-v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+			sunset := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+			first.Deprecation = model.Deprecation{Deprecated: true, Message: "use v1beta1", SunsetTime: &sunset}
+
+			_, err = serviceTypeStore.Update(context.Background(), first)
+			Expect(err).ToNot(HaveOccurred())
+
+			retrieved, err := serviceTypeStore.Get(context.Background(), "vm-v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retrieved.Deprecation.Deprecated).To(BeTrue())
+			Expect(retrieved.Deprecation.Message).To(Equal("use v1beta1"))
+			Expect(retrieved.Deprecation.SunsetTime.Equal(sunset)).To(BeTrue())
+			Expect(retrieved.Default).To(BeTrue())
+		})
+
+		It("should filter the list by service type", func() {
+			_, err := serviceTypeStore.Create(context.Background(), model.ServiceType{
+				ID: "container-v1alpha1", ApiVersion: "v1alpha1", ServiceType: "container",
+				Spec: map[string]any{}, Path: "service-types/container-v1alpha1",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			serviceType := "vm"
+			results, err := serviceTypeStore.List(context.Background(), &store.ServiceTypeListOptions{ServiceType: &serviceType})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.ServiceTypes).To(HaveLen(2))
+			Expect(results.ServiceTypes[0].ApiVersion).To(Equal("v1alpha1"))
+			Expect(results.ServiceTypes[1].ApiVersion).To(Equal("v1beta1"))
+		})
+	})
 })
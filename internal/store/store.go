@@ -8,7 +8,15 @@ import (
 type Store interface {
 	ServiceType() ServiceTypeStore
 	CatalogItem() CatalogItemStore
+	CatalogItemRevision() CatalogItemRevisionStore
 	CatalogItemInstance() CatalogItemInstanceStore
+	Quota() QuotaStore
+	Operation() OperationStore
+	Outbox() OutboxStore
+	WebhookSubscription() WebhookSubscriptionStore
+	WebhookDelivery() WebhookDeliveryStore
+	AuditEvent() AuditEventStore
+	IdempotencyKey() IdempotencyKeyStore
 	Close() error
 }
 
@@ -17,16 +25,35 @@ type DataStore struct {
 	db                  *gorm.DB
 	serviceType         ServiceTypeStore
 	catalogItem         CatalogItemStore
+	catalogItemRevision CatalogItemRevisionStore
 	catalogItemInstance CatalogItemInstanceStore
+	quota               QuotaStore
+	operation           OperationStore
+	outbox              OutboxStore
+	webhookSubscription WebhookSubscriptionStore
+	webhookDelivery     WebhookDeliveryStore
+	auditEvent          AuditEventStore
+	idempotencyKey      IdempotencyKeyStore
 }
 
 // NewStore creates a new DataStore
+// The stores recording events share a notifier, so that Outbox().Subscribe
+// is signalled by the changes they commit.
 func NewStore(db *gorm.DB) Store {
+	changes := newChangeNotifier()
 	return &DataStore{
 		db:                  db,
-		serviceType:         NewServiceTypeStore(db),
-		catalogItem:         NewCatalogItemStore(db),
-		catalogItemInstance: NewCatalogItemInstanceStore(db),
+		serviceType:         &serviceTypeStore{db: db, changes: changes},
+		catalogItem:         &catalogItemStore{db: db, changes: changes},
+		catalogItemRevision: NewCatalogItemRevisionStore(db),
+		catalogItemInstance: &catalogItemInstanceStore{db: db, changes: changes},
+		quota:               NewQuotaStore(db),
+		operation:           &operationStore{db: db, changes: changes},
+		outbox:              &outboxStore{db: db, changes: changes},
+		webhookSubscription: NewWebhookSubscriptionStore(db),
+		webhookDelivery:     NewWebhookDeliveryStore(db),
+		auditEvent:          NewAuditEventStore(db),
+		idempotencyKey:      NewIdempotencyKeyStore(db),
 	}
 }
 
@@ -40,11 +67,51 @@ func (s *DataStore) CatalogItem() CatalogItemStore {
 	return s.catalogItem
 }
 
+// CatalogItemRevision returns the CatalogItemRevision store
+func (s *DataStore) CatalogItemRevision() CatalogItemRevisionStore {
+	return s.catalogItemRevision
+}
+
 // CatalogItemInstance returns the CatalogItemInstance store
 func (s *DataStore) CatalogItemInstance() CatalogItemInstanceStore {
 	return s.catalogItemInstance
 }
 
+// Quota returns the Quota store
+func (s *DataStore) Quota() QuotaStore {
+	return s.quota
+}
+
+// Operation returns the Operation store
+func (s *DataStore) Operation() OperationStore {
+	return s.operation
+}
+
+// Outbox returns the Outbox store
+func (s *DataStore) Outbox() OutboxStore {
+	return s.outbox
+}
+
+// WebhookSubscription returns the WebhookSubscription store
+func (s *DataStore) WebhookSubscription() WebhookSubscriptionStore {
+	return s.webhookSubscription
+}
+
+// WebhookDelivery returns the WebhookDelivery store
+func (s *DataStore) WebhookDelivery() WebhookDeliveryStore {
+	return s.webhookDelivery
+}
+
+// AuditEvent returns the AuditEvent store
+func (s *DataStore) AuditEvent() AuditEventStore {
+	return s.auditEvent
+}
+
+// IdempotencyKey returns the IdempotencyKey store
+func (s *DataStore) IdempotencyKey() IdempotencyKeyStore {
+	return s.idempotencyKey
+}
+
 // Close closes the database connection
 func (s *DataStore) Close() error {
 	sqlDB, err := s.db.DB()
@@ -53,3 +120,13 @@ func (s *DataStore) Close() error {
 	}
 	return sqlDB.Close()
 }
+
+// countTotal counts the rows of model matching the filters of a list query,
+// before its pagination is applied
+func countTotal(query *gorm.DB, model any) (int64, error) {
+	var total int64
+	if err := query.Session(&gorm.Session{}).Model(model).Count(&total).Error; err != nil {
+		return 0, err
+	}
+	return total, nil
+}
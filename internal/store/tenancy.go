@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+	"gorm.io/gorm"
+)
+
+// scopeCatalogItems restricts a catalog item query to the items visible to the
+// tenant in ctx: global items and the tenant's private items.
+// Unscoped contexts see every catalog item.
+func scopeCatalogItems(ctx context.Context, db *gorm.DB) *gorm.DB {
+	tenant, ok := tenancy.FromContext(ctx)
+	if !ok {
+		return db
+	}
+	return db.Where("tenant = '' OR tenant = ?", tenant)
+}
+
+// scopeCatalogItemWrites restricts a catalog item query to the items the caller
+// in ctx may change: the tenant's private items, and global items too for
+// administrators. Unscoped contexts may change every catalog item.
+func scopeCatalogItemWrites(ctx context.Context, db *gorm.DB) *gorm.DB {
+	tenant, ok := tenancy.FromContext(ctx)
+	if !ok {
+		return db
+	}
+	if tenancy.IsPrivileged(ctx) {
+		return db.Where("tenant = '' OR tenant = ?", tenant)
+	}
+	return db.Where("tenant = ?", tenant)
+}
+
+// scopeTenantOwned restricts a query on tenant-owned resources to the tenant in ctx.
+// Unscoped contexts see the resources of every tenant.
+func scopeTenantOwned(ctx context.Context, db *gorm.DB) *gorm.DB {
+	tenant, ok := tenancy.FromContext(ctx)
+	if !ok {
+		return db
+	}
+	return db.Where("tenant = ?", tenant)
+}
+
+// scopeTenantAdministered restricts a query on tenant-owned resources to the
+// tenant in ctx, except for administrators, who see the resources of every
+// tenant like unscoped contexts.
+func scopeTenantAdministered(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tenancy.IsPrivileged(ctx) {
+		return db
+	}
+	return scopeTenantOwned(ctx, db)
+}
@@ -0,0 +1,168 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrWebhookDeliveryNotDue is returned when claiming a delivery that is not
+// pending, not due, or already claimed by another dispatcher
+var ErrWebhookDeliveryNotDue = errors.New("webhook delivery is not due")
+
+// WebhookDeliveryListOptions contains options for listing webhook deliveries
+type WebhookDeliveryListOptions struct {
+	PageToken *string
+	PageSize  int
+}
+
+// WebhookDeliveryListResult contains the result of a List operation
+type WebhookDeliveryListResult struct {
+	WebhookDeliveries model.WebhookDeliveryList
+	NextPageToken     *string
+}
+
+// WebhookDeliveryStore defines operations for the delivery log of webhook subscriptions
+type WebhookDeliveryStore interface {
+	// List returns the deliveries of a subscription, most recent first
+	List(ctx context.Context, subscriptionID string, opts *WebhookDeliveryListOptions) (*WebhookDeliveryListResult, error)
+	// Enqueue queues deliveries; a delivery of an event already queued for the
+	// same subscription is skipped, so that fan-out can be retried safely
+	Enqueue(ctx context.Context, deliveries model.WebhookDeliveryList) error
+	// Create records a delivery that was attempted already
+	Create(ctx context.Context, delivery *model.WebhookDelivery) error
+	// ListDue returns pending deliveries whose next attempt is due, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) (model.WebhookDeliveryList, error)
+	// Claim atomically takes a due delivery by moving its next attempt to
+	// leaseUntil, so that dispatchers of other replicas skip it
+	Claim(ctx context.Context, id string, now, leaseUntil time.Time) (*model.WebhookDelivery, error)
+	// UpdateResult saves the outcome of a delivery attempt
+	UpdateResult(ctx context.Context, delivery *model.WebhookDelivery) error
+}
+
+type webhookDeliveryStore struct {
+	db *gorm.DB
+}
+
+// NewWebhookDeliveryStore creates a new WebhookDelivery store
+func NewWebhookDeliveryStore(db *gorm.DB) WebhookDeliveryStore {
+	return &webhookDeliveryStore{db: db}
+}
+
+// List returns a paginated list of the deliveries of a subscription, most recent first
+func (s *webhookDeliveryStore) List(ctx context.Context, subscriptionID string, opts *WebhookDeliveryListOptions) (*WebhookDeliveryListResult, error) {
+	var deliveries model.WebhookDeliveryList
+	query := scopeTenantOwned(ctx, s.db.WithContext(ctx)).Where("subscription_id = ?", subscriptionID)
+
+	// Default max page size
+	pageSize := 100
+	if opts != nil && opts.PageSize > 0 {
+		pageSize = opts.PageSize
+	}
+
+	// Decode page token to get offset
+	offset := 0
+	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*opts.PageToken)
+		if err == nil {
+			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
+				offset = parsedOffset
+			}
+		}
+	}
+
+	query = query.Order("create_time DESC").Order("id ASC").Limit(pageSize + 1).Offset(offset)
+	if err := query.Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+
+	result := &WebhookDeliveryListResult{
+		WebhookDeliveries: deliveries,
+	}
+	if len(deliveries) > pageSize {
+		result.WebhookDeliveries = deliveries[:pageSize]
+		nextOffset := offset + pageSize
+		nextPageToken := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		result.NextPageToken = &nextPageToken
+	}
+	return result, nil
+}
+
+// Enqueue queues deliveries, skipping events already queued for a subscription
+func (s *webhookDeliveryStore) Enqueue(ctx context.Context, deliveries model.WebhookDeliveryList) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(&deliveries).Error; err != nil {
+		return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// Create records a delivery that was attempted already
+func (s *webhookDeliveryStore) Create(ctx context.Context, delivery *model.WebhookDelivery) error {
+	if err := s.db.WithContext(ctx).Create(delivery).Error; err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ListDue returns pending deliveries whose next attempt is due, oldest first
+func (s *webhookDeliveryStore) ListDue(ctx context.Context, now time.Time, limit int) (model.WebhookDeliveryList, error) {
+	var deliveries model.WebhookDeliveryList
+	if err := dueWebhookDeliveries(s.db.WithContext(ctx), now).
+		Order("create_time ASC").
+		Order("id ASC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list due webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// Claim atomically takes a due delivery by moving its next attempt to leaseUntil
+func (s *webhookDeliveryStore) Claim(ctx context.Context, id string, now, leaseUntil time.Time) (*model.WebhookDelivery, error) {
+	result := dueWebhookDeliveries(s.db.WithContext(ctx).Model(&model.WebhookDelivery{}), now).
+		Where("id = ?", id).
+		Update("next_attempt_time", leaseUntil)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim webhook delivery: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrWebhookDeliveryNotDue
+	}
+
+	var delivery model.WebhookDelivery
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&delivery).Error; err != nil {
+		return nil, fmt.Errorf("failed to get claimed webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+// dueWebhookDeliveries restricts a query to the pending deliveries whose next attempt is due
+func dueWebhookDeliveries(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("state = ? AND next_attempt_time <= ?", model.WebhookDeliveryStatePending, now)
+}
+
+// UpdateResult saves the outcome of a delivery attempt
+func (s *webhookDeliveryStore) UpdateResult(ctx context.Context, delivery *model.WebhookDelivery) error {
+	if err := s.db.WithContext(ctx).Model(&model.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Select("state", "attempts", "response_code", "last_error", "next_attempt_time", "update_time").
+		Updates(delivery).Error; err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
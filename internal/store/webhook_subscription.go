@@ -0,0 +1,170 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrWebhookSubscriptionNotFound is returned when a webhook subscription is not found
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	// ErrWebhookSubscriptionIDTaken is returned when a webhook subscription ID is already taken
+	ErrWebhookSubscriptionIDTaken = errors.New("webhook subscription ID already exists")
+)
+
+// WebhookSubscriptionListOptions contains options for listing webhook subscriptions
+type WebhookSubscriptionListOptions struct {
+	PageToken *string
+	PageSize  int
+	// Tenant restricts the results to the subscriptions of a tenant
+	Tenant *string
+}
+
+// WebhookSubscriptionListResult contains the result of a List operation
+type WebhookSubscriptionListResult struct {
+	WebhookSubscriptions model.WebhookSubscriptionList
+	NextPageToken        *string
+}
+
+// WebhookSubscriptionStore defines operations for WebhookSubscription resources
+type WebhookSubscriptionStore interface {
+	List(ctx context.Context, opts *WebhookSubscriptionListOptions) (*WebhookSubscriptionListResult, error)
+	Create(ctx context.Context, subscription model.WebhookSubscription) (*model.WebhookSubscription, error)
+	Get(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	// Delete deletes a subscription together with its deliveries
+	Delete(ctx context.Context, id string) error
+	// ListForTenant returns every subscription that may receive the events of
+	// a tenant; an empty tenant, used by global resources, returns all of them
+	ListForTenant(ctx context.Context, tenant string) (model.WebhookSubscriptionList, error)
+}
+
+type webhookSubscriptionStore struct {
+	db *gorm.DB
+}
+
+// NewWebhookSubscriptionStore creates a new WebhookSubscription store
+func NewWebhookSubscriptionStore(db *gorm.DB) WebhookSubscriptionStore {
+	return &webhookSubscriptionStore{db: db}
+}
+
+// List returns a paginated list of webhook subscriptions
+func (s *webhookSubscriptionStore) List(ctx context.Context, opts *WebhookSubscriptionListOptions) (*WebhookSubscriptionListResult, error) {
+	var subscriptions model.WebhookSubscriptionList
+	query := scopeTenantOwned(ctx, s.db.WithContext(ctx))
+
+	// Default max page size
+	pageSize := 100
+	if opts != nil && opts.PageSize > 0 {
+		pageSize = opts.PageSize
+	}
+
+	// Decode page token to get offset
+	offset := 0
+	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*opts.PageToken)
+		if err == nil {
+			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
+				offset = parsedOffset
+			}
+		}
+	}
+
+	query = query.Order("id ASC").Limit(pageSize + 1).Offset(offset)
+	if opts != nil && opts.Tenant != nil {
+		query = query.Where("tenant = ?", *opts.Tenant)
+	}
+
+	if err := query.Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	result := &WebhookSubscriptionListResult{
+		WebhookSubscriptions: subscriptions,
+	}
+	if len(subscriptions) > pageSize {
+		result.WebhookSubscriptions = subscriptions[:pageSize]
+		nextOffset := offset + pageSize
+		nextPageToken := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		result.NextPageToken = &nextPageToken
+	}
+	return result, nil
+}
+
+// Create creates a new webhook subscription
+func (s *webhookSubscriptionStore) Create(ctx context.Context, subscription model.WebhookSubscription) (*model.WebhookSubscription, error) {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Returning{}).Create(&subscription).Error; err != nil {
+			return err
+		}
+		if err := recordIdempotencyKey(ctx, tx, subscription.Path); err != nil {
+			return err
+		}
+		return recordAudit(ctx, tx, model.AuditActionCreate, auditWebhookSubscription, subscription.Path,
+			nil, webhookSubscriptionAuditData(&subscription))
+	})
+	if errors.Is(err, ErrIdempotencyKeyExists) {
+		return nil, err
+	}
+	if err != nil {
+		errStr := strings.ToLower(err.Error())
+		if errors.Is(err, gorm.ErrDuplicatedKey) ||
+			strings.Contains(errStr, "unique") ||
+			strings.Contains(errStr, "duplicate key") {
+			return nil, ErrWebhookSubscriptionIDTaken
+		}
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// Get retrieves a webhook subscription by ID
+func (s *webhookSubscriptionStore) Get(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	var subscription model.WebhookSubscription
+	if err := scopeTenantOwned(ctx, s.db.WithContext(ctx)).Where("id = ?", id).First(&subscription).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+	return &subscription, nil
+}
+
+// Delete deletes a webhook subscription by ID. Its deliveries are removed by
+// the cascading foreign key.
+func (s *webhookSubscriptionStore) Delete(ctx context.Context, id string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var subscription model.WebhookSubscription
+		if err := scopeTenantOwned(ctx, tx).Where("id = ?", id).First(&subscription).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrWebhookSubscriptionNotFound
+			}
+			return fmt.Errorf("failed to get webhook subscription: %w", err)
+		}
+		if err := tx.Delete(&subscription).Error; err != nil {
+			return fmt.Errorf("failed to delete webhook subscription: %w", err)
+		}
+		return recordAudit(ctx, tx, model.AuditActionDelete, auditWebhookSubscription, subscription.Path,
+			webhookSubscriptionAuditData(&subscription), nil)
+	})
+}
+
+// ListForTenant returns the subscriptions that may receive the events of a tenant
+func (s *webhookSubscriptionStore) ListForTenant(ctx context.Context, tenant string) (model.WebhookSubscriptionList, error) {
+	var subscriptions model.WebhookSubscriptionList
+	query := s.db.WithContext(ctx).Order("id ASC")
+	if tenant != "" {
+		query = query.Where("tenant = ?", tenant)
+	}
+	if err := query.Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
@@ -0,0 +1,161 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/tenancy"
+)
+
+var _ = Describe("Webhook Stores", func() {
+	var (
+		ctx   context.Context
+		teamA context.Context
+		teamB context.Context
+		str   store.Store
+	)
+
+	subscription := func(tenant, id string) model.WebhookSubscription {
+		return model.WebhookSubscription{
+			ID:     id,
+			Tenant: tenant,
+			URL:    "https://example.com/" + id,
+			Secret: "0123456789abcdef",
+			Path:   "tenants/" + tenant + "/webhook-subscriptions/" + id,
+		}
+	}
+
+	delivery := func(id, subscriptionID, eventID string) model.WebhookDelivery {
+		return model.WebhookDelivery{
+			ID:              id,
+			SubscriptionID:  subscriptionID,
+			Tenant:          "team-a",
+			EventID:         eventID,
+			EventType:       model.EventCatalogItemCreated,
+			Payload:         "{}",
+			State:           model.WebhookDeliveryStatePending,
+			NextAttemptTime: time.Now(),
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		teamA = tenancy.NewContext(ctx, "team-a")
+		teamB = tenancy.NewContext(ctx, "team-b")
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		err = db.AutoMigrate(&model.WebhookSubscription{}, &model.WebhookDelivery{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+
+		for _, s := range []model.WebhookSubscription{subscription("team-a", "hook-a"), subscription("team-b", "hook-b")} {
+			_, err = str.WebhookSubscription().Create(ctx, s)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		Expect(str.Close()).To(Succeed())
+	})
+
+	Describe("WebhookSubscription", func() {
+		It("should scope subscriptions to the tenant", func() {
+			_, err := str.WebhookSubscription().Get(teamB, "hook-a")
+			Expect(err).To(MatchError(store.ErrWebhookSubscriptionNotFound))
+			Expect(str.WebhookSubscription().Delete(teamB, "hook-a")).To(MatchError(store.ErrWebhookSubscriptionNotFound))
+
+			result, err := str.WebhookSubscription().List(teamA, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.WebhookSubscriptions).To(HaveLen(1))
+			Expect(result.WebhookSubscriptions[0].ID).To(Equal("hook-a"))
+		})
+
+		It("should reject duplicate IDs", func() {
+			_, err := str.WebhookSubscription().Create(ctx, subscription("team-b", "hook-a"))
+			Expect(err).To(MatchError(store.ErrWebhookSubscriptionIDTaken))
+		})
+
+		It("should list every subscription for global events", func() {
+			subscriptions, err := str.WebhookSubscription().ListForTenant(ctx, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscriptions).To(HaveLen(2))
+
+			subscriptions, err = str.WebhookSubscription().ListForTenant(ctx, "team-b")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscriptions).To(HaveLen(1))
+			Expect(subscriptions[0].ID).To(Equal("hook-b"))
+		})
+	})
+
+	Describe("WebhookDelivery", func() {
+		It("should enqueue an event once per subscription", func() {
+			Expect(str.WebhookDelivery().Enqueue(ctx, model.WebhookDeliveryList{delivery("d1", "hook-a", "e1")})).To(Succeed())
+			Expect(str.WebhookDelivery().Enqueue(ctx, model.WebhookDeliveryList{
+				delivery("d2", "hook-a", "e1"),
+				delivery("d3", "hook-a", "e2"),
+			})).To(Succeed())
+
+			due, err := str.WebhookDelivery().ListDue(ctx, time.Now(), 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(HaveLen(2))
+		})
+
+		It("should only list due pending deliveries", func() {
+			Expect(str.WebhookDelivery().Enqueue(ctx, model.WebhookDeliveryList{
+				delivery("d1", "hook-a", "e1"),
+				delivery("d2", "hook-a", "e2"),
+			})).To(Succeed())
+
+			d := delivery("d1", "hook-a", "e1")
+			d.State = model.WebhookDeliveryStateSucceeded
+			d.Attempts = 1
+			d.ResponseCode = 204
+			Expect(str.WebhookDelivery().UpdateResult(ctx, &d)).To(Succeed())
+
+			due, err := str.WebhookDelivery().ListDue(ctx, time.Now(), 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(HaveLen(1))
+			Expect(due[0].ID).To(Equal("d2"))
+
+			result, err := str.WebhookDelivery().List(teamA, "hook-a", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.WebhookDeliveries).To(HaveLen(2))
+		})
+
+		It("should let only one dispatcher claim a due delivery", func() {
+			Expect(str.WebhookDelivery().Enqueue(ctx, model.WebhookDeliveryList{delivery("d1", "hook-a", "e1")})).To(Succeed())
+
+			now := time.Now()
+			claimed, err := str.WebhookDelivery().Claim(ctx, "d1", now, now.Add(time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claimed.EventID).To(Equal("e1"))
+
+			_, err = str.WebhookDelivery().Claim(ctx, "d1", now, now.Add(time.Minute))
+			Expect(err).To(MatchError(store.ErrWebhookDeliveryNotDue))
+
+			due, err := str.WebhookDelivery().ListDue(ctx, time.Now(), 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(BeEmpty())
+		})
+
+		It("should delete the deliveries of a deleted subscription", func() {
+			Expect(str.WebhookDelivery().Enqueue(ctx, model.WebhookDeliveryList{delivery("d1", "hook-a", "e1")})).To(Succeed())
+			Expect(str.WebhookSubscription().Delete(teamA, "hook-a")).To(Succeed())
+
+			due, err := str.WebhookDelivery().ListDue(ctx, time.Now(), 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(due).To(BeEmpty())
+		})
+	})
+})
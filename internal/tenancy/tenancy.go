@@ -0,0 +1,82 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+const (
+	// Header is the HTTP header carrying the caller's tenant
+	Header = "X-Tenant-ID"
+	// AdminTokenHeader is the HTTP header carrying the secret that the trusted
+	// gateway adds to the requests of administrators
+	AdminTokenHeader = "X-Admin-Token"
+
+	parentPrefix = "tenants/"
+)
+
+var (
+	// ErrInvalidTenant is returned when a tenant ID is not a DNS-1123 label
+	ErrInvalidTenant = errors.New("invalid tenant: must be a DNS-1123 label")
+	// ErrInvalidParent is returned when a parent is not in the format tenants/{tenant_id}
+	ErrInvalidParent = errors.New("invalid parent: must be in the format tenants/{tenant_id}")
+
+	tenantPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+)
+
+type contextKey struct{}
+
+type adminKey struct{}
+
+// NewContext returns a copy of ctx scoped to the given tenant.
+// Store queries made with the returned context only see resources visible to the tenant.
+func NewContext(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenant)
+}
+
+// FromContext returns the tenant carried by ctx, if any.
+// A context without a tenant is unscoped and is reserved for internal callers.
+func FromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(contextKey{}).(string)
+	return tenant, ok
+}
+
+// NewAdminContext returns a copy of ctx whose caller administers the resources
+// shared by every tenant, such as global catalog items and quotas
+func NewAdminContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, adminKey{}, true)
+}
+
+// IsPrivileged reports whether ctx may change the resources shared by every
+// tenant: it is unscoped, or its caller is an administrator.
+func IsPrivileged(ctx context.Context) bool {
+	if _, ok := FromContext(ctx); !ok {
+		return true
+	}
+	admin, _ := ctx.Value(adminKey{}).(bool)
+	return admin
+}
+
+// Validate checks that tenant is a valid tenant ID
+func Validate(tenant string) error {
+	if !tenantPattern.MatchString(tenant) {
+		return ErrInvalidTenant
+	}
+	return nil
+}
+
+// ParentPath returns the parent resource path of a tenant (tenants/{tenant_id})
+func ParentPath(tenant string) string {
+	return parentPrefix + tenant
+}
+
+// ParseParent extracts the tenant ID from a parent in the format tenants/{tenant_id}
+func ParseParent(parent string) (string, error) {
+	tenant, ok := strings.CutPrefix(parent, parentPrefix)
+	if !ok || Validate(tenant) != nil {
+		return "", ErrInvalidParent
+	}
+	return tenant, nil
+}
@@ -0,0 +1,57 @@
+// Package warning collects the warnings raised while serving a request, such
+// as the use of a deprecated service type version, so that they can be
+// returned to the caller in Warning headers (RFC 7234, section 5.5).
+package warning
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// Header is the HTTP header carrying warnings
+const Header = "Warning"
+
+// Recorder collects the warnings of a request
+type Recorder struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+// Messages returns the warnings recorded so far, without duplicates
+func (r *Recorder) Messages() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.messages...)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying a new Recorder
+func NewContext(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{}
+	return context.WithValue(ctx, contextKey{}, r), r
+}
+
+// Add records a warning for the request of ctx.
+// It does nothing for contexts without a Recorder, such as internal callers.
+func Add(ctx context.Context, message string) {
+	r, ok := ctx.Value(contextKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, m := range r.messages {
+		if m == message {
+			return
+		}
+	}
+	r.messages = append(r.messages, message)
+}
+
+// HeaderValue formats a message as a Warning header value, with the
+// "miscellaneous persistent warning" code and no agent
+func HeaderValue(message string) string {
+	return "299 - " + strconv.Quote(message)
+}
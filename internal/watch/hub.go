@@ -0,0 +1,295 @@
+// Package watch streams the changes recorded in the outbox to watchers.
+//
+// A Hub reads each new outbox event once and fans it out to its
+// subscriptions, so that the number of watchers does not multiply the load
+// on the database. It is woken by the commits of this process and, with
+// several replicas on Postgres, by the notifications of the others.
+package watch
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+var (
+	// ErrClosed is returned by a subscription once the hub stopped
+	ErrClosed = errors.New("watch closed")
+	// ErrTooSlow is returned by a subscription that fell too far behind the hub
+	ErrTooSlow = errors.New("watcher fell behind; resume from the last resume token")
+	// ErrResumeTokenExpired is returned when the events following a resume token may have been pruned
+	ErrResumeTokenExpired = errors.New("resume token expired")
+)
+
+// Config holds the tuning parameters of the hub. Zero values use the defaults.
+type Config struct {
+	// PollInterval is how often the outbox is read when the hub is not woken,
+	// covering writes by replicas that cannot notify
+	PollInterval time.Duration
+	// GapGrace is how long a missing sequence number is waited for before the
+	// events following it are streamed. Concurrent transactions may commit
+	// their events out of sequence order.
+	GapGrace time.Duration
+	// Retention is how long events stay in the outbox; older resume tokens are rejected
+	Retention time.Duration
+	// BufferSize is the number of events a subscription may fall behind before it is closed
+	BufferSize int
+}
+
+// batchSize is the maximum number of events read from the outbox at once
+const batchSize = 500
+
+// Hub fans the events recorded in the outbox out to subscriptions
+type Hub struct {
+	store store.Store
+	cfg   Config
+	wake  chan struct{}
+
+	mu    sync.Mutex
+	ready bool
+	// last is the sequence number of the last event fanned out
+	last   int64
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// NewHub creates a Hub
+func NewHub(store store.Store, cfg Config) *Hub {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	if cfg.GapGrace <= 0 {
+		cfg.GapGrace = 2 * time.Second
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = 24 * time.Hour
+	}
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = 256
+	}
+	return &Hub{
+		store: store,
+		cfg:   cfg,
+		wake:  make(chan struct{}, 1),
+		subs:  map[*Subscription]struct{}{},
+	}
+}
+
+// Notify wakes the hub without blocking, e.g. when another replica committed events
+func (h *Hub) Notify() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// init starts the hub at the end of the outbox. h.mu must be held.
+func (h *Hub) init(ctx context.Context) error {
+	if h.ready {
+		return nil
+	}
+	last, err := h.store.Outbox().LastSequence(ctx)
+	if err != nil {
+		return err
+	}
+	h.last = last
+	h.ready = true
+	return nil
+}
+
+// Run fans out new events until ctx is cancelled, then closes every subscription
+func (h *Hub) Run(ctx context.Context) {
+	changes, unsubscribe := h.store.Outbox().Subscribe()
+	defer unsubscribe()
+	defer h.close()
+
+	ticker := time.NewTicker(h.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		retry, err := h.poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to read outbox events for watchers: %v", err)
+		}
+
+		var gap <-chan time.Time
+		if retry > 0 {
+			gap = time.After(retry)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-changes:
+		case <-h.wake:
+		case <-ticker.C:
+		case <-gap:
+		}
+	}
+}
+
+// poll fans out the events recorded since the last poll. It stops at a gap in
+// the sequence numbers younger than GapGrace and returns when to poll again.
+func (h *Hub) poll(ctx context.Context) (time.Duration, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err := h.init(ctx); err != nil {
+		return 0, err
+	}
+
+	for {
+		events, err := h.store.Outbox().ListAfter(ctx, h.last, batchSize)
+		if err != nil {
+			return 0, err
+		}
+		for i := range events {
+			e := &events[i]
+			if e.Sequence != h.last+1 {
+				if wait := h.cfg.GapGrace - time.Since(e.Time); wait > 0 {
+					return wait, nil
+				}
+			}
+			h.broadcast(e)
+			h.last = e.Sequence
+		}
+		if len(events) < batchSize {
+			return 0, nil
+		}
+	}
+}
+
+// broadcast sends an event to every subscription, closing the ones whose buffer
+// is full. h.mu must be held.
+func (h *Hub) broadcast(e *model.OutboxEvent) {
+	for sub := range h.subs {
+		select {
+		case sub.live <- *e:
+		default:
+			h.remove(sub, ErrTooSlow)
+		}
+	}
+}
+
+// remove closes a subscription with err. h.mu must be held.
+func (h *Hub) remove(sub *Subscription, err error) {
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	sub.err = err
+	close(sub.live)
+}
+
+// close closes every subscription and rejects new ones
+func (h *Hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for sub := range h.subs {
+		h.remove(sub, ErrClosed)
+	}
+}
+
+// Subscribe returns a subscription to the events following from, or to the
+// events recorded from now on when from is nil
+func (h *Hub) Subscribe(ctx context.Context, from *Position) (*Subscription, error) {
+	if from != nil && time.Since(from.Time) > h.cfg.Retention {
+		return nil, ErrResumeTokenExpired
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil, ErrClosed
+	}
+	if err := h.init(ctx); err != nil {
+		return nil, err
+	}
+
+	sub := &Subscription{
+		hub:         h,
+		live:        make(chan model.OutboxEvent, h.cfg.BufferSize),
+		replayUntil: h.last,
+		position:    Position{Sequence: h.last, Time: time.Now().UTC()},
+	}
+	if from != nil && from.Sequence < h.last {
+		sub.position = *from
+	}
+	h.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// unsubscribe removes a subscription from the hub
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub, ErrClosed)
+}
+
+// Subscription is a stream of outbox events. Events recorded before it was
+// created are replayed from the outbox before the events fanned out by the hub.
+// It is not safe for concurrent use.
+type Subscription struct {
+	hub  *Hub
+	live chan model.OutboxEvent
+	// err is why live was closed; written before the close
+	err error
+	// replayUntil is the last sequence number read from the outbox rather than from live
+	replayUntil int64
+	replay      model.OutboxEventList
+	position    Position
+}
+
+// Position returns the position of the last event returned by Next
+func (s *Subscription) Position() Position {
+	return s.position
+}
+
+// Next returns the next event, blocking until one is recorded or ctx is done
+func (s *Subscription) Next(ctx context.Context) (*model.OutboxEvent, error) {
+	for s.position.Sequence < s.replayUntil {
+		if len(s.replay) == 0 {
+			events, err := s.hub.store.Outbox().ListAfter(ctx, s.position.Sequence, batchSize)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range events {
+				if e.Sequence <= s.replayUntil {
+					s.replay = append(s.replay, e)
+				}
+			}
+			if len(s.replay) == 0 {
+				s.position.Sequence = s.replayUntil
+				break
+			}
+		}
+		e := s.replay[0]
+		s.replay = s.replay[1:]
+		s.position = Position{Sequence: e.Sequence, Time: e.Time}
+		return &e, nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case e, ok := <-s.live:
+			if !ok {
+				return nil, s.err
+			}
+			if e.Sequence <= s.position.Sequence {
+				continue
+			}
+			s.position = Position{Sequence: e.Sequence, Time: e.Time}
+			return &e, nil
+		}
+	}
+}
+
+// Close stops the subscription
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+}
@@ -0,0 +1,201 @@
+package watch_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/watch"
+)
+
+var _ = Describe("Hub", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		db     *gorm.DB
+		str    store.Store
+		hub    *watch.Hub
+		done   chan struct{}
+	)
+
+	createServiceType := func(id string) {
+		_, err := str.ServiceType().Create(ctx, model.ServiceType{
+			ID: id, ApiVersion: "v1alpha1", ServiceType: id, Spec: map[string]any{}, Path: "service-types/" + id,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	next := func(sub *watch.Subscription) *model.OutboxEvent {
+		nextCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		event, err := sub.Next(nextCtx)
+		Expect(err).ToNot(HaveOccurred())
+		return event
+	}
+
+	// fannedOut returns the sequence number of the last event fanned out by the hub
+	fannedOut := func() int64 {
+		s, err := hub.Subscribe(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer s.Close()
+		return s.Position().Sequence
+	}
+
+	startHub := func(cfg watch.Config) {
+		hub = watch.NewHub(str, cfg)
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			hub.Run(ctx)
+		}()
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		// Every connection to :memory: opens a distinct database
+		sqlDB, err := db.DB()
+		Expect(err).ToNot(HaveOccurred())
+		sqlDB.SetMaxOpenConns(1)
+		err = db.AutoMigrate(&model.ServiceType{}, &model.OutboxEvent{})
+		Expect(err).ToNot(HaveOccurred())
+		str = store.NewStore(db)
+
+		createServiceType("vm")
+	})
+
+	AfterEach(func() {
+		cancel()
+		if done != nil {
+			Eventually(done).Should(BeClosed())
+		}
+		Expect(str.Close()).To(Succeed())
+	})
+
+	It("should stream the events recorded after subscribing", func() {
+		startHub(watch.Config{})
+		sub, err := hub.Subscribe(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Close()
+		Expect(sub.Position().Sequence).To(Equal(int64(1)))
+
+		createServiceType("container")
+		createServiceType("cluster")
+
+		event := next(sub)
+		Expect(event.Sequence).To(Equal(int64(2)))
+		Expect(event.Subject).To(Equal("service-types/container"))
+		Expect(next(sub).Subject).To(Equal("service-types/cluster"))
+		Expect(sub.Position().Sequence).To(Equal(int64(3)))
+	})
+
+	It("should replay the events following a position before streaming", func() {
+		startHub(watch.Config{})
+		sub, err := hub.Subscribe(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		createServiceType("container")
+		first := next(sub)
+		position := sub.Position()
+		sub.Close()
+
+		createServiceType("cluster")
+		// Wait for the hub to fan the event out so that it is replayed
+		Eventually(fannedOut).Should(Equal(int64(3)))
+
+		token, err := watch.ParseToken(position.Token())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Sequence).To(Equal(first.Sequence))
+
+		sub, err = hub.Subscribe(ctx, &token)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Close()
+		Expect(next(sub).Subject).To(Equal("service-types/cluster"))
+
+		createServiceType("database")
+		Expect(next(sub).Subject).To(Equal("service-types/database"))
+	})
+
+	It("should reject expired resume tokens", func() {
+		startHub(watch.Config{Retention: time.Hour})
+		_, err := hub.Subscribe(ctx, &watch.Position{Sequence: 1, Time: time.Now().Add(-2 * time.Hour)})
+		Expect(err).To(MatchError(watch.ErrResumeTokenExpired))
+	})
+
+	It("should wait for the events missing from the sequence", func() {
+		startHub(watch.Config{GapGrace: 300 * time.Millisecond})
+		sub, err := hub.Subscribe(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Close()
+
+		// Sequence 2 is still being committed by another transaction
+		Expect(db.Create(&model.OutboxEvent{
+			Sequence: 3, ID: "late", Type: model.EventServiceTypeCreated, Subject: "service-types/late",
+			Time: time.Now(), Data: map[string]any{}, State: model.OutboxEventStatePending, NextAttemptTime: time.Now(),
+		}).Error).To(Succeed())
+		hub.Notify()
+
+		shortCtx, shortCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer shortCancel()
+		_, err = sub.Next(shortCtx)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		Expect(next(sub).Sequence).To(Equal(int64(3)))
+	})
+
+	It("should close subscriptions that fall behind", func() {
+		startHub(watch.Config{BufferSize: 1})
+		sub, err := hub.Subscribe(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Close()
+
+		createServiceType("container")
+		createServiceType("cluster")
+		Eventually(fannedOut).Should(Equal(int64(3)))
+
+		// The events buffered before the subscription was closed are still returned
+		Expect(next(sub).Sequence).To(Equal(int64(2)))
+		_, err = sub.Next(ctx)
+		Expect(err).To(MatchError(watch.ErrTooSlow))
+	})
+
+	It("should close subscriptions when it stops", func() {
+		startHub(watch.Config{})
+		sub, err := hub.Subscribe(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+		_, err = sub.Next(context.Background())
+		Expect(err).To(MatchError(watch.ErrClosed))
+		_, err = hub.Subscribe(context.Background(), nil)
+		Expect(err).To(MatchError(watch.ErrClosed))
+	})
+})
+
+var _ = Describe("ParseToken", func() {
+	It("should decode the tokens of positions", func() {
+		position := watch.Position{Sequence: 42, Time: time.Now().UTC()}
+		parsed, err := watch.ParseToken(position.Token())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed.Sequence).To(Equal(position.Sequence))
+		Expect(parsed.Time.Equal(position.Time)).To(BeTrue())
+	})
+
+	It("should reject malformed tokens", func() {
+		for _, token := range []string{"", "!", "MTI", "YTpi"} {
+			_, err := watch.ParseToken(token)
+			Expect(err).To(MatchError(watch.ErrInvalidResumeToken), token)
+		}
+	})
+})
@@ -0,0 +1,47 @@
+package watch
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidResumeToken is returned when a resume token cannot be parsed
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// Position is a point in the stream of outbox events: the sequence number of
+// the last event seen and when it was recorded
+type Position struct {
+	Sequence int64
+	Time     time.Time
+}
+
+// Token encodes the position as an opaque resume token
+func (p Position) Token() string {
+	raw := fmt.Sprintf("%d:%d", p.Sequence, p.Time.UnixNano())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseToken decodes a resume token returned by Position.Token
+func ParseToken(token string) (Position, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Position{}, ErrInvalidResumeToken
+	}
+	sequence, nanos, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Position{}, ErrInvalidResumeToken
+	}
+	seq, err := strconv.ParseInt(sequence, 10, 64)
+	if err != nil || seq < 0 {
+		return Position{}, ErrInvalidResumeToken
+	}
+	ns, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Position{}, ErrInvalidResumeToken
+	}
+	return Position{Sequence: seq, Time: time.Unix(0, ns).UTC()}, nil
+}
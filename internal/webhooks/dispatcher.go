@@ -0,0 +1,310 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/events"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/google/uuid"
+)
+
+// Config holds the tuning parameters of the dispatcher. Zero values use the defaults.
+type Config struct {
+	// Source is the CloudEvents source attribute of ping events
+	Source string
+	// PollInterval is how often due deliveries are scanned for when not woken by new events
+	PollInterval time.Duration
+	// MaxAttempts is the number of failed attempts after which a delivery fails
+	MaxAttempts int
+	// BackoffBase is the delay after the first failed attempt; it doubles with every further failure
+	BackoffBase time.Duration
+	// BackoffMax caps the delay between attempts
+	BackoffMax time.Duration
+	// Timeout bounds each request
+	Timeout time.Duration
+	// Workers is the number of deliveries attempted concurrently
+	Workers int
+	// Lease is how long a claimed delivery is withheld from the dispatchers of
+	// other replicas. It defaults to twice the Timeout.
+	Lease time.Duration
+	// AllowPrivateTargets lets subscriptions target loopback, private and
+	// link-local addresses, which are rejected by default
+	AllowPrivateTargets bool
+}
+
+// batchSize is the maximum number of due deliveries fetched per scan
+const batchSize = 100
+
+// Dispatcher attempts the deliveries queued by its Sink. Failed attempts are
+// retried with exponential backoff until the endpoint acknowledges the event
+// or the attempts are exhausted. Deliveries of different events may arrive
+// out of order. Dispatchers of several replicas may share the deliveries: each
+// delivery is claimed before it is attempted, so that only one of them sends it.
+type Dispatcher struct {
+	store  store.Store
+	client *http.Client
+	cfg    Config
+	wake   chan struct{}
+}
+
+// NewDispatcher creates a Dispatcher
+func NewDispatcher(store store.Store, cfg Config) *Dispatcher {
+	if cfg.Source == "" {
+		cfg.Source = "/catalog-manager"
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.BackoffBase <= 0 {
+		cfg.BackoffBase = 5 * time.Second
+	}
+	if cfg.BackoffMax <= 0 {
+		cfg.BackoffMax = time.Hour
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 2 * cfg.Timeout
+	}
+	return &Dispatcher{
+		store:  store,
+		client: newHTTPClient(cfg.Timeout, cfg.AllowPrivateTargets),
+		cfg:    cfg,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Sink returns the events.Sink queueing deliveries for the dispatcher
+func (d *Dispatcher) Sink() events.Sink {
+	return &Sink{dispatcher: d}
+}
+
+// notify wakes Run without blocking
+func (d *Dispatcher) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run attempts due deliveries until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := d.Deliver(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("Failed to deliver webhooks: %v", err)
+				}
+				break
+			}
+			if n < batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// Deliver attempts one batch of due deliveries and returns the number of
+// deliveries attempted
+func (d *Dispatcher) Deliver(ctx context.Context) (int, error) {
+	due, err := d.store.WebhookDelivery().ListDue(ctx, time.Now(), batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := map[string]*model.WebhookSubscription{}
+	for _, delivery := range due {
+		if _, ok := subscriptions[delivery.SubscriptionID]; ok {
+			continue
+		}
+		subscription, err := d.store.WebhookSubscription().Get(ctx, delivery.SubscriptionID)
+		if err != nil && !errors.Is(err, store.ErrWebhookSubscriptionNotFound) {
+			return 0, err
+		}
+		subscriptions[delivery.SubscriptionID] = subscription
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, d.cfg.Workers)
+	for i := range due {
+		id := due[i].ID
+		subscription := subscriptions[due[i].SubscriptionID]
+		if subscription == nil {
+			// Deleted since the scan; its deliveries are deleted with it
+			continue
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := d.claimAndDeliver(ctx, subscription, id); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return 0, firstErr
+	}
+	return len(due), nil
+}
+
+// claimAndDeliver claims a due delivery and makes one attempt of it. Deliveries
+// claimed by another replica are skipped.
+func (d *Dispatcher) claimAndDeliver(ctx context.Context, subscription *model.WebhookSubscription, id string) error {
+	now := time.Now()
+	delivery, err := d.store.WebhookDelivery().Claim(ctx, id, now, now.Add(d.cfg.Lease))
+	if err != nil {
+		if errors.Is(err, store.ErrWebhookDeliveryNotDue) {
+			return nil
+		}
+		return err
+	}
+	return d.deliver(ctx, subscription, delivery)
+}
+
+// deliver makes one attempt of a delivery and records the outcome
+func (d *Dispatcher) deliver(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) error {
+	err := d.send(ctx, subscription, delivery)
+	if ctx.Err() != nil {
+		// Shutting down; the delivery is retried once its lease expires
+		return ctx.Err()
+	}
+	if err != nil && delivery.Attempts < d.cfg.MaxAttempts {
+		delivery.State = model.WebhookDeliveryStatePending
+		delivery.NextAttemptTime = time.Now().Add(events.Backoff(delivery.Attempts, d.cfg.BackoffBase, d.cfg.BackoffMax))
+	} else if err != nil {
+		log.Printf("Webhook delivery %s of event %s to %s failed after %d attempts: %s",
+			delivery.ID, delivery.EventID, subscription.Path, delivery.Attempts, delivery.LastError)
+	}
+	return d.store.WebhookDelivery().UpdateResult(ctx, delivery)
+}
+
+// send makes one attempt of a delivery and sets its attempts, response code,
+// error and final state
+func (d *Dispatcher) send(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) error {
+	delivery.Attempts++
+	delivery.ResponseCode = 0
+	err := d.post(ctx, subscription, delivery)
+	if err != nil {
+		delivery.State = model.WebhookDeliveryStateFailed
+		delivery.LastError = err.Error()
+		return err
+	}
+	delivery.State = model.WebhookDeliveryStateSucceeded
+	delivery.LastError = ""
+	return nil
+}
+
+// post sends the signed request of a delivery
+func (d *Dispatcher) post(ctx context.Context, subscription *model.WebhookSubscription, delivery *model.WebhookDelivery) error {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", events.ContentType)
+	req.Header.Set(HeaderID, delivery.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	delivery.ResponseCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// Test sends a ping event to a subscription once, without retries, and
+// records the delivery
+func (d *Dispatcher) Test(ctx context.Context, subscription *model.WebhookSubscription) (*model.WebhookDelivery, error) {
+	now := time.Now().UTC()
+	event := events.Event{
+		SpecVersion:     events.SpecVersion,
+		ID:              uuid.New().String(),
+		Source:          d.cfg.Source,
+		Type:            model.EventWebhookPing,
+		Subject:         subscription.Path,
+		Time:            now,
+		DataContentType: "application/json",
+		Data:            map[string]any{"webhook_subscription": subscription.Path},
+		Tenant:          subscription.Tenant,
+	}
+	delivery, err := newDelivery(subscription, event)
+	if err != nil {
+		return nil, err
+	}
+	// The ping is attempted once, so its outcome is final
+	_ = d.send(ctx, subscription, delivery)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := d.store.WebhookDelivery().Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// newDelivery builds the pending delivery of an event to a subscription
+func newDelivery(subscription *model.WebhookSubscription, event events.Event) (*model.WebhookDelivery, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event: %w", err)
+	}
+	return &model.WebhookDelivery{
+		ID:              uuid.New().String(),
+		SubscriptionID:  subscription.ID,
+		Tenant:          subscription.Tenant,
+		EventID:         event.ID,
+		EventType:       event.Type,
+		Payload:         string(payload),
+		State:           model.WebhookDeliveryStatePending,
+		NextAttemptTime: time.Now(),
+	}, nil
+}
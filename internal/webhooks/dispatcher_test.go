@@ -0,0 +1,313 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dcm-project/catalog-manager/internal/events"
+	"github.com/dcm-project/catalog-manager/internal/store"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+	"github.com/dcm-project/catalog-manager/internal/webhooks"
+)
+
+const secret = "0123456789abcdef"
+
+// receiver is a webhook endpoint recording the requests it receives
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	w.WriteHeader(r.status)
+}
+
+func (r *receiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+var _ = Describe("Dispatcher", func() {
+	var (
+		ctx        context.Context
+		str        store.Store
+		recv       *receiver
+		srv        *httptest.Server
+		dispatcher *webhooks.Dispatcher
+	)
+
+	subscribe := func(id, tenant string, eventTypes []string, resourceFilter string) *model.WebhookSubscription {
+		subscription, err := str.WebhookSubscription().Create(ctx, model.WebhookSubscription{
+			ID:             id,
+			Tenant:         tenant,
+			URL:            srv.URL,
+			EventTypes:     eventTypes,
+			ResourceFilter: resourceFilter,
+			Secret:         secret,
+			Path:           "tenants/" + tenant + "/webhook-subscriptions/" + id,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return subscription
+	}
+
+	event := func(id, tenant, eventType, subject string) events.Event {
+		return events.Event{
+			SpecVersion: events.SpecVersion,
+			ID:          id,
+			Source:      "/test",
+			Type:        eventType,
+			Subject:     subject,
+			Time:        time.Now().UTC(),
+			Tenant:      tenant,
+		}
+	}
+
+	deliveries := func(subscriptionID string) model.WebhookDeliveryList {
+		result, err := str.WebhookDelivery().List(ctx, subscriptionID, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return result.WebhookDeliveries
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Discard,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		// A single connection keeps every goroutine on the same in-memory database
+		sqlDB, err := db.DB()
+		Expect(err).ToNot(HaveOccurred())
+		sqlDB.SetMaxOpenConns(1)
+		Expect(db.Exec("PRAGMA foreign_keys = ON").Error).To(Succeed())
+		Expect(db.AutoMigrate(&model.WebhookSubscription{}, &model.WebhookDelivery{})).To(Succeed())
+		str = store.NewStore(db)
+
+		recv = &receiver{status: http.StatusNoContent}
+		srv = httptest.NewServer(recv)
+		dispatcher = webhooks.NewDispatcher(str, webhooks.Config{
+			Source:      "/test",
+			MaxAttempts: 2,
+			// Keep failed deliveries due so that they are retried on the next Deliver
+			BackoffBase: time.Nanosecond,
+			BackoffMax:  time.Nanosecond,
+			// The receiver listens on the loopback address
+			AllowPrivateTargets: true,
+		})
+	})
+
+	AfterEach(func() {
+		srv.Close()
+		Expect(str.Close()).To(Succeed())
+	})
+
+	Describe("Sink", func() {
+		It("should queue events for the matching subscriptions only", func() {
+			subscribe("all-a", "team-a", nil, "")
+			subscribe("items-a", "team-a", []string{model.EventCatalogItemCreated}, "")
+			subscribe("prefix-a", "team-a", nil, "tenants/team-a/catalog-item-instances/")
+			subscribe("all-b", "team-b", nil, "")
+
+			sink := dispatcher.Sink()
+			Expect(sink.Send(ctx, event("e1", "team-a", model.EventCatalogItemInstanceCreated, "tenants/team-a/catalog-item-instances/vm"))).To(Succeed())
+			Expect(sink.Send(ctx, event("e2", "", model.EventCatalogItemCreated, "catalog-items/small-vm"))).To(Succeed())
+			// Resent by the relay after a failure of another sink
+			Expect(sink.Send(ctx, event("e2", "", model.EventCatalogItemCreated, "catalog-items/small-vm"))).To(Succeed())
+
+			Expect(deliveries("all-a")).To(HaveLen(2))
+			Expect(deliveries("items-a")).To(ConsistOf(HaveField("EventID", "e2")))
+			Expect(deliveries("prefix-a")).To(ConsistOf(HaveField("EventID", "e1")))
+			Expect(deliveries("all-b")).To(ConsistOf(HaveField("EventID", "e2")))
+		})
+	})
+
+	Describe("Deliver", func() {
+		It("should post signed CloudEvents", func() {
+			subscribe("hook", "team-a", nil, "")
+			Expect(dispatcher.Sink().Send(ctx, event("e1", "team-a", model.EventCatalogItemInstanceCreated, "tenants/team-a/catalog-item-instances/vm"))).To(Succeed())
+
+			n, err := dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(1))
+			Expect(recv.count()).To(Equal(1))
+
+			req, body := recv.requests[0], recv.bodies[0]
+			Expect(req.Header.Get("Content-Type")).To(Equal(events.ContentType))
+			timestamp, err := strconv.ParseInt(req.Header.Get(webhooks.HeaderTimestamp), 10, 64)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webhooks.Verify(secret, timestamp, body, req.Header.Get(webhooks.HeaderSignature))).To(BeTrue())
+			Expect(webhooks.Verify("another-secret-value", timestamp, body, req.Header.Get(webhooks.HeaderSignature))).To(BeFalse())
+
+			var received events.Event
+			Expect(json.Unmarshal(body, &received)).To(Succeed())
+			Expect(received.ID).To(Equal("e1"))
+
+			delivered := deliveries("hook")
+			Expect(delivered).To(HaveLen(1))
+			Expect(req.Header.Get(webhooks.HeaderID)).To(Equal(delivered[0].ID))
+			Expect(delivered[0].State).To(Equal(model.WebhookDeliveryStateSucceeded))
+			Expect(delivered[0].ResponseCode).To(Equal(http.StatusNoContent))
+		})
+
+		It("should retry failed deliveries and fail after the maximum attempts", func() {
+			recv.status = http.StatusServiceUnavailable
+			subscribe("hook", "team-a", nil, "")
+			Expect(dispatcher.Sink().Send(ctx, event("e1", "team-a", model.EventCatalogItemInstanceCreated, "tenants/team-a/catalog-item-instances/vm"))).To(Succeed())
+
+			_, err := dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			delivery := deliveries("hook")[0]
+			Expect(delivery.State).To(Equal(model.WebhookDeliveryStatePending))
+			Expect(delivery.Attempts).To(Equal(1))
+			Expect(delivery.ResponseCode).To(Equal(http.StatusServiceUnavailable))
+			Expect(delivery.LastError).To(ContainSubstring("503"))
+
+			_, err = dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			delivery = deliveries("hook")[0]
+			Expect(delivery.State).To(Equal(model.WebhookDeliveryStateFailed))
+			Expect(delivery.Attempts).To(Equal(2))
+
+			n, err := dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(BeZero())
+			Expect(recv.count()).To(Equal(2))
+		})
+
+		It("should skip deliveries claimed by another replica", func() {
+			subscribe("hook", "team-a", nil, "")
+			Expect(dispatcher.Sink().Send(ctx, event("e1", "team-a", model.EventCatalogItemInstanceCreated, "tenants/team-a/catalog-item-instances/vm"))).To(Succeed())
+
+			now := time.Now()
+			_, err := str.WebhookDelivery().Claim(ctx, deliveries("hook")[0].ID, now, now.Add(time.Minute))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recv.count()).To(BeZero())
+			Expect(deliveries("hook")[0].State).To(Equal(model.WebhookDeliveryStatePending))
+		})
+
+		It("should sign retries over the same body with a stable delivery ID", func() {
+			recv.status = http.StatusInternalServerError
+			subscribe("hook", "team-a", nil, "")
+			Expect(dispatcher.Sink().Send(ctx, event("e1", "team-a", model.EventCatalogItemInstanceCreated, "tenants/team-a/catalog-item-instances/vm"))).To(Succeed())
+
+			_, err := dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			recv.status = http.StatusOK
+			_, err = dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(recv.count()).To(Equal(2))
+			Expect(recv.bodies[1]).To(Equal(recv.bodies[0]))
+			Expect(recv.requests[1].Header.Get(webhooks.HeaderID)).To(Equal(recv.requests[0].Header.Get(webhooks.HeaderID)))
+			Expect(deliveries("hook")[0].State).To(Equal(model.WebhookDeliveryStateSucceeded))
+		})
+	})
+
+	Describe("Run", func() {
+		It("should deliver queued events without waiting for the poll interval", func() {
+			dispatcher = webhooks.NewDispatcher(str, webhooks.Config{PollInterval: time.Hour, AllowPrivateTargets: true})
+			subscribe("hook", "team-a", nil, "")
+
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				dispatcher.Run(runCtx)
+			}()
+			defer func() {
+				cancel()
+				<-done
+			}()
+
+			Expect(dispatcher.Sink().Send(ctx, event("e1", "team-a", model.EventCatalogItemInstanceCreated, "tenants/team-a/catalog-item-instances/vm"))).To(Succeed())
+			Eventually(recv.count).Should(Equal(1))
+		})
+	})
+
+	Describe("Test", func() {
+		It("should send a ping once and record the delivery", func() {
+			recv.status = http.StatusGone
+			subscription := subscribe("hook", "team-a", []string{model.EventCatalogItemCreated}, "")
+
+			delivery, err := dispatcher.Test(ctx, subscription)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery.EventType).To(Equal(model.EventWebhookPing))
+			Expect(delivery.State).To(Equal(model.WebhookDeliveryStateFailed))
+			Expect(delivery.ResponseCode).To(Equal(http.StatusGone))
+			Expect(recv.count()).To(Equal(1))
+
+			// Not retried
+			n, err := dispatcher.Deliver(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(BeZero())
+			Expect(deliveries("hook")).To(HaveLen(1))
+		})
+	})
+
+	Describe("Targets", func() {
+		It("should reject loopback, private and link-local targets", func() {
+			dispatcher = webhooks.NewDispatcher(str, webhooks.Config{})
+			for _, target := range []string{
+				"http://127.0.0.1:8080/hook",
+				"http://localhost/hook",
+				"https://10.1.2.3/hook",
+				"https://192.168.0.10/hook",
+				"http://169.254.169.254/latest/meta-data",
+				"http://[::1]/hook",
+				"http://[fe80::1]/hook",
+				"http://[::ffff:127.0.0.1]/hook",
+				"http://0.0.0.0/hook",
+			} {
+				Expect(dispatcher.CheckTarget(ctx, target)).To(MatchError(webhooks.ErrTargetNotAllowed), target)
+			}
+			Expect(dispatcher.CheckTarget(ctx, "https://203.0.113.10/hook")).To(Succeed())
+		})
+
+		It("should refuse to connect to a private address at delivery time", func() {
+			dispatcher = webhooks.NewDispatcher(str, webhooks.Config{})
+			subscription := subscribe("hook", "team-a", nil, "")
+
+			delivery, err := dispatcher.Test(ctx, subscription)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery.State).To(Equal(model.WebhookDeliveryStateFailed))
+			Expect(delivery.LastError).To(ContainSubstring(webhooks.ErrTargetNotAllowed.Error()))
+			Expect(recv.count()).To(BeZero())
+		})
+
+		It("should not follow redirects", func() {
+			redirected := &receiver{status: http.StatusNoContent}
+			target := httptest.NewServer(redirected)
+			defer target.Close()
+			srv.Config.Handler = http.RedirectHandler(target.URL, http.StatusTemporaryRedirect)
+			subscription := subscribe("hook", "team-a", nil, "")
+
+			delivery, err := dispatcher.Test(ctx, subscription)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery.State).To(Equal(model.WebhookDeliveryStateFailed))
+			Expect(delivery.ResponseCode).To(Equal(http.StatusTemporaryRedirect))
+			Expect(redirected.count()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,39 @@
+// Package webhooks delivers resource change events to the URLs registered as
+// webhook subscriptions, signing each request with the subscription's secret.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Headers set on every webhook request
+const (
+	// HeaderID carries the delivery ID, which is stable across retries
+	HeaderID = "X-DCM-Webhook-Id"
+	// HeaderTimestamp carries the Unix time of the attempt, in seconds
+	HeaderTimestamp = "X-DCM-Webhook-Timestamp"
+	// HeaderSignature carries the HMAC-SHA256 signature of the request
+	HeaderSignature = "X-DCM-Webhook-Signature"
+)
+
+// signaturePrefix names the algorithm in HeaderSignature
+const signaturePrefix = "sha256="
+
+// Sign returns the value of HeaderSignature for a request: the hex-encoded
+// HMAC-SHA256 of "{timestamp}.{body}" keyed with the secret
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the valid signature of a request.
+// Receivers should also reject timestamps too far in the past.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/internal/events"
+	"github.com/dcm-project/catalog-manager/internal/store/model"
+)
+
+// Sink is the relay sink fanning events out to the matching webhook
+// subscriptions. It only queues the deliveries, so that a slow or failing
+// endpoint does not hold up the relay; the Dispatcher attempts them.
+type Sink struct {
+	dispatcher *Dispatcher
+}
+
+// Name returns "webhooks"
+func (s *Sink) Name() string {
+	return "webhooks"
+}
+
+// Send queues a delivery of the event for every matching subscription.
+// Resending an event does not queue it twice.
+func (s *Sink) Send(ctx context.Context, event events.Event) error {
+	subscriptions, err := s.dispatcher.store.WebhookSubscription().ListForTenant(ctx, event.Tenant)
+	if err != nil {
+		return err
+	}
+
+	var deliveries model.WebhookDeliveryList
+	for i := range subscriptions {
+		if !Matches(&subscriptions[i], event) {
+			continue
+		}
+		delivery, err := newDelivery(&subscriptions[i], event)
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := s.dispatcher.store.WebhookDelivery().Enqueue(ctx, deliveries); err != nil {
+		return err
+	}
+	s.dispatcher.notify()
+	return nil
+}
+
+// Matches reports whether a subscription receives an event: the event
+// belongs to the subscription's tenant or to a global resource, and passes
+// the event type and resource filters
+func Matches(subscription *model.WebhookSubscription, event events.Event) bool {
+	if event.Tenant != "" && event.Tenant != subscription.Tenant {
+		return false
+	}
+	if len(subscription.EventTypes) > 0 && !slices.Contains(subscription.EventTypes, event.Type) {
+		return false
+	}
+	return strings.HasPrefix(event.Subject, subscription.ResourceFilter)
+}
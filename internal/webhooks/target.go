@@ -0,0 +1,85 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrTargetNotAllowed is returned when a webhook URL resolves to an address
+// webhooks may not be sent to
+var ErrTargetNotAllowed = errors.New("webhook target address is not allowed")
+
+// checkAddress rejects the loopback, private, link-local and unspecified
+// addresses, so that subscriptions cannot reach the internal network
+func checkAddress(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsUnspecified() {
+		return fmt.Errorf("%w: %s", ErrTargetNotAllowed, addr)
+	}
+	return nil
+}
+
+// CheckTarget reports whether webhooks may be sent to rawURL. Literal
+// addresses and the addresses a host name resolves to are checked; a host name
+// that cannot be resolved is accepted, as every connection is checked again.
+func (d *Dispatcher) CheckTarget(ctx context.Context, rawURL string) error {
+	if d.cfg.AllowPrivateTargets {
+		return nil
+	}
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := target.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return checkAddress(addr)
+	}
+	if host == "localhost" {
+		return fmt.Errorf("%w: %s", ErrTargetNotAllowed, host)
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if err := checkAddress(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newHTTPClient returns the client posting webhooks. Redirects are not
+// followed, and unless allowPrivate is set, connections to addresses rejected
+// by checkAddress fail, whatever the host name resolved to. Proxies are not
+// used, as the addresses they connect to cannot be checked.
+func newHTTPClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			return checkAddress(addrPort.Addr())
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
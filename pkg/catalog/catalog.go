@@ -0,0 +1,155 @@
+// Package catalog is a Go SDK for the DCM Catalog Manager API.
+//
+// It wraps the generated pkg/client with typed methods per resource,
+// errors that can be matched with errors.Is, iterators over every page of
+// list results, retries with backoff and authentication:
+//
+//	c, err := catalog.New("https://catalog.example.com/api/v1alpha1",
+//		catalog.WithToken(token), catalog.WithTenant("team-a"))
+//	if err != nil {
+//		return err
+//	}
+//	item, err := c.CatalogItems().Get(ctx, "small-vm")
+//	if errors.Is(err, catalog.ErrNotFound) {
+//		...
+//	}
+//	for st, err := range c.ServiceTypes().List(ctx, nil) {
+//		...
+//	}
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// TenantHeader is the HTTP header selecting the tenant of a request
+const TenantHeader = "X-Tenant-ID"
+
+// Client is a client of the Catalog Manager API. It is safe for concurrent use.
+type Client struct {
+	raw *client.ClientWithResponses
+}
+
+// Option configures a Client
+type Option func(*options)
+
+type options struct {
+	httpClient client.HttpRequestDoer
+	token      func(ctx context.Context) (string, error)
+	tenant     string
+	retry      RetryPolicy
+	userAgent  string
+}
+
+// WithHTTPClient sends the requests with doer instead of http.DefaultClient
+func WithHTTPClient(doer client.HttpRequestDoer) Option {
+	return func(o *options) {
+		o.httpClient = doer
+	}
+}
+
+// WithToken authenticates every request with a bearer token
+func WithToken(token string) Option {
+	return WithTokenFunc(func(context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// WithTokenFunc authenticates every request with the bearer token returned by
+// fn, which is called for each request so that it can refresh expired tokens
+func WithTokenFunc(fn func(ctx context.Context) (string, error)) Option {
+	return func(o *options) {
+		o.token = fn
+	}
+}
+
+// WithTenant sends every request on behalf of a tenant. Without it the server
+// uses its default tenant.
+func WithTenant(tenant string) Option {
+	return func(o *options) {
+		o.tenant = tenant
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. A policy with MaxAttempts 1
+// disables retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// New creates a Client for the API served at server, such as
+// "https://catalog.example.com/api/v1alpha1"
+func New(server string, opts ...Option) (*Client, error) {
+	o := options{httpClient: http.DefaultClient, retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	raw, err := client.NewClientWithResponses(server,
+		client.WithHTTPClient(&retryDoer{doer: o.httpClient, policy: o.retry.withDefaults()}),
+		client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if o.token != nil {
+				token, err := o.token(ctx)
+				if err != nil {
+					return fmt.Errorf("get token: %w", err)
+				}
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			if o.tenant != "" {
+				req.Header.Set(TenantHeader, o.tenant)
+			}
+			if o.userAgent != "" {
+				req.Header.Set("User-Agent", o.userAgent)
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{raw: raw}, nil
+}
+
+// Raw returns the generated client, for the operations the SDK does not wrap.
+// Its requests are authenticated and retried like those of the SDK.
+func (c *Client) Raw() *client.ClientWithResponses {
+	return c.raw
+}
+
+// ServiceTypes returns the client of service types
+func (c *Client) ServiceTypes() *ServiceTypes {
+	return &ServiceTypes{raw: c.raw}
+}
+
+// CatalogItems returns the client of catalog items
+func (c *Client) CatalogItems() *CatalogItems {
+	return &CatalogItems{raw: c.raw}
+}
+
+// CatalogItemInstances returns the client of catalog item instances
+func (c *Client) CatalogItemInstances() *CatalogItemInstances {
+	return &CatalogItemInstances{raw: c.raw}
+}
+
+// Operations returns the client of long-running operations
+func (c *Client) Operations() *Operations {
+	return &Operations{raw: c.raw}
+}
+
+// Quotas returns the client of quotas
+func (c *Client) Quotas() *Quotas {
+	return &Quotas{raw: c.raw}
+}
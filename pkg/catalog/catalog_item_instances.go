@@ -0,0 +1,119 @@
+package catalog
+
+import (
+	"context"
+	"iter"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// CatalogItemInstances is the client of catalog item instances
+type CatalogItemInstances struct {
+	raw *client.ClientWithResponses
+}
+
+// Get returns a catalog item instance
+func (c *CatalogItemInstances) Get(ctx context.Context, id string) (*v1alpha1.CatalogItemInstance, error) {
+	rsp, err := c.raw.GetCatalogItemInstanceWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// BatchGet returns the catalog item instances with the given IDs, in their
+// order. It fails with ErrNotFound if any of them does not exist.
+func (c *CatalogItemInstances) BatchGet(ctx context.Context, ids ...string) ([]v1alpha1.CatalogItemInstance, error) {
+	rsp, err := c.raw.BatchGetCatalogItemInstancesWithResponse(ctx, &v1alpha1.BatchGetCatalogItemInstancesParams{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+	batch, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+	return batch.Results, nil
+}
+
+// List iterates over the catalog item instances matching params, across all
+// pages starting at params.PageToken. The total size is not counted unless
+// params.SkipTotalSize is false.
+func (c *CatalogItemInstances) List(ctx context.Context, params *v1alpha1.ListCatalogItemInstancesParams) iter.Seq2[v1alpha1.CatalogItemInstance, error] {
+	p := v1alpha1.ListCatalogItemInstancesParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.SkipTotalSize == nil {
+		skip := true
+		p.SkipTotalSize = &skip
+	}
+	return paginate(ctx, p.PageToken, func(ctx context.Context, token *string) (*page[v1alpha1.CatalogItemInstance], error) {
+		p.PageToken = token
+		rsp, err := c.raw.ListCatalogItemInstancesWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		list, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+		if err != nil {
+			return nil, err
+		}
+		return &page[v1alpha1.CatalogItemInstance]{results: list.Results, next: list.NextPageToken}, nil
+	})
+}
+
+// Count returns the number of catalog item instances matching params, grouped
+// by params.GroupBy
+func (c *CatalogItemInstances) Count(ctx context.Context, params *v1alpha1.CountCatalogItemInstancesParams) (*v1alpha1.CatalogItemInstanceCounts, error) {
+	rsp, err := c.raw.CountCatalogItemInstancesWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// Create starts the creation of a catalog item instance. params may be nil to
+// let the server choose its ID. The returned operation can be waited for with
+// Operations().Wait.
+func (c *CatalogItemInstances) Create(ctx context.Context, params *v1alpha1.CreateCatalogItemInstanceParams, instance v1alpha1.CatalogItemInstance) (*v1alpha1.Operation, error) {
+	rsp, err := c.raw.CreateCatalogItemInstanceWithResponse(ctx, params, instance)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON202)
+}
+
+// BatchCreate starts the creation of catalog item instances, either all of
+// them or none. params may be nil. The returned operations, one per request
+// in the same order, can be waited for with Operations().Wait.
+func (c *CatalogItemInstances) BatchCreate(ctx context.Context, params *v1alpha1.BatchCreateCatalogItemInstancesParams, requests []v1alpha1.CreateCatalogItemInstanceRequest) ([]v1alpha1.Operation, error) {
+	rsp, err := c.raw.BatchCreateCatalogItemInstancesWithResponse(ctx, params, v1alpha1.BatchCreateCatalogItemInstancesRequest{Requests: requests})
+	if err != nil {
+		return nil, err
+	}
+	batch, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON202)
+	if err != nil {
+		return nil, err
+	}
+	return batch.Results, nil
+}
+
+// Delete starts the deletion of a catalog item instance. The returned
+// operation can be waited for with Operations().Wait.
+func (c *CatalogItemInstances) Delete(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	rsp, err := c.raw.DeleteCatalogItemInstanceWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON202)
+}
+
+// Convert previews the conversion of a catalog item instance to another
+// version of its service type
+func (c *CatalogItemInstances) Convert(ctx context.Context, id string, req v1alpha1.ConvertRequest) (*v1alpha1.CatalogItemInstanceConversion, error) {
+	rsp, err := c.raw.ConvertCatalogItemInstanceWithResponse(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
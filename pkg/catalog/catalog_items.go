@@ -0,0 +1,152 @@
+package catalog
+
+import (
+	"context"
+	"iter"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// CatalogItems is the client of catalog items
+type CatalogItems struct {
+	raw *client.ClientWithResponses
+}
+
+// Get returns a catalog item
+func (c *CatalogItems) Get(ctx context.Context, id string) (*v1alpha1.CatalogItem, error) {
+	rsp, err := c.raw.GetCatalogItemWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// BatchGet returns the catalog items with the given IDs, in their order. It
+// fails with ErrNotFound if any of them does not exist.
+func (c *CatalogItems) BatchGet(ctx context.Context, ids ...string) ([]v1alpha1.CatalogItem, error) {
+	rsp, err := c.raw.BatchGetCatalogItemsWithResponse(ctx, &v1alpha1.BatchGetCatalogItemsParams{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+	batch, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+	return batch.Results, nil
+}
+
+// List iterates over the catalog items matching params, across all pages
+// starting at params.PageToken. The total size is not counted unless
+// params.SkipTotalSize is false.
+func (c *CatalogItems) List(ctx context.Context, params *v1alpha1.ListCatalogItemsParams) iter.Seq2[v1alpha1.CatalogItem, error] {
+	p := v1alpha1.ListCatalogItemsParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.SkipTotalSize == nil {
+		skip := true
+		p.SkipTotalSize = &skip
+	}
+	return paginate(ctx, p.PageToken, func(ctx context.Context, token *string) (*page[v1alpha1.CatalogItem], error) {
+		p.PageToken = token
+		rsp, err := c.raw.ListCatalogItemsWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		list, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+		if err != nil {
+			return nil, err
+		}
+		return &page[v1alpha1.CatalogItem]{results: list.Results, next: list.NextPageToken}, nil
+	})
+}
+
+// Create creates a catalog item. params may be nil to let the server choose
+// its ID.
+func (c *CatalogItems) Create(ctx context.Context, params *v1alpha1.CreateCatalogItemParams, catalogItem v1alpha1.CatalogItem) (*v1alpha1.CatalogItem, error) {
+	rsp, err := c.raw.CreateCatalogItemWithResponse(ctx, params, catalogItem)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON201)
+}
+
+// Update applies a merge patch to a catalog item
+func (c *CatalogItems) Update(ctx context.Context, id string, patch v1alpha1.CatalogItem) (*v1alpha1.CatalogItem, error) {
+	rsp, err := c.raw.UpdateCatalogItemWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, id, patch)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// Apply creates the catalog item with the given ID, or updates it to match
+// catalogItem, and reports the changes. params may be nil; set ValidateOnly
+// to preview the changes without making them.
+func (c *CatalogItems) Apply(ctx context.Context, id string, params *v1alpha1.ApplyCatalogItemParams, catalogItem v1alpha1.CatalogItem) (*v1alpha1.CatalogItemApplyResult, error) {
+	rsp, err := c.raw.ApplyCatalogItemWithResponse(ctx, id, params, catalogItem)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// Delete deletes a catalog item
+func (c *CatalogItems) Delete(ctx context.Context, id string) error {
+	rsp, err := c.raw.DeleteCatalogItemWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return checkResponse(rsp.HTTPResponse, rsp.Body)
+}
+
+// Form returns the JSON Schema and UI schema of the form of a catalog item's
+// editable fields
+func (c *CatalogItems) Form(ctx context.Context, id string) (*v1alpha1.CatalogItemForm, error) {
+	rsp, err := c.raw.GetCatalogItemFormWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// Convert previews the conversion of a catalog item to another version of its
+// service type
+func (c *CatalogItems) Convert(ctx context.Context, id string, req v1alpha1.ConvertRequest) (*v1alpha1.CatalogItemConversion, error) {
+	rsp, err := c.raw.ConvertCatalogItemWithResponse(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// Revisions iterates over the revisions of a catalog item, across all pages
+// starting at params.PageToken
+func (c *CatalogItems) Revisions(ctx context.Context, id string, params *v1alpha1.ListCatalogItemRevisionsParams) iter.Seq2[v1alpha1.CatalogItemRevision, error] {
+	p := v1alpha1.ListCatalogItemRevisionsParams{}
+	if params != nil {
+		p = *params
+	}
+	return paginate(ctx, p.PageToken, func(ctx context.Context, token *string) (*page[v1alpha1.CatalogItemRevision], error) {
+		p.PageToken = token
+		rsp, err := c.raw.ListCatalogItemRevisionsWithResponse(ctx, id, &p)
+		if err != nil {
+			return nil, err
+		}
+		list, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+		if err != nil {
+			return nil, err
+		}
+		return &page[v1alpha1.CatalogItemRevision]{results: list.Results, next: list.NextPageToken}, nil
+	})
+}
+
+// Rollback restores a previous revision of a catalog item
+func (c *CatalogItems) Rollback(ctx context.Context, id string, req v1alpha1.RollbackCatalogItemRequest) (*v1alpha1.CatalogItem, error) {
+	rsp, err := c.raw.RollbackCatalogItemWithResponse(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
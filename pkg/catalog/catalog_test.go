@@ -0,0 +1,319 @@
+package catalog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/catalog"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		calls   atomic.Int32
+		server  *httptest.Server
+		handler http.HandlerFunc
+		c       *catalog.Client
+		ctx     context.Context
+	)
+
+	// fastRetries keeps the retry tests quick
+	fastRetries := catalog.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	writeJSON := func(w http.ResponseWriter, status int, body any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+
+	writeProblem := func(w http.ResponseWriter, status int, errorType v1alpha1.ErrorType, title string) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v1alpha1.Error{Type: errorType, Status: int32(status), Title: title})
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		calls.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			handler(w, r)
+		}))
+		var err error
+		c, err = catalog.New(server.URL, catalog.WithRetryPolicy(fastRetries))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Get", func() {
+		It("should decode the resource", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/service-types/vm-v1"))
+				writeJSON(w, http.StatusOK, map[string]any{"service_type": "vm", "api_version": "v1", "spec": map[string]any{}})
+			}
+
+			st, err := c.ServiceTypes().Get(ctx, "vm-v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(st.ServiceType).To(Equal("vm"))
+		})
+
+		It("should return errors matching the sentinel of their type", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusNotFound, v1alpha1.NOTFOUND, "Catalog item not found")
+			}
+
+			_, err := c.CatalogItems().Get(ctx, "missing")
+			Expect(errors.Is(err, catalog.ErrNotFound)).To(BeTrue())
+			Expect(errors.Is(err, catalog.ErrAlreadyExists)).To(BeFalse())
+			var apiErr *catalog.Error
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.Status).To(Equal(http.StatusNotFound))
+			Expect(apiErr.Title).To(Equal("Catalog item not found"))
+		})
+
+		It("should map the status of responses without an error body", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = io.WriteString(w, "denied by proxy")
+			}
+
+			_, err := c.Quotas().Get(ctx, "q")
+			Expect(errors.Is(err, catalog.ErrPermissionDenied)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("denied by proxy")))
+		})
+	})
+
+	Describe("BatchGet", func() {
+		It("should repeat the ids parameter and keep the order of the results", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/catalog-items:batchGet"))
+				Expect(r.URL.Query()["ids"]).To(Equal([]string{"small-vm", "large-vm"}))
+				writeJSON(w, http.StatusOK, map[string]any{"results": []map[string]any{
+					{"uid": "small-vm", "api_version": "v1alpha1", "spec": map[string]any{"service_type": "vm", "fields": []any{}}},
+					{"uid": "large-vm", "api_version": "v1alpha1", "spec": map[string]any{"service_type": "vm", "fields": []any{}}},
+				}})
+			}
+
+			items, err := c.CatalogItems().BatchGet(ctx, "small-vm", "large-vm")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(items).To(HaveLen(2))
+			Expect(*items[1].Uid).To(Equal("large-vm"))
+		})
+	})
+
+	Describe("List", func() {
+		It("should iterate over every page", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("service_type")).To(Equal("vm"))
+				switch r.URL.Query().Get("page_token") {
+				case "":
+					writeJSON(w, http.StatusOK, map[string]any{"results": []any{map[string]any{"uid": "a"}, map[string]any{"uid": "b"}}, "next_page_token": "p2"})
+				case "p2":
+					writeJSON(w, http.StatusOK, map[string]any{"results": []any{map[string]any{"uid": "c"}}, "next_page_token": ""})
+				}
+			}
+
+			serviceType := "vm"
+			var uids []string
+			for item, err := range c.CatalogItems().List(ctx, &v1alpha1.ListCatalogItemsParams{ServiceType: &serviceType}) {
+				Expect(err).ToNot(HaveOccurred())
+				uids = append(uids, *item.Uid)
+			}
+			Expect(uids).To(Equal([]string{"a", "b", "c"}))
+			Expect(calls.Load()).To(Equal(int32(2)))
+		})
+
+		It("should not fetch the next page when the iteration stops", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, map[string]any{"results": []any{map[string]any{"uid": "a"}, map[string]any{"uid": "b"}}, "next_page_token": "p2"})
+			}
+
+			for range c.CatalogItems().List(ctx, nil) {
+				break
+			}
+			Expect(calls.Load()).To(Equal(int32(1)))
+		})
+
+		It("should yield the error of a page and stop", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusBadRequest, v1alpha1.INVALIDARGUMENT, "Invalid page token")
+			}
+
+			var errs []error
+			for _, err := range c.ServiceTypes().List(ctx, nil) {
+				errs = append(errs, err)
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errors.Is(errs[0], catalog.ErrInvalidArgument)).To(BeTrue())
+		})
+	})
+
+	Describe("Retries", func() {
+		It("should retry unavailable responses", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				if calls.Load() < 3 {
+					writeProblem(w, http.StatusServiceUnavailable, v1alpha1.UNAVAILABLE, "Unavailable")
+					return
+				}
+				writeJSON(w, http.StatusOK, map[string]any{"uid": "a"})
+			}
+
+			item, err := c.CatalogItems().Get(ctx, "a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*item.Uid).To(Equal("a"))
+			Expect(calls.Load()).To(Equal(int32(3)))
+		})
+
+		It("should give up after the maximum number of attempts", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusServiceUnavailable, v1alpha1.UNAVAILABLE, "Unavailable")
+			}
+
+			_, err := c.CatalogItems().Get(ctx, "a")
+			Expect(errors.Is(err, catalog.ErrUnavailable)).To(BeTrue())
+			Expect(calls.Load()).To(Equal(int32(3)))
+		})
+
+		It("should resend the body of retried requests", func() {
+			var bodies []string
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				if calls.Load() == 1 {
+					writeProblem(w, http.StatusServiceUnavailable, v1alpha1.UNAVAILABLE, "Unavailable")
+					return
+				}
+				writeJSON(w, http.StatusCreated, map[string]any{"uid": "q"})
+			}
+
+			_, err := c.Quotas().Create(ctx, nil, v1alpha1.Quota{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bodies).To(HaveLen(2))
+			Expect(bodies[1]).To(Equal(bodies[0]))
+		})
+
+		It("should not retry other 5xx responses to non-idempotent requests", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusInternalServerError, v1alpha1.INTERNAL, "Internal error")
+			}
+
+			_, err := c.CatalogItemInstances().Create(ctx, nil, v1alpha1.CatalogItemInstance{})
+			Expect(errors.Is(err, catalog.ErrInternal)).To(BeTrue())
+			Expect(calls.Load()).To(Equal(int32(1)))
+		})
+
+		It("should retry other 5xx responses to idempotent requests", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusInternalServerError, v1alpha1.INTERNAL, "Internal error")
+			}
+
+			err := c.CatalogItems().Delete(ctx, "a")
+			Expect(errors.Is(err, catalog.ErrInternal)).To(BeTrue())
+			Expect(calls.Load()).To(Equal(int32(3)))
+		})
+
+		It("should retry other 5xx responses to creates with an idempotency key", func() {
+			var keys []string
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				keys = append(keys, r.Header.Get("Idempotency-Key"))
+				writeProblem(w, http.StatusInternalServerError, v1alpha1.INTERNAL, "Internal error")
+			}
+
+			key := "create-1"
+			params := &v1alpha1.CreateCatalogItemInstanceParams{IdempotencyKey: &key}
+			_, err := c.CatalogItemInstances().Create(ctx, params, v1alpha1.CatalogItemInstance{})
+			Expect(errors.Is(err, catalog.ErrInternal)).To(BeTrue())
+			Expect(keys).To(Equal([]string{key, key, key}))
+		})
+
+		It("should not retry client errors", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(w, http.StatusConflict, v1alpha1.ALREADYEXISTS, "Already exists")
+			}
+
+			_, err := c.ServiceTypes().Get(ctx, "vm-v1")
+			Expect(errors.Is(err, catalog.ErrAlreadyExists)).To(BeTrue())
+			Expect(calls.Load()).To(Equal(int32(1)))
+		})
+
+		It("should stop retrying when the context is cancelled", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "10")
+				writeProblem(w, http.StatusServiceUnavailable, v1alpha1.UNAVAILABLE, "Unavailable")
+			}
+			var err error
+			c, err = catalog.New(server.URL, catalog.WithRetryPolicy(catalog.RetryPolicy{MaxAttempts: 5, MaxBackoff: time.Minute}))
+			Expect(err).ToNot(HaveOccurred())
+			ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+
+			_, err = c.ServiceTypes().Get(ctx, "vm-v1")
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+			Expect(calls.Load()).To(Equal(int32(1)))
+		})
+	})
+
+	Describe("Authentication", func() {
+		It("should send the token and tenant with every request", func() {
+			var headers http.Header
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				headers = r.Header
+				writeJSON(w, http.StatusOK, map[string]any{})
+			}
+			var err error
+			c, err = catalog.New(server.URL, catalog.WithToken("secret"), catalog.WithTenant("team-a"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = c.Operations().Get(ctx, "op-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(headers.Get("Authorization")).To(Equal("Bearer secret"))
+			Expect(headers.Get(catalog.TenantHeader)).To(Equal("team-a"))
+		})
+
+		It("should fail the request when the token cannot be obtained", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {}
+			var err error
+			c, err = catalog.New(server.URL, catalog.WithTokenFunc(func(context.Context) (string, error) {
+				return "", errors.New("token expired")
+			}))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = c.Operations().Get(ctx, "op-1")
+			Expect(err).To(MatchError(ContainSubstring("token expired")))
+			Expect(calls.Load()).To(BeZero())
+		})
+	})
+
+	Describe("Operations", func() {
+		It("should wait for an operation and report its error", func() {
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/operations/op-1:wait"))
+				done := calls.Load() >= 2
+				op := v1alpha1.Operation{Done: done}
+				if done {
+					op.Error = &v1alpha1.Error{Type: v1alpha1.CANCELLED, Status: 499, Title: "Cancelled"}
+				}
+				writeJSON(w, http.StatusOK, op)
+			}
+
+			op, err := c.Operations().Wait(ctx, "op-1")
+			Expect(op.Done).To(BeTrue())
+			var opErr *client.OperationError
+			Expect(errors.As(err, &opErr)).To(BeTrue())
+			Expect(opErr.IsCancelled()).To(BeTrue())
+		})
+	})
+})
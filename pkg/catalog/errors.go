@@ -0,0 +1,124 @@
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+)
+
+// Sentinel errors matching the type of the errors returned by the API, for
+// use with errors.Is
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrOutOfRange         = errors.New("out of range")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrNotFound           = errors.New("not found")
+	ErrAborted            = errors.New("aborted")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrResourceExhausted  = errors.New("resource exhausted")
+	ErrCancelled          = errors.New("cancelled")
+	ErrInternal           = errors.New("internal error")
+	ErrUnimplemented      = errors.New("unimplemented")
+	ErrUnavailable        = errors.New("unavailable")
+	ErrDeadlineExceeded   = errors.New("deadline exceeded")
+)
+
+var sentinels = map[v1alpha1.ErrorType]error{
+	v1alpha1.INVALIDARGUMENT:    ErrInvalidArgument,
+	v1alpha1.FAILEDPRECONDITION: ErrFailedPrecondition,
+	v1alpha1.OUTOFRANGE:         ErrOutOfRange,
+	v1alpha1.UNAUTHENTICATED:    ErrUnauthenticated,
+	v1alpha1.PERMISSIONDENIED:   ErrPermissionDenied,
+	v1alpha1.NOTFOUND:           ErrNotFound,
+	v1alpha1.ABORTED:            ErrAborted,
+	v1alpha1.ALREADYEXISTS:      ErrAlreadyExists,
+	v1alpha1.RESOURCEEXHAUSTED:  ErrResourceExhausted,
+	v1alpha1.CANCELLED:          ErrCancelled,
+	v1alpha1.INTERNAL:           ErrInternal,
+	v1alpha1.UNIMPLEMENTED:      ErrUnimplemented,
+	v1alpha1.UNAVAILABLE:        ErrUnavailable,
+	v1alpha1.DEADLINEEXCEEDED:   ErrDeadlineExceeded,
+}
+
+// Error is an RFC 7807 error returned by the API. It matches the sentinel
+// error of its type with errors.Is.
+type Error struct {
+	// Type is the machine-readable error code, such as NOT_FOUND
+	Type v1alpha1.ErrorType
+	// Status is the HTTP status of the response
+	Status int
+	// Title is the summary of the error type
+	Title string
+	// Detail explains this occurrence of the error, when the server gave one
+	Detail string
+	// Instance identifies this occurrence of the error, when the server gave one
+	Instance string
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s: %s", e.Type, e.Title)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return msg
+}
+
+// Is reports whether target is the sentinel error of the type of e
+func (e *Error) Is(target error) bool {
+	sentinel, ok := sentinels[e.Type]
+	return ok && sentinel == target
+}
+
+// errorTypes maps the HTTP status of responses without an error body to an error type
+var errorTypes = map[int]v1alpha1.ErrorType{
+	http.StatusBadRequest:          v1alpha1.INVALIDARGUMENT,
+	http.StatusUnauthorized:        v1alpha1.UNAUTHENTICATED,
+	http.StatusForbidden:           v1alpha1.PERMISSIONDENIED,
+	http.StatusNotFound:            v1alpha1.NOTFOUND,
+	http.StatusConflict:            v1alpha1.ALREADYEXISTS,
+	http.StatusTooManyRequests:     v1alpha1.RESOURCEEXHAUSTED,
+	http.StatusNotImplemented:      v1alpha1.UNIMPLEMENTED,
+	http.StatusServiceUnavailable:  v1alpha1.UNAVAILABLE,
+	http.StatusGatewayTimeout:      v1alpha1.DEADLINEEXCEEDED,
+	http.StatusInternalServerError: v1alpha1.INTERNAL,
+}
+
+// checkResponse returns nil for successful responses, or the *Error they carry
+func checkResponse(rsp *http.Response, body []byte) error {
+	if rsp.StatusCode < 300 {
+		return nil
+	}
+	problem := v1alpha1.Error{}
+	if err := json.Unmarshal(body, &problem); err != nil || problem.Type == "" {
+		// Not an RFC 7807 body, such as the error page of a proxy
+		errorType, ok := errorTypes[rsp.StatusCode]
+		if !ok {
+			errorType = v1alpha1.INTERNAL
+		}
+		return &Error{Type: errorType, Status: rsp.StatusCode, Title: http.StatusText(rsp.StatusCode), Detail: string(body)}
+	}
+	apiErr := &Error{Type: problem.Type, Status: rsp.StatusCode, Title: problem.Title}
+	if problem.Detail != nil {
+		apiErr.Detail = *problem.Detail
+	}
+	if problem.Instance != nil {
+		apiErr.Instance = *problem.Instance
+	}
+	return apiErr
+}
+
+// result returns the decoded body of a successful response
+func result[T any](rsp *http.Response, body []byte, value *T) (*T, error) {
+	if err := checkResponse(rsp, body); err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("unexpected response: %s", rsp.Status)
+	}
+	return value, nil
+}
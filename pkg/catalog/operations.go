@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"context"
+	"iter"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// waitTimeout is the server-side timeout of each :wait call made by Wait
+const waitTimeout = "30s"
+
+// Operations is the client of long-running operations
+type Operations struct {
+	raw *client.ClientWithResponses
+}
+
+// Get returns an operation
+func (c *Operations) Get(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	rsp, err := c.raw.GetOperationWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// List iterates over the operations matching params, across all pages
+// starting at params.PageToken
+func (c *Operations) List(ctx context.Context, params *v1alpha1.ListOperationsParams) iter.Seq2[v1alpha1.Operation, error] {
+	p := v1alpha1.ListOperationsParams{}
+	if params != nil {
+		p = *params
+	}
+	return paginate(ctx, p.PageToken, func(ctx context.Context, token *string) (*page[v1alpha1.Operation], error) {
+		p.PageToken = token
+		rsp, err := c.raw.ListOperationsWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		list, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+		if err != nil {
+			return nil, err
+		}
+		return &page[v1alpha1.Operation]{results: list.Results, next: list.NextPageToken}, nil
+	})
+}
+
+// Cancel requests the cancellation of an operation
+func (c *Operations) Cancel(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	rsp, err := c.raw.CancelOperationWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// Wait calls :wait on an operation until it is done or ctx is cancelled. It
+// returns the completed operation, with a *client.OperationError if the
+// operation completed with an error.
+func (c *Operations) Wait(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	timeout := waitTimeout
+	params := &v1alpha1.WaitOperationParams{Timeout: &timeout}
+
+	for {
+		rsp, err := c.raw.WaitOperationWithResponse(ctx, id, params)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, err
+		}
+		op, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+		if err != nil {
+			return nil, err
+		}
+		if op.Done {
+			if op.Error != nil {
+				return op, &client.OperationError{Operation: op}
+			}
+			return op, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return op, err
+		}
+	}
+}
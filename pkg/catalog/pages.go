@@ -0,0 +1,39 @@
+package catalog
+
+import (
+	"context"
+	"iter"
+)
+
+// page is a page of results and the token of the next one, empty on the last page
+type page[T any] struct {
+	results []T
+	next    string
+}
+
+// paginate iterates over the results of every page fetched with list, starting
+// at the page of token, nil for the first one. Iteration stops after the
+// first error.
+func paginate[T any](ctx context.Context, token *string, list func(ctx context.Context, token *string) (*page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		token := token
+		for {
+			p, err := list(ctx, token)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, result := range p.results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+			if p.next == "" {
+				return
+			}
+			next := p.next
+			token = &next
+		}
+	}
+}
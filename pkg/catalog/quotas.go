@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"context"
+	"iter"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// Quotas is the client of quotas
+type Quotas struct {
+	raw *client.ClientWithResponses
+}
+
+// Get returns a quota
+func (c *Quotas) Get(ctx context.Context, id string) (*v1alpha1.Quota, error) {
+	rsp, err := c.raw.GetQuotaWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// List iterates over the quotas matching params, across all pages starting
+// at params.PageToken
+func (c *Quotas) List(ctx context.Context, params *v1alpha1.ListQuotasParams) iter.Seq2[v1alpha1.Quota, error] {
+	p := v1alpha1.ListQuotasParams{}
+	if params != nil {
+		p = *params
+	}
+	return paginate(ctx, p.PageToken, func(ctx context.Context, token *string) (*page[v1alpha1.Quota], error) {
+		p.PageToken = token
+		rsp, err := c.raw.ListQuotasWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		list, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+		if err != nil {
+			return nil, err
+		}
+		return &page[v1alpha1.Quota]{results: list.Results, next: list.NextPageToken}, nil
+	})
+}
+
+// Create creates a quota. params may be nil to let the server choose its ID.
+func (c *Quotas) Create(ctx context.Context, params *v1alpha1.CreateQuotaParams, quota v1alpha1.Quota) (*v1alpha1.Quota, error) {
+	rsp, err := c.raw.CreateQuotaWithResponse(ctx, params, quota)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON201)
+}
+
+// Delete deletes a quota
+func (c *Quotas) Delete(ctx context.Context, id string) error {
+	rsp, err := c.raw.DeleteQuotaWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return checkResponse(rsp.HTTPResponse, rsp.Body)
+}
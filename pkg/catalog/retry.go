@@ -0,0 +1,134 @@
+package catalog
+
+import (
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// RetryPolicy configures the retries of failed requests. Requests answered
+// with 503 Service Unavailable (UNAVAILABLE) are retried whatever their method.
+// Other 5xx responses and network errors are only retried for idempotent
+// methods (GET, HEAD, OPTIONS, PUT and DELETE) and for requests carrying an
+// Idempotency-Key header or a request_id, as the server may have applied a
+// POST or PATCH before failing.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts of a request, including the first.
+	// 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. The delay doubles
+	// on each retry, with jitter, up to MaxBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, including the delays asked
+	// by the server with Retry-After
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the policy of clients created without WithRetryPolicy
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// withDefaults fills the unset fields of a policy
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = 1
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = DefaultRetryPolicy.InitialBackoff
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+	return p
+}
+
+// backoff returns the delay before a retry, the first retry being 1
+func (p RetryPolicy) backoff(retry int, rsp *http.Response) time.Duration {
+	if rsp != nil {
+		if seconds, err := strconv.Atoi(rsp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, p.MaxBackoff)
+		}
+	}
+	delay := p.InitialBackoff << (retry - 1)
+	if delay <= 0 || delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	// Full jitter over the upper half, so that clients failing together do
+	// not retry together
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// retryDoer sends requests with a doer and retries them following a policy
+type retryDoer struct {
+	doer   client.HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		rsp, err := d.doer.Do(req)
+		if attempt >= d.policy.MaxAttempts || !retryable(req, rsp, err) {
+			return rsp, err
+		}
+		// The body of a request can only be sent again if it can be rewound
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return rsp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return rsp, err
+			}
+			req.Body = body
+		}
+
+		timer := time.NewTimer(d.policy.backoff(attempt, rsp))
+		if rsp != nil {
+			_, _ = io.Copy(io.Discard, rsp.Body)
+			rsp.Body.Close()
+		}
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether the outcome of a request is worth a retry
+func retryable(req *http.Request, rsp *http.Response, err error) bool {
+	if err != nil {
+		if req.Context().Err() != nil || errors.Is(err, req.Context().Err()) {
+			return false
+		}
+		return idempotent(req)
+	}
+	switch {
+	case rsp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case rsp.StatusCode >= 500 && rsp.StatusCode != http.StatusNotImplemented:
+		return idempotent(req)
+	default:
+		return false
+	}
+}
+
+// idempotent reports whether a request can safely be sent twice, either by
+// its method or because it carries an idempotency key the server deduplicates
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return req.Header.Get("Idempotency-Key") != "" || req.URL.Query().Get("request_id") != ""
+	}
+}
@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"context"
+	"iter"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// ServiceTypes is the client of service types
+type ServiceTypes struct {
+	raw *client.ClientWithResponses
+}
+
+// Get returns a service type version
+func (c *ServiceTypes) Get(ctx context.Context, id string) (*v1alpha1.ServiceType, error) {
+	rsp, err := c.raw.GetServiceTypeWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// List iterates over the service type versions matching params, across all
+// pages starting at params.PageToken. The total size is not counted unless
+// params.SkipTotalSize is false.
+func (c *ServiceTypes) List(ctx context.Context, params *v1alpha1.ListServiceTypesParams) iter.Seq2[v1alpha1.ServiceType, error] {
+	p := v1alpha1.ListServiceTypesParams{}
+	if params != nil {
+		p = *params
+	}
+	if p.SkipTotalSize == nil {
+		skip := true
+		p.SkipTotalSize = &skip
+	}
+	return paginate(ctx, p.PageToken, func(ctx context.Context, token *string) (*page[v1alpha1.ServiceType], error) {
+		p.PageToken = token
+		rsp, err := c.raw.ListServiceTypesWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		list, err := result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+		if err != nil {
+			return nil, err
+		}
+		return &page[v1alpha1.ServiceType]{results: list.Results, next: list.NextPageToken}, nil
+	})
+}
+
+// Create creates a service type version. params may be nil to let the
+// server choose its ID.
+func (c *ServiceTypes) Create(ctx context.Context, params *v1alpha1.CreateServiceTypeParams, serviceType v1alpha1.ServiceType) (*v1alpha1.ServiceType, error) {
+	rsp, err := c.raw.CreateServiceTypeWithResponse(ctx, params, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON201)
+}
+
+// Update applies a merge patch to a service type version
+func (c *ServiceTypes) Update(ctx context.Context, id string, patch v1alpha1.ServiceTypeUpdate) (*v1alpha1.ServiceType, error) {
+	rsp, err := c.raw.UpdateServiceTypeWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, id, patch)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
+
+// Apply creates the service type version with the given ID, or updates it to
+// match serviceType, and reports the changes. params may be nil; set
+// ValidateOnly to preview the changes without making them.
+func (c *ServiceTypes) Apply(ctx context.Context, id string, params *v1alpha1.ApplyServiceTypeParams, serviceType v1alpha1.ServiceType) (*v1alpha1.ServiceTypeApplyResult, error) {
+	rsp, err := c.raw.ApplyServiceTypeWithResponse(ctx, id, params, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	return result(rsp.HTTPResponse, rsp.Body, rsp.JSON200)
+}
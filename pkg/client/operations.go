@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+)
+
+// DefaultPollInterval is the interval PollOperation uses when none is given
+const DefaultPollInterval = 2 * time.Second
+
+// waitTimeout is the server-side timeout of each :wait call made by WaitOperationDone
+const waitTimeout = "30s"
+
+// OperationError is returned by the operation helpers when an operation
+// completed with an error
+type OperationError struct {
+	Operation *v1alpha1.Operation
+}
+
+func (e *OperationError) Error() string {
+	err := e.Operation.Error
+	msg := fmt.Sprintf("operation failed: %s: %s", err.Type, err.Title)
+	if err.Detail != nil {
+		msg += ": " + *err.Detail
+	}
+	return msg
+}
+
+// IsCancelled reports whether the operation was cancelled
+func (e *OperationError) IsCancelled() bool {
+	return e.Operation.Error.Type == v1alpha1.CANCELLED
+}
+
+// PollOperation gets an operation every interval until it is done or ctx is
+// cancelled. It returns the completed operation, with an *OperationError if the
+// operation completed with an error.
+func (c *ClientWithResponses) PollOperation(ctx context.Context, operationId v1alpha1.OperationIdPath, interval time.Duration, reqEditors ...RequestEditorFn) (*v1alpha1.Operation, error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		rsp, err := c.GetOperationWithResponse(ctx, operationId, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.JSON200 == nil {
+			return nil, unexpectedResponse(rsp.HTTPResponse, rsp.Body)
+		}
+		if rsp.JSON200.Done {
+			return completed(rsp.JSON200)
+		}
+
+		select {
+		case <-ctx.Done():
+			return rsp.JSON200, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitOperationDone calls :wait on an operation until it is done or ctx is
+// cancelled. It returns the completed operation, with an *OperationError if the
+// operation completed with an error.
+func (c *ClientWithResponses) WaitOperationDone(ctx context.Context, operationId v1alpha1.OperationIdPath, reqEditors ...RequestEditorFn) (*v1alpha1.Operation, error) {
+	timeout := waitTimeout
+	params := &v1alpha1.WaitOperationParams{Timeout: &timeout}
+
+	for {
+		rsp, err := c.WaitOperationWithResponse(ctx, operationId, params, reqEditors...)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, err
+		}
+		if rsp.JSON200 == nil {
+			return nil, unexpectedResponse(rsp.HTTPResponse, rsp.Body)
+		}
+		if rsp.JSON200.Done {
+			return completed(rsp.JSON200)
+		}
+		if err := ctx.Err(); err != nil {
+			return rsp.JSON200, err
+		}
+	}
+}
+
+// completed returns a done operation, with an *OperationError if it failed
+func completed(op *v1alpha1.Operation) (*v1alpha1.Operation, error) {
+	if op.Error != nil {
+		return op, &OperationError{Operation: op}
+	}
+	return op, nil
+}
+
+// unexpectedResponse builds the error for a response other than 200 OK
+func unexpectedResponse(rsp *http.Response, body []byte) error {
+	if len(body) == 0 {
+		return errors.New(rsp.Status)
+	}
+	return fmt.Errorf("%s: %s", rsp.Status, body)
+}
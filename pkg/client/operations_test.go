@@ -0,0 +1,98 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+var _ = Describe("Operation helpers", func() {
+	var (
+		calls  atomic.Int32
+		server *httptest.Server
+		c      *client.ClientWithResponses
+		// respond builds the operation returned by the nth call
+		respond func(n int32) v1alpha1.Operation
+	)
+
+	BeforeEach(func() {
+		calls.Store(0)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := calls.Add(1)
+			if r.URL.Path == "/operations/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(respond(n))
+		}))
+		var err error
+		c, err = client.NewClientWithResponses(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("PollOperation", func() {
+		It("should poll until the operation is done", func() {
+			respond = func(n int32) v1alpha1.Operation {
+				return v1alpha1.Operation{Done: n >= 3}
+			}
+
+			op, err := c.PollOperation(context.Background(), "op-1", time.Millisecond)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeTrue())
+			Expect(calls.Load()).To(Equal(int32(3)))
+		})
+
+		It("should return an OperationError for failed operations", func() {
+			respond = func(int32) v1alpha1.Operation {
+				return v1alpha1.Operation{Done: true, Error: &v1alpha1.Error{Type: v1alpha1.CANCELLED, Status: 499, Title: "Cancelled"}}
+			}
+
+			_, err := c.PollOperation(context.Background(), "op-1", time.Millisecond)
+			var opErr *client.OperationError
+			Expect(errors.As(err, &opErr)).To(BeTrue())
+			Expect(opErr.IsCancelled()).To(BeTrue())
+		})
+
+		It("should stop when the context is cancelled", func() {
+			respond = func(int32) v1alpha1.Operation { return v1alpha1.Operation{} }
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			_, err := c.PollOperation(ctx, "op-1", time.Millisecond)
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+		})
+
+		It("should report unexpected responses", func() {
+			_, err := c.PollOperation(context.Background(), "missing", time.Millisecond)
+			Expect(err).To(MatchError(ContainSubstring("404")))
+		})
+	})
+
+	Describe("WaitOperationDone", func() {
+		It("should call :wait until the operation is done", func() {
+			respond = func(n int32) v1alpha1.Operation {
+				return v1alpha1.Operation{Done: n >= 2}
+			}
+
+			op, err := c.WaitOperationDone(context.Background(), "op-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(op.Done).To(BeTrue())
+			Expect(calls.Load()).To(Equal(int32(2)))
+		})
+	})
+})